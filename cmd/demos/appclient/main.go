@@ -32,35 +32,15 @@ func main() {
 func TestRPCAppClient() {
 	ctx := context.Background()
 
-	connect := func() (*app.AppRPCClient, error) {
-		const APP_RPC_SERVICE_CLIENT_ID = app.ServiceID(0xdb6c5b7c386221bc)
-		return app.NewAppClient(APP_RPC_SERVICE_CLIENT_ID)
-		//return app.NewAppClientForAddr(APP_RPC_SERVICE_CLIENT_ID, "") // for local testing
-	}
-
-	appClient, err := connect()
+	// the client reconnects transparently
+	const APP_RPC_SERVICE_CLIENT_ID = app.ServiceID(0xdb6c5b7c386221bc)
+	appClient, err := app.NewAppClient(APP_RPC_SERVICE_CLIENT_ID)
+	//appClient, err := app.NewAppClientForAddr(APP_RPC_SERVICE_CLIENT_ID, "") // for local testing
 	if err != nil {
 		app.Logger().Error().Err(err).Msg("Failed to create App RPCService client")
-	} else {
-		app.Logger().Info().Msg("App RPCService client is connected")
-
-		if result, err := appClient.Id(ctx).Struct(); err != nil {
-			app.Logger().Error().Err(err).Msg("RPC App.Id() error")
-		} else {
-			app.Logger().Info().Msgf("app id : %x", result.AppId())
-		}
-	}
-
-	reconnect := func() {
-		app.Logger().Error().Err(err).Msg("RPC App.Id() error")
-
-		appClient, err = connect()
-		if err != nil {
-			app.Logger().Error().Err(err).Msg("Failed to create App RPCService client")
-		} else {
-			app.Logger().Info().Msg("App RPCService client is connected")
-		}
+		return
 	}
+	defer appClient.Close()
 
 	ticker := time.NewTicker(time.Second * 5)
 	for {
@@ -68,31 +48,21 @@ func TestRPCAppClient() {
 		case <-app.Dying():
 			return
 		case <-ticker.C:
-			if appClient == nil {
-				appClient, err = connect()
-				if err != nil {
-					app.Logger().Error().Err(err).Msg("Failed to create App RPCService client")
-				} else {
-					app.Logger().Info().Msg("App RPCService client is connected")
-				}
-			} else {
-				idPromise := appClient.Id(ctx)
-				instancePromise := appClient.Instance(ctx)
+			idPromise := appClient.Id(ctx)
+			instancePromise := appClient.Instance(ctx)
 
-				if result, err := idPromise.Struct(); err != nil {
-					reconnect()
-				} else {
-					appId := result.AppId()
-					if result, err := instancePromise.Struct(); err != nil {
-						reconnect()
-					} else {
-						if instanceID, err := result.InstanceId(); err != nil {
-							reconnect()
-						} else {
-							app.Logger().Info().Msgf("app id : %x, instance id: %s", appId, instanceID)
-						}
-					}
-				}
+			result, err := idPromise.Struct()
+			if err != nil {
+				app.Logger().Error().Err(err).Msgf("RPC App.Id() error : %v", appClient.Pool().ConnInfo())
+				continue
+			}
+			appId := result.AppId()
+			if result, err := instancePromise.Struct(); err != nil {
+				app.Logger().Error().Err(err).Msg("RPC App.Instance() error")
+			} else if instanceID, err := result.InstanceId(); err != nil {
+				app.Logger().Error().Err(err).Msg("RPC App.Instance() error")
+			} else {
+				app.Logger().Info().Msgf("app id : %x, instance id: %s", appId, instanceID)
 			}
 		}
 	}
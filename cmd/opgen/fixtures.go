@@ -0,0 +1,301 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"compress/zlib"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	"zombiezen.com/go/capnproto2"
+)
+
+const (
+	// the generated certs are only meant for testing
+	FIXTURE_CERT_VALIDITY = 365 * 24 * time.Hour
+	FIXTURE_MAX_CONNS     = 20
+)
+
+// Fixtures are the test certs and configs for the RPC service
+type Fixtures struct {
+	DomainID        uint64
+	AppID           uint64
+	ServiceID       uint64
+	ClientServiceID uint64
+	Port            uint16
+}
+
+// KeyPair is a PEM encoded cert and key
+type KeyPair struct {
+	Cert []byte
+	Key  []byte
+}
+
+// Certs are the generated test certs. The server cert CN follows the service naming convention, i.e., the client
+// verifies the server using its CN - see net.ServerCN().
+type Certs struct {
+	CACert []byte
+	Server KeyPair
+	Client KeyPair
+}
+
+// serverCN mirrors net.ServerCN() - the net package is not imported because it would initialize the app
+func serverCN(domainID, appID, serviceID uint64) string {
+	return fmt.Sprintf("%x.%x.%x", serviceID, appID, domainID)
+}
+
+// GenerateCerts generates a self-signed test CA, which issues the server and client certs.
+// The client cert is issued for the client service, i.e., the client CN is also a service CN.
+func (a *Fixtures) GenerateCerts() (*Certs, error) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	caTemplate := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: fmt.Sprintf("%x.%x opgen test CA", a.AppID, a.DomainID)},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLen:            0,
+		MaxPathLenZero:        true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	caCert, caPEM, err := signCert(caTemplate, &caKey.PublicKey, nil, caKey)
+	if err != nil {
+		return nil, err
+	}
+	certs := &Certs{CACert: caPEM}
+
+	cn := serverCN(a.DomainID, a.AppID, a.ServiceID)
+	serverTemplate := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: cn},
+		DNSNames:    []string{cn, "localhost"},
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if certs.Server, err = issueCert(serverTemplate, caCert, caKey); err != nil {
+		return nil, err
+	}
+
+	clientTemplate := &x509.Certificate{
+		Subject:     pkix.Name{CommonName: serverCN(a.DomainID, a.AppID, a.ClientServiceID)},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if certs.Client, err = issueCert(clientTemplate, caCert, caKey); err != nil {
+		return nil, err
+	}
+	return certs, nil
+}
+
+func issueCert(template, caCert *x509.Certificate, caKey *ecdsa.PrivateKey) (KeyPair, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	_, certPEM, err := signCert(template, &key.PublicKey, caCert, caKey)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{certPEM, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})}, nil
+}
+
+// signCert assigns a random serial number and the validity period. If the parent is nil, then the cert is self-signed.
+func signCert(template *x509.Certificate, pub *ecdsa.PublicKey, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, []byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template.SerialNumber = serial
+	template.NotBefore = now.Add(-time.Minute)
+	template.NotAfter = now.Add(FIXTURE_CERT_VALIDITY)
+	if parent == nil {
+		parent = template
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, parentKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func (a *Fixtures) rpcServiceSpec(seg *capnp.Segment) (config.RPCServiceSpec, error) {
+	spec, err := config.NewRPCServiceSpec(seg)
+	if err != nil {
+		return spec, err
+	}
+	spec.SetDomainID(a.DomainID)
+	spec.SetAppId(a.AppID)
+	spec.SetServiceId(a.ServiceID)
+	spec.SetPort(a.Port)
+	return spec, nil
+}
+
+func x509KeyPair(seg *capnp.Segment, keyPair KeyPair) (config.X509KeyPair, error) {
+	pair, err := config.NewX509KeyPair(seg)
+	if err != nil {
+		return pair, err
+	}
+	if err := pair.SetCert(keyPair.Cert); err != nil {
+		return pair, err
+	}
+	return pair, pair.SetKey(keyPair.Key)
+}
+
+// ServerConfig returns the RPCServerSpec config message for the service
+func (a *Fixtures) ServerConfig(certs *Certs) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	spec, err := config.NewRootRPCServerSpec(seg)
+	if err != nil {
+		return nil, err
+	}
+	serviceSpec, err := a.rpcServiceSpec(seg)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.SetRpcServiceSpec(serviceSpec); err != nil {
+		return nil, err
+	}
+	serverCert, err := x509KeyPair(seg, certs.Server)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.SetServerCert(serverCert); err != nil {
+		return nil, err
+	}
+	if err := spec.SetCaCert(certs.CACert); err != nil {
+		return nil, err
+	}
+	spec.SetMaxConns(FIXTURE_MAX_CONNS)
+	return msg, nil
+}
+
+// ClientConfig returns the RPCClientSpec config message for the client service
+func (a *Fixtures) ClientConfig(certs *Certs) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	spec, err := config.NewRootRPCClientSpec(seg)
+	if err != nil {
+		return nil, err
+	}
+	serviceSpec, err := a.rpcServiceSpec(seg)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.SetRpcServiceSpec(serviceSpec); err != nil {
+		return nil, err
+	}
+	clientCert, err := x509KeyPair(seg, certs.Client)
+	if err != nil {
+		return nil, err
+	}
+	if err := spec.SetClientCert(clientCert); err != nil {
+		return nil, err
+	}
+	if err := spec.SetCaCert(certs.CACert); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Write generates the certs, and writes the test fixtures to the dir :
+//
+//	{dir}/certs/ca.pem, server.pem, server.key.pem, client.pem, client.key.pem
+//	{dir}/config/0x{ServiceID}          - RPCServerSpec
+//	{dir}/config/0x{ClientServiceID}    - RPCClientSpec
+//
+// The config files use the app config file format, i.e., the config dir can be used as the app config dir.
+func (a *Fixtures) Write(dir string) error {
+	certs, err := a.GenerateCerts()
+	if err != nil {
+		return err
+	}
+	certFiles := map[string][]byte{
+		"ca.pem":         certs.CACert,
+		"server.pem":     certs.Server.Cert,
+		"server.key.pem": certs.Server.Key,
+		"client.pem":     certs.Client.Cert,
+		"client.key.pem": certs.Client.Key,
+	}
+	certDir := filepath.Join(dir, "certs")
+	if err := os.MkdirAll(certDir, 0755); err != nil {
+		return err
+	}
+	for name, data := range certFiles {
+		if err := ioutil.WriteFile(filepath.Join(certDir, name), data, 0600); err != nil {
+			return err
+		}
+	}
+
+	serverConfig, err := a.ServerConfig(certs)
+	if err != nil {
+		return err
+	}
+	clientConfig, err := a.ClientConfig(certs)
+	if err != nil {
+		return err
+	}
+	configDir := filepath.Join(dir, "config")
+	if err := os.MkdirAll(configDir, 0755); err != nil {
+		return err
+	}
+	if err := writeConfig(filepath.Join(configDir, fmt.Sprintf("0x%x", a.ServiceID)), serverConfig); err != nil {
+		return err
+	}
+	return writeConfig(filepath.Join(configDir, fmt.Sprintf("0x%x", a.ClientServiceID)), clientConfig)
+}
+
+// writeConfig writes the config message using the app config file format - see app.MarshalCapnpMessage()
+func writeConfig(path string, msg *capnp.Message) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	compressor := zlib.NewWriter(f)
+	if err := capnp.NewPackedEncoder(compressor).Encode(msg); err != nil {
+		return err
+	}
+	if err := compressor.Close(); err != nil {
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,316 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// Scaffold is the template data for the generated server, client, and test files
+type Scaffold struct {
+	Interface *Interface
+	Schema    *Schema
+
+	// the Go package that the files are generated into
+	Package string
+	// the qualifier for the capnp generated types - empty if the files are generated into the capnp Go package
+	Qualifier string
+
+	ServiceID       uint64
+	ClientServiceID uint64
+	ConfigDir       string
+}
+
+// Name is the interface name, e.g., PKI
+func (a *Scaffold) Name() string {
+	return a.Interface.Name
+}
+
+// ConstPrefix is the interface name in upper snake case, e.g., CommandPipeline -> COMMAND_PIPELINE
+func (a *Scaffold) ConstPrefix() string {
+	return strings.ToUpper(snakeCase(a.Interface.Name))
+}
+
+// FileName returns the generated file name, e.g., command_pipeline_rpc_server.go
+func (a *Scaffold) FileName(suffix string) string {
+	return fmt.Sprintf("%s_rpc_%s", snakeCase(a.Interface.Name), suffix)
+}
+
+// Type qualifies the capnp generated type name
+func (a *Scaffold) Type(name string) string {
+	return a.Qualifier + name
+}
+
+// ServerType is the unexported server struct name, e.g., pkiServer
+func (a *Scaffold) ServerType() string {
+	return lowerFirst(a.Interface.Name) + "Server"
+}
+
+// Methods returns the template data for the interface methods
+func (a *Scaffold) Methods() []*ScaffoldMethod {
+	methods := make([]*ScaffoldMethod, len(a.Interface.Methods))
+	for i, method := range a.Interface.Methods {
+		methods[i] = &ScaffoldMethod{Method: method, scaffold: a}
+	}
+	return methods
+}
+
+// ScaffoldMethod is the template data for an interface method
+type ScaffoldMethod struct {
+	*Method
+	scaffold *Scaffold
+}
+
+// GoName is the capnp generated method name, e.g., revokeCert -> RevokeCert
+func (a *ScaffoldMethod) GoName() string {
+	return upperFirst(a.Name)
+}
+
+// SchemaName is the method's schema name, e.g., PKI.revokeCert
+func (a *ScaffoldMethod) SchemaName() string {
+	return a.scaffold.Interface.Name + "." + a.Name
+}
+
+// CallType is the server call type, e.g., PKI_revokeCert
+func (a *ScaffoldMethod) CallType() string {
+	return a.scaffold.Type(a.scaffold.Interface.Name + "_" + a.Name)
+}
+
+// ParamsType is the params struct type, e.g., PKI_revokeCert_Params
+func (a *ScaffoldMethod) ParamsType() string {
+	if a.ParamsStruct != "" {
+		return a.scaffold.Type(a.ParamsStruct)
+	}
+	return a.scaffold.Type(a.scaffold.Interface.Name + "_" + a.Name + "_Params")
+}
+
+// PromiseType is the results promise type, e.g., PKI_revokeCert_Results_Promise
+func (a *ScaffoldMethod) PromiseType() string {
+	if a.ResultsStruct != "" {
+		return a.scaffold.Type(a.ResultsStruct + "_Promise")
+	}
+	return a.scaffold.Type(a.scaffold.Interface.Name + "_" + a.Name + "_Results_Promise")
+}
+
+// NoParamsVar is the name of the var that holds the params func for methods that take no params, e.g., _PKI_rootCert
+func (a *ScaffoldMethod) NoParamsVar() string {
+	return "_" + a.scaffold.Interface.Name + "_" + a.Name
+}
+
+// NoParams returns true if the method takes no params
+func (a *ScaffoldMethod) NoParams() bool {
+	return a.ParamsStruct == "" && len(a.Params) == 0
+}
+
+// TypedParams returns true if all params are primitive types, i.e., the client wrapper takes the params as args.
+// Otherwise, the client wrapper takes a func that sets the params.
+func (a *ScaffoldMethod) TypedParams() bool {
+	if a.ParamsStruct != "" || len(a.Params) == 0 {
+		return false
+	}
+	for _, param := range a.Params {
+		if _, ok := goTypes[param.Type]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Args returns the client wrapper args, e.g., "serial string"
+func (a *ScaffoldMethod) Args() string {
+	args := make([]string, len(a.Params))
+	for i, param := range a.Params {
+		args[i] = argName(param.Name) + " " + goTypes[param.Type].goType
+	}
+	return strings.Join(args, ", ")
+}
+
+// ZeroArgs returns zero values for the client wrapper args, which is used by the generated test
+func (a *ScaffoldMethod) ZeroArgs() string {
+	args := make([]string, len(a.Params))
+	for i, param := range a.Params {
+		args[i] = goTypes[param.Type].zero
+	}
+	return strings.Join(args, ", ")
+}
+
+// SetParams returns the statements that set the params from the client wrapper args, followed by the return statement
+func (a *ScaffoldMethod) SetParams() []string {
+	statements := []string{}
+	for i, param := range a.Params {
+		setter := fmt.Sprintf("params.Set%s(%s)", upperFirst(param.Name), argName(param.Name))
+		switch {
+		case !goTypes[param.Type].setterErr:
+			statements = append(statements, setter)
+		case i == len(a.Params)-1:
+			return append(statements, "return "+setter)
+		default:
+			statements = append(statements, fmt.Sprintf("if err := %s; err != nil {\n\t\t\treturn err\n\t\t}", setter))
+		}
+	}
+	return append(statements, "return nil")
+}
+
+type goType struct {
+	goType string
+	zero   string
+	// true if the capnp setter returns an error
+	setterErr bool
+}
+
+// goTypes maps the capnp primitive types to Go
+var goTypes = map[string]goType{
+	"Bool":    {"bool", "false", false},
+	"Int8":    {"int8", "0", false},
+	"Int16":   {"int16", "0", false},
+	"Int32":   {"int32", "0", false},
+	"Int64":   {"int64", "0", false},
+	"UInt8":   {"uint8", "0", false},
+	"UInt16":  {"uint16", "0", false},
+	"UInt32":  {"uint32", "0", false},
+	"UInt64":  {"uint64", "0", false},
+	"Float32": {"float32", "0", false},
+	"Float64": {"float64", "0", false},
+	"Text":    {"string", `""`, true},
+	"Data":    {"[]byte", "nil", true},
+}
+
+// argName returns the param name as a Go arg name, which must not clash with Go keywords or the generated code's names
+func argName(name string) string {
+	switch {
+	case token.Lookup(name).IsKeyword(), name == "a", name == "ctx", name == "params", name == "err":
+		return name + "_"
+	default:
+		return name
+	}
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+func lowerFirst(s string) string {
+	r := []rune(s)
+	for i := range r {
+		// lower the leading acronym, e.g., PKI -> pki, RPCService -> rpcService
+		if i > 0 && i+1 < len(r) && unicode.IsLower(r[i+1]) {
+			break
+		}
+		if !unicode.IsUpper(r[i]) {
+			break
+		}
+		r[i] = unicode.ToLower(r[i])
+	}
+	return string(r)
+}
+
+// snakeCase converts camel case to snake case, e.g., RPCService -> rpc_service
+func snakeCase(s string) string {
+	r := []rune(s)
+	var buf bytes.Buffer
+	for i, c := range r {
+		if unicode.IsUpper(c) && i > 0 && (unicode.IsLower(r[i-1]) || (i+1 < len(r) && unicode.IsLower(r[i+1]))) {
+			buf.WriteRune('_')
+		}
+		buf.WriteRune(unicode.ToLower(c))
+	}
+	return buf.String()
+}
+
+// Generate renders the template, and formats the Go source
+func (a *Scaffold) Generate(tmpl *template.Template) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, a); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%s : generated source is invalid : %v", tmpl.Name(), err)
+	}
+	return src, nil
+}
+
+// HasNoParamsMethods returns true if any method takes no params
+func (a *Scaffold) HasNoParamsMethods() bool {
+	for _, method := range a.Interface.Methods {
+		if method.ParamsStruct == "" && len(method.Params) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasParamsFuncMethods returns true if the client wrapper takes a params func for any method, i.e., the capnp params
+// types are referenced by the generated test
+func (a *Scaffold) HasParamsFuncMethods() bool {
+	for _, method := range a.Methods() {
+		if !method.NoParams() && !method.TypedParams() {
+			return true
+		}
+	}
+	return false
+}
+
+// NoResults returns true if the method returns no results, i.e., the client wrapper only returns an error
+func (a *ScaffoldMethod) NoResults() bool {
+	return a.ResultsStruct == "" && len(a.Results) == 0
+}
+
+// WrapperArgs returns the client wrapper args, following the context arg
+func (a *ScaffoldMethod) WrapperArgs() string {
+	switch {
+	case a.NoParams():
+		return ""
+	case a.TypedParams():
+		return ", " + a.Args()
+	default:
+		return ", params func(" + a.ParamsType() + ") error"
+	}
+}
+
+// ParamsFunc returns the params func that the client wrapper passes to the capnp client
+func (a *ScaffoldMethod) ParamsFunc() string {
+	switch {
+	case a.NoParams():
+		return a.NoParamsVar()
+	case a.TypedParams():
+		return "func(params " + a.ParamsType() + ") error {\n\t\t" + strings.Join(a.SetParams(), "\n\t\t") + "\n\t}"
+	default:
+		return "params"
+	}
+}
+
+// TestArgs returns the args that the generated test passes to the client wrapper, following the context arg
+func (a *ScaffoldMethod) TestArgs() string {
+	switch {
+	case a.NoParams():
+		return ""
+	case a.TypedParams():
+		return ", " + a.ZeroArgs()
+	default:
+		return ", func(params " + a.ParamsType() + ") error { return nil }"
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// opgen scaffolds a capnp RPC service from a capnp interface. It generates :
+//
+//	{interface}_rpc_server.go - the server skeleton, which is started as an app.Service via an RPCServerSpec config
+//	{interface}_rpc_client.go - the client wrapper, which is backed by an RPCClientPool, with a helper per method
+//	{interface}_rpc_test.go   - a test that starts the server, and calls each method via the client
+//	testdata/certs            - a test CA, and the server and client certs, which are issued by the test CA
+//	testdata/config           - the RPCServerSpec and RPCClientSpec config files
+//
+// The config dir doubles as a sample config, i.e., it can be used as the app config dir to run the service locally.
+// Run `go generate` for the capnp schema before building the generated code.
+//
+// Usage :
+//
+//	opgen -schema pkg/foo/foo.capnp -service 0xd4b3f1b3e5a0c8a1 [-interface Foo] [-package foo] [-out dir]
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type hexID uint64
+
+func (a *hexID) String() string {
+	return fmt.Sprintf("0x%x", uint64(*a))
+}
+
+func (a *hexID) Set(value string) error {
+	id, err := strconv.ParseUint(strings.TrimPrefix(value, "0x"), 16, 64)
+	if err != nil {
+		return err
+	}
+	if id == 0 {
+		return errors.New("id must not be zero")
+	}
+	*a = hexID(id)
+	return nil
+}
+
+// randomID returns a random non-zero id
+func randomID() uint64 {
+	for {
+		var b [8]byte
+		if _, err := rand.Read(b[:]); err != nil {
+			panic(err)
+		}
+		if id := binary.BigEndian.Uint64(b[:]); id != 0 {
+			return id
+		}
+	}
+}
+
+func main() {
+	var schemaPath, interfaceName, packageName, outDir string
+	var serviceID, clientServiceID, domainID, appID hexID
+	var port uint
+	var force bool
+	flag.StringVar(&schemaPath, "schema", "", "capnp schema file (required)")
+	flag.StringVar(&interfaceName, "interface", "", "capnp interface name - defaults to the first interface in the schema")
+	flag.Var(&serviceID, "service", "ServiceID in hex (required)")
+	flag.Var(&clientServiceID, "client-service", "client ServiceID in hex, which is used for the client config - defaults to a random id")
+	flag.Var(&domainID, "domain", "DomainID in hex for the test config - defaults to a random id")
+	flag.Var(&appID, "app", "AppID in hex for the test config - defaults to a random id")
+	flag.UintVar(&port, "port", 0, "RPC port for the test config - defaults to a random port in the range [40000,50000)")
+	flag.StringVar(&packageName, "package", "", "Go package for the generated files - defaults to the schema's Go package")
+	flag.StringVar(&outDir, "out", "", "output dir - defaults to the schema dir")
+	flag.BoolVar(&force, "force", false, "overwrite existing files")
+	flag.Parse()
+
+	if schemaPath == "" || serviceID == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if port > 0xffff {
+		exit(fmt.Errorf("invalid port : %d", port))
+	}
+	if clientServiceID == 0 {
+		clientServiceID = hexID(randomID())
+	}
+	if domainID == 0 {
+		domainID = hexID(randomID())
+	}
+	if appID == 0 {
+		appID = hexID(randomID())
+	}
+	if port == 0 {
+		port = 40000 + uint(randomID()%10000)
+	}
+	if outDir == "" {
+		outDir = filepath.Dir(schemaPath)
+	}
+
+	schema, err := ParseSchemaFile(schemaPath)
+	if err != nil {
+		exit(err)
+	}
+	scaffold, err := NewScaffold(schema, interfaceName, packageName)
+	if err != nil {
+		exit(err)
+	}
+	scaffold.ServiceID = uint64(serviceID)
+	scaffold.ClientServiceID = uint64(clientServiceID)
+	scaffold.ConfigDir = "./testdata/config"
+
+	files := map[string]*template.Template{
+		scaffold.FileName("server.go"): serverTemplate,
+		scaffold.FileName("client.go"): clientTemplate,
+		scaffold.FileName("test.go"):   testTemplate,
+	}
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(outDir, name)); err == nil && !force {
+			exit(fmt.Errorf("%s already exists - use -force to overwrite", filepath.Join(outDir, name)))
+		}
+	}
+	for name, tmpl := range files {
+		src, err := scaffold.Generate(tmpl)
+		if err != nil {
+			exit(err)
+		}
+		if err := ioutil.WriteFile(filepath.Join(outDir, name), src, 0644); err != nil {
+			exit(err)
+		}
+		fmt.Println(filepath.Join(outDir, name))
+	}
+
+	fixtures := &Fixtures{
+		DomainID:        uint64(domainID),
+		AppID:           uint64(appID),
+		ServiceID:       uint64(serviceID),
+		ClientServiceID: uint64(clientServiceID),
+		Port:            uint16(port),
+	}
+	if err := fixtures.Write(filepath.Join(outDir, "testdata")); err != nil {
+		exit(err)
+	}
+	fmt.Printf("%s : domain = %s, app = %s, service = %s, client service = %s, port = %d\n",
+		filepath.Join(outDir, "testdata"), &domainID, &appID, &serviceID, &clientServiceID, port)
+}
+
+// NewScaffold returns the scaffold for the schema interface. If the interface name is blank, then the first interface
+// is used. If the package is blank, then the files are generated into the schema's Go package.
+func NewScaffold(schema *Schema, interfaceName, packageName string) (*Scaffold, error) {
+	if len(schema.Interfaces) == 0 {
+		return nil, errors.New("the schema has no interfaces")
+	}
+	iface := schema.Interfaces[0]
+	if interfaceName != "" {
+		if iface = schema.Interface(interfaceName); iface == nil {
+			return nil, fmt.Errorf("interface not found : %s", interfaceName)
+		}
+	}
+	scaffold := &Scaffold{Interface: iface, Schema: schema, Package: schema.Package}
+	if packageName != "" && packageName != schema.Package {
+		if schema.Import == "" {
+			return nil, errors.New("the schema $Go.import annotation is required to generate into another package")
+		}
+		scaffold.Package = packageName
+		scaffold.Qualifier = schema.Package + "."
+	}
+	return scaffold, nil
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"text/template"
+)
+
+func TestParseSchema(t *testing.T) {
+	schema, err := ParseSchemaFile("../../pkg/app/pki/pki.capnp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.Package != "pki" || schema.Import != "github.com/oysterpack/oysterpack.go/pkg/app/pki" {
+		t.Errorf("Go annotations did not match : %q %q", schema.Package, schema.Import)
+	}
+	if len(schema.Interfaces) != 1 {
+		t.Fatalf("expected 1 interface : %d", len(schema.Interfaces))
+	}
+	iface := schema.Interface("PKI")
+	if iface == nil || iface.ID != 0x9fa48ded30823c9b {
+		t.Fatalf("PKI interface did not match : %v", iface)
+	}
+	if len(iface.Methods) != 8 {
+		t.Fatalf("expected 8 methods : %d", len(iface.Methods))
+	}
+	serverCert := iface.Methods[2]
+	if serverCert.Name != "serverCert" || serverCert.Ordinal != 2 || len(serverCert.Params) != 4 || len(serverCert.Results) != 1 {
+		t.Errorf("serverCert did not match : %v", serverCert)
+	}
+	if serverCert.Params[3].Name != "dnsNames" || serverCert.Params[3].Type != "List(Text)" {
+		t.Errorf("dnsNames did not match : %v", serverCert.Params[3])
+	}
+	if revokeCert := iface.Methods[5]; len(revokeCert.Results) != 0 {
+		t.Errorf("revokeCert has no results : %v", revokeCert.Results)
+	}
+
+	schema, err = ParseSchema(`
+using Go = import "/go.capnp";
+@0xdb8274f9144abc7e;
+$Go.package("foo"); # comment
+interface Foo @0xf052e7e084b31199 extends(Bar) {
+	struct Nested { a @0 :Text; }
+	get   @0 GetRequest -> GetResponse $Go.doc("named structs");
+	put   @1 (key :Text = "#", value :Data) -> ();
+	list  @2 [T] (t :T) -> (items :List(T));
+	kill  @3 ();
+}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	methods := schema.Interface("Foo").Methods
+	if len(methods) != 3 {
+		t.Fatalf("expected 3 methods, i.e., the generic method is skipped : %d", len(methods))
+	}
+	if methods[0].ParamsStruct != "GetRequest" || methods[0].ResultsStruct != "GetResponse" {
+		t.Errorf("get did not match : %v", methods[0])
+	}
+	if len(methods[1].Params) != 2 || methods[1].Params[1].Type != "Data" {
+		t.Errorf("put did not match : %v", methods[1].Params)
+	}
+	if methods[2].Name != "kill" || methods[2].Ordinal != 3 {
+		t.Errorf("kill did not match : %v", methods[2])
+	}
+
+	if _, err := ParseSchema(`interface Foo @0xf052e7e084b31199 { get @0 () -> (); }`); err == nil {
+		t.Error("$Go.package is required")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	schema, err := ParseSchemaFile("../../pkg/app/pki/pki.capnp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scaffold, err := NewScaffold(schema, "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scaffold.ServiceID, scaffold.ClientServiceID, scaffold.ConfigDir = 0xd4b3f1b3e5a0c8a1, 0xc1a2b3, "./testdata/config"
+	if scaffold.FileName("server.go") != "pki_rpc_server.go" || scaffold.ServerType() != "pkiServer" || scaffold.ConstPrefix() != "PKI" {
+		t.Errorf("names did not match : %s %s %s", scaffold.FileName("server.go"), scaffold.ServerType(), scaffold.ConstPrefix())
+	}
+
+	server, err := scaffold.Generate(serverTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"PKI_RPC_SERVICE_ID = app.ServiceID(0xd4b3f1b3e5a0c8a1)",
+		"func (a pkiServer) RevokedSerials(call PKI_revokedSerials) error {",
+		"trace.ServerMethods(PKI_Methods(nil, NewPKIServer(service)))",
+	} {
+		if !strings.Contains(string(server), s) {
+			t.Errorf("server source does not contain : %s", s)
+		}
+	}
+
+	client, err := scaffold.Generate(clientTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"_PKI_revokedSerials = func(_ PKI_revokedSerials_Params) error { return nil }",
+		"func (a *PKIRPCClient) RenewCert(ctx context.Context, serial string) PKI_renewCert_Results_Promise {",
+		"return params.SetSerial(serial)",
+		"func (a *PKIRPCClient) RevokeCert(ctx context.Context, serial string) error {",
+		"func (a *PKIRPCClient) OwnCert(ctx context.Context, params func(PKI_ownCert_Params) error) PKI_ownCert_Results_Promise {",
+	} {
+		if !strings.Contains(string(client), s) {
+			t.Errorf("client source does not contain : %s", s)
+		}
+	}
+
+	test, err := scaffold.Generate(testTemplate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{
+		"PKI_RPC_CLIENT_ID = app.ServiceID(0xc1a2b3)",
+		`app.ResetWithConfigDir("./testdata/config")`,
+		"client.Crl(ctx, 0, 0).Struct()",
+		"if err := client.RevokeCert(ctx, \"\"); err != nil {",
+	} {
+		if !strings.Contains(string(test), s) {
+			t.Errorf("test source does not contain : %s", s)
+		}
+	}
+
+	// generating into another package qualifies the capnp types
+	schema, err = ParseSchemaFile("../../pkg/app/capnprpc/app.capnp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	scaffold, err = NewScaffold(schema, "CommandPipeline", "apprpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scaffold.ConstPrefix() != "COMMAND_PIPELINE" || scaffold.FileName("client.go") != "command_pipeline_rpc_client.go" {
+		t.Errorf("names did not match : %s %s", scaffold.ConstPrefix(), scaffold.FileName("client.go"))
+	}
+	for _, tmpl := range []*template.Template{serverTemplate, clientTemplate} {
+		src, err := scaffold.Generate(tmpl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(src), `"github.com/oysterpack/oysterpack.go/pkg/app/capnprpc"`) {
+			t.Errorf("%s : capnprpc is not imported", tmpl.Name())
+		}
+	}
+	if _, err := NewScaffold(schema, "Foo", ""); err == nil {
+		t.Error("interface should not have been found")
+	}
+}
+
+func TestFixtures(t *testing.T) {
+	fixtures := &Fixtures{DomainID: 0xa1, AppID: 0xb2, ServiceID: 0xc3, ClientServiceID: 0xd4, Port: 44222}
+	certs, err := fixtures.GenerateCerts()
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(certs.CACert) {
+		t.Fatal("invalid CA cert")
+	}
+	parse := func(certPEM []byte) *x509.Certificate {
+		block, _ := pem.Decode(certPEM)
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert
+	}
+
+	// the client verifies the server cert using the service CN
+	serverCert := parse(certs.Server.Cert)
+	if _, err := serverCert.Verify(x509.VerifyOptions{Roots: roots, DNSName: "c3.b2.a1"}); err != nil {
+		t.Error(err)
+	}
+	clientCert := parse(certs.Client.Cert)
+	if _, err := clientCert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}); err != nil {
+		t.Error(err)
+	}
+	if clientCert.Subject.CommonName != "d4.b2.a1" {
+		t.Errorf("client CN did not match : %s", clientCert.Subject.CommonName)
+	}
+
+	serverConfig, err := fixtures.ServerConfig(certs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := serverConfig.Marshal(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := fixtures.ClientConfig(certs); err != nil {
+		t.Fatal(err)
+	}
+}
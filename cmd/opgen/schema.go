@@ -0,0 +1,374 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Schema is the subset of a capnp schema file that is needed to scaffold RPC services, i.e., the Go package annotations
+// and the top level interfaces.
+type Schema struct {
+	// $Go.package
+	Package string
+	// $Go.import
+	Import string
+
+	Interfaces []*Interface
+}
+
+// Interface returns the interface with the specified name, or nil if it does not exist
+func (a *Schema) Interface(name string) *Interface {
+	for _, iface := range a.Interfaces {
+		if iface.Name == name {
+			return iface
+		}
+	}
+	return nil
+}
+
+// Interface is a capnp interface declaration
+type Interface struct {
+	Name    string
+	ID      uint64
+	Methods []*Method
+}
+
+// Method is a capnp interface method.
+//
+// Params and Results are the inline field lists. If the method uses a named struct, e.g., `foo @0 FooRequest -> FooResponse`,
+// then ParamsStruct or ResultsStruct is set instead.
+type Method struct {
+	Name    string
+	Ordinal uint16
+
+	Params       []*Field
+	ParamsStruct string
+
+	Results       []*Field
+	ResultsStruct string
+}
+
+// Field is a method param or result field
+type Field struct {
+	Name string
+	// the capnp type, e.g., UInt64, List(Text)
+	Type string
+}
+
+// ParseSchemaFile parses the capnp schema file
+func ParseSchemaFile(path string) (*Schema, error) {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	schema, err := ParseSchema(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("%s : %v", path, err)
+	}
+	return schema, nil
+}
+
+// ParseSchema parses the capnp schema.
+// Nested declarations, generic methods, and annotations other than $Go.package and $Go.import are skipped.
+func ParseSchema(src string) (*Schema, error) {
+	p := &schemaParser{tokens: tokenize(src)}
+	schema := &Schema{}
+	for !p.done() {
+		switch tok := p.next(); tok {
+		case "$":
+			name := p.next()
+			if name != "Go.package" && name != "Go.import" {
+				p.skipStatement()
+				continue
+			}
+			if err := p.expect("("); err != nil {
+				return nil, err
+			}
+			value, err := p.stringLiteral()
+			if err != nil {
+				return nil, err
+			}
+			if name == "Go.package" {
+				schema.Package = value
+			} else {
+				schema.Import = value
+			}
+			p.skipStatement()
+		case "interface":
+			iface, err := p.parseInterface()
+			if err != nil {
+				return nil, err
+			}
+			schema.Interfaces = append(schema.Interfaces, iface)
+		case "struct", "enum", "annotation":
+			p.skipDeclaration()
+		default:
+			if tok != ";" {
+				p.skipStatement()
+			}
+		}
+	}
+	if schema.Package == "" {
+		return nil, fmt.Errorf("$Go.package annotation is missing")
+	}
+	return schema, nil
+}
+
+type schemaParser struct {
+	tokens []string
+	pos    int
+}
+
+func (a *schemaParser) done() bool {
+	return a.pos >= len(a.tokens)
+}
+
+func (a *schemaParser) peek() string {
+	if a.done() {
+		return ""
+	}
+	return a.tokens[a.pos]
+}
+
+func (a *schemaParser) next() string {
+	tok := a.peek()
+	a.pos++
+	return tok
+}
+
+func (a *schemaParser) expect(tok string) error {
+	if next := a.next(); next != tok {
+		return fmt.Errorf("expected %q, but found %q", tok, next)
+	}
+	return nil
+}
+
+func (a *schemaParser) stringLiteral() (string, error) {
+	tok := a.next()
+	if !strings.HasPrefix(tok, `"`) {
+		return "", fmt.Errorf("expected a string, but found %q", tok)
+	}
+	return strconv.Unquote(tok)
+}
+
+// skipStatement skips to the end of the statement, i.e., past the next ';' that is not nested
+func (a *schemaParser) skipStatement() {
+	depth := 0
+	for !a.done() {
+		switch a.next() {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case ";":
+			if depth <= 0 {
+				return
+			}
+		}
+	}
+}
+
+// skipDeclaration skips past the declaration's body
+func (a *schemaParser) skipDeclaration() {
+	for !a.done() && a.peek() != "{" {
+		if a.next() == ";" {
+			return
+		}
+	}
+	a.skipBlock("{", "}")
+}
+
+// skipBlock skips past the balanced block, which starts at the current token
+func (a *schemaParser) skipBlock(open, close string) {
+	depth := 0
+	for !a.done() {
+		switch a.next() {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (a *schemaParser) parseInterface() (*Interface, error) {
+	iface := &Interface{Name: a.next()}
+	if !strings.HasPrefix(a.peek(), "@") {
+		return nil, fmt.Errorf("interface %s : id is missing", iface.Name)
+	}
+	id, err := strconv.ParseUint(strings.TrimPrefix(a.next(), "@0x"), 16, 64)
+	if err != nil {
+		return nil, fmt.Errorf("interface %s : invalid id : %v", iface.Name, err)
+	}
+	iface.ID = id
+	for !a.done() && a.peek() != "{" {
+		a.next()
+	}
+	if err := a.expect("{"); err != nil {
+		return nil, fmt.Errorf("interface %s : %v", iface.Name, err)
+	}
+	for !a.done() {
+		switch tok := a.peek(); tok {
+		case "}":
+			a.next()
+			return iface, nil
+		case "struct", "interface", "enum", "annotation":
+			a.next()
+			a.skipDeclaration()
+		case "using", "const", "$", ";":
+			a.skipStatement()
+		default:
+			method, err := a.parseMethod()
+			if err != nil {
+				return nil, fmt.Errorf("interface %s : %v", iface.Name, err)
+			}
+			if method != nil {
+				iface.Methods = append(iface.Methods, method)
+			}
+		}
+	}
+	return nil, fmt.Errorf("interface %s : '}' is missing", iface.Name)
+}
+
+// parseMethod returns nil for generic methods, which are not supported
+func (a *schemaParser) parseMethod() (*Method, error) {
+	method := &Method{Name: a.next()}
+	ordinal, err := strconv.ParseUint(strings.TrimPrefix(a.next(), "@"), 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("method %s : invalid ordinal : %v", method.Name, err)
+	}
+	method.Ordinal = uint16(ordinal)
+	if a.peek() == "[" {
+		a.skipStatement()
+		return nil, nil
+	}
+
+	if method.Params, method.ParamsStruct, err = a.parseParams(); err != nil {
+		return nil, fmt.Errorf("method %s : %v", method.Name, err)
+	}
+	if a.peek() == "->" {
+		a.next()
+		if method.Results, method.ResultsStruct, err = a.parseParams(); err != nil {
+			return nil, fmt.Errorf("method %s : %v", method.Name, err)
+		}
+	}
+	a.skipStatement()
+	return method, nil
+}
+
+// parseParams parses either an inline field list, or a named struct type
+func (a *schemaParser) parseParams() ([]*Field, string, error) {
+	if a.peek() != "(" {
+		return nil, a.parseType(), nil
+	}
+	a.next()
+	fields := []*Field{}
+	for !a.done() {
+		switch a.peek() {
+		case ")":
+			a.next()
+			return fields, "", nil
+		case ",":
+			a.next()
+			continue
+		}
+		field := &Field{Name: a.next()}
+		if err := a.expect(":"); err != nil {
+			return nil, "", fmt.Errorf("param %s : %v", field.Name, err)
+		}
+		field.Type = a.parseType()
+		fields = append(fields, field)
+		// skip default values and annotations
+		for depth := 0; !a.done(); a.next() {
+			tok := a.peek()
+			if depth == 0 && (tok == "," || tok == ")") {
+				break
+			}
+			switch tok {
+			case "(", "[":
+				depth++
+			case ")", "]":
+				depth--
+			}
+		}
+	}
+	return nil, "", fmt.Errorf("')' is missing")
+}
+
+// parseType parses a type name, including its generic params, e.g., List(UInt64)
+func (a *schemaParser) parseType() string {
+	typeName := a.next()
+	if a.peek() == "(" {
+		start := a.pos
+		a.skipBlock("(", ")")
+		typeName += strings.Join(a.tokens[start:a.pos], "")
+	}
+	return typeName
+}
+
+// tokenize splits the schema into tokens, i.e., identifiers, ordinals, string literals, and punctuation.
+// Comments are dropped. Dotted names, e.g., Go.package, are returned as a single token.
+func tokenize(src string) []string {
+	tokens := []string{}
+	runes := []rune(src)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+		case r == '"':
+			j := i + 1
+			for ; j < len(runes) && runes[j] != '"'; j++ {
+				if runes[j] == '\\' {
+					j++
+				}
+			}
+			tokens = append(tokens, string(runes[i:min(j+1, len(runes))]))
+			i = j + 1
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '>':
+			tokens = append(tokens, "->")
+			i += 2
+		case r == '@' || r == '_' || r == '.' || unicode.IsLetter(r) || unicode.IsDigit(r):
+			j := i + 1
+			for ; j < len(runes) && (runes[j] == '_' || runes[j] == '.' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])); j++ {
+			}
+			tokens = append(tokens, string(runes[i:j]))
+			i = j
+		default:
+			tokens = append(tokens, string(r))
+			i++
+		}
+	}
+	return tokens
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
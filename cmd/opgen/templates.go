@@ -0,0 +1,242 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import "text/template"
+
+var serverTemplate = template.Must(template.New("server").Parse(`// Generated by opgen from the {{.Name}} capnp interface - fill in the method implementations.
+
+package {{.Package}}
+
+import (
+	"errors"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	opcapnp "github.com/oysterpack/oysterpack.go/pkg/app/net/rpc/capnp"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
+{{- if .Qualifier}}
+	"{{.Schema.Import}}"
+{{- end}}
+)
+
+const (
+	// {{.ConstPrefix}}_RPC_SERVICE_ID is configured via an RPCServerSpec config
+	{{.ConstPrefix}}_RPC_SERVICE_ID = app.ServiceID({{printf "0x%x" .ServiceID}})
+)
+
+// Start{{.Name}}RPCService registers the {{.Name}} service, and starts its RPC server, which is configured via the
+// RPCServerSpec config for {{.ConstPrefix}}_RPC_SERVICE_ID.
+func Start{{.Name}}RPCService() (*opcapnp.RPCService, error) {
+	msg, err := app.Configs.Config({{.ConstPrefix}}_RPC_SERVICE_ID)
+	if err != nil {
+		return nil, err
+	}
+	if msg == nil {
+		return nil, app.ConfigError({{.ConstPrefix}}_RPC_SERVICE_ID, errors.New("config not found"), "RPCServerSpec config is required")
+	}
+	spec, err := config.ReadRootRPCServerSpec(msg)
+	if err != nil {
+		return nil, app.ConfigError({{.ConstPrefix}}_RPC_SERVICE_ID, err, "Failed to read RPCServerSpec config")
+	}
+	serverSpec, err := opcapnp.NewRPCServerSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	service := app.NewService({{.ConstPrefix}}_RPC_SERVICE_ID)
+	app.Services.Register(service)
+	return serverSpec.StartRPCService(service, {{.Name}}RPCMainInterface(service))
+}
+
+// {{.Name}}RPCMainInterface returns the {{.Name}} server as the RPC service main interface
+func {{.Name}}RPCMainInterface(service *app.Service) opcapnp.RPCMainInterface {
+	client := server.New(trace.ServerMethods({{.Type .Name}}_Methods(nil, New{{.Name}}Server(service))), nil)
+	return func() (capnp.Client, error) {
+		return client, nil
+	}
+}
+
+// New{{.Name}}Server returns the {{.Name}} capnp server, which runs within the service
+func New{{.Name}}Server(service *app.Service) {{.Type .Name}}_Server {
+	return {{.ServerType}}{service}
+}
+
+type {{.ServerType}} struct {
+	service *app.Service
+}
+{{range .Methods}}
+// {{.GoName}} implements {{.SchemaName}}
+func (a {{$.ServerType}}) {{.GoName}}(call {{.CallType}}) error {
+	return errors.New("{{.SchemaName}} is not implemented")
+}
+{{end}}`))
+
+var clientTemplate = template.Must(template.New("client").Parse(`// Generated by opgen from the {{.Name}} capnp interface.
+
+package {{.Package}}
+
+import (
+	"context"
+	"errors"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	opcapnp "github.com/oysterpack/oysterpack.go/pkg/app/net/rpc/capnp"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+{{- if .Qualifier}}
+	"{{.Schema.Import}}"
+{{- end}}
+)
+
+// New{{.Name}}Client creates a new {{.Name}} capnp RPC client, which is backed by an RPCClientPool using the default settings.
+// An RPCClientSpec config must exist for the specified ServiceID.
+//
+// The client connects in the background, and transparently reconnects - use Ready() to wait for the client to connect.
+func New{{.Name}}Client(serviceID app.ServiceID) (*{{.Name}}RPCClient, error) {
+	return New{{.Name}}ClientWithSettings(serviceID, opcapnp.RPCClientPoolSettings{})
+}
+
+// New{{.Name}}ClientForAddr works the same as New{{.Name}}Client, except that it connects to the specified network address
+func New{{.Name}}ClientForAddr(serviceID app.ServiceID, networkAddr string) (*{{.Name}}RPCClient, error) {
+	return New{{.Name}}ClientWithSettings(serviceID, opcapnp.RPCClientPoolSettings{Addrs: []string{networkAddr}})
+}
+
+// New{{.Name}}ClientWithSettings works the same as New{{.Name}}Client, except that the RPCClientPool is configured via the settings
+func New{{.Name}}ClientWithSettings(serviceID app.ServiceID, settings opcapnp.RPCClientPoolSettings) (*{{.Name}}RPCClient, error) {
+	cfg, err := app.Configs.Config(serviceID)
+	if err != nil {
+		return nil, err
+	}
+	if cfg == nil {
+		return nil, app.ConfigError(serviceID, errors.New("config not found"), "RPCClientSpec config is required")
+	}
+	spec, err := config.ReadRootRPCClientSpec(cfg)
+	if err != nil {
+		return nil, app.ConfigError(serviceID, err, "Failed to read RPCClientSpec config")
+	}
+	rpcClientSpec, err := opcapnp.NewRPCClientSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := opcapnp.NewRPCClientPool(app.NewService(serviceID), rpcClientSpec, settings)
+	if err != nil {
+		return nil, err
+	}
+	return &{{.Name}}RPCClient{&{{.Type .Name}}{Client: trace.Client(pool.Client())}, pool}, nil
+}
+{{if .HasNoParamsMethods}}
+// {{.Name}} function params - for RPC functions that take no params
+var (
+{{- range .Methods}}{{if .NoParams}}
+	{{.NoParamsVar}} = func(_ {{.ParamsType}}) error { return nil }
+{{- end}}{{end}}
+)
+{{end}}
+// {{.Name}}RPCClient wraps the {{.Type .Name}} in order to provide a more user friendly interface
+type {{.Name}}RPCClient struct {
+	*{{.Type .Name}}
+
+	pool *opcapnp.RPCClientPool
+}
+
+// Ready waits until the client is connected
+func (a *{{.Name}}RPCClient) Ready(ctx context.Context) error {
+	return a.pool.Ready(ctx)
+}
+
+// Pool returns the RPCClientPool, which can be used to check the conn state
+func (a *{{.Name}}RPCClient) Pool() *opcapnp.RPCClientPool {
+	return a.pool
+}
+{{range .Methods}}
+{{- if .NoResults}}
+func (a *{{$.Name}}RPCClient) {{.GoName}}(ctx context.Context{{.WrapperArgs}}) error {
+	_, err := a.{{$.Name}}.{{.GoName}}(ctx, {{.ParamsFunc}}).Struct()
+	return err
+}
+{{else}}
+func (a *{{$.Name}}RPCClient) {{.GoName}}(ctx context.Context{{.WrapperArgs}}) {{.PromiseType}} {
+	return a.{{$.Name}}.{{.GoName}}(ctx, {{.ParamsFunc}})
+}
+{{end}}
+{{- end}}
+// Close releases any resources associated with this client, i.e., the pooled conns are closed.
+// No further calls to the client should be made after calling Close.
+func (a *{{.Name}}RPCClient) Close() {
+	a.{{.Name}}.Client.Close()
+	a.pool.Close()
+}
+`))
+
+var testTemplate = template.Must(template.New("test").Parse(`// Generated by opgen from the {{.Name}} capnp interface - the method tests fail until the methods are implemented.
+
+package {{.Package}}
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+{{- if and .Qualifier .HasParamsFuncMethods}}
+	"{{.Schema.Import}}"
+{{- end}}
+)
+
+// {{.ConstPrefix}}_RPC_CLIENT_ID is configured via an RPCClientSpec config - see {{.ConfigDir}}
+const {{.ConstPrefix}}_RPC_CLIENT_ID = app.ServiceID({{printf "0x%x" .ClientServiceID}})
+
+func Test{{.Name}}RPCService(t *testing.T) {
+	app.ResetWithConfigDir("{{.ConfigDir}}")
+	defer app.Reset()
+
+	rpcService, err := Start{{.Name}}RPCService()
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-rpcService.Started():
+	case <-time.After(5 * time.Second):
+		t.Fatal("RPC service did not start")
+	}
+
+	client, err := New{{.Name}}ClientForAddr({{.ConstPrefix}}_RPC_CLIENT_ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ready(ctx); err != nil {
+		t.Fatal(err)
+	}
+{{range .Methods}}
+	t.Run("{{.SchemaName}}", func(t *testing.T) {
+{{- if .NoResults}}
+		if err := client.{{.GoName}}(ctx{{.TestArgs}}); err != nil {
+{{- else}}
+		if _, err := client.{{.GoName}}(ctx{{.TestArgs}}).Struct(); err != nil {
+{{- end}}
+			t.Error(err)
+		}
+	})
+{{end -}}
+}
+`))
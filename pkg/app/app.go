@@ -92,6 +92,8 @@ var (
 	Configs        AppConfig
 	MetricRegistry AppMetricRegistry
 	HealthChecks   AppHealthChecks
+	Events         AppEvents
+	LogEvents      AppLogEvents
 )
 
 type AppServices struct{}
@@ -197,6 +199,7 @@ func (a AppServices) Register(s *Service) {
 	}
 	services[s.id] = s
 	SERVICE_REGISTERED.Log(s.logger.Info()).Msg("registered")
+	publishServiceEvent(SERVICE_REGISTERED, s, nil)
 
 	// watch the service
 	// when it dies, then unregister it
@@ -210,6 +213,7 @@ func (a AppServices) Register(s *Service) {
 			} else {
 				SERVICE_STOPPING.Log(s.Logger().Info()).Msg("stopping")
 			}
+			publishServiceEvent(SERVICE_STOPPING, s, s.Err())
 
 			a.Unregister(s.id)
 			return nil
@@ -245,6 +249,7 @@ func (a AppServices) Unregister(id ServiceID) {
 
 	delete(services, id)
 	SERVICE_UNREGISTERED.Log(service.Logger().Info()).Msg("unregistered")
+	publishServiceEvent(SERVICE_UNREGISTERED, service, nil)
 }
 
 func logServiceDeath(service *Service) {
@@ -254,6 +259,7 @@ func logServiceDeath(service *Service) {
 		logEvent.Str("err-type", fmt.Sprintf("%T", err))
 	}
 	logEvent.Msg("stopped")
+	publishServiceEvent(SERVICE_STOPPED, service, service.Err())
 }
 
 // ServiceIDs returns the ServiceID(s) for the currently registered services
@@ -406,6 +412,7 @@ func initServiceLogLevels(serviceLogLevelsFlag string) {
 func runAppServer() {
 	app.Go(func() error {
 		APP_STARTED.Log(logger.Info()).Msg("started")
+		publishAppEvent(APP_STARTED)
 
 		sigs := make(chan os.Signal, 1)
 		signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
@@ -425,7 +432,11 @@ func runAppServer() {
 
 func shutdown() {
 	APP_STOPPING.Log(logger.Info()).Msg("stopping")
-	defer APP_STOPPED.Log(logger.Info()).Msg("stopped")
+	publishAppEvent(APP_STOPPING)
+	defer func() {
+		APP_STOPPED.Log(logger.Info()).Msg("stopped")
+		publishAppEvent(APP_STOPPED)
+	}()
 
 	registeredServices := Services.Services()
 
@@ -433,6 +444,7 @@ func shutdown() {
 	for _, service := range registeredServices {
 		service.Kill(nil)
 		SERVICE_KILLED.Log(service.Logger().Info()).Msg("killed")
+		publishServiceEvent(SERVICE_KILLED, service, nil)
 	}
 
 	// Wait until all registered srevices are shutdown.
@@ -454,9 +466,11 @@ SERVICE_LOOP:
 			case <-ticker.C:
 				ticker.Stop()
 				SERVICE_STOPPING_TIMEOUT.Log(service.Logger().Warn()).Msg("service is taking too long to stop")
+				publishServiceEvent(SERVICE_STOPPING_TIMEOUT, service, nil)
 				continue SERVICE_LOOP
 			case <-maxWaitTime.C:
 				APP_STOPPING_TIMEOUT.Log(Logger().Warn()).Msg("app is taking too long to stop")
+				publishAppEvent(APP_STOPPING_TIMEOUT)
 			}
 		}
 	}
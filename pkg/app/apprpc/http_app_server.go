@@ -0,0 +1,936 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprpc
+
+import (
+	"bytes"
+	"compress/zlib"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/capnprpc"
+	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	opcapnp "github.com/oysterpack/oysterpack.go/pkg/app/net/rpc/capnp"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/rs/zerolog"
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
+)
+
+const (
+	// The admin HTTP gateway service. Its config is an RPCServerSpec, i.e., the gateway is secured with the same mTLS
+	// setup as the app RPC server.
+	APP_HTTP_SERVICE_ID = app.ServiceID(0xfb9d0b54aad95a6c)
+
+	// how long the gateway waits for the App capnp interface call to complete
+	APP_HTTP_CALL_TIMEOUT = 10 * time.Second
+	// how long to wait for in flight requests to complete when the gateway is stopped
+	APP_HTTP_SHUTDOWN_TIMEOUT = 5 * time.Second
+)
+
+const (
+	APP_HTTP_START_ERR    = app.LogEventID(0xacbedc59026891e2)
+	APP_HTTP_STARTED      = app.LogEventID(0xb24190754b834212)
+	APP_HTTP_SERVE_ERR    = app.LogEventID(0xdc72a876d0e7a4f4)
+	APP_HTTP_UNAUTHORIZED = app.LogEventID(0xed7a664d0140f032)
+)
+
+func init() {
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: APP_HTTP_START_ERR, Name: "APP_HTTP_START_ERR", Level: zerolog.ErrorLevel, Description: "the admin HTTP gateway failed to start - the app panics",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: APP_HTTP_STARTED, Name: "APP_HTTP_STARTED", Level: zerolog.InfoLevel, Description: "the admin HTTP gateway started",
+			Fields: []app.LogEventField{{Name: "addr", Type: app.LogFieldType_STRING, Description: "gateway listener address"}}},
+		app.LogEventSpec{ID: APP_HTTP_SERVE_ERR, Name: "APP_HTTP_SERVE_ERR", Level: zerolog.ErrorLevel, Description: "the admin HTTP gateway failed while serving requests",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: APP_HTTP_UNAUTHORIZED, Name: "APP_HTTP_UNAUTHORIZED", Level: zerolog.WarnLevel, Description: "an admin HTTP request was not authorized",
+			Fields: []app.LogEventField{
+				{Name: "op", Type: app.LogFieldType_STRING, Description: "the App capnp method operation"},
+				{Name: "path", Type: app.LogFieldType_STRING, Description: "request URL path"},
+				{Name: "cn", Type: app.LogFieldType_STRING, Description: "client cert common name"},
+			}},
+	)
+}
+
+// runHTTPAppServer starts the admin HTTP gateway, if the app has a config for APP_HTTP_SERVICE_ID, i.e., the gateway is optional.
+// If the gateway fails to start, then this is considered a fatal error, which will terminate the process.
+func runHTTPAppServer() {
+	msg, err := app.Configs.Config(APP_HTTP_SERVICE_ID)
+	if err != nil {
+		app.CONFIG_LOADING_ERR.Log(app.Logger().Panic()).Err(err).Msg("")
+	}
+	if msg == nil {
+		return
+	}
+	spec, err := config.ReadRootRPCServerSpec(msg)
+	if err != nil {
+		app.CONFIG_LOADING_ERR.Log(app.Logger().Panic()).Err(err).Msg("")
+		return
+	}
+	serverSpec, err := opcapnp.NewRPCServerSpec(spec)
+	if err != nil {
+		APP_HTTP_START_ERR.Log(app.Logger().Panic()).Err(err).Msg("")
+		return
+	}
+	if _, err := StartHTTPAppServer(serverSpec); err != nil {
+		APP_HTTP_START_ERR.Log(app.Logger().Panic()).Err(err).Msg("")
+	}
+}
+
+// AppHTTPServer is the admin HTTP/JSON gateway for the App capnp interface. It serves the same operations as NewAppServer(),
+// along with the app health checks and metrics, for tools that do not speak capnp RPC - see NewAppHTTPHandler().
+//
+// The gateway is secured with the same mTLS setup as the app RPC server, i.e., clients must present a cert that is signed
+// by the spec's CA. If the spec has an Authorizer, then each route is authorized as the App capnp interface method that
+// it maps to, i.e., the same authz policy applies to RPC and HTTP clients.
+type AppHTTPServer struct {
+	*app.Service
+
+	server   *http.Server
+	listener net.Listener
+}
+
+// StartHTTPAppServer starts the admin HTTP gateway, which runs under the APP_HTTP_SERVICE_ID service.
+// The spec's port, network, certs, and Authorizer are used.
+//
+// The app panics if the APP_HTTP_SERVICE_ID service is already registered, i.e., the gateway is already running.
+//
+// errors:
+//	- app.ErrSpec_IllegalArgument if the spec is nil
+//	- listener errors
+func StartHTTPAppServer(spec *opcapnp.RPCServerSpec) (*AppHTTPServer, error) {
+	if spec == nil {
+		return nil, app.IllegalArgumentError("RPCServerSpec cannot be nil")
+	}
+	// a registration failure is fatal, i.e., app.Services.Register() panics if the service is already registered
+	if app.Services.Service(APP_HTTP_SERVICE_ID) != nil {
+		APP_HTTP_START_ERR.Log(app.Logger().Panic()).Msg("Failed to register app HTTP server - it is already registered")
+	}
+	service := app.NewService(APP_HTTP_SERVICE_ID)
+	app.Services.Register(service)
+
+	l, err := spec.ListenerFactory()()
+	if err != nil {
+		service.Kill(nil)
+		return nil, err
+	}
+	if spec.Network.TLS() {
+		l = tls.NewListener(l, spec.TLSConfig())
+	}
+
+	handler := NewAppHTTPHandler(NewAppInProcessClient())
+	if spec.Authorizer != nil {
+		handler = authorizeAppHTTPRoutes(service, spec.Authorizer, handler)
+	}
+	httpServer := &AppHTTPServer{
+		Service:  service,
+		server:   &http.Server{Handler: handler},
+		listener: l,
+	}
+
+	service.Go(func() error {
+		APP_HTTP_STARTED.Log(service.Logger().Info()).Str("addr", l.Addr().String()).Msg("admin HTTP gateway started")
+		if err := httpServer.server.Serve(l); err != nil && err != http.ErrServerClosed && service.Alive() {
+			APP_HTTP_SERVE_ERR.Log(service.Logger().Error()).Err(err).Msg("admin HTTP gateway failed")
+			return err
+		}
+		return nil
+	})
+	service.Go(func() error {
+		<-service.Dying()
+		ctx, cancel := context.WithTimeout(context.Background(), APP_HTTP_SHUTDOWN_TIMEOUT)
+		defer cancel()
+		httpServer.server.Shutdown(ctx)
+		return nil
+	})
+
+	return httpServer, nil
+}
+
+// Addr returns the listener address
+func (a *AppHTTPServer) Addr() net.Addr {
+	return a.listener.Addr()
+}
+
+// NewAppInProcessClient returns an App capnp client that calls NewAppServer() in process, i.e., the calls go through the
+// same server methods as the app RPC server.
+func NewAppInProcessClient() capnprpc.App {
+	return capnprpc.App{Client: server.New(trace.ServerMethods(capnprpc.App_Methods(nil, NewAppServer())), nil)}
+}
+
+// appHTTPRoute maps a gateway route to the App capnp interface methods that it serves. The methods are named
+// "{interface}.{method}", using the capnp schema names. The route is authorized as its first method.
+type appHTTPRoute struct {
+	httpMethod string
+	path       string
+	methods    []string
+	handler    appHTTPHandler
+}
+
+// appHTTPHandler serves the route - the path parameters are passed in via params
+type appHTTPHandler func(a appHTTPGateway, ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error
+
+// appHTTPRoutes is the gateway's mapping to the App capnp interface. Every method of the App capnp interface, including
+// the interfaces that it returns, must be mapped - see TestAppHTTPRoutes.
+//
+// The health check and metrics routes are not part of the App capnp interface.
+var appHTTPRoutes = []appHTTPRoute{
+	{http.MethodGet, "/app", []string{"App.id", "App.releaseId", "App.instance", "App.startedOn", "App.logLevel"}, appHTTPGateway.app},
+	{http.MethodPost, "/app/kill", []string{"App.kill"}, appHTTPGateway.kill},
+	{http.MethodGet, "/app/services", []string{"App.serviceIds"}, appHTTPGateway.serviceIds},
+	{http.MethodGet, "/app/services/{id}", []string{"App.service", "Service.id", "Service.logLevel", "Service.alive"}, appHTTPGateway.service},
+	{http.MethodGet, "/app/rpc-services", []string{"App.rpcServiceIds"}, appHTTPGateway.rpcServiceIds},
+	{http.MethodGet, "/app/rpc-services/{id}", []string{"App.rpcService", "RPCService.id", "RPCService.listenerAlive", "RPCService.listenerAddress", "RPCService.activeConns", "RPCService.maxConns"}, appHTTPGateway.rpcService},
+	{http.MethodGet, "/app/runtime", []string{"App.runtime", "Runtime.goVersion", "Runtime.numCPU", "Runtime.numGoroutine"}, appHTTPGateway.runtime},
+	{http.MethodGet, "/app/runtime/memstats", []string{"Runtime.memStats"}, appHTTPGateway.memStats},
+	{http.MethodGet, "/app/runtime/stackdump", []string{"Runtime.stackDump"}, appHTTPGateway.stackDump},
+	{http.MethodGet, "/app/configs", []string{"App.configs", "Configs.configDir", "Configs.configDirExists", "Configs.serviceIds"}, appHTTPGateway.configs},
+	{http.MethodGet, "/app/command-pipelines", []string{"App.commandPipelineIds"}, appHTTPGateway.commandPipelineIds},
+	{http.MethodGet, "/app/command-pipelines/{id}", []string{"App.commandPipeline", "CommandPipeline.id", "CommandPipeline.stages"}, appHTTPGateway.commandPipeline},
+	{http.MethodPost, "/app/command-pipelines/{id}/stages/{stage}", []string{"CommandPipeline.setStagePoolSize"}, appHTTPGateway.setStagePoolSize},
+	{http.MethodGet, "/app/log-events", []string{"App.logEventIds"}, appHTTPGateway.logEventIds},
+	{http.MethodGet, "/app/log-events/{id}", []string{"App.logEvent"}, appHTTPGateway.logEvent},
+	{http.MethodGet, "/health", nil, appHTTPGateway.health},
+	{http.MethodGet, "/metrics", nil, appHTTPGateway.metrics},
+}
+
+// appCapnpMethods returns the App capnp interface methods, including the interfaces that it returns, keyed by
+// "{interface}.{method}"
+func appCapnpMethods() map[string]capnp.Method {
+	interfaces := map[string][]server.Method{
+		"App":             capnprpc.App_Methods(nil, nil),
+		"Service":         capnprpc.Service_Methods(nil, nil),
+		"RPCService":      capnprpc.RPCService_Methods(nil, nil),
+		"Runtime":         capnprpc.Runtime_Methods(nil, nil),
+		"Configs":         capnprpc.Configs_Methods(nil, nil),
+		"CommandPipeline": capnprpc.CommandPipeline_Methods(nil, nil),
+	}
+	methods := make(map[string]capnp.Method)
+	for name, interfaceMethods := range interfaces {
+		for _, method := range interfaceMethods {
+			methods[name+"."+method.MethodName] = method.Method
+		}
+	}
+	return methods
+}
+
+// matchAppHTTPRoute returns the route for the request, along with the path parameters
+func matchAppHTTPRoute(httpMethod, path string) (*appHTTPRoute, map[string]string) {
+	pathSegments := strings.Split(strings.Trim(path, "/"), "/")
+ROUTES:
+	for i := range appHTTPRoutes {
+		route := &appHTTPRoutes[i]
+		if route.httpMethod != httpMethod {
+			continue
+		}
+		routeSegments := strings.Split(strings.Trim(route.path, "/"), "/")
+		if len(routeSegments) != len(pathSegments) {
+			continue
+		}
+		params := make(map[string]string)
+		for j, segment := range routeSegments {
+			if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+				params[segment[1:len(segment)-1]] = pathSegments[j]
+				continue
+			}
+			if segment != pathSegments[j] {
+				continue ROUTES
+			}
+		}
+		return route, params
+	}
+	return nil, nil
+}
+
+// authorizeAppHTTPRoutes authorizes each request as the App capnp interface methods that the route maps to, using the
+// verified client cert identity, i.e., the client must be authorized for every method that the route calls.
+// Routes that do not map to a capnp method only require the client cert.
+func authorizeAppHTTPRoutes(service *app.Service, authorizer opnet.Authorizer, handler http.Handler) http.Handler {
+	methods := appCapnpMethods()
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		route, _ := matchAppHTTPRoute(req.Method, req.URL.Path)
+		if route == nil || len(route.methods) == 0 {
+			handler.ServeHTTP(w, req)
+			return
+		}
+		var identity *opnet.PeerIdentity
+		if req.TLS != nil && len(req.TLS.VerifiedChains) > 0 && len(req.TLS.VerifiedChains[0]) > 0 {
+			identity = opnet.NewPeerIdentity(req.TLS.VerifiedChains[0][0])
+		}
+		for _, name := range route.methods {
+			method := methods[name]
+			op := opnet.MethodOperation(method.InterfaceID, method.MethodID)
+			if !authorizer(identity, op) {
+				event := APP_HTTP_UNAUTHORIZED.Log(service.Logger().Warn()).Str("op", op.String()).Str("path", req.URL.Path)
+				if identity != nil {
+					event = event.Str("cn", identity.CN)
+				}
+				event.Msg("unauthorized")
+				writeAppHTTPError(w, http.StatusForbidden, opnet.UnauthorizedError(service.ID(), op))
+				return
+			}
+		}
+		handler.ServeHTTP(w, req)
+	})
+}
+
+// NewAppHTTPHandler returns the admin HTTP/JSON gateway handler, which serves the App capnp interface via the client.
+// The IDs are formatted as hex strings, and are parsed from the path as hex.
+//
+// Routes:
+//	- GET /app : app id, release id, instance id, started on, and log level
+//	- POST /app/kill : kills the app
+//	- GET /app/services : registered service ids
+//	- GET /app/services/{id} : service id, log level, and alive
+//	- GET /app/rpc-services : registered RPC service ids
+//	- GET /app/rpc-services/{id} : RPC service id, listener alive and address, active and max conns
+//	- GET /app/runtime : go version, num CPU, num goroutines
+//	- GET /app/runtime/memstats : runtime memory stats
+//	- GET /app/runtime/stackdump : all goroutine stacks, as text
+//	- GET /app/configs : config dir, and the service ids that have configs
+//	- GET /app/command-pipelines : registered command pipeline ids
+//	- GET /app/command-pipelines/{id} : pipeline id and stages
+//	- POST /app/command-pipelines/{id}/stages/{stage}?poolSize={n} : resizes the stage worker pool
+//	- GET /app/log-events : registered log event ids
+//	- GET /app/log-events/{id}?format={elasticsearch|json-schema} : the log event spec - by default, the spec is returned.
+//	  The format can be used to request the log event's Elasticsearch index template or JSON schema instead.
+//	- GET /health : the latest health check results - the status is 503 if any health check is failing
+//	- GET /metrics : the app metrics
+//
+// Errors are returned as JSON, i.e., {"error": "..."}
+func NewAppHTTPHandler(client capnprpc.App) http.Handler {
+	gateway := appHTTPGateway{client}
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		route, params := matchAppHTTPRoute(req.Method, req.URL.Path)
+		if route == nil {
+			writeAppHTTPError(w, http.StatusNotFound, fmt.Errorf("Route not found : %s %s", req.Method, req.URL.Path))
+			return
+		}
+		ctx, cancel := context.WithTimeout(req.Context(), APP_HTTP_CALL_TIMEOUT)
+		defer cancel()
+		if err := route.handler(gateway, ctx, w, req, params); err != nil {
+			status := http.StatusInternalServerError
+			if _, ok := err.(badAppHTTPRequest); ok {
+				status = http.StatusBadRequest
+			}
+			writeAppHTTPError(w, status, err)
+		}
+	})
+}
+
+// badAppHTTPRequest is returned by the route handlers when the request is invalid, e.g., an id cannot be parsed
+type badAppHTTPRequest struct {
+	error
+}
+
+func writeAppHTTPJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeAppHTTPError(w http.ResponseWriter, status int, err error) {
+	writeAppHTTPJSON(w, status, map[string]string{"error": err.Error()})
+}
+
+func parseAppHTTPID(params map[string]string, name string) (uint64, error) {
+	id, err := strconv.ParseUint(strings.TrimPrefix(params[name], "0x"), 16, 64)
+	if err != nil {
+		return 0, badAppHTTPRequest{fmt.Errorf("Invalid %s : %q", name, params[name])}
+	}
+	return id, nil
+}
+
+func hexIDs(ids capnp.UInt64List) []string {
+	hexIDs := make([]string, ids.Len())
+	for i := range hexIDs {
+		hexIDs[i] = fmt.Sprintf("%x", ids.At(i))
+	}
+	return hexIDs
+}
+
+// appHTTPGateway serves the routes by calling the App capnp interface
+type appHTTPGateway struct {
+	capnprpc.App
+}
+
+type appInfo struct {
+	ID        string    `json:"id"`
+	ReleaseID string    `json:"releaseId"`
+	Instance  string    `json:"instance"`
+	StartedOn time.Time `json:"startedOn"`
+	LogLevel  string    `json:"logLevel"`
+}
+
+func (a appHTTPGateway) app(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	id, err := a.Id(ctx, func(capnprpc.App_id_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	releaseID, err := a.ReleaseId(ctx, func(capnprpc.App_releaseId_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	instanceResults, err := a.Instance(ctx, func(capnprpc.App_instance_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	instance, err := instanceResults.InstanceId()
+	if err != nil {
+		return err
+	}
+	startedOn, err := a.StartedOn(ctx, func(capnprpc.App_startedOn_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	logLevel, err := a.LogLevel(ctx, func(capnprpc.App_logLevel_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, appInfo{
+		ID:        fmt.Sprintf("%x", id.AppId()),
+		ReleaseID: fmt.Sprintf("%x", releaseID.ReleaseId()),
+		Instance:  instance,
+		StartedOn: time.Unix(0, startedOn.StartedOn()),
+		LogLevel:  logLevel.Level().String(),
+	})
+	return nil
+}
+
+func (a appHTTPGateway) kill(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	if _, err := a.Kill(ctx, func(capnprpc.App_kill_Params) error { return nil }).Struct(); err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (a appHTTPGateway) serviceIds(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.ServiceIds(ctx, func(capnprpc.App_serviceIds_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	ids, err := results.ServiceIds()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, hexIDs(ids))
+	return nil
+}
+
+type serviceInfo struct {
+	ID       string `json:"id"`
+	LogLevel string `json:"logLevel"`
+	Alive    bool   `json:"alive"`
+}
+
+func (a appHTTPGateway) service(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	id, err := parseAppHTTPID(params, "id")
+	if err != nil {
+		return err
+	}
+	service := a.Service(ctx, func(p capnprpc.App_service_Params) error {
+		p.SetId(id)
+		return nil
+	}).Service()
+	serviceID, err := service.Id(ctx, func(capnprpc.Service_id_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	logLevel, err := service.LogLevel(ctx, func(capnprpc.Service_logLevel_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	alive, err := service.Alive(ctx, func(capnprpc.Service_alive_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, serviceInfo{
+		ID:       fmt.Sprintf("%x", serviceID.ServiceId()),
+		LogLevel: logLevel.Level().String(),
+		Alive:    alive.Alive(),
+	})
+	return nil
+}
+
+func (a appHTTPGateway) rpcServiceIds(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.RpcServiceIds(ctx, func(capnprpc.App_rpcServiceIds_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	ids, err := results.ServiceIds()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, hexIDs(ids))
+	return nil
+}
+
+type networkAddress struct {
+	Network string `json:"network"`
+	Address string `json:"address"`
+}
+
+type rpcServiceInfo struct {
+	ID              string          `json:"id"`
+	ListenerAlive   bool            `json:"listenerAlive"`
+	ListenerAddress *networkAddress `json:"listenerAddress,omitempty"`
+	ActiveConns     uint32          `json:"activeConns"`
+	MaxConns        uint32          `json:"maxConns"`
+}
+
+func (a appHTTPGateway) rpcService(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	id, err := parseAppHTTPID(params, "id")
+	if err != nil {
+		return err
+	}
+	service := a.RpcService(ctx, func(p capnprpc.App_rpcService_Params) error {
+		p.SetId(id)
+		return nil
+	}).Service()
+	serviceID, err := service.Id(ctx, func(capnprpc.RPCService_id_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	info := rpcServiceInfo{ID: fmt.Sprintf("%x", serviceID.ServiceId())}
+	listenerAlive, err := service.ListenerAlive(ctx, func(capnprpc.RPCService_listenerAlive_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	info.ListenerAlive = listenerAlive.ListenerAlive()
+	if info.ListenerAlive {
+		results, err := service.ListenerAddress(ctx, func(capnprpc.RPCService_listenerAddress_Params) error { return nil }).Struct()
+		if err != nil {
+			return err
+		}
+		addr, err := results.Address()
+		if err != nil {
+			return err
+		}
+		info.ListenerAddress = &networkAddress{}
+		if info.ListenerAddress.Network, err = addr.Network(); err != nil {
+			return err
+		}
+		if info.ListenerAddress.Address, err = addr.Address(); err != nil {
+			return err
+		}
+	}
+	activeConns, err := service.ActiveConns(ctx, func(capnprpc.RPCService_activeConns_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	info.ActiveConns = activeConns.Count()
+	maxConns, err := service.MaxConns(ctx, func(capnprpc.RPCService_maxConns_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	info.MaxConns = maxConns.Count()
+	writeAppHTTPJSON(w, http.StatusOK, info)
+	return nil
+}
+
+type runtimeInfo struct {
+	GoVersion    string `json:"goVersion"`
+	NumCPU       uint32 `json:"numCPU"`
+	NumGoroutine uint32 `json:"numGoroutine"`
+}
+
+func (a appHTTPGateway) runtimeClient(ctx context.Context) capnprpc.Runtime {
+	return a.Runtime(ctx, func(capnprpc.App_runtime_Params) error { return nil }).Runtime()
+}
+
+func (a appHTTPGateway) runtime(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	rt := a.runtimeClient(ctx)
+	goVersionResults, err := rt.GoVersion(ctx, func(capnprpc.Runtime_goVersion_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	goVersion, err := goVersionResults.Version()
+	if err != nil {
+		return err
+	}
+	numCPU, err := rt.NumCPU(ctx, func(capnprpc.Runtime_numCPU_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	numGoroutine, err := rt.NumGoroutine(ctx, func(capnprpc.Runtime_numGoroutine_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, runtimeInfo{
+		GoVersion:    goVersion,
+		NumCPU:       numCPU.Count(),
+		NumGoroutine: numGoroutine.Count(),
+	})
+	return nil
+}
+
+func (a appHTTPGateway) memStats(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.runtimeClient(ctx).MemStats(ctx, func(capnprpc.Runtime_memStats_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	stats, err := results.Stats()
+	if err != nil {
+		return err
+	}
+	memStats, err := toRuntimeMemStats(stats)
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, memStats)
+	return nil
+}
+
+// toRuntimeMemStats maps the capnp MemStats back to runtime.MemStats, i.e., the JSON fields are the runtime.MemStats fields
+func toRuntimeMemStats(stats capnprpc.MemStats) (*runtime.MemStats, error) {
+	memStats := &runtime.MemStats{
+		Alloc:         stats.Alloc(),
+		TotalAlloc:    stats.TotalAlloc(),
+		Sys:           stats.Sys(),
+		Lookups:       stats.Lookups(),
+		Mallocs:       stats.Mallocs(),
+		Frees:         stats.Frees(),
+		HeapAlloc:     stats.HeapAlloc(),
+		HeapSys:       stats.HeapSys(),
+		HeapIdle:      stats.HeapIdle(),
+		HeapInuse:     stats.HeapInUse(),
+		HeapReleased:  stats.HeapReleased(),
+		HeapObjects:   stats.HeapObjects(),
+		StackInuse:    stats.StackInUse(),
+		StackSys:      stats.StackSys(),
+		MSpanInuse:    stats.MSpanInUse(),
+		MSpanSys:      stats.MSpanSys(),
+		MCacheInuse:   stats.MCacheInUse(),
+		MCacheSys:     stats.MCacheSys(),
+		BuckHashSys:   stats.BuckHashSys(),
+		GCSys:         stats.GCSys(),
+		OtherSys:      stats.OtherSys(),
+		NextGC:        stats.NextGC(),
+		LastGC:        stats.LastGC(),
+		PauseTotalNs:  stats.PauseTotalNs(),
+		NumGC:         stats.NumGC(),
+		NumForcedGC:   stats.NumForcedGC(),
+		GCCPUFraction: stats.GCCPUFraction(),
+	}
+	pauseNs, err := stats.PauseNs()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < pauseNs.Len() && i < len(memStats.PauseNs); i++ {
+		memStats.PauseNs[i] = pauseNs.At(i)
+	}
+	pauseEnd, err := stats.PauseEnd()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < pauseEnd.Len() && i < len(memStats.PauseEnd); i++ {
+		memStats.PauseEnd[i] = pauseEnd.At(i)
+	}
+	bySize, err := stats.BySize()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < bySize.Len() && i < len(memStats.BySize); i++ {
+		memStats.BySize[i].Size = bySize.At(i).Size()
+		memStats.BySize[i].Mallocs = bySize.At(i).Mallocs()
+		memStats.BySize[i].Frees = bySize.At(i).Frees()
+	}
+	return memStats, nil
+}
+
+func (a appHTTPGateway) stackDump(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.runtimeClient(ctx).StackDump(ctx, func(capnprpc.Runtime_stackDump_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	compressed, err := results.StackDump()
+	if err != nil {
+		return err
+	}
+	r, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	_, err = io.Copy(w, r)
+	return err
+}
+
+type configsInfo struct {
+	ConfigDir       string   `json:"configDir"`
+	ConfigDirExists bool     `json:"configDirExists"`
+	ServiceIDs      []string `json:"serviceIds"`
+}
+
+func (a appHTTPGateway) configs(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	configs := a.Configs(ctx, func(capnprpc.App_configs_Params) error { return nil }).Configs()
+	configDirResults, err := configs.ConfigDir(ctx, func(capnprpc.Configs_configDir_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	configDir, err := configDirResults.ConfigDir()
+	if err != nil {
+		return err
+	}
+	configDirExists, err := configs.ConfigDirExists(ctx, func(capnprpc.Configs_configDirExists_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	serviceIdsResults, err := configs.ServiceIds(ctx, func(capnprpc.Configs_serviceIds_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	serviceIds, err := serviceIdsResults.ServiceIds()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, configsInfo{
+		ConfigDir:       configDir,
+		ConfigDirExists: configDirExists.Exists(),
+		ServiceIDs:      hexIDs(serviceIds),
+	})
+	return nil
+}
+
+func (a appHTTPGateway) commandPipelineIds(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.CommandPipelineIds(ctx, func(capnprpc.App_commandPipelineIds_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	ids, err := results.PipelineIds()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, hexIDs(ids))
+	return nil
+}
+
+type pipelineStage struct {
+	CommandID  string `json:"commandId"`
+	PoolSize   uint8  `json:"poolSize"`
+	BufferSize uint16 `json:"bufferSize"`
+	Autoscaled bool   `json:"autoscaled"`
+}
+
+type commandPipelineInfo struct {
+	ID     string          `json:"id"`
+	Stages []pipelineStage `json:"stages"`
+}
+
+func (a appHTTPGateway) commandPipelineClient(ctx context.Context, params map[string]string) (capnprpc.CommandPipeline, error) {
+	id, err := parseAppHTTPID(params, "id")
+	if err != nil {
+		return capnprpc.CommandPipeline{}, err
+	}
+	return a.CommandPipeline(ctx, func(p capnprpc.App_commandPipeline_Params) error {
+		p.SetId(id)
+		return nil
+	}).CommandPipeline(), nil
+}
+
+func (a appHTTPGateway) commandPipeline(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	pipeline, err := a.commandPipelineClient(ctx, params)
+	if err != nil {
+		return err
+	}
+	pipelineID, err := pipeline.Id(ctx, func(capnprpc.CommandPipeline_id_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	stagesResults, err := pipeline.Stages(ctx, func(capnprpc.CommandPipeline_stages_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	stages, err := stagesResults.Stages()
+	if err != nil {
+		return err
+	}
+	info := commandPipelineInfo{
+		ID:     fmt.Sprintf("%x", pipelineID.PipelineId()),
+		Stages: make([]pipelineStage, stages.Len()),
+	}
+	for i := range info.Stages {
+		stage := stages.At(i)
+		info.Stages[i] = pipelineStage{
+			CommandID:  fmt.Sprintf("%x", stage.CommandId()),
+			PoolSize:   stage.PoolSize(),
+			BufferSize: stage.BufferSize(),
+			Autoscaled: stage.Autoscaled(),
+		}
+	}
+	writeAppHTTPJSON(w, http.StatusOK, info)
+	return nil
+}
+
+func (a appHTTPGateway) setStagePoolSize(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	pipeline, err := a.commandPipelineClient(ctx, params)
+	if err != nil {
+		return err
+	}
+	stage, err := strconv.ParseUint(params["stage"], 10, 16)
+	if err != nil {
+		return badAppHTTPRequest{fmt.Errorf("Invalid stage : %q", params["stage"])}
+	}
+	poolSize, err := strconv.ParseUint(req.URL.Query().Get("poolSize"), 10, 8)
+	if err != nil || poolSize == 0 {
+		return badAppHTTPRequest{fmt.Errorf("Invalid poolSize : %q", req.URL.Query().Get("poolSize"))}
+	}
+	_, err = pipeline.SetStagePoolSize(ctx, func(p capnprpc.CommandPipeline_setStagePoolSize_Params) error {
+		p.SetStage(uint16(stage))
+		p.SetPoolSize(uint8(poolSize))
+		return nil
+	}).Struct()
+	if err != nil {
+		return err
+	}
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (a appHTTPGateway) logEventIds(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	results, err := a.LogEventIds(ctx, func(capnprpc.App_logEventIds_Params) error { return nil }).Struct()
+	if err != nil {
+		return err
+	}
+	ids, err := results.LogEventIds()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, hexIDs(ids))
+	return nil
+}
+
+type logEventField struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type logEventInfo struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Level       string          `json:"level"`
+	Description string          `json:"description"`
+	Fields      []logEventField `json:"fields"`
+}
+
+func (a appHTTPGateway) logEvent(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	id, err := parseAppHTTPID(params, "id")
+	if err != nil {
+		return err
+	}
+	format := req.URL.Query().Get("format")
+	switch format {
+	case "", "elasticsearch", "json-schema":
+	default:
+		return badAppHTTPRequest{fmt.Errorf("Invalid format : %q", format)}
+	}
+	results, err := a.LogEvent(ctx, func(p capnprpc.App_logEvent_Params) error {
+		p.SetId(id)
+		return nil
+	}).Struct()
+	if err != nil {
+		return err
+	}
+	logEvent, err := results.LogEvent()
+	if err != nil {
+		return err
+	}
+	spec, err := CapnprpcLogEvent2LogEventSpec(logEvent)
+	if err != nil {
+		return err
+	}
+
+	var doc []byte
+	switch format {
+	case "elasticsearch":
+		doc, err = spec.ElasticsearchIndexTemplate()
+	case "json-schema":
+		doc, err = spec.JSONSchema()
+	default:
+		info := logEventInfo{
+			ID:          spec.ID.Hex(),
+			Name:        spec.Name,
+			Level:       spec.Level.String(),
+			Description: spec.Description,
+			Fields:      make([]logEventField, len(spec.Fields)),
+		}
+		for i, field := range spec.Fields {
+			info.Fields[i] = logEventField{Name: field.Name, Type: field.Type.String(), Description: field.Description}
+		}
+		writeAppHTTPJSON(w, http.StatusOK, info)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(doc)
+	return nil
+}
+
+type healthCheckResult struct {
+	ID       string    `json:"id"`
+	Healthy  bool      `json:"healthy"`
+	Err      string    `json:"error,omitempty"`
+	Time     time.Time `json:"time"`
+	Duration string    `json:"duration"`
+	ErrCount uint      `json:"errCount"`
+}
+
+// health reports the latest health check results. Health checks that have not yet run are reported as healthy.
+func (a appHTTPGateway) health(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	status := http.StatusOK
+	results := []healthCheckResult{}
+	for id, result := range app.HealthChecks.HealthCheckResults() {
+		healthCheck := healthCheckResult{
+			ID:       id.Hex(),
+			Healthy:  result.Err == nil,
+			Time:     result.Time,
+			Duration: result.Duration.String(),
+			ErrCount: result.ErrCount,
+		}
+		if result.Err != nil {
+			healthCheck.Err = result.Err.Error()
+			status = http.StatusServiceUnavailable
+		}
+		results = append(results, healthCheck)
+	}
+	writeAppHTTPJSON(w, status, results)
+	return nil
+}
+
+func (a appHTTPGateway) metrics(ctx context.Context, w http.ResponseWriter, req *http.Request, params map[string]string) error {
+	metricFamilies, err := app.MetricRegistry.Gather()
+	if err != nil {
+		return err
+	}
+	writeAppHTTPJSON(w, http.StatusOK, metricFamilies)
+	return nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+)
+
+// the HTTP gateway must stay in sync with the App capnp interface, i.e., every capnp method must be mapped to a route,
+// and every route must map to capnp methods that exist
+func TestAppHTTPRoutes(t *testing.T) {
+	capnpMethods := appCapnpMethods()
+	mapped := make(map[string]bool)
+	for _, route := range appHTTPRoutes {
+		for _, method := range route.methods {
+			if _, ok := capnpMethods[method]; !ok {
+				t.Errorf("%s %s maps to a capnp method that does not exist : %s", route.httpMethod, route.path, method)
+			}
+			if mapped[method] {
+				t.Errorf("capnp method is mapped more than once : %s", method)
+			}
+			mapped[method] = true
+		}
+		if route.handler == nil {
+			t.Errorf("%s %s has no handler", route.httpMethod, route.path)
+		}
+	}
+	for method := range capnpMethods {
+		if !mapped[method] {
+			t.Errorf("capnp method is not mapped to an HTTP route : %s", method)
+		}
+	}
+
+	route, params := matchAppHTTPRoute(http.MethodPost, "/app/command-pipelines/abc/stages/2")
+	if route == nil || route.path != "/app/command-pipelines/{id}/stages/{stage}" {
+		t.Fatalf("route did not match : %v", route)
+	}
+	if params["id"] != "abc" || params["stage"] != "2" {
+		t.Errorf("params did not match : %v", params)
+	}
+	if route, _ := matchAppHTTPRoute(http.MethodGet, "/app/command-pipelines/abc/stages/2"); route != nil {
+		t.Errorf("route should not have matched : %v", route)
+	}
+}
+
+// each route must be authorized for every capnp method that it maps to, and not just the first one
+func TestAuthorizeAppHTTPRoutes(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	methods := appCapnpMethods()
+	operation := func(name string) opnet.Operation {
+		return opnet.MethodOperation(methods[name].InterfaceID, methods[name].MethodID)
+	}
+	// GET /app/services/{id} maps to App.service, Service.id, Service.logLevel, and Service.alive
+	denied := operation("Service.alive")
+	authorizer := func(identity *opnet.PeerIdentity, op opnet.Operation) bool {
+		return op != denied
+	}
+	handler := authorizeAppHTTPRoutes(app.NewService(APP_HTTP_SERVICE_ID), authorizer, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	check := func(path string, expectedStatus int) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		if w.Code != expectedStatus {
+			t.Errorf("GET %s : %d != %d", path, w.Code, expectedStatus)
+		}
+	}
+	check("/app/services/1", http.StatusForbidden)
+	check("/app/services", http.StatusOK)
+}
+
+func TestAppHTTPHandler(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	server := httptest.NewServer(NewAppHTTPHandler(NewAppInProcessClient()))
+	defer server.Close()
+
+	get := func(path string, v interface{}) int {
+		resp, err := http.Get(server.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if v != nil {
+			if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+				t.Fatalf("%s : %v", path, err)
+			}
+		}
+		return resp.StatusCode
+	}
+
+	info := appInfo{}
+	if status := get("/app", &info); status != http.StatusOK {
+		t.Fatalf("GET /app failed : %d", status)
+	}
+	if info.ID != fmt.Sprintf("%x", app.ID()) {
+		t.Errorf("app info did not match : %v", info)
+	}
+
+	serviceIDs := []string{}
+	if status := get("/app/services", &serviceIDs); status != http.StatusOK {
+		t.Fatalf("GET /app/services failed : %d", status)
+	}
+	for _, id := range serviceIDs {
+		service := serviceInfo{}
+		if status := get("/app/services/"+id, &service); status != http.StatusOK {
+			t.Fatalf("GET /app/services/%s failed : %d", id, status)
+		}
+		if service.ID != id || !service.Alive {
+			t.Errorf("service info did not match : %v", service)
+		}
+	}
+
+	if status := get("/app/services/not-hex", nil); status != http.StatusBadRequest {
+		t.Errorf("invalid id should be a bad request : %d", status)
+	}
+	if status := get("/app/unknown", nil); status != http.StatusNotFound {
+		t.Errorf("unknown route should not be found : %d", status)
+	}
+
+	rt := runtimeInfo{}
+	if status := get("/app/runtime", &rt); status != http.StatusOK || rt.GoVersion == "" || rt.NumCPU == 0 {
+		t.Errorf("GET /app/runtime failed : %d : %v", status, rt)
+	}
+	if status := get("/app/runtime/memstats", &map[string]interface{}{}); status != http.StatusOK {
+		t.Errorf("GET /app/runtime/memstats failed : %d", status)
+	}
+	logEventIDs := []string{}
+	if status := get("/app/log-events", &logEventIDs); status != http.StatusOK || len(logEventIDs) != len(app.LogEvents.LogEventIDs()) {
+		t.Fatalf("GET /app/log-events failed : %d : %v", status, logEventIDs)
+	}
+	logEvent := logEventInfo{}
+	if status := get("/app/log-events/"+APP_HTTP_UNAUTHORIZED.Hex(), &logEvent); status != http.StatusOK {
+		t.Fatalf("GET /app/log-events/{id} failed : %d", status)
+	}
+	if logEvent.Name != "APP_HTTP_UNAUTHORIZED" || logEvent.Level != "warn" || len(logEvent.Fields) != 3 || logEvent.Fields[2].Name != "cn" {
+		t.Errorf("log event did not match : %v", logEvent)
+	}
+	indexTemplate := map[string]interface{}{}
+	if status := get("/app/log-events/"+APP_HTTP_UNAUTHORIZED.Hex()+"?format=elasticsearch", &indexTemplate); status != http.StatusOK || indexTemplate["index_patterns"] == nil {
+		t.Errorf("GET /app/log-events/{id}?format=elasticsearch failed : %d : %v", status, indexTemplate)
+	}
+	jsonSchema := map[string]interface{}{}
+	if status := get("/app/log-events/"+APP_HTTP_UNAUTHORIZED.Hex()+"?format=json-schema", &jsonSchema); status != http.StatusOK || jsonSchema["properties"] == nil {
+		t.Errorf("GET /app/log-events/{id}?format=json-schema failed : %d : %v", status, jsonSchema)
+	}
+	if status := get("/app/log-events/"+APP_HTTP_UNAUTHORIZED.Hex()+"?format=xml", nil); status != http.StatusBadRequest {
+		t.Errorf("invalid format should be a bad request : %d", status)
+	}
+	if status := get("/app/log-events/1", nil); status != http.StatusInternalServerError {
+		t.Errorf("unregistered log event should fail : %d", status)
+	}
+
+	if status := get("/health", &[]healthCheckResult{}); status != http.StatusOK {
+		t.Errorf("GET /health failed : %d", status)
+	}
+	if status := get("/metrics", &[]interface{}{}); status != http.StatusOK {
+		t.Errorf("GET /metrics failed : %d", status)
+	}
+}
@@ -17,40 +17,32 @@ package apprpc
 import (
 	"context"
 
+	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/capnprpc"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 )
 
-// NewAppClient creates a new App capnp RPC client.
+// NewAppClient creates a new App capnp RPC client, which is backed by an RPCClientPool using the default settings.
 // An RPCClientSpec config must exist for the specified ServiceID.
 //
+// The client connects in the background, and transparently reconnects - use Ready() to wait for the client to connect.
+//
 // NOTE: when the app is clustered, the RPC client will connect to any instance in the cluster
 // It will connect using the following network address : {DomainID}_{AppID}
 func NewAppClient(serviceId ServiceID) (*AppRPCClient, error) {
-	cfg, err := Configs.Config(serviceId)
-	if err != nil {
-		return nil, err
-	}
-
-	spec, err := config.ReadRootRPCClientSpec(cfg)
-	if err != nil {
-		return nil, err
-	}
-
-	rpcClientSpec, err := NewRPCClientSpec(spec)
-	if err != nil {
-		return nil, err
-	}
-
-	conn, err := rpcClientSpec.Conn()
-	if err != nil {
-		return nil, err
-	}
-	return &AppRPCClient{&capnprpc.App{Client: conn.Bootstrap(context.Background())}}, nil
+	return NewAppClientWithSettings(serviceId, RPCClientPoolSettings{})
 }
 
 // NewAppClientForAddr works the same as NewAppClient, except that it enables connecting to a specific app instance by network address
 func NewAppClientForAddr(serviceId ServiceID, networkAddr string) (*AppRPCClient, error) {
+	return NewAppClientWithSettings(serviceId, RPCClientPoolSettings{Addrs: []string{networkAddr}})
+}
+
+// NewAppClientWithSettings works the same as NewAppClient, except that the RPCClientPool is configured via the settings,
+// e.g., to fail over between app instances, or to resolve app instances via service discovery.
+func NewAppClientWithSettings(serviceId ServiceID, settings RPCClientPoolSettings) (*AppRPCClient, error) {
 	cfg, err := Configs.Config(serviceId)
 	if err != nil {
 		return nil, err
@@ -66,11 +58,11 @@ func NewAppClientForAddr(serviceId ServiceID, networkAddr string) (*AppRPCClient
 		return nil, err
 	}
 
-	conn, err := rpcClientSpec.ConnForAddr(networkAddr)
+	pool, err := NewRPCClientPool(NewService(serviceId), rpcClientSpec, settings)
 	if err != nil {
 		return nil, err
 	}
-	return &AppRPCClient{&capnprpc.App{Client: conn.Bootstrap(context.Background())}}, nil
+	return &AppRPCClient{&capnprpc.App{Client: trace.Client(pool.Client())}, pool}, nil
 }
 
 // capnprpc.App function params - for RPC functions that take no params
@@ -85,11 +77,26 @@ var (
 	_App_kill             = func(_ capnprpc.App_kill_Params) error { return nil }
 	_App_runtime          = func(_ capnprpc.App_runtime_Params) error { return nil }
 	_App_configs          = func(_ capnprpc.App_configs_Params) error { return nil }
+
+	_App_commandPipelineIds = func(_ capnprpc.App_commandPipelineIds_Params) error { return nil }
+	_App_logEventIds        = func(_ capnprpc.App_logEventIds_Params) error { return nil }
 )
 
 // AppRPCClient wraps the capnprpc.App in order to provide a more user friendly interface
 type AppRPCClient struct {
 	*capnprpc.App
+
+	pool *RPCClientPool
+}
+
+// Ready waits until the client is connected
+func (a *AppRPCClient) Ready(ctx context.Context) error {
+	return a.pool.Ready(ctx)
+}
+
+// Pool returns the RPCClientPool, which can be used to check the conn state
+func (a *AppRPCClient) Pool() *RPCClientPool {
+	return a.pool
 }
 
 func (a *AppRPCClient) Id(ctx context.Context) capnprpc.App_id_Results_Promise {
@@ -147,8 +154,31 @@ func (a *AppRPCClient) Configs(ctx context.Context) capnprpc.App_configs_Results
 	return a.App.Configs(ctx, _App_configs)
 }
 
-// Close releases any resources associated with this client.
+func (a *AppRPCClient) CommandPipelineIds(ctx context.Context) capnprpc.App_commandPipelineIds_Results_Promise {
+	return a.App.CommandPipelineIds(ctx, _App_commandPipelineIds)
+}
+
+func (a *AppRPCClient) CommandPipeline(ctx context.Context, id command.PipelineID) capnprpc.App_commandPipeline_Results_Promise {
+	return a.App.CommandPipeline(ctx, func(params capnprpc.App_commandPipeline_Params) error {
+		params.SetId(uint64(id))
+		return nil
+	})
+}
+
+func (a *AppRPCClient) LogEventIds(ctx context.Context) capnprpc.App_logEventIds_Results_Promise {
+	return a.App.LogEventIds(ctx, _App_logEventIds)
+}
+
+func (a *AppRPCClient) LogEvent(ctx context.Context, id app.LogEventID) capnprpc.App_logEvent_Results_Promise {
+	return a.App.LogEvent(ctx, func(params capnprpc.App_logEvent_Params) error {
+		params.SetId(uint64(id))
+		return nil
+	})
+}
+
+// Close releases any resources associated with this client, i.e., the pooled conns are closed.
 // No further calls to the client should be made after calling Close.
 func (a *AppRPCClient) Close() {
 	a.App.Client.Close()
+	a.pool.Close()
 }
@@ -20,17 +20,27 @@ import (
 	"bytes"
 	"compress/zlib"
 
+	"errors"
+
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/capnprpc"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	"github.com/oysterpack/oysterpack.go/pkg/app/config"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 	"github.com/rs/zerolog"
 	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
 )
 
 const (
 	APP_RPC_SERVICE_ID = app.ServiceID(0xe49214fa20b35ba8)
 )
 
+var (
+	ErrPipelineNotFound = errors.New("Pipeline not found")
+	ErrLogEventNotFound = errors.New("LogEvent not found")
+)
+
 // if the app RPC server fails to start, then this is considered a fatal error, which will terminate the process.
 func runRPCAppServer() {
 	msg, err := app.Configs.Config(APP_RPC_SERVICE_ID)
@@ -59,9 +69,9 @@ func startRPCAppServer(listenerFactory ListenerFactory, tlsConfigProvider TLSCon
 		APP_RPC_START_ERR.Log(Logger().Panic()).Err(err).Msg("Failed to register app RPC server")
 	}
 
-	server := capnprpc.App_ServerToClient(NewAppServer())
+	client := server.New(trace.ServerMethods(capnprpc.App_Methods(nil, NewAppServer())), nil)
 	rpcMainInterface := func() (capnp.Client, error) {
-		return server.Client, nil
+		return client, nil
 	}
 
 	return StartRPCService(rpcServer, listenerFactory, tlsConfigProvider, rpcMainInterface, maxConns)
@@ -168,6 +178,120 @@ func (a rpcAppServer) Configs(call capnprpc.App_configs) error {
 	return call.Results.SetConfigs(capnprpc.Configs_ServerToClient(a.configsServer))
 }
 
+func (a rpcAppServer) CommandPipelineIds(call capnprpc.App_commandPipelineIds) error {
+	ids := command.PipelineIDs()
+	list, err := capnp.NewUInt64List(call.Results.Segment(), int32(len(ids)))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < list.Len(); i++ {
+		list.Set(i, uint64(ids[i]))
+	}
+	return call.Results.SetPipelineIds(list)
+}
+
+func (a rpcAppServer) CommandPipeline(call capnprpc.App_commandPipeline) error {
+	pipeline := command.GetPipeline(command.PipelineID(call.Params.Id()))
+	if pipeline == nil {
+		return ErrPipelineNotFound
+	}
+	return call.Results.SetCommandPipeline(capnprpc.CommandPipeline_ServerToClient(rpcCommandPipelineServer{pipeline}))
+}
+
+func (a rpcAppServer) LogEventIds(call capnprpc.App_logEventIds) error {
+	ids := app.LogEvents.LogEventIDs()
+	list, err := capnp.NewUInt64List(call.Results.Segment(), int32(len(ids)))
+	if err != nil {
+		return err
+	}
+	for i := 0; i < list.Len(); i++ {
+		list.Set(i, uint64(ids[i]))
+	}
+	return call.Results.SetLogEventIds(list)
+}
+
+func (a rpcAppServer) LogEvent(call capnprpc.App_logEvent) error {
+	spec := app.LogEvents.LogEvent(app.LogEventID(call.Params.Id()))
+	if spec == nil {
+		return ErrLogEventNotFound
+	}
+	logEvent, err := call.Results.NewLogEvent()
+	if err != nil {
+		return err
+	}
+	return LogEventSpec2capnprpcLogEvent(spec, logEvent)
+}
+
+// LogEventSpec2capnprpcLogEvent copies the LogEventSpec into the capnprpc.LogEvent
+func LogEventSpec2capnprpcLogEvent(spec *app.LogEventSpec, logEvent capnprpc.LogEvent) error {
+	level, err := ZerologLevel2capnprpcLogLevel(spec.Level)
+	if err != nil {
+		return err
+	}
+	logEvent.SetId(uint64(spec.ID))
+	logEvent.SetLevel(level)
+	if err := logEvent.SetName(spec.Name); err != nil {
+		return err
+	}
+	if err := logEvent.SetDescription(spec.Description); err != nil {
+		return err
+	}
+	fields, err := logEvent.NewFields(int32(len(spec.Fields)))
+	if err != nil {
+		return err
+	}
+	for i, f := range spec.Fields {
+		field := fields.At(i)
+		if err := field.SetName(f.Name); err != nil {
+			return err
+		}
+		// the capnp enum values are declared in the same order as the app.LogFieldType values
+		field.SetType(capnprpc.LogEventField_Type(f.Type))
+		if err := field.SetDescription(f.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CapnprpcLogEvent2LogEventSpec capnprpc.LogEvent -> *app.LogEventSpec
+func CapnprpcLogEvent2LogEventSpec(logEvent capnprpc.LogEvent) (*app.LogEventSpec, error) {
+	level, err := CapnprpcLogLevel2zerologLevel(logEvent.Level())
+	if err != nil {
+		return nil, err
+	}
+	name, err := logEvent.Name()
+	if err != nil {
+		return nil, err
+	}
+	description, err := logEvent.Description()
+	if err != nil {
+		return nil, err
+	}
+	fields, err := logEvent.Fields()
+	if err != nil {
+		return nil, err
+	}
+	spec := &app.LogEventSpec{
+		ID:          app.LogEventID(logEvent.Id()),
+		Name:        name,
+		Level:       level,
+		Description: description,
+		Fields:      make([]app.LogEventField, fields.Len()),
+	}
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.At(i)
+		if spec.Fields[i].Name, err = field.Name(); err != nil {
+			return nil, err
+		}
+		spec.Fields[i].Type = app.LogFieldType(field.Type())
+		if spec.Fields[i].Description, err = field.Description(); err != nil {
+			return nil, err
+		}
+	}
+	return spec, nil
+}
+
 // CapnprpcLogLevel2zerologLevel capnproc.LogLevel -> zerolog.Level
 // error : ErrUnknownLogLevel
 func CapnprpcLogLevel2zerologLevel(logLevel capnprpc.LogLevel) (zerolog.Level, error) {
@@ -438,3 +562,32 @@ func (a rpcConfigsServer) ServiceIds(call capnprpc.Configs_serviceIds) error {
 	call.Results.SetServiceIds(serviceIdsResults)
 	return nil
 }
+
+type rpcCommandPipelineServer struct {
+	*command.Pipeline
+}
+
+func (a rpcCommandPipelineServer) Id(call capnprpc.CommandPipeline_id) error {
+	call.Results.SetPipelineId(uint64(a.ID()))
+	return nil
+}
+
+func (a rpcCommandPipelineServer) Stages(call capnprpc.CommandPipeline_stages) error {
+	stages := a.Pipeline.Stages()
+	list, err := call.Results.NewStages(int32(len(stages)))
+	if err != nil {
+		return err
+	}
+	for i, stage := range stages {
+		s := list.At(i)
+		s.SetCommandId(uint64(stage.Command().CommandID()))
+		s.SetPoolSize(stage.PoolSize())
+		s.SetBufferSize(stage.BufferSize())
+		s.SetAutoscaled(stage.Autoscaler() != nil)
+	}
+	return nil
+}
+
+func (a rpcCommandPipelineServer) SetStagePoolSize(call capnprpc.CommandPipeline_setStagePoolSize) error {
+	return a.Pipeline.SetStagePoolSize(int(call.Params.Stage()), call.Params.PoolSize())
+}
@@ -257,6 +257,86 @@ func (c App) Configs(ctx context.Context, params func(App_configs_Params) error,
 	}
 	return App_configs_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
+func (c App) CommandPipelineIds(ctx context.Context, params func(App_commandPipelineIds_Params) error, opts ...capnp.CallOption) App_commandPipelineIds_Results_Promise {
+	if c.Client == nil {
+		return App_commandPipelineIds_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      12,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "commandPipelineIds",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(App_commandPipelineIds_Params{Struct: s}) }
+	}
+	return App_commandPipelineIds_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c App) CommandPipeline(ctx context.Context, params func(App_commandPipeline_Params) error, opts ...capnp.CallOption) App_commandPipeline_Results_Promise {
+	if c.Client == nil {
+		return App_commandPipeline_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      13,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "commandPipeline",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(App_commandPipeline_Params{Struct: s}) }
+	}
+	return App_commandPipeline_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c App) LogEventIds(ctx context.Context, params func(App_logEventIds_Params) error, opts ...capnp.CallOption) App_logEventIds_Results_Promise {
+	if c.Client == nil {
+		return App_logEventIds_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      14,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "logEventIds",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(App_logEventIds_Params{Struct: s}) }
+	}
+	return App_logEventIds_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c App) LogEvent(ctx context.Context, params func(App_logEvent_Params) error, opts ...capnp.CallOption) App_logEvent_Results_Promise {
+	if c.Client == nil {
+		return App_logEvent_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      15,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "logEvent",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(App_logEvent_Params{Struct: s}) }
+	}
+	return App_logEvent_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
 
 type App_Server interface {
 	Id(App_id) error
@@ -282,6 +362,14 @@ type App_Server interface {
 	Runtime(App_runtime) error
 
 	Configs(App_configs) error
+
+	CommandPipelineIds(App_commandPipelineIds) error
+
+	CommandPipeline(App_commandPipeline) error
+
+	LogEventIds(App_logEventIds) error
+
+	LogEvent(App_logEvent) error
 }
 
 func App_ServerToClient(s App_Server) App {
@@ -291,7 +379,7 @@ func App_ServerToClient(s App_Server) App {
 
 func App_Methods(methods []server.Method, s App_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 12)
+		methods = make([]server.Method, 0, 16)
 	}
 
 	methods = append(methods, server.Method{
@@ -462,6 +550,62 @@ func App_Methods(methods []server.Method, s App_Server) []server.Method {
 		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
 	})
 
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      12,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "commandPipelineIds",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := App_commandPipelineIds{c, opts, App_commandPipelineIds_Params{Struct: p}, App_commandPipelineIds_Results{Struct: r}}
+			return s.CommandPipelineIds(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      13,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "commandPipeline",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := App_commandPipeline{c, opts, App_commandPipeline_Params{Struct: p}, App_commandPipeline_Results{Struct: r}}
+			return s.CommandPipeline(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      14,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "logEventIds",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := App_logEventIds{c, opts, App_logEventIds_Params{Struct: p}, App_logEventIds_Results{Struct: r}}
+			return s.LogEventIds(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xf052e7e084b31199,
+			MethodID:      15,
+			InterfaceName: "app.capnp:App",
+			MethodName:    "logEvent",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := App_logEvent{c, opts, App_logEvent_Params{Struct: p}, App_logEvent_Results{Struct: r}}
+			return s.LogEvent(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
 	return methods
 }
 
@@ -561,6 +705,38 @@ type App_configs struct {
 	Results App_configs_Results
 }
 
+// App_commandPipelineIds holds the arguments for a server call to App.commandPipelineIds.
+type App_commandPipelineIds struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  App_commandPipelineIds_Params
+	Results App_commandPipelineIds_Results
+}
+
+// App_commandPipeline holds the arguments for a server call to App.commandPipeline.
+type App_commandPipeline struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  App_commandPipeline_Params
+	Results App_commandPipeline_Results
+}
+
+// App_logEventIds holds the arguments for a server call to App.logEventIds.
+type App_logEventIds struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  App_logEventIds_Params
+	Results App_logEventIds_Results
+}
+
+// App_logEvent holds the arguments for a server call to App.logEvent.
+type App_logEvent struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  App_logEvent_Params
+	Results App_logEvent_Results
+}
+
 type App_id_Params struct{ capnp.Struct }
 
 // App_id_Params_TypeID is the unique identifier for the type App_id_Params.
@@ -2078,650 +2254,657 @@ func (p App_configs_Results_Promise) Configs() Configs {
 	return Configs{Client: p.Pipeline.GetPipeline(0).Client()}
 }
 
-type Service struct{ Client capnp.Client }
+type App_commandPipelineIds_Params struct{ capnp.Struct }
 
-// Service_TypeID is the unique identifier for the type Service.
-const Service_TypeID = 0xb25b411cec149334
+// App_commandPipelineIds_Params_TypeID is the unique identifier for the type App_commandPipelineIds_Params.
+const App_commandPipelineIds_Params_TypeID = 0x89415100551f18b6
 
-func (c Service) Id(ctx context.Context, params func(Service_id_Params) error, opts ...capnp.CallOption) Service_id_Results_Promise {
-	if c.Client == nil {
-		return Service_id_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "id",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_id_Params{Struct: s}) }
-	}
-	return Service_id_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewApp_commandPipelineIds_Params(s *capnp.Segment) (App_commandPipelineIds_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return App_commandPipelineIds_Params{st}, err
 }
-func (c Service) LogLevel(ctx context.Context, params func(Service_logLevel_Params) error, opts ...capnp.CallOption) Service_logLevel_Results_Promise {
-	if c.Client == nil {
-		return Service_logLevel_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "logLevel",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_logLevel_Params{Struct: s}) }
-	}
-	return Service_logLevel_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func NewRootApp_commandPipelineIds_Params(s *capnp.Segment) (App_commandPipelineIds_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return App_commandPipelineIds_Params{st}, err
 }
-func (c Service) Alive(ctx context.Context, params func(Service_alive_Params) error, opts ...capnp.CallOption) Service_alive_Results_Promise {
-	if c.Client == nil {
-		return Service_alive_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "alive",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_alive_Params{Struct: s}) }
-	}
-	return Service_alive_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func ReadRootApp_commandPipelineIds_Params(msg *capnp.Message) (App_commandPipelineIds_Params, error) {
+	root, err := msg.RootPtr()
+	return App_commandPipelineIds_Params{root.Struct()}, err
 }
 
-type Service_Server interface {
-	Id(Service_id) error
+func (s App_commandPipelineIds_Params) String() string {
+	str, _ := text.Marshal(0x89415100551f18b6, s.Struct)
+	return str
+}
 
-	LogLevel(Service_logLevel) error
+// App_commandPipelineIds_Params_List is a list of App_commandPipelineIds_Params.
+type App_commandPipelineIds_Params_List struct{ capnp.List }
 
-	Alive(Service_alive) error
+// NewApp_commandPipelineIds_Params creates a new list of App_commandPipelineIds_Params.
+func NewApp_commandPipelineIds_Params_List(s *capnp.Segment, sz int32) (App_commandPipelineIds_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return App_commandPipelineIds_Params_List{l}, err
 }
 
-func Service_ServerToClient(s Service_Server) Service {
-	c, _ := s.(server.Closer)
-	return Service{Client: server.New(Service_Methods(nil, s), c)}
+func (s App_commandPipelineIds_Params_List) At(i int) App_commandPipelineIds_Params {
+	return App_commandPipelineIds_Params{s.List.Struct(i)}
 }
 
-func Service_Methods(methods []server.Method, s Service_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
-	}
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "id",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Service_id{c, opts, Service_id_Params{Struct: p}, Service_id_Results{Struct: r}}
-			return s.Id(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+func (s App_commandPipelineIds_Params_List) Set(i int, v App_commandPipelineIds_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "logLevel",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Service_logLevel{c, opts, Service_logLevel_Params{Struct: p}, Service_logLevel_Results{Struct: r}}
-			return s.LogLevel(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+func (s App_commandPipelineIds_Params_List) String() string {
+	str, _ := text.MarshalList(0x89415100551f18b6, s.List)
+	return str
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xb25b411cec149334,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Service",
-			MethodName:    "alive",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Service_alive{c, opts, Service_alive_Params{Struct: p}, Service_alive_Results{Struct: r}}
-			return s.Alive(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+// App_commandPipelineIds_Params_Promise is a wrapper for a App_commandPipelineIds_Params promised by a client call.
+type App_commandPipelineIds_Params_Promise struct{ *capnp.Pipeline }
 
-	return methods
+func (p App_commandPipelineIds_Params_Promise) Struct() (App_commandPipelineIds_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return App_commandPipelineIds_Params{s}, err
 }
 
-// Service_id holds the arguments for a server call to Service.id.
-type Service_id struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Service_id_Params
-	Results Service_id_Results
-}
+type App_commandPipelineIds_Results struct{ capnp.Struct }
 
-// Service_logLevel holds the arguments for a server call to Service.logLevel.
-type Service_logLevel struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Service_logLevel_Params
-	Results Service_logLevel_Results
+// App_commandPipelineIds_Results_TypeID is the unique identifier for the type App_commandPipelineIds_Results.
+const App_commandPipelineIds_Results_TypeID = 0xae9ce4135e6665af
+
+func NewApp_commandPipelineIds_Results(s *capnp.Segment) (App_commandPipelineIds_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_commandPipelineIds_Results{st}, err
 }
 
-// Service_alive holds the arguments for a server call to Service.alive.
-type Service_alive struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Service_alive_Params
-	Results Service_alive_Results
+func NewRootApp_commandPipelineIds_Results(s *capnp.Segment) (App_commandPipelineIds_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_commandPipelineIds_Results{st}, err
 }
 
-type Service_id_Params struct{ capnp.Struct }
+func ReadRootApp_commandPipelineIds_Results(msg *capnp.Message) (App_commandPipelineIds_Results, error) {
+	root, err := msg.RootPtr()
+	return App_commandPipelineIds_Results{root.Struct()}, err
+}
 
-// Service_id_Params_TypeID is the unique identifier for the type Service_id_Params.
-const Service_id_Params_TypeID = 0x965465bd75220f94
+func (s App_commandPipelineIds_Results) String() string {
+	str, _ := text.Marshal(0xae9ce4135e6665af, s.Struct)
+	return str
+}
 
-func NewService_id_Params(s *capnp.Segment) (Service_id_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_id_Params{st}, err
+func (s App_commandPipelineIds_Results) PipelineIds() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.UInt64List{List: p.List()}, err
 }
 
-func NewRootService_id_Params(s *capnp.Segment) (Service_id_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_id_Params{st}, err
+func (s App_commandPipelineIds_Results) HasPipelineIds() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func ReadRootService_id_Params(msg *capnp.Message) (Service_id_Params, error) {
-	root, err := msg.RootPtr()
-	return Service_id_Params{root.Struct()}, err
+func (s App_commandPipelineIds_Results) SetPipelineIds(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
 }
 
-func (s Service_id_Params) String() string {
-	str, _ := text.Marshal(0x965465bd75220f94, s.Struct)
-	return str
+// NewPipelineIds sets the pipelineIds field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s App_commandPipelineIds_Results) NewPipelineIds(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
 }
 
-// Service_id_Params_List is a list of Service_id_Params.
-type Service_id_Params_List struct{ capnp.List }
+// App_commandPipelineIds_Results_List is a list of App_commandPipelineIds_Results.
+type App_commandPipelineIds_Results_List struct{ capnp.List }
 
-// NewService_id_Params creates a new list of Service_id_Params.
-func NewService_id_Params_List(s *capnp.Segment, sz int32) (Service_id_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Service_id_Params_List{l}, err
+// NewApp_commandPipelineIds_Results creates a new list of App_commandPipelineIds_Results.
+func NewApp_commandPipelineIds_Results_List(s *capnp.Segment, sz int32) (App_commandPipelineIds_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return App_commandPipelineIds_Results_List{l}, err
 }
 
-func (s Service_id_Params_List) At(i int) Service_id_Params {
-	return Service_id_Params{s.List.Struct(i)}
+func (s App_commandPipelineIds_Results_List) At(i int) App_commandPipelineIds_Results {
+	return App_commandPipelineIds_Results{s.List.Struct(i)}
 }
 
-func (s Service_id_Params_List) Set(i int, v Service_id_Params) error {
+func (s App_commandPipelineIds_Results_List) Set(i int, v App_commandPipelineIds_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_id_Params_List) String() string {
-	str, _ := text.MarshalList(0x965465bd75220f94, s.List)
+func (s App_commandPipelineIds_Results_List) String() string {
+	str, _ := text.MarshalList(0xae9ce4135e6665af, s.List)
 	return str
 }
 
-// Service_id_Params_Promise is a wrapper for a Service_id_Params promised by a client call.
-type Service_id_Params_Promise struct{ *capnp.Pipeline }
+// App_commandPipelineIds_Results_Promise is a wrapper for a App_commandPipelineIds_Results promised by a client call.
+type App_commandPipelineIds_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Service_id_Params_Promise) Struct() (Service_id_Params, error) {
+func (p App_commandPipelineIds_Results_Promise) Struct() (App_commandPipelineIds_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_id_Params{s}, err
+	return App_commandPipelineIds_Results{s}, err
 }
 
-type Service_id_Results struct{ capnp.Struct }
+type App_commandPipeline_Params struct{ capnp.Struct }
 
-// Service_id_Results_TypeID is the unique identifier for the type Service_id_Results.
-const Service_id_Results_TypeID = 0xccdde1728f71e904
+// App_commandPipeline_Params_TypeID is the unique identifier for the type App_commandPipeline_Params.
+const App_commandPipeline_Params_TypeID = 0x885bb978381d574b
 
-func NewService_id_Results(s *capnp.Segment) (Service_id_Results, error) {
+func NewApp_commandPipeline_Params(s *capnp.Segment) (App_commandPipeline_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_id_Results{st}, err
+	return App_commandPipeline_Params{st}, err
 }
 
-func NewRootService_id_Results(s *capnp.Segment) (Service_id_Results, error) {
+func NewRootApp_commandPipeline_Params(s *capnp.Segment) (App_commandPipeline_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_id_Results{st}, err
+	return App_commandPipeline_Params{st}, err
 }
 
-func ReadRootService_id_Results(msg *capnp.Message) (Service_id_Results, error) {
+func ReadRootApp_commandPipeline_Params(msg *capnp.Message) (App_commandPipeline_Params, error) {
 	root, err := msg.RootPtr()
-	return Service_id_Results{root.Struct()}, err
+	return App_commandPipeline_Params{root.Struct()}, err
 }
 
-func (s Service_id_Results) String() string {
-	str, _ := text.Marshal(0xccdde1728f71e904, s.Struct)
+func (s App_commandPipeline_Params) String() string {
+	str, _ := text.Marshal(0x885bb978381d574b, s.Struct)
 	return str
 }
 
-func (s Service_id_Results) ServiceId() uint64 {
+func (s App_commandPipeline_Params) Id() uint64 {
 	return s.Struct.Uint64(0)
 }
 
-func (s Service_id_Results) SetServiceId(v uint64) {
+func (s App_commandPipeline_Params) SetId(v uint64) {
 	s.Struct.SetUint64(0, v)
 }
 
-// Service_id_Results_List is a list of Service_id_Results.
-type Service_id_Results_List struct{ capnp.List }
+// App_commandPipeline_Params_List is a list of App_commandPipeline_Params.
+type App_commandPipeline_Params_List struct{ capnp.List }
 
-// NewService_id_Results creates a new list of Service_id_Results.
-func NewService_id_Results_List(s *capnp.Segment, sz int32) (Service_id_Results_List, error) {
+// NewApp_commandPipeline_Params creates a new list of App_commandPipeline_Params.
+func NewApp_commandPipeline_Params_List(s *capnp.Segment, sz int32) (App_commandPipeline_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Service_id_Results_List{l}, err
+	return App_commandPipeline_Params_List{l}, err
 }
 
-func (s Service_id_Results_List) At(i int) Service_id_Results {
-	return Service_id_Results{s.List.Struct(i)}
+func (s App_commandPipeline_Params_List) At(i int) App_commandPipeline_Params {
+	return App_commandPipeline_Params{s.List.Struct(i)}
 }
 
-func (s Service_id_Results_List) Set(i int, v Service_id_Results) error {
+func (s App_commandPipeline_Params_List) Set(i int, v App_commandPipeline_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_id_Results_List) String() string {
-	str, _ := text.MarshalList(0xccdde1728f71e904, s.List)
+func (s App_commandPipeline_Params_List) String() string {
+	str, _ := text.MarshalList(0x885bb978381d574b, s.List)
 	return str
 }
 
-// Service_id_Results_Promise is a wrapper for a Service_id_Results promised by a client call.
-type Service_id_Results_Promise struct{ *capnp.Pipeline }
+// App_commandPipeline_Params_Promise is a wrapper for a App_commandPipeline_Params promised by a client call.
+type App_commandPipeline_Params_Promise struct{ *capnp.Pipeline }
 
-func (p Service_id_Results_Promise) Struct() (Service_id_Results, error) {
+func (p App_commandPipeline_Params_Promise) Struct() (App_commandPipeline_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_id_Results{s}, err
+	return App_commandPipeline_Params{s}, err
 }
 
-type Service_logLevel_Params struct{ capnp.Struct }
+type App_commandPipeline_Results struct{ capnp.Struct }
 
-// Service_logLevel_Params_TypeID is the unique identifier for the type Service_logLevel_Params.
-const Service_logLevel_Params_TypeID = 0xa6b9c11c2aac9785
+// App_commandPipeline_Results_TypeID is the unique identifier for the type App_commandPipeline_Results.
+const App_commandPipeline_Results_TypeID = 0xb19e57bc0703f32a
 
-func NewService_logLevel_Params(s *capnp.Segment) (Service_logLevel_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_logLevel_Params{st}, err
+func NewApp_commandPipeline_Results(s *capnp.Segment) (App_commandPipeline_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_commandPipeline_Results{st}, err
 }
 
-func NewRootService_logLevel_Params(s *capnp.Segment) (Service_logLevel_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_logLevel_Params{st}, err
+func NewRootApp_commandPipeline_Results(s *capnp.Segment) (App_commandPipeline_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_commandPipeline_Results{st}, err
 }
 
-func ReadRootService_logLevel_Params(msg *capnp.Message) (Service_logLevel_Params, error) {
+func ReadRootApp_commandPipeline_Results(msg *capnp.Message) (App_commandPipeline_Results, error) {
 	root, err := msg.RootPtr()
-	return Service_logLevel_Params{root.Struct()}, err
+	return App_commandPipeline_Results{root.Struct()}, err
 }
 
-func (s Service_logLevel_Params) String() string {
-	str, _ := text.Marshal(0xa6b9c11c2aac9785, s.Struct)
+func (s App_commandPipeline_Results) String() string {
+	str, _ := text.Marshal(0xb19e57bc0703f32a, s.Struct)
 	return str
 }
 
-// Service_logLevel_Params_List is a list of Service_logLevel_Params.
-type Service_logLevel_Params_List struct{ capnp.List }
+func (s App_commandPipeline_Results) CommandPipeline() CommandPipeline {
+	p, _ := s.Struct.Ptr(0)
+	return CommandPipeline{Client: p.Interface().Client()}
+}
 
-// NewService_logLevel_Params creates a new list of Service_logLevel_Params.
-func NewService_logLevel_Params_List(s *capnp.Segment, sz int32) (Service_logLevel_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Service_logLevel_Params_List{l}, err
+func (s App_commandPipeline_Results) HasCommandPipeline() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s Service_logLevel_Params_List) At(i int) Service_logLevel_Params {
-	return Service_logLevel_Params{s.List.Struct(i)}
+func (s App_commandPipeline_Results) SetCommandPipeline(v CommandPipeline) error {
+	if v.Client == nil {
+		return s.Struct.SetPtr(0, capnp.Ptr{})
+	}
+	seg := s.Segment()
+	in := capnp.NewInterface(seg, seg.Message().AddCap(v.Client))
+	return s.Struct.SetPtr(0, in.ToPtr())
 }
 
-func (s Service_logLevel_Params_List) Set(i int, v Service_logLevel_Params) error {
+// App_commandPipeline_Results_List is a list of App_commandPipeline_Results.
+type App_commandPipeline_Results_List struct{ capnp.List }
+
+// NewApp_commandPipeline_Results creates a new list of App_commandPipeline_Results.
+func NewApp_commandPipeline_Results_List(s *capnp.Segment, sz int32) (App_commandPipeline_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return App_commandPipeline_Results_List{l}, err
+}
+
+func (s App_commandPipeline_Results_List) At(i int) App_commandPipeline_Results {
+	return App_commandPipeline_Results{s.List.Struct(i)}
+}
+
+func (s App_commandPipeline_Results_List) Set(i int, v App_commandPipeline_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_logLevel_Params_List) String() string {
-	str, _ := text.MarshalList(0xa6b9c11c2aac9785, s.List)
+func (s App_commandPipeline_Results_List) String() string {
+	str, _ := text.MarshalList(0xb19e57bc0703f32a, s.List)
 	return str
 }
 
-// Service_logLevel_Params_Promise is a wrapper for a Service_logLevel_Params promised by a client call.
-type Service_logLevel_Params_Promise struct{ *capnp.Pipeline }
+// App_commandPipeline_Results_Promise is a wrapper for a App_commandPipeline_Results promised by a client call.
+type App_commandPipeline_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Service_logLevel_Params_Promise) Struct() (Service_logLevel_Params, error) {
+func (p App_commandPipeline_Results_Promise) Struct() (App_commandPipeline_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_logLevel_Params{s}, err
+	return App_commandPipeline_Results{s}, err
 }
 
-type Service_logLevel_Results struct{ capnp.Struct }
+func (p App_commandPipeline_Results_Promise) CommandPipeline() CommandPipeline {
+	return CommandPipeline{Client: p.Pipeline.GetPipeline(0).Client()}
+}
 
-// Service_logLevel_Results_TypeID is the unique identifier for the type Service_logLevel_Results.
-const Service_logLevel_Results_TypeID = 0x9285c4944dfb709f
+type App_logEventIds_Params struct{ capnp.Struct }
 
-func NewService_logLevel_Results(s *capnp.Segment) (Service_logLevel_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_logLevel_Results{st}, err
+// App_logEventIds_Params_TypeID is the unique identifier for the type App_logEventIds_Params.
+const App_logEventIds_Params_TypeID = 0xa4c5d006e1a3d541
+
+func NewApp_logEventIds_Params(s *capnp.Segment) (App_logEventIds_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return App_logEventIds_Params{st}, err
 }
 
-func NewRootService_logLevel_Results(s *capnp.Segment) (Service_logLevel_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_logLevel_Results{st}, err
+func NewRootApp_logEventIds_Params(s *capnp.Segment) (App_logEventIds_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return App_logEventIds_Params{st}, err
 }
 
-func ReadRootService_logLevel_Results(msg *capnp.Message) (Service_logLevel_Results, error) {
+func ReadRootApp_logEventIds_Params(msg *capnp.Message) (App_logEventIds_Params, error) {
 	root, err := msg.RootPtr()
-	return Service_logLevel_Results{root.Struct()}, err
+	return App_logEventIds_Params{root.Struct()}, err
 }
 
-func (s Service_logLevel_Results) String() string {
-	str, _ := text.Marshal(0x9285c4944dfb709f, s.Struct)
+func (s App_logEventIds_Params) String() string {
+	str, _ := text.Marshal(0xa4c5d006e1a3d541, s.Struct)
 	return str
 }
 
-func (s Service_logLevel_Results) Level() LogLevel {
-	return LogLevel(s.Struct.Uint16(0))
-}
-
-func (s Service_logLevel_Results) SetLevel(v LogLevel) {
-	s.Struct.SetUint16(0, uint16(v))
-}
-
-// Service_logLevel_Results_List is a list of Service_logLevel_Results.
-type Service_logLevel_Results_List struct{ capnp.List }
+// App_logEventIds_Params_List is a list of App_logEventIds_Params.
+type App_logEventIds_Params_List struct{ capnp.List }
 
-// NewService_logLevel_Results creates a new list of Service_logLevel_Results.
-func NewService_logLevel_Results_List(s *capnp.Segment, sz int32) (Service_logLevel_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Service_logLevel_Results_List{l}, err
+// NewApp_logEventIds_Params creates a new list of App_logEventIds_Params.
+func NewApp_logEventIds_Params_List(s *capnp.Segment, sz int32) (App_logEventIds_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return App_logEventIds_Params_List{l}, err
 }
 
-func (s Service_logLevel_Results_List) At(i int) Service_logLevel_Results {
-	return Service_logLevel_Results{s.List.Struct(i)}
+func (s App_logEventIds_Params_List) At(i int) App_logEventIds_Params {
+	return App_logEventIds_Params{s.List.Struct(i)}
 }
 
-func (s Service_logLevel_Results_List) Set(i int, v Service_logLevel_Results) error {
+func (s App_logEventIds_Params_List) Set(i int, v App_logEventIds_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_logLevel_Results_List) String() string {
-	str, _ := text.MarshalList(0x9285c4944dfb709f, s.List)
+func (s App_logEventIds_Params_List) String() string {
+	str, _ := text.MarshalList(0xa4c5d006e1a3d541, s.List)
 	return str
 }
 
-// Service_logLevel_Results_Promise is a wrapper for a Service_logLevel_Results promised by a client call.
-type Service_logLevel_Results_Promise struct{ *capnp.Pipeline }
+// App_logEventIds_Params_Promise is a wrapper for a App_logEventIds_Params promised by a client call.
+type App_logEventIds_Params_Promise struct{ *capnp.Pipeline }
 
-func (p Service_logLevel_Results_Promise) Struct() (Service_logLevel_Results, error) {
+func (p App_logEventIds_Params_Promise) Struct() (App_logEventIds_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_logLevel_Results{s}, err
+	return App_logEventIds_Params{s}, err
 }
 
-type Service_alive_Params struct{ capnp.Struct }
+type App_logEventIds_Results struct{ capnp.Struct }
 
-// Service_alive_Params_TypeID is the unique identifier for the type Service_alive_Params.
-const Service_alive_Params_TypeID = 0x91dfcb778d8d16c0
+// App_logEventIds_Results_TypeID is the unique identifier for the type App_logEventIds_Results.
+const App_logEventIds_Results_TypeID = 0xd5d207666c0faa3a
 
-func NewService_alive_Params(s *capnp.Segment) (Service_alive_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_alive_Params{st}, err
+func NewApp_logEventIds_Results(s *capnp.Segment) (App_logEventIds_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_logEventIds_Results{st}, err
 }
 
-func NewRootService_alive_Params(s *capnp.Segment) (Service_alive_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Service_alive_Params{st}, err
+func NewRootApp_logEventIds_Results(s *capnp.Segment) (App_logEventIds_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_logEventIds_Results{st}, err
 }
 
-func ReadRootService_alive_Params(msg *capnp.Message) (Service_alive_Params, error) {
+func ReadRootApp_logEventIds_Results(msg *capnp.Message) (App_logEventIds_Results, error) {
 	root, err := msg.RootPtr()
-	return Service_alive_Params{root.Struct()}, err
+	return App_logEventIds_Results{root.Struct()}, err
 }
 
-func (s Service_alive_Params) String() string {
-	str, _ := text.Marshal(0x91dfcb778d8d16c0, s.Struct)
+func (s App_logEventIds_Results) String() string {
+	str, _ := text.Marshal(0xd5d207666c0faa3a, s.Struct)
 	return str
 }
 
-// Service_alive_Params_List is a list of Service_alive_Params.
-type Service_alive_Params_List struct{ capnp.List }
+func (s App_logEventIds_Results) LogEventIds() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.UInt64List{List: p.List()}, err
+}
 
-// NewService_alive_Params creates a new list of Service_alive_Params.
-func NewService_alive_Params_List(s *capnp.Segment, sz int32) (Service_alive_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Service_alive_Params_List{l}, err
+func (s App_logEventIds_Results) HasLogEventIds() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s Service_alive_Params_List) At(i int) Service_alive_Params {
-	return Service_alive_Params{s.List.Struct(i)}
+func (s App_logEventIds_Results) SetLogEventIds(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
 }
 
-func (s Service_alive_Params_List) Set(i int, v Service_alive_Params) error {
+// NewLogEventIds sets the logEventIds field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s App_logEventIds_Results) NewLogEventIds(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// App_logEventIds_Results_List is a list of App_logEventIds_Results.
+type App_logEventIds_Results_List struct{ capnp.List }
+
+// NewApp_logEventIds_Results creates a new list of App_logEventIds_Results.
+func NewApp_logEventIds_Results_List(s *capnp.Segment, sz int32) (App_logEventIds_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return App_logEventIds_Results_List{l}, err
+}
+
+func (s App_logEventIds_Results_List) At(i int) App_logEventIds_Results {
+	return App_logEventIds_Results{s.List.Struct(i)}
+}
+
+func (s App_logEventIds_Results_List) Set(i int, v App_logEventIds_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_alive_Params_List) String() string {
-	str, _ := text.MarshalList(0x91dfcb778d8d16c0, s.List)
+func (s App_logEventIds_Results_List) String() string {
+	str, _ := text.MarshalList(0xd5d207666c0faa3a, s.List)
 	return str
 }
 
-// Service_alive_Params_Promise is a wrapper for a Service_alive_Params promised by a client call.
-type Service_alive_Params_Promise struct{ *capnp.Pipeline }
+// App_logEventIds_Results_Promise is a wrapper for a App_logEventIds_Results promised by a client call.
+type App_logEventIds_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Service_alive_Params_Promise) Struct() (Service_alive_Params, error) {
+func (p App_logEventIds_Results_Promise) Struct() (App_logEventIds_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_alive_Params{s}, err
+	return App_logEventIds_Results{s}, err
 }
 
-type Service_alive_Results struct{ capnp.Struct }
+type App_logEvent_Params struct{ capnp.Struct }
 
-// Service_alive_Results_TypeID is the unique identifier for the type Service_alive_Results.
-const Service_alive_Results_TypeID = 0xe542d95b68592c1c
+// App_logEvent_Params_TypeID is the unique identifier for the type App_logEvent_Params.
+const App_logEvent_Params_TypeID = 0xa1baae87b981db62
 
-func NewService_alive_Results(s *capnp.Segment) (Service_alive_Results, error) {
+func NewApp_logEvent_Params(s *capnp.Segment) (App_logEvent_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_alive_Results{st}, err
+	return App_logEvent_Params{st}, err
 }
 
-func NewRootService_alive_Results(s *capnp.Segment) (Service_alive_Results, error) {
+func NewRootApp_logEvent_Params(s *capnp.Segment) (App_logEvent_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Service_alive_Results{st}, err
+	return App_logEvent_Params{st}, err
 }
 
-func ReadRootService_alive_Results(msg *capnp.Message) (Service_alive_Results, error) {
+func ReadRootApp_logEvent_Params(msg *capnp.Message) (App_logEvent_Params, error) {
 	root, err := msg.RootPtr()
-	return Service_alive_Results{root.Struct()}, err
+	return App_logEvent_Params{root.Struct()}, err
 }
 
-func (s Service_alive_Results) String() string {
-	str, _ := text.Marshal(0xe542d95b68592c1c, s.Struct)
+func (s App_logEvent_Params) String() string {
+	str, _ := text.Marshal(0xa1baae87b981db62, s.Struct)
 	return str
 }
 
-func (s Service_alive_Results) Alive() bool {
-	return s.Struct.Bit(0)
+func (s App_logEvent_Params) Id() uint64 {
+	return s.Struct.Uint64(0)
 }
 
-func (s Service_alive_Results) SetAlive(v bool) {
-	s.Struct.SetBit(0, v)
+func (s App_logEvent_Params) SetId(v uint64) {
+	s.Struct.SetUint64(0, v)
 }
 
-// Service_alive_Results_List is a list of Service_alive_Results.
-type Service_alive_Results_List struct{ capnp.List }
+// App_logEvent_Params_List is a list of App_logEvent_Params.
+type App_logEvent_Params_List struct{ capnp.List }
 
-// NewService_alive_Results creates a new list of Service_alive_Results.
-func NewService_alive_Results_List(s *capnp.Segment, sz int32) (Service_alive_Results_List, error) {
+// NewApp_logEvent_Params creates a new list of App_logEvent_Params.
+func NewApp_logEvent_Params_List(s *capnp.Segment, sz int32) (App_logEvent_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Service_alive_Results_List{l}, err
+	return App_logEvent_Params_List{l}, err
 }
 
-func (s Service_alive_Results_List) At(i int) Service_alive_Results {
-	return Service_alive_Results{s.List.Struct(i)}
+func (s App_logEvent_Params_List) At(i int) App_logEvent_Params {
+	return App_logEvent_Params{s.List.Struct(i)}
 }
 
-func (s Service_alive_Results_List) Set(i int, v Service_alive_Results) error {
+func (s App_logEvent_Params_List) Set(i int, v App_logEvent_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Service_alive_Results_List) String() string {
-	str, _ := text.MarshalList(0xe542d95b68592c1c, s.List)
+func (s App_logEvent_Params_List) String() string {
+	str, _ := text.MarshalList(0xa1baae87b981db62, s.List)
 	return str
 }
 
-// Service_alive_Results_Promise is a wrapper for a Service_alive_Results promised by a client call.
-type Service_alive_Results_Promise struct{ *capnp.Pipeline }
+// App_logEvent_Params_Promise is a wrapper for a App_logEvent_Params promised by a client call.
+type App_logEvent_Params_Promise struct{ *capnp.Pipeline }
 
-func (p Service_alive_Results_Promise) Struct() (Service_alive_Results, error) {
+func (p App_logEvent_Params_Promise) Struct() (App_logEvent_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return Service_alive_Results{s}, err
+	return App_logEvent_Params{s}, err
 }
 
-type RPCService struct{ Client capnp.Client }
+type App_logEvent_Results struct{ capnp.Struct }
 
-// RPCService_TypeID is the unique identifier for the type RPCService.
-const RPCService_TypeID = 0xa7e3c40f8e5ecb74
+// App_logEvent_Results_TypeID is the unique identifier for the type App_logEvent_Results.
+const App_logEvent_Results_TypeID = 0xac80c3b53411a0bb
 
-func (c RPCService) Id(ctx context.Context, params func(RPCService_id_Params) error, opts ...capnp.CallOption) RPCService_id_Results_Promise {
-	if c.Client == nil {
-		return RPCService_id_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      0,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "id",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_id_Params{Struct: s}) }
-	}
-	return RPCService_id_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewApp_logEvent_Results(s *capnp.Segment) (App_logEvent_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_logEvent_Results{st}, err
 }
-func (c RPCService) ListenerAlive(ctx context.Context, params func(RPCService_listenerAlive_Params) error, opts ...capnp.CallOption) RPCService_listenerAlive_Results_Promise {
-	if c.Client == nil {
-		return RPCService_listenerAlive_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      1,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "listenerAlive",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_listenerAlive_Params{Struct: s}) }
+
+func NewRootApp_logEvent_Results(s *capnp.Segment) (App_logEvent_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return App_logEvent_Results{st}, err
+}
+
+func ReadRootApp_logEvent_Results(msg *capnp.Message) (App_logEvent_Results, error) {
+	root, err := msg.RootPtr()
+	return App_logEvent_Results{root.Struct()}, err
+}
+
+func (s App_logEvent_Results) String() string {
+	str, _ := text.Marshal(0xac80c3b53411a0bb, s.Struct)
+	return str
+}
+
+func (s App_logEvent_Results) LogEvent() (LogEvent, error) {
+	p, err := s.Struct.Ptr(0)
+	return LogEvent{Struct: p.Struct()}, err
+}
+
+func (s App_logEvent_Results) HasLogEvent() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s App_logEvent_Results) SetLogEvent(v LogEvent) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewLogEvent sets the logEvent field to a newly
+// allocated LogEvent struct, preferring placement in s's segment.
+func (s App_logEvent_Results) NewLogEvent() (LogEvent, error) {
+	ss, err := NewLogEvent(s.Struct.Segment())
+	if err != nil {
+		return LogEvent{}, err
 	}
-	return RPCService_listenerAlive_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
-func (c RPCService) ListenerAddress(ctx context.Context, params func(RPCService_listenerAddress_Params) error, opts ...capnp.CallOption) RPCService_listenerAddress_Results_Promise {
+
+// App_logEvent_Results_List is a list of App_logEvent_Results.
+type App_logEvent_Results_List struct{ capnp.List }
+
+// NewApp_logEvent_Results creates a new list of App_logEvent_Results.
+func NewApp_logEvent_Results_List(s *capnp.Segment, sz int32) (App_logEvent_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return App_logEvent_Results_List{l}, err
+}
+
+func (s App_logEvent_Results_List) At(i int) App_logEvent_Results {
+	return App_logEvent_Results{s.List.Struct(i)}
+}
+
+func (s App_logEvent_Results_List) Set(i int, v App_logEvent_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s App_logEvent_Results_List) String() string {
+	str, _ := text.MarshalList(0xac80c3b53411a0bb, s.List)
+	return str
+}
+
+// App_logEvent_Results_Promise is a wrapper for a App_logEvent_Results promised by a client call.
+type App_logEvent_Results_Promise struct{ *capnp.Pipeline }
+
+func (p App_logEvent_Results_Promise) Struct() (App_logEvent_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return App_logEvent_Results{s}, err
+}
+
+func (p App_logEvent_Results_Promise) LogEvent() LogEvent_Promise {
+	return LogEvent_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type Service struct{ Client capnp.Client }
+
+// Service_TypeID is the unique identifier for the type Service.
+const Service_TypeID = 0xb25b411cec149334
+
+func (c Service) Id(ctx context.Context, params func(Service_id_Params) error, opts ...capnp.CallOption) Service_id_Results_Promise {
 	if c.Client == nil {
-		return RPCService_listenerAddress_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+		return Service_id_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
 	}
 	call := &capnp.Call{
 		Ctx: ctx,
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      2,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "listenerAddress",
+			InterfaceID:   0xb25b411cec149334,
+			MethodID:      0,
+			InterfaceName: "app.capnp:Service",
+			MethodName:    "id",
 		},
 		Options: capnp.NewCallOptions(opts),
 	}
 	if params != nil {
 		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_listenerAddress_Params{Struct: s}) }
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_id_Params{Struct: s}) }
 	}
-	return RPCService_listenerAddress_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+	return Service_id_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-func (c RPCService) ActiveConns(ctx context.Context, params func(RPCService_activeConns_Params) error, opts ...capnp.CallOption) RPCService_activeConns_Results_Promise {
+func (c Service) LogLevel(ctx context.Context, params func(Service_logLevel_Params) error, opts ...capnp.CallOption) Service_logLevel_Results_Promise {
 	if c.Client == nil {
-		return RPCService_activeConns_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+		return Service_logLevel_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
 	}
 	call := &capnp.Call{
 		Ctx: ctx,
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      3,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "activeConns",
+			InterfaceID:   0xb25b411cec149334,
+			MethodID:      1,
+			InterfaceName: "app.capnp:Service",
+			MethodName:    "logLevel",
 		},
 		Options: capnp.NewCallOptions(opts),
 	}
 	if params != nil {
 		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_activeConns_Params{Struct: s}) }
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_logLevel_Params{Struct: s}) }
 	}
-	return RPCService_activeConns_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+	return Service_logLevel_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-func (c RPCService) MaxConns(ctx context.Context, params func(RPCService_maxConns_Params) error, opts ...capnp.CallOption) RPCService_maxConns_Results_Promise {
+func (c Service) Alive(ctx context.Context, params func(Service_alive_Params) error, opts ...capnp.CallOption) Service_alive_Results_Promise {
 	if c.Client == nil {
-		return RPCService_maxConns_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+		return Service_alive_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
 	}
 	call := &capnp.Call{
 		Ctx: ctx,
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      4,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "maxConns",
+			InterfaceID:   0xb25b411cec149334,
+			MethodID:      2,
+			InterfaceName: "app.capnp:Service",
+			MethodName:    "alive",
 		},
 		Options: capnp.NewCallOptions(opts),
 	}
 	if params != nil {
 		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_maxConns_Params{Struct: s}) }
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Service_alive_Params{Struct: s}) }
 	}
-	return RPCService_maxConns_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+	return Service_alive_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
 
-type RPCService_Server interface {
-	Id(RPCService_id) error
-
-	ListenerAlive(RPCService_listenerAlive) error
-
-	ListenerAddress(RPCService_listenerAddress) error
+type Service_Server interface {
+	Id(Service_id) error
 
-	ActiveConns(RPCService_activeConns) error
+	LogLevel(Service_logLevel) error
 
-	MaxConns(RPCService_maxConns) error
+	Alive(Service_alive) error
 }
 
-func RPCService_ServerToClient(s RPCService_Server) RPCService {
+func Service_ServerToClient(s Service_Server) Service {
 	c, _ := s.(server.Closer)
-	return RPCService{Client: server.New(RPCService_Methods(nil, s), c)}
+	return Service{Client: server.New(Service_Methods(nil, s), c)}
 }
 
-func RPCService_Methods(methods []server.Method, s RPCService_Server) []server.Method {
+func Service_Methods(methods []server.Method, s Service_Server) []server.Method {
 	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 5)
+		methods = make([]server.Method, 0, 3)
 	}
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
+			InterfaceID:   0xb25b411cec149334,
 			MethodID:      0,
-			InterfaceName: "app.capnp:RPCService",
+			InterfaceName: "app.capnp:Service",
 			MethodName:    "id",
 		},
 		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := RPCService_id{c, opts, RPCService_id_Params{Struct: p}, RPCService_id_Results{Struct: r}}
+			call := Service_id{c, opts, Service_id_Params{Struct: p}, Service_id_Results{Struct: r}}
 			return s.Id(call)
 		},
 		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
@@ -2729,56 +2912,28 @@ func RPCService_Methods(methods []server.Method, s RPCService_Server) []server.M
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
+			InterfaceID:   0xb25b411cec149334,
 			MethodID:      1,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "listenerAlive",
+			InterfaceName: "app.capnp:Service",
+			MethodName:    "logLevel",
 		},
 		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := RPCService_listenerAlive{c, opts, RPCService_listenerAlive_Params{Struct: p}, RPCService_listenerAlive_Results{Struct: r}}
-			return s.ListenerAlive(call)
+			call := Service_logLevel{c, opts, Service_logLevel_Params{Struct: p}, Service_logLevel_Results{Struct: r}}
+			return s.LogLevel(call)
 		},
 		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
 	})
 
 	methods = append(methods, server.Method{
 		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
+			InterfaceID:   0xb25b411cec149334,
 			MethodID:      2,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "listenerAddress",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := RPCService_listenerAddress{c, opts, RPCService_listenerAddress_Params{Struct: p}, RPCService_listenerAddress_Results{Struct: r}}
-			return s.ListenerAddress(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      3,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "activeConns",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := RPCService_activeConns{c, opts, RPCService_activeConns_Params{Struct: p}, RPCService_activeConns_Results{Struct: r}}
-			return s.ActiveConns(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
-
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xa7e3c40f8e5ecb74,
-			MethodID:      4,
-			InterfaceName: "app.capnp:RPCService",
-			MethodName:    "maxConns",
+			InterfaceName: "app.capnp:Service",
+			MethodName:    "alive",
 		},
 		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := RPCService_maxConns{c, opts, RPCService_maxConns_Params{Struct: p}, RPCService_maxConns_Results{Struct: r}}
-			return s.MaxConns(call)
+			call := Service_alive{c, opts, Service_alive_Params{Struct: p}, Service_alive_Results{Struct: r}}
+			return s.Alive(call)
 		},
 		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
 	})
@@ -2786,2897 +2941,4542 @@ func RPCService_Methods(methods []server.Method, s RPCService_Server) []server.M
 	return methods
 }
 
-// RPCService_id holds the arguments for a server call to RPCService.id.
-type RPCService_id struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  RPCService_id_Params
-	Results RPCService_id_Results
-}
-
-// RPCService_listenerAlive holds the arguments for a server call to RPCService.listenerAlive.
-type RPCService_listenerAlive struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  RPCService_listenerAlive_Params
-	Results RPCService_listenerAlive_Results
-}
-
-// RPCService_listenerAddress holds the arguments for a server call to RPCService.listenerAddress.
-type RPCService_listenerAddress struct {
+// Service_id holds the arguments for a server call to Service.id.
+type Service_id struct {
 	Ctx     context.Context
 	Options capnp.CallOptions
-	Params  RPCService_listenerAddress_Params
-	Results RPCService_listenerAddress_Results
+	Params  Service_id_Params
+	Results Service_id_Results
 }
 
-// RPCService_activeConns holds the arguments for a server call to RPCService.activeConns.
-type RPCService_activeConns struct {
+// Service_logLevel holds the arguments for a server call to Service.logLevel.
+type Service_logLevel struct {
 	Ctx     context.Context
 	Options capnp.CallOptions
-	Params  RPCService_activeConns_Params
-	Results RPCService_activeConns_Results
+	Params  Service_logLevel_Params
+	Results Service_logLevel_Results
 }
 
-// RPCService_maxConns holds the arguments for a server call to RPCService.maxConns.
-type RPCService_maxConns struct {
+// Service_alive holds the arguments for a server call to Service.alive.
+type Service_alive struct {
 	Ctx     context.Context
 	Options capnp.CallOptions
-	Params  RPCService_maxConns_Params
-	Results RPCService_maxConns_Results
+	Params  Service_alive_Params
+	Results Service_alive_Results
 }
 
-type RPCService_id_Params struct{ capnp.Struct }
+type Service_id_Params struct{ capnp.Struct }
 
-// RPCService_id_Params_TypeID is the unique identifier for the type RPCService_id_Params.
-const RPCService_id_Params_TypeID = 0x88e166675c857e18
+// Service_id_Params_TypeID is the unique identifier for the type Service_id_Params.
+const Service_id_Params_TypeID = 0x965465bd75220f94
 
-func NewRPCService_id_Params(s *capnp.Segment) (RPCService_id_Params, error) {
+func NewService_id_Params(s *capnp.Segment) (Service_id_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_id_Params{st}, err
+	return Service_id_Params{st}, err
 }
 
-func NewRootRPCService_id_Params(s *capnp.Segment) (RPCService_id_Params, error) {
+func NewRootService_id_Params(s *capnp.Segment) (Service_id_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_id_Params{st}, err
+	return Service_id_Params{st}, err
 }
 
-func ReadRootRPCService_id_Params(msg *capnp.Message) (RPCService_id_Params, error) {
+func ReadRootService_id_Params(msg *capnp.Message) (Service_id_Params, error) {
 	root, err := msg.RootPtr()
-	return RPCService_id_Params{root.Struct()}, err
+	return Service_id_Params{root.Struct()}, err
 }
 
-func (s RPCService_id_Params) String() string {
-	str, _ := text.Marshal(0x88e166675c857e18, s.Struct)
+func (s Service_id_Params) String() string {
+	str, _ := text.Marshal(0x965465bd75220f94, s.Struct)
 	return str
 }
 
-// RPCService_id_Params_List is a list of RPCService_id_Params.
-type RPCService_id_Params_List struct{ capnp.List }
+// Service_id_Params_List is a list of Service_id_Params.
+type Service_id_Params_List struct{ capnp.List }
 
-// NewRPCService_id_Params creates a new list of RPCService_id_Params.
-func NewRPCService_id_Params_List(s *capnp.Segment, sz int32) (RPCService_id_Params_List, error) {
+// NewService_id_Params creates a new list of Service_id_Params.
+func NewService_id_Params_List(s *capnp.Segment, sz int32) (Service_id_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return RPCService_id_Params_List{l}, err
+	return Service_id_Params_List{l}, err
 }
 
-func (s RPCService_id_Params_List) At(i int) RPCService_id_Params {
-	return RPCService_id_Params{s.List.Struct(i)}
+func (s Service_id_Params_List) At(i int) Service_id_Params {
+	return Service_id_Params{s.List.Struct(i)}
 }
 
-func (s RPCService_id_Params_List) Set(i int, v RPCService_id_Params) error {
+func (s Service_id_Params_List) Set(i int, v Service_id_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_id_Params_List) String() string {
-	str, _ := text.MarshalList(0x88e166675c857e18, s.List)
+func (s Service_id_Params_List) String() string {
+	str, _ := text.MarshalList(0x965465bd75220f94, s.List)
 	return str
 }
 
-// RPCService_id_Params_Promise is a wrapper for a RPCService_id_Params promised by a client call.
-type RPCService_id_Params_Promise struct{ *capnp.Pipeline }
+// Service_id_Params_Promise is a wrapper for a Service_id_Params promised by a client call.
+type Service_id_Params_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_id_Params_Promise) Struct() (RPCService_id_Params, error) {
+func (p Service_id_Params_Promise) Struct() (Service_id_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_id_Params{s}, err
+	return Service_id_Params{s}, err
 }
 
-type RPCService_id_Results struct{ capnp.Struct }
+type Service_id_Results struct{ capnp.Struct }
 
-// RPCService_id_Results_TypeID is the unique identifier for the type RPCService_id_Results.
-const RPCService_id_Results_TypeID = 0xc21e37cdb9df069e
+// Service_id_Results_TypeID is the unique identifier for the type Service_id_Results.
+const Service_id_Results_TypeID = 0xccdde1728f71e904
 
-func NewRPCService_id_Results(s *capnp.Segment) (RPCService_id_Results, error) {
+func NewService_id_Results(s *capnp.Segment) (Service_id_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_id_Results{st}, err
+	return Service_id_Results{st}, err
 }
 
-func NewRootRPCService_id_Results(s *capnp.Segment) (RPCService_id_Results, error) {
+func NewRootService_id_Results(s *capnp.Segment) (Service_id_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_id_Results{st}, err
+	return Service_id_Results{st}, err
 }
 
-func ReadRootRPCService_id_Results(msg *capnp.Message) (RPCService_id_Results, error) {
+func ReadRootService_id_Results(msg *capnp.Message) (Service_id_Results, error) {
 	root, err := msg.RootPtr()
-	return RPCService_id_Results{root.Struct()}, err
+	return Service_id_Results{root.Struct()}, err
 }
 
-func (s RPCService_id_Results) String() string {
-	str, _ := text.Marshal(0xc21e37cdb9df069e, s.Struct)
+func (s Service_id_Results) String() string {
+	str, _ := text.Marshal(0xccdde1728f71e904, s.Struct)
 	return str
 }
 
-func (s RPCService_id_Results) ServiceId() uint64 {
+func (s Service_id_Results) ServiceId() uint64 {
 	return s.Struct.Uint64(0)
 }
 
-func (s RPCService_id_Results) SetServiceId(v uint64) {
+func (s Service_id_Results) SetServiceId(v uint64) {
 	s.Struct.SetUint64(0, v)
 }
 
-// RPCService_id_Results_List is a list of RPCService_id_Results.
-type RPCService_id_Results_List struct{ capnp.List }
+// Service_id_Results_List is a list of Service_id_Results.
+type Service_id_Results_List struct{ capnp.List }
 
-// NewRPCService_id_Results creates a new list of RPCService_id_Results.
-func NewRPCService_id_Results_List(s *capnp.Segment, sz int32) (RPCService_id_Results_List, error) {
+// NewService_id_Results creates a new list of Service_id_Results.
+func NewService_id_Results_List(s *capnp.Segment, sz int32) (Service_id_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return RPCService_id_Results_List{l}, err
+	return Service_id_Results_List{l}, err
 }
 
-func (s RPCService_id_Results_List) At(i int) RPCService_id_Results {
-	return RPCService_id_Results{s.List.Struct(i)}
+func (s Service_id_Results_List) At(i int) Service_id_Results {
+	return Service_id_Results{s.List.Struct(i)}
 }
 
-func (s RPCService_id_Results_List) Set(i int, v RPCService_id_Results) error {
+func (s Service_id_Results_List) Set(i int, v Service_id_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_id_Results_List) String() string {
-	str, _ := text.MarshalList(0xc21e37cdb9df069e, s.List)
+func (s Service_id_Results_List) String() string {
+	str, _ := text.MarshalList(0xccdde1728f71e904, s.List)
 	return str
 }
 
-// RPCService_id_Results_Promise is a wrapper for a RPCService_id_Results promised by a client call.
-type RPCService_id_Results_Promise struct{ *capnp.Pipeline }
+// Service_id_Results_Promise is a wrapper for a Service_id_Results promised by a client call.
+type Service_id_Results_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_id_Results_Promise) Struct() (RPCService_id_Results, error) {
+func (p Service_id_Results_Promise) Struct() (Service_id_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_id_Results{s}, err
+	return Service_id_Results{s}, err
 }
 
-type RPCService_listenerAlive_Params struct{ capnp.Struct }
+type Service_logLevel_Params struct{ capnp.Struct }
 
-// RPCService_listenerAlive_Params_TypeID is the unique identifier for the type RPCService_listenerAlive_Params.
-const RPCService_listenerAlive_Params_TypeID = 0xc7fcacbb7e6c5bb0
+// Service_logLevel_Params_TypeID is the unique identifier for the type Service_logLevel_Params.
+const Service_logLevel_Params_TypeID = 0xa6b9c11c2aac9785
 
-func NewRPCService_listenerAlive_Params(s *capnp.Segment) (RPCService_listenerAlive_Params, error) {
+func NewService_logLevel_Params(s *capnp.Segment) (Service_logLevel_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_listenerAlive_Params{st}, err
+	return Service_logLevel_Params{st}, err
 }
 
-func NewRootRPCService_listenerAlive_Params(s *capnp.Segment) (RPCService_listenerAlive_Params, error) {
+func NewRootService_logLevel_Params(s *capnp.Segment) (Service_logLevel_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_listenerAlive_Params{st}, err
+	return Service_logLevel_Params{st}, err
 }
 
-func ReadRootRPCService_listenerAlive_Params(msg *capnp.Message) (RPCService_listenerAlive_Params, error) {
+func ReadRootService_logLevel_Params(msg *capnp.Message) (Service_logLevel_Params, error) {
 	root, err := msg.RootPtr()
-	return RPCService_listenerAlive_Params{root.Struct()}, err
+	return Service_logLevel_Params{root.Struct()}, err
 }
 
-func (s RPCService_listenerAlive_Params) String() string {
-	str, _ := text.Marshal(0xc7fcacbb7e6c5bb0, s.Struct)
+func (s Service_logLevel_Params) String() string {
+	str, _ := text.Marshal(0xa6b9c11c2aac9785, s.Struct)
 	return str
 }
 
-// RPCService_listenerAlive_Params_List is a list of RPCService_listenerAlive_Params.
-type RPCService_listenerAlive_Params_List struct{ capnp.List }
+// Service_logLevel_Params_List is a list of Service_logLevel_Params.
+type Service_logLevel_Params_List struct{ capnp.List }
 
-// NewRPCService_listenerAlive_Params creates a new list of RPCService_listenerAlive_Params.
-func NewRPCService_listenerAlive_Params_List(s *capnp.Segment, sz int32) (RPCService_listenerAlive_Params_List, error) {
+// NewService_logLevel_Params creates a new list of Service_logLevel_Params.
+func NewService_logLevel_Params_List(s *capnp.Segment, sz int32) (Service_logLevel_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return RPCService_listenerAlive_Params_List{l}, err
+	return Service_logLevel_Params_List{l}, err
 }
 
-func (s RPCService_listenerAlive_Params_List) At(i int) RPCService_listenerAlive_Params {
-	return RPCService_listenerAlive_Params{s.List.Struct(i)}
+func (s Service_logLevel_Params_List) At(i int) Service_logLevel_Params {
+	return Service_logLevel_Params{s.List.Struct(i)}
 }
 
-func (s RPCService_listenerAlive_Params_List) Set(i int, v RPCService_listenerAlive_Params) error {
+func (s Service_logLevel_Params_List) Set(i int, v Service_logLevel_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_listenerAlive_Params_List) String() string {
-	str, _ := text.MarshalList(0xc7fcacbb7e6c5bb0, s.List)
+func (s Service_logLevel_Params_List) String() string {
+	str, _ := text.MarshalList(0xa6b9c11c2aac9785, s.List)
 	return str
 }
 
-// RPCService_listenerAlive_Params_Promise is a wrapper for a RPCService_listenerAlive_Params promised by a client call.
-type RPCService_listenerAlive_Params_Promise struct{ *capnp.Pipeline }
+// Service_logLevel_Params_Promise is a wrapper for a Service_logLevel_Params promised by a client call.
+type Service_logLevel_Params_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_listenerAlive_Params_Promise) Struct() (RPCService_listenerAlive_Params, error) {
+func (p Service_logLevel_Params_Promise) Struct() (Service_logLevel_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_listenerAlive_Params{s}, err
+	return Service_logLevel_Params{s}, err
 }
 
-type RPCService_listenerAlive_Results struct{ capnp.Struct }
+type Service_logLevel_Results struct{ capnp.Struct }
 
-// RPCService_listenerAlive_Results_TypeID is the unique identifier for the type RPCService_listenerAlive_Results.
-const RPCService_listenerAlive_Results_TypeID = 0xa76b7607195dee3a
+// Service_logLevel_Results_TypeID is the unique identifier for the type Service_logLevel_Results.
+const Service_logLevel_Results_TypeID = 0x9285c4944dfb709f
 
-func NewRPCService_listenerAlive_Results(s *capnp.Segment) (RPCService_listenerAlive_Results, error) {
+func NewService_logLevel_Results(s *capnp.Segment) (Service_logLevel_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_listenerAlive_Results{st}, err
+	return Service_logLevel_Results{st}, err
 }
 
-func NewRootRPCService_listenerAlive_Results(s *capnp.Segment) (RPCService_listenerAlive_Results, error) {
+func NewRootService_logLevel_Results(s *capnp.Segment) (Service_logLevel_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_listenerAlive_Results{st}, err
+	return Service_logLevel_Results{st}, err
 }
 
-func ReadRootRPCService_listenerAlive_Results(msg *capnp.Message) (RPCService_listenerAlive_Results, error) {
+func ReadRootService_logLevel_Results(msg *capnp.Message) (Service_logLevel_Results, error) {
 	root, err := msg.RootPtr()
-	return RPCService_listenerAlive_Results{root.Struct()}, err
+	return Service_logLevel_Results{root.Struct()}, err
 }
 
-func (s RPCService_listenerAlive_Results) String() string {
-	str, _ := text.Marshal(0xa76b7607195dee3a, s.Struct)
+func (s Service_logLevel_Results) String() string {
+	str, _ := text.Marshal(0x9285c4944dfb709f, s.Struct)
 	return str
 }
 
-func (s RPCService_listenerAlive_Results) ListenerAlive() bool {
-	return s.Struct.Bit(0)
+func (s Service_logLevel_Results) Level() LogLevel {
+	return LogLevel(s.Struct.Uint16(0))
 }
 
-func (s RPCService_listenerAlive_Results) SetListenerAlive(v bool) {
-	s.Struct.SetBit(0, v)
+func (s Service_logLevel_Results) SetLevel(v LogLevel) {
+	s.Struct.SetUint16(0, uint16(v))
 }
 
-// RPCService_listenerAlive_Results_List is a list of RPCService_listenerAlive_Results.
-type RPCService_listenerAlive_Results_List struct{ capnp.List }
+// Service_logLevel_Results_List is a list of Service_logLevel_Results.
+type Service_logLevel_Results_List struct{ capnp.List }
 
-// NewRPCService_listenerAlive_Results creates a new list of RPCService_listenerAlive_Results.
-func NewRPCService_listenerAlive_Results_List(s *capnp.Segment, sz int32) (RPCService_listenerAlive_Results_List, error) {
+// NewService_logLevel_Results creates a new list of Service_logLevel_Results.
+func NewService_logLevel_Results_List(s *capnp.Segment, sz int32) (Service_logLevel_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return RPCService_listenerAlive_Results_List{l}, err
+	return Service_logLevel_Results_List{l}, err
 }
 
-func (s RPCService_listenerAlive_Results_List) At(i int) RPCService_listenerAlive_Results {
-	return RPCService_listenerAlive_Results{s.List.Struct(i)}
+func (s Service_logLevel_Results_List) At(i int) Service_logLevel_Results {
+	return Service_logLevel_Results{s.List.Struct(i)}
 }
 
-func (s RPCService_listenerAlive_Results_List) Set(i int, v RPCService_listenerAlive_Results) error {
+func (s Service_logLevel_Results_List) Set(i int, v Service_logLevel_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_listenerAlive_Results_List) String() string {
-	str, _ := text.MarshalList(0xa76b7607195dee3a, s.List)
+func (s Service_logLevel_Results_List) String() string {
+	str, _ := text.MarshalList(0x9285c4944dfb709f, s.List)
 	return str
 }
 
-// RPCService_listenerAlive_Results_Promise is a wrapper for a RPCService_listenerAlive_Results promised by a client call.
-type RPCService_listenerAlive_Results_Promise struct{ *capnp.Pipeline }
+// Service_logLevel_Results_Promise is a wrapper for a Service_logLevel_Results promised by a client call.
+type Service_logLevel_Results_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_listenerAlive_Results_Promise) Struct() (RPCService_listenerAlive_Results, error) {
+func (p Service_logLevel_Results_Promise) Struct() (Service_logLevel_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_listenerAlive_Results{s}, err
+	return Service_logLevel_Results{s}, err
 }
 
-type RPCService_listenerAddress_Params struct{ capnp.Struct }
+type Service_alive_Params struct{ capnp.Struct }
 
-// RPCService_listenerAddress_Params_TypeID is the unique identifier for the type RPCService_listenerAddress_Params.
-const RPCService_listenerAddress_Params_TypeID = 0xdc063192b2b7a561
+// Service_alive_Params_TypeID is the unique identifier for the type Service_alive_Params.
+const Service_alive_Params_TypeID = 0x91dfcb778d8d16c0
 
-func NewRPCService_listenerAddress_Params(s *capnp.Segment) (RPCService_listenerAddress_Params, error) {
+func NewService_alive_Params(s *capnp.Segment) (Service_alive_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_listenerAddress_Params{st}, err
+	return Service_alive_Params{st}, err
 }
 
-func NewRootRPCService_listenerAddress_Params(s *capnp.Segment) (RPCService_listenerAddress_Params, error) {
+func NewRootService_alive_Params(s *capnp.Segment) (Service_alive_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_listenerAddress_Params{st}, err
+	return Service_alive_Params{st}, err
 }
 
-func ReadRootRPCService_listenerAddress_Params(msg *capnp.Message) (RPCService_listenerAddress_Params, error) {
+func ReadRootService_alive_Params(msg *capnp.Message) (Service_alive_Params, error) {
 	root, err := msg.RootPtr()
-	return RPCService_listenerAddress_Params{root.Struct()}, err
+	return Service_alive_Params{root.Struct()}, err
 }
 
-func (s RPCService_listenerAddress_Params) String() string {
-	str, _ := text.Marshal(0xdc063192b2b7a561, s.Struct)
+func (s Service_alive_Params) String() string {
+	str, _ := text.Marshal(0x91dfcb778d8d16c0, s.Struct)
 	return str
 }
 
-// RPCService_listenerAddress_Params_List is a list of RPCService_listenerAddress_Params.
-type RPCService_listenerAddress_Params_List struct{ capnp.List }
+// Service_alive_Params_List is a list of Service_alive_Params.
+type Service_alive_Params_List struct{ capnp.List }
 
-// NewRPCService_listenerAddress_Params creates a new list of RPCService_listenerAddress_Params.
-func NewRPCService_listenerAddress_Params_List(s *capnp.Segment, sz int32) (RPCService_listenerAddress_Params_List, error) {
+// NewService_alive_Params creates a new list of Service_alive_Params.
+func NewService_alive_Params_List(s *capnp.Segment, sz int32) (Service_alive_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return RPCService_listenerAddress_Params_List{l}, err
+	return Service_alive_Params_List{l}, err
 }
 
-func (s RPCService_listenerAddress_Params_List) At(i int) RPCService_listenerAddress_Params {
-	return RPCService_listenerAddress_Params{s.List.Struct(i)}
+func (s Service_alive_Params_List) At(i int) Service_alive_Params {
+	return Service_alive_Params{s.List.Struct(i)}
 }
 
-func (s RPCService_listenerAddress_Params_List) Set(i int, v RPCService_listenerAddress_Params) error {
+func (s Service_alive_Params_List) Set(i int, v Service_alive_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_listenerAddress_Params_List) String() string {
-	str, _ := text.MarshalList(0xdc063192b2b7a561, s.List)
+func (s Service_alive_Params_List) String() string {
+	str, _ := text.MarshalList(0x91dfcb778d8d16c0, s.List)
 	return str
 }
 
-// RPCService_listenerAddress_Params_Promise is a wrapper for a RPCService_listenerAddress_Params promised by a client call.
-type RPCService_listenerAddress_Params_Promise struct{ *capnp.Pipeline }
+// Service_alive_Params_Promise is a wrapper for a Service_alive_Params promised by a client call.
+type Service_alive_Params_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_listenerAddress_Params_Promise) Struct() (RPCService_listenerAddress_Params, error) {
+func (p Service_alive_Params_Promise) Struct() (Service_alive_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_listenerAddress_Params{s}, err
+	return Service_alive_Params{s}, err
 }
 
-type RPCService_listenerAddress_Results struct{ capnp.Struct }
+type Service_alive_Results struct{ capnp.Struct }
 
-// RPCService_listenerAddress_Results_TypeID is the unique identifier for the type RPCService_listenerAddress_Results.
-const RPCService_listenerAddress_Results_TypeID = 0xb95e72a43cd7c47c
+// Service_alive_Results_TypeID is the unique identifier for the type Service_alive_Results.
+const Service_alive_Results_TypeID = 0xe542d95b68592c1c
 
-func NewRPCService_listenerAddress_Results(s *capnp.Segment) (RPCService_listenerAddress_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return RPCService_listenerAddress_Results{st}, err
+func NewService_alive_Results(s *capnp.Segment) (Service_alive_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Service_alive_Results{st}, err
 }
 
-func NewRootRPCService_listenerAddress_Results(s *capnp.Segment) (RPCService_listenerAddress_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return RPCService_listenerAddress_Results{st}, err
+func NewRootService_alive_Results(s *capnp.Segment) (Service_alive_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Service_alive_Results{st}, err
 }
 
-func ReadRootRPCService_listenerAddress_Results(msg *capnp.Message) (RPCService_listenerAddress_Results, error) {
+func ReadRootService_alive_Results(msg *capnp.Message) (Service_alive_Results, error) {
 	root, err := msg.RootPtr()
-	return RPCService_listenerAddress_Results{root.Struct()}, err
+	return Service_alive_Results{root.Struct()}, err
 }
 
-func (s RPCService_listenerAddress_Results) String() string {
-	str, _ := text.Marshal(0xb95e72a43cd7c47c, s.Struct)
+func (s Service_alive_Results) String() string {
+	str, _ := text.Marshal(0xe542d95b68592c1c, s.Struct)
 	return str
 }
 
-func (s RPCService_listenerAddress_Results) Address() (NetworkAddress, error) {
-	p, err := s.Struct.Ptr(0)
-	return NetworkAddress{Struct: p.Struct()}, err
-}
-
-func (s RPCService_listenerAddress_Results) HasAddress() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
-}
-
-func (s RPCService_listenerAddress_Results) SetAddress(v NetworkAddress) error {
-	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+func (s Service_alive_Results) Alive() bool {
+	return s.Struct.Bit(0)
 }
 
-// NewAddress sets the address field to a newly
-// allocated NetworkAddress struct, preferring placement in s's segment.
-func (s RPCService_listenerAddress_Results) NewAddress() (NetworkAddress, error) {
-	ss, err := NewNetworkAddress(s.Struct.Segment())
-	if err != nil {
-		return NetworkAddress{}, err
-	}
-	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
-	return ss, err
+func (s Service_alive_Results) SetAlive(v bool) {
+	s.Struct.SetBit(0, v)
 }
 
-// RPCService_listenerAddress_Results_List is a list of RPCService_listenerAddress_Results.
-type RPCService_listenerAddress_Results_List struct{ capnp.List }
+// Service_alive_Results_List is a list of Service_alive_Results.
+type Service_alive_Results_List struct{ capnp.List }
 
-// NewRPCService_listenerAddress_Results creates a new list of RPCService_listenerAddress_Results.
-func NewRPCService_listenerAddress_Results_List(s *capnp.Segment, sz int32) (RPCService_listenerAddress_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return RPCService_listenerAddress_Results_List{l}, err
+// NewService_alive_Results creates a new list of Service_alive_Results.
+func NewService_alive_Results_List(s *capnp.Segment, sz int32) (Service_alive_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Service_alive_Results_List{l}, err
 }
 
-func (s RPCService_listenerAddress_Results_List) At(i int) RPCService_listenerAddress_Results {
-	return RPCService_listenerAddress_Results{s.List.Struct(i)}
+func (s Service_alive_Results_List) At(i int) Service_alive_Results {
+	return Service_alive_Results{s.List.Struct(i)}
 }
 
-func (s RPCService_listenerAddress_Results_List) Set(i int, v RPCService_listenerAddress_Results) error {
+func (s Service_alive_Results_List) Set(i int, v Service_alive_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_listenerAddress_Results_List) String() string {
-	str, _ := text.MarshalList(0xb95e72a43cd7c47c, s.List)
+func (s Service_alive_Results_List) String() string {
+	str, _ := text.MarshalList(0xe542d95b68592c1c, s.List)
 	return str
 }
 
-// RPCService_listenerAddress_Results_Promise is a wrapper for a RPCService_listenerAddress_Results promised by a client call.
-type RPCService_listenerAddress_Results_Promise struct{ *capnp.Pipeline }
+// Service_alive_Results_Promise is a wrapper for a Service_alive_Results promised by a client call.
+type Service_alive_Results_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_listenerAddress_Results_Promise) Struct() (RPCService_listenerAddress_Results, error) {
+func (p Service_alive_Results_Promise) Struct() (Service_alive_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_listenerAddress_Results{s}, err
-}
-
-func (p RPCService_listenerAddress_Results_Promise) Address() NetworkAddress_Promise {
-	return NetworkAddress_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
-}
-
-type RPCService_activeConns_Params struct{ capnp.Struct }
-
-// RPCService_activeConns_Params_TypeID is the unique identifier for the type RPCService_activeConns_Params.
-const RPCService_activeConns_Params_TypeID = 0xf604c2f7eff9f3c7
-
-func NewRPCService_activeConns_Params(s *capnp.Segment) (RPCService_activeConns_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_activeConns_Params{st}, err
+	return Service_alive_Results{s}, err
 }
 
-func NewRootRPCService_activeConns_Params(s *capnp.Segment) (RPCService_activeConns_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_activeConns_Params{st}, err
-}
+type RPCService struct{ Client capnp.Client }
 
-func ReadRootRPCService_activeConns_Params(msg *capnp.Message) (RPCService_activeConns_Params, error) {
-	root, err := msg.RootPtr()
-	return RPCService_activeConns_Params{root.Struct()}, err
-}
+// RPCService_TypeID is the unique identifier for the type RPCService.
+const RPCService_TypeID = 0xa7e3c40f8e5ecb74
 
-func (s RPCService_activeConns_Params) String() string {
-	str, _ := text.Marshal(0xf604c2f7eff9f3c7, s.Struct)
-	return str
+func (c RPCService) Id(ctx context.Context, params func(RPCService_id_Params) error, opts ...capnp.CallOption) RPCService_id_Results_Promise {
+	if c.Client == nil {
+		return RPCService_id_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      0,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "id",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_id_Params{Struct: s}) }
+	}
+	return RPCService_id_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-// RPCService_activeConns_Params_List is a list of RPCService_activeConns_Params.
-type RPCService_activeConns_Params_List struct{ capnp.List }
-
-// NewRPCService_activeConns_Params creates a new list of RPCService_activeConns_Params.
-func NewRPCService_activeConns_Params_List(s *capnp.Segment, sz int32) (RPCService_activeConns_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return RPCService_activeConns_Params_List{l}, err
+func (c RPCService) ListenerAlive(ctx context.Context, params func(RPCService_listenerAlive_Params) error, opts ...capnp.CallOption) RPCService_listenerAlive_Results_Promise {
+	if c.Client == nil {
+		return RPCService_listenerAlive_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      1,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "listenerAlive",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_listenerAlive_Params{Struct: s}) }
+	}
+	return RPCService_listenerAlive_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-func (s RPCService_activeConns_Params_List) At(i int) RPCService_activeConns_Params {
-	return RPCService_activeConns_Params{s.List.Struct(i)}
+func (c RPCService) ListenerAddress(ctx context.Context, params func(RPCService_listenerAddress_Params) error, opts ...capnp.CallOption) RPCService_listenerAddress_Results_Promise {
+	if c.Client == nil {
+		return RPCService_listenerAddress_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      2,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "listenerAddress",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_listenerAddress_Params{Struct: s}) }
+	}
+	return RPCService_listenerAddress_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-func (s RPCService_activeConns_Params_List) Set(i int, v RPCService_activeConns_Params) error {
-	return s.List.SetStruct(i, v.Struct)
+func (c RPCService) ActiveConns(ctx context.Context, params func(RPCService_activeConns_Params) error, opts ...capnp.CallOption) RPCService_activeConns_Results_Promise {
+	if c.Client == nil {
+		return RPCService_activeConns_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      3,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "activeConns",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_activeConns_Params{Struct: s}) }
+	}
+	return RPCService_activeConns_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-func (s RPCService_activeConns_Params_List) String() string {
-	str, _ := text.MarshalList(0xf604c2f7eff9f3c7, s.List)
-	return str
+func (c RPCService) MaxConns(ctx context.Context, params func(RPCService_maxConns_Params) error, opts ...capnp.CallOption) RPCService_maxConns_Results_Promise {
+	if c.Client == nil {
+		return RPCService_maxConns_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      4,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "maxConns",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(RPCService_maxConns_Params{Struct: s}) }
+	}
+	return RPCService_maxConns_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
 
-// RPCService_activeConns_Params_Promise is a wrapper for a RPCService_activeConns_Params promised by a client call.
-type RPCService_activeConns_Params_Promise struct{ *capnp.Pipeline }
-
-func (p RPCService_activeConns_Params_Promise) Struct() (RPCService_activeConns_Params, error) {
-	s, err := p.Pipeline.Struct()
-	return RPCService_activeConns_Params{s}, err
-}
+type RPCService_Server interface {
+	Id(RPCService_id) error
 
-type RPCService_activeConns_Results struct{ capnp.Struct }
+	ListenerAlive(RPCService_listenerAlive) error
 
-// RPCService_activeConns_Results_TypeID is the unique identifier for the type RPCService_activeConns_Results.
-const RPCService_activeConns_Results_TypeID = 0xf175231b9048f2c5
+	ListenerAddress(RPCService_listenerAddress) error
 
-func NewRPCService_activeConns_Results(s *capnp.Segment) (RPCService_activeConns_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_activeConns_Results{st}, err
-}
+	ActiveConns(RPCService_activeConns) error
 
-func NewRootRPCService_activeConns_Results(s *capnp.Segment) (RPCService_activeConns_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_activeConns_Results{st}, err
+	MaxConns(RPCService_maxConns) error
 }
 
-func ReadRootRPCService_activeConns_Results(msg *capnp.Message) (RPCService_activeConns_Results, error) {
-	root, err := msg.RootPtr()
-	return RPCService_activeConns_Results{root.Struct()}, err
+func RPCService_ServerToClient(s RPCService_Server) RPCService {
+	c, _ := s.(server.Closer)
+	return RPCService{Client: server.New(RPCService_Methods(nil, s), c)}
 }
 
-func (s RPCService_activeConns_Results) String() string {
-	str, _ := text.Marshal(0xf175231b9048f2c5, s.Struct)
-	return str
-}
+func RPCService_Methods(methods []server.Method, s RPCService_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 5)
+	}
 
-func (s RPCService_activeConns_Results) Count() uint32 {
-	return s.Struct.Uint32(0)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      0,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "id",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := RPCService_id{c, opts, RPCService_id_Params{Struct: p}, RPCService_id_Results{Struct: r}}
+			return s.Id(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
 
-func (s RPCService_activeConns_Results) SetCount(v uint32) {
-	s.Struct.SetUint32(0, v)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      1,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "listenerAlive",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := RPCService_listenerAlive{c, opts, RPCService_listenerAlive_Params{Struct: p}, RPCService_listenerAlive_Results{Struct: r}}
+			return s.ListenerAlive(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
 
-// RPCService_activeConns_Results_List is a list of RPCService_activeConns_Results.
-type RPCService_activeConns_Results_List struct{ capnp.List }
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      2,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "listenerAddress",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := RPCService_listenerAddress{c, opts, RPCService_listenerAddress_Params{Struct: p}, RPCService_listenerAddress_Results{Struct: r}}
+			return s.ListenerAddress(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
 
-// NewRPCService_activeConns_Results creates a new list of RPCService_activeConns_Results.
-func NewRPCService_activeConns_Results_List(s *capnp.Segment, sz int32) (RPCService_activeConns_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return RPCService_activeConns_Results_List{l}, err
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      3,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "activeConns",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := RPCService_activeConns{c, opts, RPCService_activeConns_Params{Struct: p}, RPCService_activeConns_Results{Struct: r}}
+			return s.ActiveConns(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa7e3c40f8e5ecb74,
+			MethodID:      4,
+			InterfaceName: "app.capnp:RPCService",
+			MethodName:    "maxConns",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := RPCService_maxConns{c, opts, RPCService_maxConns_Params{Struct: p}, RPCService_maxConns_Results{Struct: r}}
+			return s.MaxConns(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
+
+	return methods
 }
 
-func (s RPCService_activeConns_Results_List) At(i int) RPCService_activeConns_Results {
-	return RPCService_activeConns_Results{s.List.Struct(i)}
+// RPCService_id holds the arguments for a server call to RPCService.id.
+type RPCService_id struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  RPCService_id_Params
+	Results RPCService_id_Results
 }
 
-func (s RPCService_activeConns_Results_List) Set(i int, v RPCService_activeConns_Results) error {
-	return s.List.SetStruct(i, v.Struct)
+// RPCService_listenerAlive holds the arguments for a server call to RPCService.listenerAlive.
+type RPCService_listenerAlive struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  RPCService_listenerAlive_Params
+	Results RPCService_listenerAlive_Results
 }
 
-func (s RPCService_activeConns_Results_List) String() string {
-	str, _ := text.MarshalList(0xf175231b9048f2c5, s.List)
-	return str
+// RPCService_listenerAddress holds the arguments for a server call to RPCService.listenerAddress.
+type RPCService_listenerAddress struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  RPCService_listenerAddress_Params
+	Results RPCService_listenerAddress_Results
 }
 
-// RPCService_activeConns_Results_Promise is a wrapper for a RPCService_activeConns_Results promised by a client call.
-type RPCService_activeConns_Results_Promise struct{ *capnp.Pipeline }
+// RPCService_activeConns holds the arguments for a server call to RPCService.activeConns.
+type RPCService_activeConns struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  RPCService_activeConns_Params
+	Results RPCService_activeConns_Results
+}
 
-func (p RPCService_activeConns_Results_Promise) Struct() (RPCService_activeConns_Results, error) {
-	s, err := p.Pipeline.Struct()
-	return RPCService_activeConns_Results{s}, err
+// RPCService_maxConns holds the arguments for a server call to RPCService.maxConns.
+type RPCService_maxConns struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  RPCService_maxConns_Params
+	Results RPCService_maxConns_Results
 }
 
-type RPCService_maxConns_Params struct{ capnp.Struct }
+type RPCService_id_Params struct{ capnp.Struct }
 
-// RPCService_maxConns_Params_TypeID is the unique identifier for the type RPCService_maxConns_Params.
-const RPCService_maxConns_Params_TypeID = 0x8526d6d896c688e0
+// RPCService_id_Params_TypeID is the unique identifier for the type RPCService_id_Params.
+const RPCService_id_Params_TypeID = 0x88e166675c857e18
 
-func NewRPCService_maxConns_Params(s *capnp.Segment) (RPCService_maxConns_Params, error) {
+func NewRPCService_id_Params(s *capnp.Segment) (RPCService_id_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_maxConns_Params{st}, err
+	return RPCService_id_Params{st}, err
 }
 
-func NewRootRPCService_maxConns_Params(s *capnp.Segment) (RPCService_maxConns_Params, error) {
+func NewRootRPCService_id_Params(s *capnp.Segment) (RPCService_id_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return RPCService_maxConns_Params{st}, err
+	return RPCService_id_Params{st}, err
 }
 
-func ReadRootRPCService_maxConns_Params(msg *capnp.Message) (RPCService_maxConns_Params, error) {
+func ReadRootRPCService_id_Params(msg *capnp.Message) (RPCService_id_Params, error) {
 	root, err := msg.RootPtr()
-	return RPCService_maxConns_Params{root.Struct()}, err
+	return RPCService_id_Params{root.Struct()}, err
 }
 
-func (s RPCService_maxConns_Params) String() string {
-	str, _ := text.Marshal(0x8526d6d896c688e0, s.Struct)
+func (s RPCService_id_Params) String() string {
+	str, _ := text.Marshal(0x88e166675c857e18, s.Struct)
 	return str
 }
 
-// RPCService_maxConns_Params_List is a list of RPCService_maxConns_Params.
-type RPCService_maxConns_Params_List struct{ capnp.List }
+// RPCService_id_Params_List is a list of RPCService_id_Params.
+type RPCService_id_Params_List struct{ capnp.List }
 
-// NewRPCService_maxConns_Params creates a new list of RPCService_maxConns_Params.
-func NewRPCService_maxConns_Params_List(s *capnp.Segment, sz int32) (RPCService_maxConns_Params_List, error) {
+// NewRPCService_id_Params creates a new list of RPCService_id_Params.
+func NewRPCService_id_Params_List(s *capnp.Segment, sz int32) (RPCService_id_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return RPCService_maxConns_Params_List{l}, err
+	return RPCService_id_Params_List{l}, err
 }
 
-func (s RPCService_maxConns_Params_List) At(i int) RPCService_maxConns_Params {
-	return RPCService_maxConns_Params{s.List.Struct(i)}
+func (s RPCService_id_Params_List) At(i int) RPCService_id_Params {
+	return RPCService_id_Params{s.List.Struct(i)}
 }
 
-func (s RPCService_maxConns_Params_List) Set(i int, v RPCService_maxConns_Params) error {
+func (s RPCService_id_Params_List) Set(i int, v RPCService_id_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_maxConns_Params_List) String() string {
-	str, _ := text.MarshalList(0x8526d6d896c688e0, s.List)
+func (s RPCService_id_Params_List) String() string {
+	str, _ := text.MarshalList(0x88e166675c857e18, s.List)
 	return str
 }
 
-// RPCService_maxConns_Params_Promise is a wrapper for a RPCService_maxConns_Params promised by a client call.
-type RPCService_maxConns_Params_Promise struct{ *capnp.Pipeline }
+// RPCService_id_Params_Promise is a wrapper for a RPCService_id_Params promised by a client call.
+type RPCService_id_Params_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_maxConns_Params_Promise) Struct() (RPCService_maxConns_Params, error) {
+func (p RPCService_id_Params_Promise) Struct() (RPCService_id_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_maxConns_Params{s}, err
+	return RPCService_id_Params{s}, err
 }
 
-type RPCService_maxConns_Results struct{ capnp.Struct }
+type RPCService_id_Results struct{ capnp.Struct }
 
-// RPCService_maxConns_Results_TypeID is the unique identifier for the type RPCService_maxConns_Results.
-const RPCService_maxConns_Results_TypeID = 0xa23b4c1a964c722b
+// RPCService_id_Results_TypeID is the unique identifier for the type RPCService_id_Results.
+const RPCService_id_Results_TypeID = 0xc21e37cdb9df069e
 
-func NewRPCService_maxConns_Results(s *capnp.Segment) (RPCService_maxConns_Results, error) {
+func NewRPCService_id_Results(s *capnp.Segment) (RPCService_id_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_maxConns_Results{st}, err
+	return RPCService_id_Results{st}, err
 }
 
-func NewRootRPCService_maxConns_Results(s *capnp.Segment) (RPCService_maxConns_Results, error) {
+func NewRootRPCService_id_Results(s *capnp.Segment) (RPCService_id_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return RPCService_maxConns_Results{st}, err
+	return RPCService_id_Results{st}, err
 }
 
-func ReadRootRPCService_maxConns_Results(msg *capnp.Message) (RPCService_maxConns_Results, error) {
+func ReadRootRPCService_id_Results(msg *capnp.Message) (RPCService_id_Results, error) {
 	root, err := msg.RootPtr()
-	return RPCService_maxConns_Results{root.Struct()}, err
+	return RPCService_id_Results{root.Struct()}, err
 }
 
-func (s RPCService_maxConns_Results) String() string {
-	str, _ := text.Marshal(0xa23b4c1a964c722b, s.Struct)
+func (s RPCService_id_Results) String() string {
+	str, _ := text.Marshal(0xc21e37cdb9df069e, s.Struct)
 	return str
 }
 
-func (s RPCService_maxConns_Results) Count() uint32 {
-	return s.Struct.Uint32(0)
+func (s RPCService_id_Results) ServiceId() uint64 {
+	return s.Struct.Uint64(0)
 }
 
-func (s RPCService_maxConns_Results) SetCount(v uint32) {
-	s.Struct.SetUint32(0, v)
+func (s RPCService_id_Results) SetServiceId(v uint64) {
+	s.Struct.SetUint64(0, v)
 }
 
-// RPCService_maxConns_Results_List is a list of RPCService_maxConns_Results.
-type RPCService_maxConns_Results_List struct{ capnp.List }
+// RPCService_id_Results_List is a list of RPCService_id_Results.
+type RPCService_id_Results_List struct{ capnp.List }
 
-// NewRPCService_maxConns_Results creates a new list of RPCService_maxConns_Results.
-func NewRPCService_maxConns_Results_List(s *capnp.Segment, sz int32) (RPCService_maxConns_Results_List, error) {
+// NewRPCService_id_Results creates a new list of RPCService_id_Results.
+func NewRPCService_id_Results_List(s *capnp.Segment, sz int32) (RPCService_id_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return RPCService_maxConns_Results_List{l}, err
+	return RPCService_id_Results_List{l}, err
 }
 
-func (s RPCService_maxConns_Results_List) At(i int) RPCService_maxConns_Results {
-	return RPCService_maxConns_Results{s.List.Struct(i)}
+func (s RPCService_id_Results_List) At(i int) RPCService_id_Results {
+	return RPCService_id_Results{s.List.Struct(i)}
 }
 
-func (s RPCService_maxConns_Results_List) Set(i int, v RPCService_maxConns_Results) error {
+func (s RPCService_id_Results_List) Set(i int, v RPCService_id_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s RPCService_maxConns_Results_List) String() string {
-	str, _ := text.MarshalList(0xa23b4c1a964c722b, s.List)
+func (s RPCService_id_Results_List) String() string {
+	str, _ := text.MarshalList(0xc21e37cdb9df069e, s.List)
 	return str
 }
 
-// RPCService_maxConns_Results_Promise is a wrapper for a RPCService_maxConns_Results promised by a client call.
-type RPCService_maxConns_Results_Promise struct{ *capnp.Pipeline }
+// RPCService_id_Results_Promise is a wrapper for a RPCService_id_Results promised by a client call.
+type RPCService_id_Results_Promise struct{ *capnp.Pipeline }
 
-func (p RPCService_maxConns_Results_Promise) Struct() (RPCService_maxConns_Results, error) {
+func (p RPCService_id_Results_Promise) Struct() (RPCService_id_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return RPCService_maxConns_Results{s}, err
+	return RPCService_id_Results{s}, err
 }
 
-type NetworkAddress struct{ capnp.Struct }
+type RPCService_listenerAlive_Params struct{ capnp.Struct }
 
-// NetworkAddress_TypeID is the unique identifier for the type NetworkAddress.
-const NetworkAddress_TypeID = 0x9aae3a8502e6d5eb
+// RPCService_listenerAlive_Params_TypeID is the unique identifier for the type RPCService_listenerAlive_Params.
+const RPCService_listenerAlive_Params_TypeID = 0xc7fcacbb7e6c5bb0
 
-func NewNetworkAddress(s *capnp.Segment) (NetworkAddress, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return NetworkAddress{st}, err
+func NewRPCService_listenerAlive_Params(s *capnp.Segment) (RPCService_listenerAlive_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_listenerAlive_Params{st}, err
 }
 
-func NewRootNetworkAddress(s *capnp.Segment) (NetworkAddress, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
-	return NetworkAddress{st}, err
+func NewRootRPCService_listenerAlive_Params(s *capnp.Segment) (RPCService_listenerAlive_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_listenerAlive_Params{st}, err
 }
 
-func ReadRootNetworkAddress(msg *capnp.Message) (NetworkAddress, error) {
+func ReadRootRPCService_listenerAlive_Params(msg *capnp.Message) (RPCService_listenerAlive_Params, error) {
 	root, err := msg.RootPtr()
-	return NetworkAddress{root.Struct()}, err
+	return RPCService_listenerAlive_Params{root.Struct()}, err
 }
 
-func (s NetworkAddress) String() string {
-	str, _ := text.Marshal(0x9aae3a8502e6d5eb, s.Struct)
+func (s RPCService_listenerAlive_Params) String() string {
+	str, _ := text.Marshal(0xc7fcacbb7e6c5bb0, s.Struct)
 	return str
 }
 
-func (s NetworkAddress) Network() (string, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+// RPCService_listenerAlive_Params_List is a list of RPCService_listenerAlive_Params.
+type RPCService_listenerAlive_Params_List struct{ capnp.List }
+
+// NewRPCService_listenerAlive_Params creates a new list of RPCService_listenerAlive_Params.
+func NewRPCService_listenerAlive_Params_List(s *capnp.Segment, sz int32) (RPCService_listenerAlive_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return RPCService_listenerAlive_Params_List{l}, err
 }
 
-func (s NetworkAddress) HasNetwork() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+func (s RPCService_listenerAlive_Params_List) At(i int) RPCService_listenerAlive_Params {
+	return RPCService_listenerAlive_Params{s.List.Struct(i)}
 }
 
-func (s NetworkAddress) NetworkBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s RPCService_listenerAlive_Params_List) Set(i int, v RPCService_listenerAlive_Params) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s NetworkAddress) SetNetwork(v string) error {
-	return s.Struct.SetText(0, v)
+func (s RPCService_listenerAlive_Params_List) String() string {
+	str, _ := text.MarshalList(0xc7fcacbb7e6c5bb0, s.List)
+	return str
 }
 
-func (s NetworkAddress) Address() (string, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.Text(), err
+// RPCService_listenerAlive_Params_Promise is a wrapper for a RPCService_listenerAlive_Params promised by a client call.
+type RPCService_listenerAlive_Params_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_listenerAlive_Params_Promise) Struct() (RPCService_listenerAlive_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_listenerAlive_Params{s}, err
 }
 
-func (s NetworkAddress) HasAddress() bool {
-	p, err := s.Struct.Ptr(1)
-	return p.IsValid() || err != nil
+type RPCService_listenerAlive_Results struct{ capnp.Struct }
+
+// RPCService_listenerAlive_Results_TypeID is the unique identifier for the type RPCService_listenerAlive_Results.
+const RPCService_listenerAlive_Results_TypeID = 0xa76b7607195dee3a
+
+func NewRPCService_listenerAlive_Results(s *capnp.Segment) (RPCService_listenerAlive_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_listenerAlive_Results{st}, err
 }
 
-func (s NetworkAddress) AddressBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(1)
-	return p.TextBytes(), err
+func NewRootRPCService_listenerAlive_Results(s *capnp.Segment) (RPCService_listenerAlive_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_listenerAlive_Results{st}, err
 }
 
-func (s NetworkAddress) SetAddress(v string) error {
-	return s.Struct.SetText(1, v)
+func ReadRootRPCService_listenerAlive_Results(msg *capnp.Message) (RPCService_listenerAlive_Results, error) {
+	root, err := msg.RootPtr()
+	return RPCService_listenerAlive_Results{root.Struct()}, err
 }
 
-// NetworkAddress_List is a list of NetworkAddress.
-type NetworkAddress_List struct{ capnp.List }
+func (s RPCService_listenerAlive_Results) String() string {
+	str, _ := text.Marshal(0xa76b7607195dee3a, s.Struct)
+	return str
+}
 
-// NewNetworkAddress creates a new list of NetworkAddress.
-func NewNetworkAddress_List(s *capnp.Segment, sz int32) (NetworkAddress_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
-	return NetworkAddress_List{l}, err
+func (s RPCService_listenerAlive_Results) ListenerAlive() bool {
+	return s.Struct.Bit(0)
 }
 
-func (s NetworkAddress_List) At(i int) NetworkAddress { return NetworkAddress{s.List.Struct(i)} }
+func (s RPCService_listenerAlive_Results) SetListenerAlive(v bool) {
+	s.Struct.SetBit(0, v)
+}
 
-func (s NetworkAddress_List) Set(i int, v NetworkAddress) error { return s.List.SetStruct(i, v.Struct) }
+// RPCService_listenerAlive_Results_List is a list of RPCService_listenerAlive_Results.
+type RPCService_listenerAlive_Results_List struct{ capnp.List }
 
-func (s NetworkAddress_List) String() string {
-	str, _ := text.MarshalList(0x9aae3a8502e6d5eb, s.List)
+// NewRPCService_listenerAlive_Results creates a new list of RPCService_listenerAlive_Results.
+func NewRPCService_listenerAlive_Results_List(s *capnp.Segment, sz int32) (RPCService_listenerAlive_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return RPCService_listenerAlive_Results_List{l}, err
+}
+
+func (s RPCService_listenerAlive_Results_List) At(i int) RPCService_listenerAlive_Results {
+	return RPCService_listenerAlive_Results{s.List.Struct(i)}
+}
+
+func (s RPCService_listenerAlive_Results_List) Set(i int, v RPCService_listenerAlive_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s RPCService_listenerAlive_Results_List) String() string {
+	str, _ := text.MarshalList(0xa76b7607195dee3a, s.List)
 	return str
 }
 
-// NetworkAddress_Promise is a wrapper for a NetworkAddress promised by a client call.
-type NetworkAddress_Promise struct{ *capnp.Pipeline }
+// RPCService_listenerAlive_Results_Promise is a wrapper for a RPCService_listenerAlive_Results promised by a client call.
+type RPCService_listenerAlive_Results_Promise struct{ *capnp.Pipeline }
 
-func (p NetworkAddress_Promise) Struct() (NetworkAddress, error) {
+func (p RPCService_listenerAlive_Results_Promise) Struct() (RPCService_listenerAlive_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return NetworkAddress{s}, err
+	return RPCService_listenerAlive_Results{s}, err
 }
 
-type LogLevel uint16
+type RPCService_listenerAddress_Params struct{ capnp.Struct }
 
-// LogLevel_TypeID is the unique identifier for the type LogLevel.
-const LogLevel_TypeID = 0xce802aa8977a9aee
+// RPCService_listenerAddress_Params_TypeID is the unique identifier for the type RPCService_listenerAddress_Params.
+const RPCService_listenerAddress_Params_TypeID = 0xdc063192b2b7a561
 
-// Values of LogLevel.
-const (
-	LogLevel_debug LogLevel = 0
-	LogLevel_info  LogLevel = 1
-	LogLevel_warn  LogLevel = 2
-	LogLevel_error LogLevel = 3
-)
+func NewRPCService_listenerAddress_Params(s *capnp.Segment) (RPCService_listenerAddress_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_listenerAddress_Params{st}, err
+}
 
-// String returns the enum's constant name.
-func (c LogLevel) String() string {
-	switch c {
-	case LogLevel_debug:
-		return "debug"
-	case LogLevel_info:
-		return "info"
-	case LogLevel_warn:
-		return "warn"
-	case LogLevel_error:
-		return "error"
+func NewRootRPCService_listenerAddress_Params(s *capnp.Segment) (RPCService_listenerAddress_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_listenerAddress_Params{st}, err
+}
 
-	default:
-		return ""
-	}
+func ReadRootRPCService_listenerAddress_Params(msg *capnp.Message) (RPCService_listenerAddress_Params, error) {
+	root, err := msg.RootPtr()
+	return RPCService_listenerAddress_Params{root.Struct()}, err
 }
 
-// LogLevelFromString returns the enum value with a name,
-// or the zero value if there's no such value.
-func LogLevelFromString(c string) LogLevel {
-	switch c {
-	case "debug":
-		return LogLevel_debug
-	case "info":
-		return LogLevel_info
-	case "warn":
-		return LogLevel_warn
-	case "error":
-		return LogLevel_error
+func (s RPCService_listenerAddress_Params) String() string {
+	str, _ := text.Marshal(0xdc063192b2b7a561, s.Struct)
+	return str
+}
 
-	default:
-		return 0
-	}
+// RPCService_listenerAddress_Params_List is a list of RPCService_listenerAddress_Params.
+type RPCService_listenerAddress_Params_List struct{ capnp.List }
+
+// NewRPCService_listenerAddress_Params creates a new list of RPCService_listenerAddress_Params.
+func NewRPCService_listenerAddress_Params_List(s *capnp.Segment, sz int32) (RPCService_listenerAddress_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return RPCService_listenerAddress_Params_List{l}, err
 }
 
-type LogLevel_List struct{ capnp.List }
+func (s RPCService_listenerAddress_Params_List) At(i int) RPCService_listenerAddress_Params {
+	return RPCService_listenerAddress_Params{s.List.Struct(i)}
+}
 
-func NewLogLevel_List(s *capnp.Segment, sz int32) (LogLevel_List, error) {
-	l, err := capnp.NewUInt16List(s, sz)
-	return LogLevel_List{l.List}, err
+func (s RPCService_listenerAddress_Params_List) Set(i int, v RPCService_listenerAddress_Params) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (l LogLevel_List) At(i int) LogLevel {
-	ul := capnp.UInt16List{List: l.List}
-	return LogLevel(ul.At(i))
+func (s RPCService_listenerAddress_Params_List) String() string {
+	str, _ := text.MarshalList(0xdc063192b2b7a561, s.List)
+	return str
 }
 
-func (l LogLevel_List) Set(i int, v LogLevel) {
-	ul := capnp.UInt16List{List: l.List}
-	ul.Set(i, uint16(v))
+// RPCService_listenerAddress_Params_Promise is a wrapper for a RPCService_listenerAddress_Params promised by a client call.
+type RPCService_listenerAddress_Params_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_listenerAddress_Params_Promise) Struct() (RPCService_listenerAddress_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_listenerAddress_Params{s}, err
 }
 
-type Runtime struct{ Client capnp.Client }
+type RPCService_listenerAddress_Results struct{ capnp.Struct }
 
-// Runtime_TypeID is the unique identifier for the type Runtime.
-const Runtime_TypeID = 0xdda2e02140fe8f08
+// RPCService_listenerAddress_Results_TypeID is the unique identifier for the type RPCService_listenerAddress_Results.
+const RPCService_listenerAddress_Results_TypeID = 0xb95e72a43cd7c47c
 
-func (c Runtime) GoVersion(ctx context.Context, params func(Runtime_goVersion_Params) error, opts ...capnp.CallOption) Runtime_goVersion_Results_Promise {
-	if c.Client == nil {
-		return Runtime_goVersion_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "goVersion",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_goVersion_Params{Struct: s}) }
-	}
-	return Runtime_goVersion_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewRPCService_listenerAddress_Results(s *capnp.Segment) (RPCService_listenerAddress_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return RPCService_listenerAddress_Results{st}, err
 }
-func (c Runtime) NumCPU(ctx context.Context, params func(Runtime_numCPU_Params) error, opts ...capnp.CallOption) Runtime_numCPU_Results_Promise {
-	if c.Client == nil {
-		return Runtime_numCPU_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "numCPU",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_numCPU_Params{Struct: s}) }
-	}
-	return Runtime_numCPU_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func NewRootRPCService_listenerAddress_Results(s *capnp.Segment) (RPCService_listenerAddress_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return RPCService_listenerAddress_Results{st}, err
 }
-func (c Runtime) NumGoroutine(ctx context.Context, params func(Runtime_numGoroutine_Params) error, opts ...capnp.CallOption) Runtime_numGoroutine_Results_Promise {
-	if c.Client == nil {
-		return Runtime_numGoroutine_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "numGoroutine",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_numGoroutine_Params{Struct: s}) }
-	}
-	return Runtime_numGoroutine_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func ReadRootRPCService_listenerAddress_Results(msg *capnp.Message) (RPCService_listenerAddress_Results, error) {
+	root, err := msg.RootPtr()
+	return RPCService_listenerAddress_Results{root.Struct()}, err
 }
-func (c Runtime) MemStats(ctx context.Context, params func(Runtime_memStats_Params) error, opts ...capnp.CallOption) Runtime_memStats_Results_Promise {
-	if c.Client == nil {
-		return Runtime_memStats_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      3,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "memStats",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_memStats_Params{Struct: s}) }
-	}
-	return Runtime_memStats_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func (s RPCService_listenerAddress_Results) String() string {
+	str, _ := text.Marshal(0xb95e72a43cd7c47c, s.Struct)
+	return str
 }
-func (c Runtime) StackDump(ctx context.Context, params func(Runtime_stackDump_Params) error, opts ...capnp.CallOption) Runtime_stackDump_Results_Promise {
-	if c.Client == nil {
-		return Runtime_stackDump_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      4,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "stackDump",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_stackDump_Params{Struct: s}) }
+
+func (s RPCService_listenerAddress_Results) Address() (NetworkAddress, error) {
+	p, err := s.Struct.Ptr(0)
+	return NetworkAddress{Struct: p.Struct()}, err
+}
+
+func (s RPCService_listenerAddress_Results) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s RPCService_listenerAddress_Results) SetAddress(v NetworkAddress) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewAddress sets the address field to a newly
+// allocated NetworkAddress struct, preferring placement in s's segment.
+func (s RPCService_listenerAddress_Results) NewAddress() (NetworkAddress, error) {
+	ss, err := NewNetworkAddress(s.Struct.Segment())
+	if err != nil {
+		return NetworkAddress{}, err
 	}
-	return Runtime_stackDump_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
 }
 
-type Runtime_Server interface {
-	GoVersion(Runtime_goVersion) error
+// RPCService_listenerAddress_Results_List is a list of RPCService_listenerAddress_Results.
+type RPCService_listenerAddress_Results_List struct{ capnp.List }
 
-	NumCPU(Runtime_numCPU) error
+// NewRPCService_listenerAddress_Results creates a new list of RPCService_listenerAddress_Results.
+func NewRPCService_listenerAddress_Results_List(s *capnp.Segment, sz int32) (RPCService_listenerAddress_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return RPCService_listenerAddress_Results_List{l}, err
+}
 
-	NumGoroutine(Runtime_numGoroutine) error
+func (s RPCService_listenerAddress_Results_List) At(i int) RPCService_listenerAddress_Results {
+	return RPCService_listenerAddress_Results{s.List.Struct(i)}
+}
 
-	MemStats(Runtime_memStats) error
+func (s RPCService_listenerAddress_Results_List) Set(i int, v RPCService_listenerAddress_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
 
-	StackDump(Runtime_stackDump) error
+func (s RPCService_listenerAddress_Results_List) String() string {
+	str, _ := text.MarshalList(0xb95e72a43cd7c47c, s.List)
+	return str
 }
 
-func Runtime_ServerToClient(s Runtime_Server) Runtime {
-	c, _ := s.(server.Closer)
-	return Runtime{Client: server.New(Runtime_Methods(nil, s), c)}
+// RPCService_listenerAddress_Results_Promise is a wrapper for a RPCService_listenerAddress_Results promised by a client call.
+type RPCService_listenerAddress_Results_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_listenerAddress_Results_Promise) Struct() (RPCService_listenerAddress_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_listenerAddress_Results{s}, err
+}
+
+func (p RPCService_listenerAddress_Results_Promise) Address() NetworkAddress_Promise {
+	return NetworkAddress_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type RPCService_activeConns_Params struct{ capnp.Struct }
+
+// RPCService_activeConns_Params_TypeID is the unique identifier for the type RPCService_activeConns_Params.
+const RPCService_activeConns_Params_TypeID = 0xf604c2f7eff9f3c7
+
+func NewRPCService_activeConns_Params(s *capnp.Segment) (RPCService_activeConns_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_activeConns_Params{st}, err
+}
+
+func NewRootRPCService_activeConns_Params(s *capnp.Segment) (RPCService_activeConns_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_activeConns_Params{st}, err
+}
+
+func ReadRootRPCService_activeConns_Params(msg *capnp.Message) (RPCService_activeConns_Params, error) {
+	root, err := msg.RootPtr()
+	return RPCService_activeConns_Params{root.Struct()}, err
+}
+
+func (s RPCService_activeConns_Params) String() string {
+	str, _ := text.Marshal(0xf604c2f7eff9f3c7, s.Struct)
+	return str
+}
+
+// RPCService_activeConns_Params_List is a list of RPCService_activeConns_Params.
+type RPCService_activeConns_Params_List struct{ capnp.List }
+
+// NewRPCService_activeConns_Params creates a new list of RPCService_activeConns_Params.
+func NewRPCService_activeConns_Params_List(s *capnp.Segment, sz int32) (RPCService_activeConns_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return RPCService_activeConns_Params_List{l}, err
+}
+
+func (s RPCService_activeConns_Params_List) At(i int) RPCService_activeConns_Params {
+	return RPCService_activeConns_Params{s.List.Struct(i)}
+}
+
+func (s RPCService_activeConns_Params_List) Set(i int, v RPCService_activeConns_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s RPCService_activeConns_Params_List) String() string {
+	str, _ := text.MarshalList(0xf604c2f7eff9f3c7, s.List)
+	return str
+}
+
+// RPCService_activeConns_Params_Promise is a wrapper for a RPCService_activeConns_Params promised by a client call.
+type RPCService_activeConns_Params_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_activeConns_Params_Promise) Struct() (RPCService_activeConns_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_activeConns_Params{s}, err
+}
+
+type RPCService_activeConns_Results struct{ capnp.Struct }
+
+// RPCService_activeConns_Results_TypeID is the unique identifier for the type RPCService_activeConns_Results.
+const RPCService_activeConns_Results_TypeID = 0xf175231b9048f2c5
+
+func NewRPCService_activeConns_Results(s *capnp.Segment) (RPCService_activeConns_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_activeConns_Results{st}, err
+}
+
+func NewRootRPCService_activeConns_Results(s *capnp.Segment) (RPCService_activeConns_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_activeConns_Results{st}, err
+}
+
+func ReadRootRPCService_activeConns_Results(msg *capnp.Message) (RPCService_activeConns_Results, error) {
+	root, err := msg.RootPtr()
+	return RPCService_activeConns_Results{root.Struct()}, err
+}
+
+func (s RPCService_activeConns_Results) String() string {
+	str, _ := text.Marshal(0xf175231b9048f2c5, s.Struct)
+	return str
+}
+
+func (s RPCService_activeConns_Results) Count() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s RPCService_activeConns_Results) SetCount(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// RPCService_activeConns_Results_List is a list of RPCService_activeConns_Results.
+type RPCService_activeConns_Results_List struct{ capnp.List }
+
+// NewRPCService_activeConns_Results creates a new list of RPCService_activeConns_Results.
+func NewRPCService_activeConns_Results_List(s *capnp.Segment, sz int32) (RPCService_activeConns_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return RPCService_activeConns_Results_List{l}, err
+}
+
+func (s RPCService_activeConns_Results_List) At(i int) RPCService_activeConns_Results {
+	return RPCService_activeConns_Results{s.List.Struct(i)}
+}
+
+func (s RPCService_activeConns_Results_List) Set(i int, v RPCService_activeConns_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s RPCService_activeConns_Results_List) String() string {
+	str, _ := text.MarshalList(0xf175231b9048f2c5, s.List)
+	return str
+}
+
+// RPCService_activeConns_Results_Promise is a wrapper for a RPCService_activeConns_Results promised by a client call.
+type RPCService_activeConns_Results_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_activeConns_Results_Promise) Struct() (RPCService_activeConns_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_activeConns_Results{s}, err
+}
+
+type RPCService_maxConns_Params struct{ capnp.Struct }
+
+// RPCService_maxConns_Params_TypeID is the unique identifier for the type RPCService_maxConns_Params.
+const RPCService_maxConns_Params_TypeID = 0x8526d6d896c688e0
+
+func NewRPCService_maxConns_Params(s *capnp.Segment) (RPCService_maxConns_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_maxConns_Params{st}, err
+}
+
+func NewRootRPCService_maxConns_Params(s *capnp.Segment) (RPCService_maxConns_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return RPCService_maxConns_Params{st}, err
+}
+
+func ReadRootRPCService_maxConns_Params(msg *capnp.Message) (RPCService_maxConns_Params, error) {
+	root, err := msg.RootPtr()
+	return RPCService_maxConns_Params{root.Struct()}, err
+}
+
+func (s RPCService_maxConns_Params) String() string {
+	str, _ := text.Marshal(0x8526d6d896c688e0, s.Struct)
+	return str
+}
+
+// RPCService_maxConns_Params_List is a list of RPCService_maxConns_Params.
+type RPCService_maxConns_Params_List struct{ capnp.List }
+
+// NewRPCService_maxConns_Params creates a new list of RPCService_maxConns_Params.
+func NewRPCService_maxConns_Params_List(s *capnp.Segment, sz int32) (RPCService_maxConns_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return RPCService_maxConns_Params_List{l}, err
+}
+
+func (s RPCService_maxConns_Params_List) At(i int) RPCService_maxConns_Params {
+	return RPCService_maxConns_Params{s.List.Struct(i)}
+}
+
+func (s RPCService_maxConns_Params_List) Set(i int, v RPCService_maxConns_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s RPCService_maxConns_Params_List) String() string {
+	str, _ := text.MarshalList(0x8526d6d896c688e0, s.List)
+	return str
+}
+
+// RPCService_maxConns_Params_Promise is a wrapper for a RPCService_maxConns_Params promised by a client call.
+type RPCService_maxConns_Params_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_maxConns_Params_Promise) Struct() (RPCService_maxConns_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_maxConns_Params{s}, err
+}
+
+type RPCService_maxConns_Results struct{ capnp.Struct }
+
+// RPCService_maxConns_Results_TypeID is the unique identifier for the type RPCService_maxConns_Results.
+const RPCService_maxConns_Results_TypeID = 0xa23b4c1a964c722b
+
+func NewRPCService_maxConns_Results(s *capnp.Segment) (RPCService_maxConns_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_maxConns_Results{st}, err
+}
+
+func NewRootRPCService_maxConns_Results(s *capnp.Segment) (RPCService_maxConns_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return RPCService_maxConns_Results{st}, err
+}
+
+func ReadRootRPCService_maxConns_Results(msg *capnp.Message) (RPCService_maxConns_Results, error) {
+	root, err := msg.RootPtr()
+	return RPCService_maxConns_Results{root.Struct()}, err
+}
+
+func (s RPCService_maxConns_Results) String() string {
+	str, _ := text.Marshal(0xa23b4c1a964c722b, s.Struct)
+	return str
+}
+
+func (s RPCService_maxConns_Results) Count() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s RPCService_maxConns_Results) SetCount(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// RPCService_maxConns_Results_List is a list of RPCService_maxConns_Results.
+type RPCService_maxConns_Results_List struct{ capnp.List }
+
+// NewRPCService_maxConns_Results creates a new list of RPCService_maxConns_Results.
+func NewRPCService_maxConns_Results_List(s *capnp.Segment, sz int32) (RPCService_maxConns_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return RPCService_maxConns_Results_List{l}, err
+}
+
+func (s RPCService_maxConns_Results_List) At(i int) RPCService_maxConns_Results {
+	return RPCService_maxConns_Results{s.List.Struct(i)}
+}
+
+func (s RPCService_maxConns_Results_List) Set(i int, v RPCService_maxConns_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s RPCService_maxConns_Results_List) String() string {
+	str, _ := text.MarshalList(0xa23b4c1a964c722b, s.List)
+	return str
+}
+
+// RPCService_maxConns_Results_Promise is a wrapper for a RPCService_maxConns_Results promised by a client call.
+type RPCService_maxConns_Results_Promise struct{ *capnp.Pipeline }
+
+func (p RPCService_maxConns_Results_Promise) Struct() (RPCService_maxConns_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return RPCService_maxConns_Results{s}, err
+}
+
+type NetworkAddress struct{ capnp.Struct }
+
+// NetworkAddress_TypeID is the unique identifier for the type NetworkAddress.
+const NetworkAddress_TypeID = 0x9aae3a8502e6d5eb
+
+func NewNetworkAddress(s *capnp.Segment) (NetworkAddress, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return NetworkAddress{st}, err
+}
+
+func NewRootNetworkAddress(s *capnp.Segment) (NetworkAddress, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2})
+	return NetworkAddress{st}, err
+}
+
+func ReadRootNetworkAddress(msg *capnp.Message) (NetworkAddress, error) {
+	root, err := msg.RootPtr()
+	return NetworkAddress{root.Struct()}, err
+}
+
+func (s NetworkAddress) String() string {
+	str, _ := text.Marshal(0x9aae3a8502e6d5eb, s.Struct)
+	return str
+}
+
+func (s NetworkAddress) Network() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s NetworkAddress) HasNetwork() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s NetworkAddress) NetworkBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s NetworkAddress) SetNetwork(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s NetworkAddress) Address() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s NetworkAddress) HasAddress() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s NetworkAddress) AddressBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s NetworkAddress) SetAddress(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+// NetworkAddress_List is a list of NetworkAddress.
+type NetworkAddress_List struct{ capnp.List }
+
+// NewNetworkAddress creates a new list of NetworkAddress.
+func NewNetworkAddress_List(s *capnp.Segment, sz int32) (NetworkAddress_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 2}, sz)
+	return NetworkAddress_List{l}, err
+}
+
+func (s NetworkAddress_List) At(i int) NetworkAddress { return NetworkAddress{s.List.Struct(i)} }
+
+func (s NetworkAddress_List) Set(i int, v NetworkAddress) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s NetworkAddress_List) String() string {
+	str, _ := text.MarshalList(0x9aae3a8502e6d5eb, s.List)
+	return str
+}
+
+// NetworkAddress_Promise is a wrapper for a NetworkAddress promised by a client call.
+type NetworkAddress_Promise struct{ *capnp.Pipeline }
+
+func (p NetworkAddress_Promise) Struct() (NetworkAddress, error) {
+	s, err := p.Pipeline.Struct()
+	return NetworkAddress{s}, err
+}
+
+type LogLevel uint16
+
+// LogLevel_TypeID is the unique identifier for the type LogLevel.
+const LogLevel_TypeID = 0xce802aa8977a9aee
+
+// Values of LogLevel.
+const (
+	LogLevel_debug LogLevel = 0
+	LogLevel_info  LogLevel = 1
+	LogLevel_warn  LogLevel = 2
+	LogLevel_error LogLevel = 3
+)
+
+// String returns the enum's constant name.
+func (c LogLevel) String() string {
+	switch c {
+	case LogLevel_debug:
+		return "debug"
+	case LogLevel_info:
+		return "info"
+	case LogLevel_warn:
+		return "warn"
+	case LogLevel_error:
+		return "error"
+
+	default:
+		return ""
+	}
+}
+
+// LogLevelFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func LogLevelFromString(c string) LogLevel {
+	switch c {
+	case "debug":
+		return LogLevel_debug
+	case "info":
+		return LogLevel_info
+	case "warn":
+		return LogLevel_warn
+	case "error":
+		return LogLevel_error
+
+	default:
+		return 0
+	}
+}
+
+type LogLevel_List struct{ capnp.List }
+
+func NewLogLevel_List(s *capnp.Segment, sz int32) (LogLevel_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return LogLevel_List{l.List}, err
+}
+
+func (l LogLevel_List) At(i int) LogLevel {
+	ul := capnp.UInt16List{List: l.List}
+	return LogLevel(ul.At(i))
+}
+
+func (l LogLevel_List) Set(i int, v LogLevel) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Runtime struct{ Client capnp.Client }
+
+// Runtime_TypeID is the unique identifier for the type Runtime.
+const Runtime_TypeID = 0xdda2e02140fe8f08
+
+func (c Runtime) GoVersion(ctx context.Context, params func(Runtime_goVersion_Params) error, opts ...capnp.CallOption) Runtime_goVersion_Results_Promise {
+	if c.Client == nil {
+		return Runtime_goVersion_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      0,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "goVersion",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_goVersion_Params{Struct: s}) }
+	}
+	return Runtime_goVersion_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c Runtime) NumCPU(ctx context.Context, params func(Runtime_numCPU_Params) error, opts ...capnp.CallOption) Runtime_numCPU_Results_Promise {
+	if c.Client == nil {
+		return Runtime_numCPU_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      1,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "numCPU",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_numCPU_Params{Struct: s}) }
+	}
+	return Runtime_numCPU_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c Runtime) NumGoroutine(ctx context.Context, params func(Runtime_numGoroutine_Params) error, opts ...capnp.CallOption) Runtime_numGoroutine_Results_Promise {
+	if c.Client == nil {
+		return Runtime_numGoroutine_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      2,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "numGoroutine",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_numGoroutine_Params{Struct: s}) }
+	}
+	return Runtime_numGoroutine_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c Runtime) MemStats(ctx context.Context, params func(Runtime_memStats_Params) error, opts ...capnp.CallOption) Runtime_memStats_Results_Promise {
+	if c.Client == nil {
+		return Runtime_memStats_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      3,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "memStats",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_memStats_Params{Struct: s}) }
+	}
+	return Runtime_memStats_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c Runtime) StackDump(ctx context.Context, params func(Runtime_stackDump_Params) error, opts ...capnp.CallOption) Runtime_stackDump_Results_Promise {
+	if c.Client == nil {
+		return Runtime_stackDump_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      4,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "stackDump",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Runtime_stackDump_Params{Struct: s}) }
+	}
+	return Runtime_stackDump_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+
+type Runtime_Server interface {
+	GoVersion(Runtime_goVersion) error
+
+	NumCPU(Runtime_numCPU) error
+
+	NumGoroutine(Runtime_numGoroutine) error
+
+	MemStats(Runtime_memStats) error
+
+	StackDump(Runtime_stackDump) error
+}
+
+func Runtime_ServerToClient(s Runtime_Server) Runtime {
+	c, _ := s.(server.Closer)
+	return Runtime{Client: server.New(Runtime_Methods(nil, s), c)}
+}
+
+func Runtime_Methods(methods []server.Method, s Runtime_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 5)
+	}
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      0,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "goVersion",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Runtime_goVersion{c, opts, Runtime_goVersion_Params{Struct: p}, Runtime_goVersion_Results{Struct: r}}
+			return s.GoVersion(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      1,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "numCPU",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Runtime_numCPU{c, opts, Runtime_numCPU_Params{Struct: p}, Runtime_numCPU_Results{Struct: r}}
+			return s.NumCPU(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      2,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "numGoroutine",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Runtime_numGoroutine{c, opts, Runtime_numGoroutine_Params{Struct: p}, Runtime_numGoroutine_Results{Struct: r}}
+			return s.NumGoroutine(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      3,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "memStats",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Runtime_memStats{c, opts, Runtime_memStats_Params{Struct: p}, Runtime_memStats_Results{Struct: r}}
+			return s.MemStats(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xdda2e02140fe8f08,
+			MethodID:      4,
+			InterfaceName: "app.capnp:Runtime",
+			MethodName:    "stackDump",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Runtime_stackDump{c, opts, Runtime_stackDump_Params{Struct: p}, Runtime_stackDump_Results{Struct: r}}
+			return s.StackDump(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
+
+	return methods
+}
+
+// Runtime_goVersion holds the arguments for a server call to Runtime.goVersion.
+type Runtime_goVersion struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Runtime_goVersion_Params
+	Results Runtime_goVersion_Results
+}
+
+// Runtime_numCPU holds the arguments for a server call to Runtime.numCPU.
+type Runtime_numCPU struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Runtime_numCPU_Params
+	Results Runtime_numCPU_Results
+}
+
+// Runtime_numGoroutine holds the arguments for a server call to Runtime.numGoroutine.
+type Runtime_numGoroutine struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Runtime_numGoroutine_Params
+	Results Runtime_numGoroutine_Results
+}
+
+// Runtime_memStats holds the arguments for a server call to Runtime.memStats.
+type Runtime_memStats struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Runtime_memStats_Params
+	Results Runtime_memStats_Results
+}
+
+// Runtime_stackDump holds the arguments for a server call to Runtime.stackDump.
+type Runtime_stackDump struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Runtime_stackDump_Params
+	Results Runtime_stackDump_Results
+}
+
+type Runtime_goVersion_Params struct{ capnp.Struct }
+
+// Runtime_goVersion_Params_TypeID is the unique identifier for the type Runtime_goVersion_Params.
+const Runtime_goVersion_Params_TypeID = 0xed1583a692140448
+
+func NewRuntime_goVersion_Params(s *capnp.Segment) (Runtime_goVersion_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_goVersion_Params{st}, err
+}
+
+func NewRootRuntime_goVersion_Params(s *capnp.Segment) (Runtime_goVersion_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_goVersion_Params{st}, err
+}
+
+func ReadRootRuntime_goVersion_Params(msg *capnp.Message) (Runtime_goVersion_Params, error) {
+	root, err := msg.RootPtr()
+	return Runtime_goVersion_Params{root.Struct()}, err
+}
+
+func (s Runtime_goVersion_Params) String() string {
+	str, _ := text.Marshal(0xed1583a692140448, s.Struct)
+	return str
+}
+
+// Runtime_goVersion_Params_List is a list of Runtime_goVersion_Params.
+type Runtime_goVersion_Params_List struct{ capnp.List }
+
+// NewRuntime_goVersion_Params creates a new list of Runtime_goVersion_Params.
+func NewRuntime_goVersion_Params_List(s *capnp.Segment, sz int32) (Runtime_goVersion_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Runtime_goVersion_Params_List{l}, err
+}
+
+func (s Runtime_goVersion_Params_List) At(i int) Runtime_goVersion_Params {
+	return Runtime_goVersion_Params{s.List.Struct(i)}
+}
+
+func (s Runtime_goVersion_Params_List) Set(i int, v Runtime_goVersion_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_goVersion_Params_List) String() string {
+	str, _ := text.MarshalList(0xed1583a692140448, s.List)
+	return str
+}
+
+// Runtime_goVersion_Params_Promise is a wrapper for a Runtime_goVersion_Params promised by a client call.
+type Runtime_goVersion_Params_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_goVersion_Params_Promise) Struct() (Runtime_goVersion_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_goVersion_Params{s}, err
+}
+
+type Runtime_goVersion_Results struct{ capnp.Struct }
+
+// Runtime_goVersion_Results_TypeID is the unique identifier for the type Runtime_goVersion_Results.
+const Runtime_goVersion_Results_TypeID = 0xe5a432109337fc5d
+
+func NewRuntime_goVersion_Results(s *capnp.Segment) (Runtime_goVersion_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_goVersion_Results{st}, err
+}
+
+func NewRootRuntime_goVersion_Results(s *capnp.Segment) (Runtime_goVersion_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_goVersion_Results{st}, err
+}
+
+func ReadRootRuntime_goVersion_Results(msg *capnp.Message) (Runtime_goVersion_Results, error) {
+	root, err := msg.RootPtr()
+	return Runtime_goVersion_Results{root.Struct()}, err
+}
+
+func (s Runtime_goVersion_Results) String() string {
+	str, _ := text.Marshal(0xe5a432109337fc5d, s.Struct)
+	return str
+}
+
+func (s Runtime_goVersion_Results) Version() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Runtime_goVersion_Results) HasVersion() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Runtime_goVersion_Results) VersionBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Runtime_goVersion_Results) SetVersion(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Runtime_goVersion_Results_List is a list of Runtime_goVersion_Results.
+type Runtime_goVersion_Results_List struct{ capnp.List }
+
+// NewRuntime_goVersion_Results creates a new list of Runtime_goVersion_Results.
+func NewRuntime_goVersion_Results_List(s *capnp.Segment, sz int32) (Runtime_goVersion_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Runtime_goVersion_Results_List{l}, err
+}
+
+func (s Runtime_goVersion_Results_List) At(i int) Runtime_goVersion_Results {
+	return Runtime_goVersion_Results{s.List.Struct(i)}
+}
+
+func (s Runtime_goVersion_Results_List) Set(i int, v Runtime_goVersion_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_goVersion_Results_List) String() string {
+	str, _ := text.MarshalList(0xe5a432109337fc5d, s.List)
+	return str
+}
+
+// Runtime_goVersion_Results_Promise is a wrapper for a Runtime_goVersion_Results promised by a client call.
+type Runtime_goVersion_Results_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_goVersion_Results_Promise) Struct() (Runtime_goVersion_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_goVersion_Results{s}, err
+}
+
+type Runtime_numCPU_Params struct{ capnp.Struct }
+
+// Runtime_numCPU_Params_TypeID is the unique identifier for the type Runtime_numCPU_Params.
+const Runtime_numCPU_Params_TypeID = 0xad64659a5d76e80b
+
+func NewRuntime_numCPU_Params(s *capnp.Segment) (Runtime_numCPU_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_numCPU_Params{st}, err
+}
+
+func NewRootRuntime_numCPU_Params(s *capnp.Segment) (Runtime_numCPU_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_numCPU_Params{st}, err
+}
+
+func ReadRootRuntime_numCPU_Params(msg *capnp.Message) (Runtime_numCPU_Params, error) {
+	root, err := msg.RootPtr()
+	return Runtime_numCPU_Params{root.Struct()}, err
+}
+
+func (s Runtime_numCPU_Params) String() string {
+	str, _ := text.Marshal(0xad64659a5d76e80b, s.Struct)
+	return str
+}
+
+// Runtime_numCPU_Params_List is a list of Runtime_numCPU_Params.
+type Runtime_numCPU_Params_List struct{ capnp.List }
+
+// NewRuntime_numCPU_Params creates a new list of Runtime_numCPU_Params.
+func NewRuntime_numCPU_Params_List(s *capnp.Segment, sz int32) (Runtime_numCPU_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Runtime_numCPU_Params_List{l}, err
+}
+
+func (s Runtime_numCPU_Params_List) At(i int) Runtime_numCPU_Params {
+	return Runtime_numCPU_Params{s.List.Struct(i)}
+}
+
+func (s Runtime_numCPU_Params_List) Set(i int, v Runtime_numCPU_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_numCPU_Params_List) String() string {
+	str, _ := text.MarshalList(0xad64659a5d76e80b, s.List)
+	return str
+}
+
+// Runtime_numCPU_Params_Promise is a wrapper for a Runtime_numCPU_Params promised by a client call.
+type Runtime_numCPU_Params_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_numCPU_Params_Promise) Struct() (Runtime_numCPU_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_numCPU_Params{s}, err
+}
+
+type Runtime_numCPU_Results struct{ capnp.Struct }
+
+// Runtime_numCPU_Results_TypeID is the unique identifier for the type Runtime_numCPU_Results.
+const Runtime_numCPU_Results_TypeID = 0xc3806a9410e187be
+
+func NewRuntime_numCPU_Results(s *capnp.Segment) (Runtime_numCPU_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Runtime_numCPU_Results{st}, err
+}
+
+func NewRootRuntime_numCPU_Results(s *capnp.Segment) (Runtime_numCPU_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Runtime_numCPU_Results{st}, err
+}
+
+func ReadRootRuntime_numCPU_Results(msg *capnp.Message) (Runtime_numCPU_Results, error) {
+	root, err := msg.RootPtr()
+	return Runtime_numCPU_Results{root.Struct()}, err
+}
+
+func (s Runtime_numCPU_Results) String() string {
+	str, _ := text.Marshal(0xc3806a9410e187be, s.Struct)
+	return str
+}
+
+func (s Runtime_numCPU_Results) Count() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Runtime_numCPU_Results) SetCount(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// Runtime_numCPU_Results_List is a list of Runtime_numCPU_Results.
+type Runtime_numCPU_Results_List struct{ capnp.List }
+
+// NewRuntime_numCPU_Results creates a new list of Runtime_numCPU_Results.
+func NewRuntime_numCPU_Results_List(s *capnp.Segment, sz int32) (Runtime_numCPU_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Runtime_numCPU_Results_List{l}, err
+}
+
+func (s Runtime_numCPU_Results_List) At(i int) Runtime_numCPU_Results {
+	return Runtime_numCPU_Results{s.List.Struct(i)}
+}
+
+func (s Runtime_numCPU_Results_List) Set(i int, v Runtime_numCPU_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_numCPU_Results_List) String() string {
+	str, _ := text.MarshalList(0xc3806a9410e187be, s.List)
+	return str
+}
+
+// Runtime_numCPU_Results_Promise is a wrapper for a Runtime_numCPU_Results promised by a client call.
+type Runtime_numCPU_Results_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_numCPU_Results_Promise) Struct() (Runtime_numCPU_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_numCPU_Results{s}, err
+}
+
+type Runtime_numGoroutine_Params struct{ capnp.Struct }
+
+// Runtime_numGoroutine_Params_TypeID is the unique identifier for the type Runtime_numGoroutine_Params.
+const Runtime_numGoroutine_Params_TypeID = 0xfa6ca90efc9ff291
+
+func NewRuntime_numGoroutine_Params(s *capnp.Segment) (Runtime_numGoroutine_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_numGoroutine_Params{st}, err
+}
+
+func NewRootRuntime_numGoroutine_Params(s *capnp.Segment) (Runtime_numGoroutine_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_numGoroutine_Params{st}, err
+}
+
+func ReadRootRuntime_numGoroutine_Params(msg *capnp.Message) (Runtime_numGoroutine_Params, error) {
+	root, err := msg.RootPtr()
+	return Runtime_numGoroutine_Params{root.Struct()}, err
+}
+
+func (s Runtime_numGoroutine_Params) String() string {
+	str, _ := text.Marshal(0xfa6ca90efc9ff291, s.Struct)
+	return str
+}
+
+// Runtime_numGoroutine_Params_List is a list of Runtime_numGoroutine_Params.
+type Runtime_numGoroutine_Params_List struct{ capnp.List }
+
+// NewRuntime_numGoroutine_Params creates a new list of Runtime_numGoroutine_Params.
+func NewRuntime_numGoroutine_Params_List(s *capnp.Segment, sz int32) (Runtime_numGoroutine_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Runtime_numGoroutine_Params_List{l}, err
+}
+
+func (s Runtime_numGoroutine_Params_List) At(i int) Runtime_numGoroutine_Params {
+	return Runtime_numGoroutine_Params{s.List.Struct(i)}
+}
+
+func (s Runtime_numGoroutine_Params_List) Set(i int, v Runtime_numGoroutine_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_numGoroutine_Params_List) String() string {
+	str, _ := text.MarshalList(0xfa6ca90efc9ff291, s.List)
+	return str
+}
+
+// Runtime_numGoroutine_Params_Promise is a wrapper for a Runtime_numGoroutine_Params promised by a client call.
+type Runtime_numGoroutine_Params_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_numGoroutine_Params_Promise) Struct() (Runtime_numGoroutine_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_numGoroutine_Params{s}, err
+}
+
+type Runtime_numGoroutine_Results struct{ capnp.Struct }
+
+// Runtime_numGoroutine_Results_TypeID is the unique identifier for the type Runtime_numGoroutine_Results.
+const Runtime_numGoroutine_Results_TypeID = 0xfcf6d1267c1553d3
+
+func NewRuntime_numGoroutine_Results(s *capnp.Segment) (Runtime_numGoroutine_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Runtime_numGoroutine_Results{st}, err
+}
+
+func NewRootRuntime_numGoroutine_Results(s *capnp.Segment) (Runtime_numGoroutine_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Runtime_numGoroutine_Results{st}, err
+}
+
+func ReadRootRuntime_numGoroutine_Results(msg *capnp.Message) (Runtime_numGoroutine_Results, error) {
+	root, err := msg.RootPtr()
+	return Runtime_numGoroutine_Results{root.Struct()}, err
+}
+
+func (s Runtime_numGoroutine_Results) String() string {
+	str, _ := text.Marshal(0xfcf6d1267c1553d3, s.Struct)
+	return str
+}
+
+func (s Runtime_numGoroutine_Results) Count() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Runtime_numGoroutine_Results) SetCount(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// Runtime_numGoroutine_Results_List is a list of Runtime_numGoroutine_Results.
+type Runtime_numGoroutine_Results_List struct{ capnp.List }
+
+// NewRuntime_numGoroutine_Results creates a new list of Runtime_numGoroutine_Results.
+func NewRuntime_numGoroutine_Results_List(s *capnp.Segment, sz int32) (Runtime_numGoroutine_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Runtime_numGoroutine_Results_List{l}, err
+}
+
+func (s Runtime_numGoroutine_Results_List) At(i int) Runtime_numGoroutine_Results {
+	return Runtime_numGoroutine_Results{s.List.Struct(i)}
+}
+
+func (s Runtime_numGoroutine_Results_List) Set(i int, v Runtime_numGoroutine_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_numGoroutine_Results_List) String() string {
+	str, _ := text.MarshalList(0xfcf6d1267c1553d3, s.List)
+	return str
+}
+
+// Runtime_numGoroutine_Results_Promise is a wrapper for a Runtime_numGoroutine_Results promised by a client call.
+type Runtime_numGoroutine_Results_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_numGoroutine_Results_Promise) Struct() (Runtime_numGoroutine_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_numGoroutine_Results{s}, err
+}
+
+type Runtime_memStats_Params struct{ capnp.Struct }
+
+// Runtime_memStats_Params_TypeID is the unique identifier for the type Runtime_memStats_Params.
+const Runtime_memStats_Params_TypeID = 0xfa7d2ded965e55e3
+
+func NewRuntime_memStats_Params(s *capnp.Segment) (Runtime_memStats_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_memStats_Params{st}, err
+}
+
+func NewRootRuntime_memStats_Params(s *capnp.Segment) (Runtime_memStats_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_memStats_Params{st}, err
+}
+
+func ReadRootRuntime_memStats_Params(msg *capnp.Message) (Runtime_memStats_Params, error) {
+	root, err := msg.RootPtr()
+	return Runtime_memStats_Params{root.Struct()}, err
+}
+
+func (s Runtime_memStats_Params) String() string {
+	str, _ := text.Marshal(0xfa7d2ded965e55e3, s.Struct)
+	return str
+}
+
+// Runtime_memStats_Params_List is a list of Runtime_memStats_Params.
+type Runtime_memStats_Params_List struct{ capnp.List }
+
+// NewRuntime_memStats_Params creates a new list of Runtime_memStats_Params.
+func NewRuntime_memStats_Params_List(s *capnp.Segment, sz int32) (Runtime_memStats_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Runtime_memStats_Params_List{l}, err
+}
+
+func (s Runtime_memStats_Params_List) At(i int) Runtime_memStats_Params {
+	return Runtime_memStats_Params{s.List.Struct(i)}
+}
+
+func (s Runtime_memStats_Params_List) Set(i int, v Runtime_memStats_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_memStats_Params_List) String() string {
+	str, _ := text.MarshalList(0xfa7d2ded965e55e3, s.List)
+	return str
+}
+
+// Runtime_memStats_Params_Promise is a wrapper for a Runtime_memStats_Params promised by a client call.
+type Runtime_memStats_Params_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_memStats_Params_Promise) Struct() (Runtime_memStats_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_memStats_Params{s}, err
+}
+
+type Runtime_memStats_Results struct{ capnp.Struct }
+
+// Runtime_memStats_Results_TypeID is the unique identifier for the type Runtime_memStats_Results.
+const Runtime_memStats_Results_TypeID = 0xc8c60b05d115f411
+
+func NewRuntime_memStats_Results(s *capnp.Segment) (Runtime_memStats_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_memStats_Results{st}, err
+}
+
+func NewRootRuntime_memStats_Results(s *capnp.Segment) (Runtime_memStats_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_memStats_Results{st}, err
+}
+
+func ReadRootRuntime_memStats_Results(msg *capnp.Message) (Runtime_memStats_Results, error) {
+	root, err := msg.RootPtr()
+	return Runtime_memStats_Results{root.Struct()}, err
+}
+
+func (s Runtime_memStats_Results) String() string {
+	str, _ := text.Marshal(0xc8c60b05d115f411, s.Struct)
+	return str
+}
+
+func (s Runtime_memStats_Results) Stats() (MemStats, error) {
+	p, err := s.Struct.Ptr(0)
+	return MemStats{Struct: p.Struct()}, err
+}
+
+func (s Runtime_memStats_Results) HasStats() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Runtime_memStats_Results) SetStats(v MemStats) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewStats sets the stats field to a newly
+// allocated MemStats struct, preferring placement in s's segment.
+func (s Runtime_memStats_Results) NewStats() (MemStats, error) {
+	ss, err := NewMemStats(s.Struct.Segment())
+	if err != nil {
+		return MemStats{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+// Runtime_memStats_Results_List is a list of Runtime_memStats_Results.
+type Runtime_memStats_Results_List struct{ capnp.List }
+
+// NewRuntime_memStats_Results creates a new list of Runtime_memStats_Results.
+func NewRuntime_memStats_Results_List(s *capnp.Segment, sz int32) (Runtime_memStats_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Runtime_memStats_Results_List{l}, err
+}
+
+func (s Runtime_memStats_Results_List) At(i int) Runtime_memStats_Results {
+	return Runtime_memStats_Results{s.List.Struct(i)}
+}
+
+func (s Runtime_memStats_Results_List) Set(i int, v Runtime_memStats_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_memStats_Results_List) String() string {
+	str, _ := text.MarshalList(0xc8c60b05d115f411, s.List)
+	return str
+}
+
+// Runtime_memStats_Results_Promise is a wrapper for a Runtime_memStats_Results promised by a client call.
+type Runtime_memStats_Results_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_memStats_Results_Promise) Struct() (Runtime_memStats_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_memStats_Results{s}, err
+}
+
+func (p Runtime_memStats_Results_Promise) Stats() MemStats_Promise {
+	return MemStats_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type Runtime_stackDump_Params struct{ capnp.Struct }
+
+// Runtime_stackDump_Params_TypeID is the unique identifier for the type Runtime_stackDump_Params.
+const Runtime_stackDump_Params_TypeID = 0xb9c996f05a75ae42
+
+func NewRuntime_stackDump_Params(s *capnp.Segment) (Runtime_stackDump_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_stackDump_Params{st}, err
+}
+
+func NewRootRuntime_stackDump_Params(s *capnp.Segment) (Runtime_stackDump_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Runtime_stackDump_Params{st}, err
+}
+
+func ReadRootRuntime_stackDump_Params(msg *capnp.Message) (Runtime_stackDump_Params, error) {
+	root, err := msg.RootPtr()
+	return Runtime_stackDump_Params{root.Struct()}, err
+}
+
+func (s Runtime_stackDump_Params) String() string {
+	str, _ := text.Marshal(0xb9c996f05a75ae42, s.Struct)
+	return str
+}
+
+// Runtime_stackDump_Params_List is a list of Runtime_stackDump_Params.
+type Runtime_stackDump_Params_List struct{ capnp.List }
+
+// NewRuntime_stackDump_Params creates a new list of Runtime_stackDump_Params.
+func NewRuntime_stackDump_Params_List(s *capnp.Segment, sz int32) (Runtime_stackDump_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Runtime_stackDump_Params_List{l}, err
+}
+
+func (s Runtime_stackDump_Params_List) At(i int) Runtime_stackDump_Params {
+	return Runtime_stackDump_Params{s.List.Struct(i)}
+}
+
+func (s Runtime_stackDump_Params_List) Set(i int, v Runtime_stackDump_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_stackDump_Params_List) String() string {
+	str, _ := text.MarshalList(0xb9c996f05a75ae42, s.List)
+	return str
+}
+
+// Runtime_stackDump_Params_Promise is a wrapper for a Runtime_stackDump_Params promised by a client call.
+type Runtime_stackDump_Params_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_stackDump_Params_Promise) Struct() (Runtime_stackDump_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_stackDump_Params{s}, err
+}
+
+type Runtime_stackDump_Results struct{ capnp.Struct }
+
+// Runtime_stackDump_Results_TypeID is the unique identifier for the type Runtime_stackDump_Results.
+const Runtime_stackDump_Results_TypeID = 0xa8fc15721302db2a
+
+func NewRuntime_stackDump_Results(s *capnp.Segment) (Runtime_stackDump_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_stackDump_Results{st}, err
+}
+
+func NewRootRuntime_stackDump_Results(s *capnp.Segment) (Runtime_stackDump_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Runtime_stackDump_Results{st}, err
+}
+
+func ReadRootRuntime_stackDump_Results(msg *capnp.Message) (Runtime_stackDump_Results, error) {
+	root, err := msg.RootPtr()
+	return Runtime_stackDump_Results{root.Struct()}, err
+}
+
+func (s Runtime_stackDump_Results) String() string {
+	str, _ := text.Marshal(0xa8fc15721302db2a, s.Struct)
+	return str
+}
+
+func (s Runtime_stackDump_Results) StackDump() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return []byte(p.Data()), err
+}
+
+func (s Runtime_stackDump_Results) HasStackDump() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Runtime_stackDump_Results) SetStackDump(v []byte) error {
+	return s.Struct.SetData(0, v)
+}
+
+// Runtime_stackDump_Results_List is a list of Runtime_stackDump_Results.
+type Runtime_stackDump_Results_List struct{ capnp.List }
+
+// NewRuntime_stackDump_Results creates a new list of Runtime_stackDump_Results.
+func NewRuntime_stackDump_Results_List(s *capnp.Segment, sz int32) (Runtime_stackDump_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Runtime_stackDump_Results_List{l}, err
+}
+
+func (s Runtime_stackDump_Results_List) At(i int) Runtime_stackDump_Results {
+	return Runtime_stackDump_Results{s.List.Struct(i)}
+}
+
+func (s Runtime_stackDump_Results_List) Set(i int, v Runtime_stackDump_Results) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Runtime_stackDump_Results_List) String() string {
+	str, _ := text.MarshalList(0xa8fc15721302db2a, s.List)
+	return str
+}
+
+// Runtime_stackDump_Results_Promise is a wrapper for a Runtime_stackDump_Results promised by a client call.
+type Runtime_stackDump_Results_Promise struct{ *capnp.Pipeline }
+
+func (p Runtime_stackDump_Results_Promise) Struct() (Runtime_stackDump_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Runtime_stackDump_Results{s}, err
+}
+
+type MemStats struct{ capnp.Struct }
+
+// MemStats_TypeID is the unique identifier for the type MemStats.
+const MemStats_TypeID = 0xcdf011e3e3860026
+
+func NewMemStats(s *capnp.Segment) (MemStats, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3})
+	return MemStats{st}, err
+}
+
+func NewRootMemStats(s *capnp.Segment) (MemStats, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3})
+	return MemStats{st}, err
+}
+
+func ReadRootMemStats(msg *capnp.Message) (MemStats, error) {
+	root, err := msg.RootPtr()
+	return MemStats{root.Struct()}, err
+}
+
+func (s MemStats) String() string {
+	str, _ := text.Marshal(0xcdf011e3e3860026, s.Struct)
+	return str
+}
+
+func (s MemStats) Alloc() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s MemStats) SetAlloc(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s MemStats) TotalAlloc() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s MemStats) SetTotalAlloc(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s MemStats) Sys() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s MemStats) SetSys(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+func (s MemStats) Lookups() uint64 {
+	return s.Struct.Uint64(24)
+}
+
+func (s MemStats) SetLookups(v uint64) {
+	s.Struct.SetUint64(24, v)
+}
+
+func (s MemStats) Mallocs() uint64 {
+	return s.Struct.Uint64(32)
+}
+
+func (s MemStats) SetMallocs(v uint64) {
+	s.Struct.SetUint64(32, v)
+}
+
+func (s MemStats) Frees() uint64 {
+	return s.Struct.Uint64(40)
+}
+
+func (s MemStats) SetFrees(v uint64) {
+	s.Struct.SetUint64(40, v)
+}
+
+func (s MemStats) HeapAlloc() uint64 {
+	return s.Struct.Uint64(48)
+}
+
+func (s MemStats) SetHeapAlloc(v uint64) {
+	s.Struct.SetUint64(48, v)
+}
+
+func (s MemStats) HeapSys() uint64 {
+	return s.Struct.Uint64(56)
+}
+
+func (s MemStats) SetHeapSys(v uint64) {
+	s.Struct.SetUint64(56, v)
 }
 
-func Runtime_Methods(methods []server.Method, s Runtime_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 5)
-	}
+func (s MemStats) HeapIdle() uint64 {
+	return s.Struct.Uint64(64)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "goVersion",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Runtime_goVersion{c, opts, Runtime_goVersion_Params{Struct: p}, Runtime_goVersion_Results{Struct: r}}
-			return s.GoVersion(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s MemStats) SetHeapIdle(v uint64) {
+	s.Struct.SetUint64(64, v)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "numCPU",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Runtime_numCPU{c, opts, Runtime_numCPU_Params{Struct: p}, Runtime_numCPU_Results{Struct: r}}
-			return s.NumCPU(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+func (s MemStats) HeapInUse() uint64 {
+	return s.Struct.Uint64(72)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "numGoroutine",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Runtime_numGoroutine{c, opts, Runtime_numGoroutine_Params{Struct: p}, Runtime_numGoroutine_Results{Struct: r}}
-			return s.NumGoroutine(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+func (s MemStats) SetHeapInUse(v uint64) {
+	s.Struct.SetUint64(72, v)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      3,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "memStats",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Runtime_memStats{c, opts, Runtime_memStats_Params{Struct: p}, Runtime_memStats_Results{Struct: r}}
-			return s.MemStats(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s MemStats) HeapReleased() uint64 {
+	return s.Struct.Uint64(80)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xdda2e02140fe8f08,
-			MethodID:      4,
-			InterfaceName: "app.capnp:Runtime",
-			MethodName:    "stackDump",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Runtime_stackDump{c, opts, Runtime_stackDump_Params{Struct: p}, Runtime_stackDump_Results{Struct: r}}
-			return s.StackDump(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s MemStats) SetHeapReleased(v uint64) {
+	s.Struct.SetUint64(80, v)
+}
 
-	return methods
+func (s MemStats) HeapObjects() uint64 {
+	return s.Struct.Uint64(88)
 }
 
-// Runtime_goVersion holds the arguments for a server call to Runtime.goVersion.
-type Runtime_goVersion struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Runtime_goVersion_Params
-	Results Runtime_goVersion_Results
+func (s MemStats) SetHeapObjects(v uint64) {
+	s.Struct.SetUint64(88, v)
 }
 
-// Runtime_numCPU holds the arguments for a server call to Runtime.numCPU.
-type Runtime_numCPU struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Runtime_numCPU_Params
-	Results Runtime_numCPU_Results
+func (s MemStats) StackInUse() uint64 {
+	return s.Struct.Uint64(96)
 }
 
-// Runtime_numGoroutine holds the arguments for a server call to Runtime.numGoroutine.
-type Runtime_numGoroutine struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Runtime_numGoroutine_Params
-	Results Runtime_numGoroutine_Results
+func (s MemStats) SetStackInUse(v uint64) {
+	s.Struct.SetUint64(96, v)
 }
 
-// Runtime_memStats holds the arguments for a server call to Runtime.memStats.
-type Runtime_memStats struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Runtime_memStats_Params
-	Results Runtime_memStats_Results
+func (s MemStats) StackSys() uint64 {
+	return s.Struct.Uint64(104)
 }
 
-// Runtime_stackDump holds the arguments for a server call to Runtime.stackDump.
-type Runtime_stackDump struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Runtime_stackDump_Params
-	Results Runtime_stackDump_Results
+func (s MemStats) SetStackSys(v uint64) {
+	s.Struct.SetUint64(104, v)
 }
 
-type Runtime_goVersion_Params struct{ capnp.Struct }
+func (s MemStats) MSpanInUse() uint64 {
+	return s.Struct.Uint64(112)
+}
 
-// Runtime_goVersion_Params_TypeID is the unique identifier for the type Runtime_goVersion_Params.
-const Runtime_goVersion_Params_TypeID = 0xed1583a692140448
+func (s MemStats) SetMSpanInUse(v uint64) {
+	s.Struct.SetUint64(112, v)
+}
 
-func NewRuntime_goVersion_Params(s *capnp.Segment) (Runtime_goVersion_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_goVersion_Params{st}, err
+func (s MemStats) MSpanSys() uint64 {
+	return s.Struct.Uint64(120)
 }
 
-func NewRootRuntime_goVersion_Params(s *capnp.Segment) (Runtime_goVersion_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_goVersion_Params{st}, err
+func (s MemStats) SetMSpanSys(v uint64) {
+	s.Struct.SetUint64(120, v)
 }
 
-func ReadRootRuntime_goVersion_Params(msg *capnp.Message) (Runtime_goVersion_Params, error) {
-	root, err := msg.RootPtr()
-	return Runtime_goVersion_Params{root.Struct()}, err
+func (s MemStats) MCacheInUse() uint64 {
+	return s.Struct.Uint64(128)
 }
 
-func (s Runtime_goVersion_Params) String() string {
-	str, _ := text.Marshal(0xed1583a692140448, s.Struct)
-	return str
+func (s MemStats) SetMCacheInUse(v uint64) {
+	s.Struct.SetUint64(128, v)
 }
 
-// Runtime_goVersion_Params_List is a list of Runtime_goVersion_Params.
-type Runtime_goVersion_Params_List struct{ capnp.List }
+func (s MemStats) MCacheSys() uint64 {
+	return s.Struct.Uint64(136)
+}
 
-// NewRuntime_goVersion_Params creates a new list of Runtime_goVersion_Params.
-func NewRuntime_goVersion_Params_List(s *capnp.Segment, sz int32) (Runtime_goVersion_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Runtime_goVersion_Params_List{l}, err
+func (s MemStats) SetMCacheSys(v uint64) {
+	s.Struct.SetUint64(136, v)
 }
 
-func (s Runtime_goVersion_Params_List) At(i int) Runtime_goVersion_Params {
-	return Runtime_goVersion_Params{s.List.Struct(i)}
+func (s MemStats) BuckHashSys() uint64 {
+	return s.Struct.Uint64(144)
 }
 
-func (s Runtime_goVersion_Params_List) Set(i int, v Runtime_goVersion_Params) error {
-	return s.List.SetStruct(i, v.Struct)
+func (s MemStats) SetBuckHashSys(v uint64) {
+	s.Struct.SetUint64(144, v)
 }
 
-func (s Runtime_goVersion_Params_List) String() string {
-	str, _ := text.MarshalList(0xed1583a692140448, s.List)
-	return str
+func (s MemStats) GCSys() uint64 {
+	return s.Struct.Uint64(152)
 }
 
-// Runtime_goVersion_Params_Promise is a wrapper for a Runtime_goVersion_Params promised by a client call.
-type Runtime_goVersion_Params_Promise struct{ *capnp.Pipeline }
+func (s MemStats) SetGCSys(v uint64) {
+	s.Struct.SetUint64(152, v)
+}
 
-func (p Runtime_goVersion_Params_Promise) Struct() (Runtime_goVersion_Params, error) {
-	s, err := p.Pipeline.Struct()
-	return Runtime_goVersion_Params{s}, err
+func (s MemStats) OtherSys() uint64 {
+	return s.Struct.Uint64(160)
 }
 
-type Runtime_goVersion_Results struct{ capnp.Struct }
+func (s MemStats) SetOtherSys(v uint64) {
+	s.Struct.SetUint64(160, v)
+}
 
-// Runtime_goVersion_Results_TypeID is the unique identifier for the type Runtime_goVersion_Results.
-const Runtime_goVersion_Results_TypeID = 0xe5a432109337fc5d
+func (s MemStats) NextGC() uint64 {
+	return s.Struct.Uint64(168)
+}
 
-func NewRuntime_goVersion_Results(s *capnp.Segment) (Runtime_goVersion_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_goVersion_Results{st}, err
+func (s MemStats) SetNextGC(v uint64) {
+	s.Struct.SetUint64(168, v)
 }
 
-func NewRootRuntime_goVersion_Results(s *capnp.Segment) (Runtime_goVersion_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_goVersion_Results{st}, err
+func (s MemStats) LastGC() uint64 {
+	return s.Struct.Uint64(176)
 }
 
-func ReadRootRuntime_goVersion_Results(msg *capnp.Message) (Runtime_goVersion_Results, error) {
-	root, err := msg.RootPtr()
-	return Runtime_goVersion_Results{root.Struct()}, err
+func (s MemStats) SetLastGC(v uint64) {
+	s.Struct.SetUint64(176, v)
 }
 
-func (s Runtime_goVersion_Results) String() string {
-	str, _ := text.Marshal(0xe5a432109337fc5d, s.Struct)
-	return str
+func (s MemStats) PauseTotalNs() uint64 {
+	return s.Struct.Uint64(184)
 }
 
-func (s Runtime_goVersion_Results) Version() (string, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+func (s MemStats) SetPauseTotalNs(v uint64) {
+	s.Struct.SetUint64(184, v)
 }
 
-func (s Runtime_goVersion_Results) HasVersion() bool {
+func (s MemStats) PauseNs() (capnp.UInt64List, error) {
 	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+	return capnp.UInt64List{List: p.List()}, err
 }
 
-func (s Runtime_goVersion_Results) VersionBytes() ([]byte, error) {
+func (s MemStats) HasPauseNs() bool {
 	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+	return p.IsValid() || err != nil
 }
 
-func (s Runtime_goVersion_Results) SetVersion(v string) error {
-	return s.Struct.SetText(0, v)
+func (s MemStats) SetPauseNs(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
 }
 
-// Runtime_goVersion_Results_List is a list of Runtime_goVersion_Results.
-type Runtime_goVersion_Results_List struct{ capnp.List }
-
-// NewRuntime_goVersion_Results creates a new list of Runtime_goVersion_Results.
-func NewRuntime_goVersion_Results_List(s *capnp.Segment, sz int32) (Runtime_goVersion_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return Runtime_goVersion_Results_List{l}, err
+// NewPauseNs sets the pauseNs field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s MemStats) NewPauseNs(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
 }
 
-func (s Runtime_goVersion_Results_List) At(i int) Runtime_goVersion_Results {
-	return Runtime_goVersion_Results{s.List.Struct(i)}
+func (s MemStats) PauseEnd() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.UInt64List{List: p.List()}, err
 }
 
-func (s Runtime_goVersion_Results_List) Set(i int, v Runtime_goVersion_Results) error {
-	return s.List.SetStruct(i, v.Struct)
+func (s MemStats) HasPauseEnd() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
 }
 
-func (s Runtime_goVersion_Results_List) String() string {
-	str, _ := text.MarshalList(0xe5a432109337fc5d, s.List)
-	return str
+func (s MemStats) SetPauseEnd(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
 }
 
-// Runtime_goVersion_Results_Promise is a wrapper for a Runtime_goVersion_Results promised by a client call.
-type Runtime_goVersion_Results_Promise struct{ *capnp.Pipeline }
+// NewPauseEnd sets the pauseEnd field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s MemStats) NewPauseEnd(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
 
-func (p Runtime_goVersion_Results_Promise) Struct() (Runtime_goVersion_Results, error) {
-	s, err := p.Pipeline.Struct()
-	return Runtime_goVersion_Results{s}, err
+func (s MemStats) NumGC() uint32 {
+	return s.Struct.Uint32(192)
 }
 
-type Runtime_numCPU_Params struct{ capnp.Struct }
+func (s MemStats) SetNumGC(v uint32) {
+	s.Struct.SetUint32(192, v)
+}
 
-// Runtime_numCPU_Params_TypeID is the unique identifier for the type Runtime_numCPU_Params.
-const Runtime_numCPU_Params_TypeID = 0xad64659a5d76e80b
+func (s MemStats) NumForcedGC() uint32 {
+	return s.Struct.Uint32(196)
+}
 
-func NewRuntime_numCPU_Params(s *capnp.Segment) (Runtime_numCPU_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_numCPU_Params{st}, err
+func (s MemStats) SetNumForcedGC(v uint32) {
+	s.Struct.SetUint32(196, v)
 }
 
-func NewRootRuntime_numCPU_Params(s *capnp.Segment) (Runtime_numCPU_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_numCPU_Params{st}, err
+func (s MemStats) GCCPUFraction() float64 {
+	return math.Float64frombits(s.Struct.Uint64(200))
 }
 
-func ReadRootRuntime_numCPU_Params(msg *capnp.Message) (Runtime_numCPU_Params, error) {
-	root, err := msg.RootPtr()
-	return Runtime_numCPU_Params{root.Struct()}, err
+func (s MemStats) SetGCCPUFraction(v float64) {
+	s.Struct.SetUint64(200, math.Float64bits(v))
 }
 
-func (s Runtime_numCPU_Params) String() string {
-	str, _ := text.Marshal(0xad64659a5d76e80b, s.Struct)
-	return str
+func (s MemStats) BySize() (MemStats_BySize_List, error) {
+	p, err := s.Struct.Ptr(2)
+	return MemStats_BySize_List{List: p.List()}, err
 }
 
-// Runtime_numCPU_Params_List is a list of Runtime_numCPU_Params.
-type Runtime_numCPU_Params_List struct{ capnp.List }
+func (s MemStats) HasBySize() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
 
-// NewRuntime_numCPU_Params creates a new list of Runtime_numCPU_Params.
-func NewRuntime_numCPU_Params_List(s *capnp.Segment, sz int32) (Runtime_numCPU_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Runtime_numCPU_Params_List{l}, err
+func (s MemStats) SetBySize(v MemStats_BySize_List) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
 }
 
-func (s Runtime_numCPU_Params_List) At(i int) Runtime_numCPU_Params {
-	return Runtime_numCPU_Params{s.List.Struct(i)}
+// NewBySize sets the bySize field to a newly
+// allocated MemStats_BySize_List, preferring placement in s's segment.
+func (s MemStats) NewBySize(n int32) (MemStats_BySize_List, error) {
+	l, err := NewMemStats_BySize_List(s.Struct.Segment(), n)
+	if err != nil {
+		return MemStats_BySize_List{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
 }
 
-func (s Runtime_numCPU_Params_List) Set(i int, v Runtime_numCPU_Params) error {
-	return s.List.SetStruct(i, v.Struct)
+// MemStats_List is a list of MemStats.
+type MemStats_List struct{ capnp.List }
+
+// NewMemStats creates a new list of MemStats.
+func NewMemStats_List(s *capnp.Segment, sz int32) (MemStats_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3}, sz)
+	return MemStats_List{l}, err
 }
 
-func (s Runtime_numCPU_Params_List) String() string {
-	str, _ := text.MarshalList(0xad64659a5d76e80b, s.List)
+func (s MemStats_List) At(i int) MemStats { return MemStats{s.List.Struct(i)} }
+
+func (s MemStats_List) Set(i int, v MemStats) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s MemStats_List) String() string {
+	str, _ := text.MarshalList(0xcdf011e3e3860026, s.List)
 	return str
 }
 
-// Runtime_numCPU_Params_Promise is a wrapper for a Runtime_numCPU_Params promised by a client call.
-type Runtime_numCPU_Params_Promise struct{ *capnp.Pipeline }
+// MemStats_Promise is a wrapper for a MemStats promised by a client call.
+type MemStats_Promise struct{ *capnp.Pipeline }
 
-func (p Runtime_numCPU_Params_Promise) Struct() (Runtime_numCPU_Params, error) {
+func (p MemStats_Promise) Struct() (MemStats, error) {
 	s, err := p.Pipeline.Struct()
-	return Runtime_numCPU_Params{s}, err
+	return MemStats{s}, err
 }
 
-type Runtime_numCPU_Results struct{ capnp.Struct }
+type MemStats_BySize struct{ capnp.Struct }
 
-// Runtime_numCPU_Results_TypeID is the unique identifier for the type Runtime_numCPU_Results.
-const Runtime_numCPU_Results_TypeID = 0xc3806a9410e187be
+// MemStats_BySize_TypeID is the unique identifier for the type MemStats_BySize.
+const MemStats_BySize_TypeID = 0xc3e472677f9be8ad
 
-func NewRuntime_numCPU_Results(s *capnp.Segment) (Runtime_numCPU_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Runtime_numCPU_Results{st}, err
+func NewMemStats_BySize(s *capnp.Segment) (MemStats_BySize, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return MemStats_BySize{st}, err
 }
 
-func NewRootRuntime_numCPU_Results(s *capnp.Segment) (Runtime_numCPU_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Runtime_numCPU_Results{st}, err
+func NewRootMemStats_BySize(s *capnp.Segment) (MemStats_BySize, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return MemStats_BySize{st}, err
 }
 
-func ReadRootRuntime_numCPU_Results(msg *capnp.Message) (Runtime_numCPU_Results, error) {
+func ReadRootMemStats_BySize(msg *capnp.Message) (MemStats_BySize, error) {
 	root, err := msg.RootPtr()
-	return Runtime_numCPU_Results{root.Struct()}, err
+	return MemStats_BySize{root.Struct()}, err
 }
 
-func (s Runtime_numCPU_Results) String() string {
-	str, _ := text.Marshal(0xc3806a9410e187be, s.Struct)
+func (s MemStats_BySize) String() string {
+	str, _ := text.Marshal(0xc3e472677f9be8ad, s.Struct)
 	return str
 }
 
-func (s Runtime_numCPU_Results) Count() uint32 {
+func (s MemStats_BySize) Size() uint32 {
 	return s.Struct.Uint32(0)
 }
 
-func (s Runtime_numCPU_Results) SetCount(v uint32) {
+func (s MemStats_BySize) SetSize(v uint32) {
 	s.Struct.SetUint32(0, v)
 }
 
-// Runtime_numCPU_Results_List is a list of Runtime_numCPU_Results.
-type Runtime_numCPU_Results_List struct{ capnp.List }
-
-// NewRuntime_numCPU_Results creates a new list of Runtime_numCPU_Results.
-func NewRuntime_numCPU_Results_List(s *capnp.Segment, sz int32) (Runtime_numCPU_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Runtime_numCPU_Results_List{l}, err
-}
-
-func (s Runtime_numCPU_Results_List) At(i int) Runtime_numCPU_Results {
-	return Runtime_numCPU_Results{s.List.Struct(i)}
+func (s MemStats_BySize) Mallocs() uint64 {
+	return s.Struct.Uint64(8)
 }
 
-func (s Runtime_numCPU_Results_List) Set(i int, v Runtime_numCPU_Results) error {
-	return s.List.SetStruct(i, v.Struct)
+func (s MemStats_BySize) SetMallocs(v uint64) {
+	s.Struct.SetUint64(8, v)
 }
 
-func (s Runtime_numCPU_Results_List) String() string {
-	str, _ := text.MarshalList(0xc3806a9410e187be, s.List)
-	return str
+func (s MemStats_BySize) Frees() uint64 {
+	return s.Struct.Uint64(16)
 }
 
-// Runtime_numCPU_Results_Promise is a wrapper for a Runtime_numCPU_Results promised by a client call.
-type Runtime_numCPU_Results_Promise struct{ *capnp.Pipeline }
-
-func (p Runtime_numCPU_Results_Promise) Struct() (Runtime_numCPU_Results, error) {
-	s, err := p.Pipeline.Struct()
-	return Runtime_numCPU_Results{s}, err
+func (s MemStats_BySize) SetFrees(v uint64) {
+	s.Struct.SetUint64(16, v)
 }
 
-type Runtime_numGoroutine_Params struct{ capnp.Struct }
-
-// Runtime_numGoroutine_Params_TypeID is the unique identifier for the type Runtime_numGoroutine_Params.
-const Runtime_numGoroutine_Params_TypeID = 0xfa6ca90efc9ff291
+// MemStats_BySize_List is a list of MemStats_BySize.
+type MemStats_BySize_List struct{ capnp.List }
 
-func NewRuntime_numGoroutine_Params(s *capnp.Segment) (Runtime_numGoroutine_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_numGoroutine_Params{st}, err
+// NewMemStats_BySize creates a new list of MemStats_BySize.
+func NewMemStats_BySize_List(s *capnp.Segment, sz int32) (MemStats_BySize_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return MemStats_BySize_List{l}, err
 }
 
-func NewRootRuntime_numGoroutine_Params(s *capnp.Segment) (Runtime_numGoroutine_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_numGoroutine_Params{st}, err
-}
+func (s MemStats_BySize_List) At(i int) MemStats_BySize { return MemStats_BySize{s.List.Struct(i)} }
 
-func ReadRootRuntime_numGoroutine_Params(msg *capnp.Message) (Runtime_numGoroutine_Params, error) {
-	root, err := msg.RootPtr()
-	return Runtime_numGoroutine_Params{root.Struct()}, err
+func (s MemStats_BySize_List) Set(i int, v MemStats_BySize) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Runtime_numGoroutine_Params) String() string {
-	str, _ := text.Marshal(0xfa6ca90efc9ff291, s.Struct)
+func (s MemStats_BySize_List) String() string {
+	str, _ := text.MarshalList(0xc3e472677f9be8ad, s.List)
 	return str
 }
 
-// Runtime_numGoroutine_Params_List is a list of Runtime_numGoroutine_Params.
-type Runtime_numGoroutine_Params_List struct{ capnp.List }
+// MemStats_BySize_Promise is a wrapper for a MemStats_BySize promised by a client call.
+type MemStats_BySize_Promise struct{ *capnp.Pipeline }
 
-// NewRuntime_numGoroutine_Params creates a new list of Runtime_numGoroutine_Params.
-func NewRuntime_numGoroutine_Params_List(s *capnp.Segment, sz int32) (Runtime_numGoroutine_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Runtime_numGoroutine_Params_List{l}, err
+func (p MemStats_BySize_Promise) Struct() (MemStats_BySize, error) {
+	s, err := p.Pipeline.Struct()
+	return MemStats_BySize{s}, err
 }
 
-func (s Runtime_numGoroutine_Params_List) At(i int) Runtime_numGoroutine_Params {
-	return Runtime_numGoroutine_Params{s.List.Struct(i)}
-}
+type Configs struct{ Client capnp.Client }
 
-func (s Runtime_numGoroutine_Params_List) Set(i int, v Runtime_numGoroutine_Params) error {
-	return s.List.SetStruct(i, v.Struct)
-}
+// Configs_TypeID is the unique identifier for the type Configs.
+const Configs_TypeID = 0xa391f67e209a873d
 
-func (s Runtime_numGoroutine_Params_List) String() string {
-	str, _ := text.MarshalList(0xfa6ca90efc9ff291, s.List)
-	return str
+func (c Configs) ConfigDir(ctx context.Context, params func(Configs_configDir_Params) error, opts ...capnp.CallOption) Configs_configDir_Results_Promise {
+	if c.Client == nil {
+		return Configs_configDir_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      0,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "configDir",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_configDir_Params{Struct: s}) }
+	}
+	return Configs_configDir_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+}
+func (c Configs) ConfigDirExists(ctx context.Context, params func(Configs_configDirExists_Params) error, opts ...capnp.CallOption) Configs_configDirExists_Results_Promise {
+	if c.Client == nil {
+		return Configs_configDirExists_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      1,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "configDirExists",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_configDirExists_Params{Struct: s}) }
+	}
+	return Configs_configDirExists_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-// Runtime_numGoroutine_Params_Promise is a wrapper for a Runtime_numGoroutine_Params promised by a client call.
-type Runtime_numGoroutine_Params_Promise struct{ *capnp.Pipeline }
-
-func (p Runtime_numGoroutine_Params_Promise) Struct() (Runtime_numGoroutine_Params, error) {
-	s, err := p.Pipeline.Struct()
-	return Runtime_numGoroutine_Params{s}, err
+func (c Configs) ServiceIds(ctx context.Context, params func(Configs_serviceIds_Params) error, opts ...capnp.CallOption) Configs_serviceIds_Results_Promise {
+	if c.Client == nil {
+		return Configs_serviceIds_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      2,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "serviceIds",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_serviceIds_Params{Struct: s}) }
+	}
+	return Configs_serviceIds_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
 
-type Runtime_numGoroutine_Results struct{ capnp.Struct }
-
-// Runtime_numGoroutine_Results_TypeID is the unique identifier for the type Runtime_numGoroutine_Results.
-const Runtime_numGoroutine_Results_TypeID = 0xfcf6d1267c1553d3
-
-func NewRuntime_numGoroutine_Results(s *capnp.Segment) (Runtime_numGoroutine_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Runtime_numGoroutine_Results{st}, err
-}
+type Configs_Server interface {
+	ConfigDir(Configs_configDir) error
 
-func NewRootRuntime_numGoroutine_Results(s *capnp.Segment) (Runtime_numGoroutine_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Runtime_numGoroutine_Results{st}, err
-}
+	ConfigDirExists(Configs_configDirExists) error
 
-func ReadRootRuntime_numGoroutine_Results(msg *capnp.Message) (Runtime_numGoroutine_Results, error) {
-	root, err := msg.RootPtr()
-	return Runtime_numGoroutine_Results{root.Struct()}, err
+	ServiceIds(Configs_serviceIds) error
 }
 
-func (s Runtime_numGoroutine_Results) String() string {
-	str, _ := text.Marshal(0xfcf6d1267c1553d3, s.Struct)
-	return str
+func Configs_ServerToClient(s Configs_Server) Configs {
+	c, _ := s.(server.Closer)
+	return Configs{Client: server.New(Configs_Methods(nil, s), c)}
 }
 
-func (s Runtime_numGoroutine_Results) Count() uint32 {
-	return s.Struct.Uint32(0)
-}
+func Configs_Methods(methods []server.Method, s Configs_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
 
-func (s Runtime_numGoroutine_Results) SetCount(v uint32) {
-	s.Struct.SetUint32(0, v)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      0,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "configDir",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Configs_configDir{c, opts, Configs_configDir_Params{Struct: p}, Configs_configDir_Results{Struct: r}}
+			return s.ConfigDir(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
 
-// Runtime_numGoroutine_Results_List is a list of Runtime_numGoroutine_Results.
-type Runtime_numGoroutine_Results_List struct{ capnp.List }
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      1,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "configDirExists",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Configs_configDirExists{c, opts, Configs_configDirExists_Params{Struct: p}, Configs_configDirExists_Results{Struct: r}}
+			return s.ConfigDirExists(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
 
-// NewRuntime_numGoroutine_Results creates a new list of Runtime_numGoroutine_Results.
-func NewRuntime_numGoroutine_Results_List(s *capnp.Segment, sz int32) (Runtime_numGoroutine_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Runtime_numGoroutine_Results_List{l}, err
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0xa391f67e209a873d,
+			MethodID:      2,
+			InterfaceName: "app.capnp:Configs",
+			MethodName:    "serviceIds",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := Configs_serviceIds{c, opts, Configs_serviceIds_Params{Struct: p}, Configs_serviceIds_Results{Struct: r}}
+			return s.ServiceIds(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
 
-func (s Runtime_numGoroutine_Results_List) At(i int) Runtime_numGoroutine_Results {
-	return Runtime_numGoroutine_Results{s.List.Struct(i)}
+	return methods
 }
 
-func (s Runtime_numGoroutine_Results_List) Set(i int, v Runtime_numGoroutine_Results) error {
-	return s.List.SetStruct(i, v.Struct)
+// Configs_configDir holds the arguments for a server call to Configs.configDir.
+type Configs_configDir struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Configs_configDir_Params
+	Results Configs_configDir_Results
 }
 
-func (s Runtime_numGoroutine_Results_List) String() string {
-	str, _ := text.MarshalList(0xfcf6d1267c1553d3, s.List)
-	return str
+// Configs_configDirExists holds the arguments for a server call to Configs.configDirExists.
+type Configs_configDirExists struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Configs_configDirExists_Params
+	Results Configs_configDirExists_Results
 }
 
-// Runtime_numGoroutine_Results_Promise is a wrapper for a Runtime_numGoroutine_Results promised by a client call.
-type Runtime_numGoroutine_Results_Promise struct{ *capnp.Pipeline }
-
-func (p Runtime_numGoroutine_Results_Promise) Struct() (Runtime_numGoroutine_Results, error) {
-	s, err := p.Pipeline.Struct()
-	return Runtime_numGoroutine_Results{s}, err
+// Configs_serviceIds holds the arguments for a server call to Configs.serviceIds.
+type Configs_serviceIds struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  Configs_serviceIds_Params
+	Results Configs_serviceIds_Results
 }
 
-type Runtime_memStats_Params struct{ capnp.Struct }
+type Configs_configDir_Params struct{ capnp.Struct }
 
-// Runtime_memStats_Params_TypeID is the unique identifier for the type Runtime_memStats_Params.
-const Runtime_memStats_Params_TypeID = 0xfa7d2ded965e55e3
+// Configs_configDir_Params_TypeID is the unique identifier for the type Configs_configDir_Params.
+const Configs_configDir_Params_TypeID = 0x98be837673e8652a
 
-func NewRuntime_memStats_Params(s *capnp.Segment) (Runtime_memStats_Params, error) {
+func NewConfigs_configDir_Params(s *capnp.Segment) (Configs_configDir_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_memStats_Params{st}, err
+	return Configs_configDir_Params{st}, err
 }
 
-func NewRootRuntime_memStats_Params(s *capnp.Segment) (Runtime_memStats_Params, error) {
+func NewRootConfigs_configDir_Params(s *capnp.Segment) (Configs_configDir_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_memStats_Params{st}, err
+	return Configs_configDir_Params{st}, err
 }
 
-func ReadRootRuntime_memStats_Params(msg *capnp.Message) (Runtime_memStats_Params, error) {
+func ReadRootConfigs_configDir_Params(msg *capnp.Message) (Configs_configDir_Params, error) {
 	root, err := msg.RootPtr()
-	return Runtime_memStats_Params{root.Struct()}, err
+	return Configs_configDir_Params{root.Struct()}, err
 }
 
-func (s Runtime_memStats_Params) String() string {
-	str, _ := text.Marshal(0xfa7d2ded965e55e3, s.Struct)
+func (s Configs_configDir_Params) String() string {
+	str, _ := text.Marshal(0x98be837673e8652a, s.Struct)
 	return str
 }
 
-// Runtime_memStats_Params_List is a list of Runtime_memStats_Params.
-type Runtime_memStats_Params_List struct{ capnp.List }
+// Configs_configDir_Params_List is a list of Configs_configDir_Params.
+type Configs_configDir_Params_List struct{ capnp.List }
 
-// NewRuntime_memStats_Params creates a new list of Runtime_memStats_Params.
-func NewRuntime_memStats_Params_List(s *capnp.Segment, sz int32) (Runtime_memStats_Params_List, error) {
+// NewConfigs_configDir_Params creates a new list of Configs_configDir_Params.
+func NewConfigs_configDir_Params_List(s *capnp.Segment, sz int32) (Configs_configDir_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Runtime_memStats_Params_List{l}, err
+	return Configs_configDir_Params_List{l}, err
 }
 
-func (s Runtime_memStats_Params_List) At(i int) Runtime_memStats_Params {
-	return Runtime_memStats_Params{s.List.Struct(i)}
+func (s Configs_configDir_Params_List) At(i int) Configs_configDir_Params {
+	return Configs_configDir_Params{s.List.Struct(i)}
 }
 
-func (s Runtime_memStats_Params_List) Set(i int, v Runtime_memStats_Params) error {
+func (s Configs_configDir_Params_List) Set(i int, v Configs_configDir_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Runtime_memStats_Params_List) String() string {
-	str, _ := text.MarshalList(0xfa7d2ded965e55e3, s.List)
+func (s Configs_configDir_Params_List) String() string {
+	str, _ := text.MarshalList(0x98be837673e8652a, s.List)
 	return str
 }
 
-// Runtime_memStats_Params_Promise is a wrapper for a Runtime_memStats_Params promised by a client call.
-type Runtime_memStats_Params_Promise struct{ *capnp.Pipeline }
+// Configs_configDir_Params_Promise is a wrapper for a Configs_configDir_Params promised by a client call.
+type Configs_configDir_Params_Promise struct{ *capnp.Pipeline }
 
-func (p Runtime_memStats_Params_Promise) Struct() (Runtime_memStats_Params, error) {
+func (p Configs_configDir_Params_Promise) Struct() (Configs_configDir_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return Runtime_memStats_Params{s}, err
+	return Configs_configDir_Params{s}, err
 }
 
-type Runtime_memStats_Results struct{ capnp.Struct }
+type Configs_configDir_Results struct{ capnp.Struct }
 
-// Runtime_memStats_Results_TypeID is the unique identifier for the type Runtime_memStats_Results.
-const Runtime_memStats_Results_TypeID = 0xc8c60b05d115f411
+// Configs_configDir_Results_TypeID is the unique identifier for the type Configs_configDir_Results.
+const Configs_configDir_Results_TypeID = 0xf6b932063d110fed
 
-func NewRuntime_memStats_Results(s *capnp.Segment) (Runtime_memStats_Results, error) {
+func NewConfigs_configDir_Results(s *capnp.Segment) (Configs_configDir_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_memStats_Results{st}, err
+	return Configs_configDir_Results{st}, err
 }
 
-func NewRootRuntime_memStats_Results(s *capnp.Segment) (Runtime_memStats_Results, error) {
+func NewRootConfigs_configDir_Results(s *capnp.Segment) (Configs_configDir_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_memStats_Results{st}, err
+	return Configs_configDir_Results{st}, err
 }
 
-func ReadRootRuntime_memStats_Results(msg *capnp.Message) (Runtime_memStats_Results, error) {
+func ReadRootConfigs_configDir_Results(msg *capnp.Message) (Configs_configDir_Results, error) {
 	root, err := msg.RootPtr()
-	return Runtime_memStats_Results{root.Struct()}, err
+	return Configs_configDir_Results{root.Struct()}, err
 }
 
-func (s Runtime_memStats_Results) String() string {
-	str, _ := text.Marshal(0xc8c60b05d115f411, s.Struct)
+func (s Configs_configDir_Results) String() string {
+	str, _ := text.Marshal(0xf6b932063d110fed, s.Struct)
 	return str
 }
 
-func (s Runtime_memStats_Results) Stats() (MemStats, error) {
+func (s Configs_configDir_Results) ConfigDir() (string, error) {
 	p, err := s.Struct.Ptr(0)
-	return MemStats{Struct: p.Struct()}, err
+	return p.Text(), err
 }
 
-func (s Runtime_memStats_Results) HasStats() bool {
+func (s Configs_configDir_Results) HasConfigDir() bool {
 	p, err := s.Struct.Ptr(0)
 	return p.IsValid() || err != nil
 }
 
-func (s Runtime_memStats_Results) SetStats(v MemStats) error {
-	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+func (s Configs_configDir_Results) ConfigDirBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
 }
 
-// NewStats sets the stats field to a newly
-// allocated MemStats struct, preferring placement in s's segment.
-func (s Runtime_memStats_Results) NewStats() (MemStats, error) {
-	ss, err := NewMemStats(s.Struct.Segment())
-	if err != nil {
-		return MemStats{}, err
-	}
-	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
-	return ss, err
+func (s Configs_configDir_Results) SetConfigDir(v string) error {
+	return s.Struct.SetText(0, v)
 }
 
-// Runtime_memStats_Results_List is a list of Runtime_memStats_Results.
-type Runtime_memStats_Results_List struct{ capnp.List }
+// Configs_configDir_Results_List is a list of Configs_configDir_Results.
+type Configs_configDir_Results_List struct{ capnp.List }
 
-// NewRuntime_memStats_Results creates a new list of Runtime_memStats_Results.
-func NewRuntime_memStats_Results_List(s *capnp.Segment, sz int32) (Runtime_memStats_Results_List, error) {
+// NewConfigs_configDir_Results creates a new list of Configs_configDir_Results.
+func NewConfigs_configDir_Results_List(s *capnp.Segment, sz int32) (Configs_configDir_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return Runtime_memStats_Results_List{l}, err
+	return Configs_configDir_Results_List{l}, err
 }
 
-func (s Runtime_memStats_Results_List) At(i int) Runtime_memStats_Results {
-	return Runtime_memStats_Results{s.List.Struct(i)}
+func (s Configs_configDir_Results_List) At(i int) Configs_configDir_Results {
+	return Configs_configDir_Results{s.List.Struct(i)}
 }
 
-func (s Runtime_memStats_Results_List) Set(i int, v Runtime_memStats_Results) error {
+func (s Configs_configDir_Results_List) Set(i int, v Configs_configDir_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
-
-func (s Runtime_memStats_Results_List) String() string {
-	str, _ := text.MarshalList(0xc8c60b05d115f411, s.List)
+
+func (s Configs_configDir_Results_List) String() string {
+	str, _ := text.MarshalList(0xf6b932063d110fed, s.List)
 	return str
 }
 
-// Runtime_memStats_Results_Promise is a wrapper for a Runtime_memStats_Results promised by a client call.
-type Runtime_memStats_Results_Promise struct{ *capnp.Pipeline }
+// Configs_configDir_Results_Promise is a wrapper for a Configs_configDir_Results promised by a client call.
+type Configs_configDir_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Runtime_memStats_Results_Promise) Struct() (Runtime_memStats_Results, error) {
+func (p Configs_configDir_Results_Promise) Struct() (Configs_configDir_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Runtime_memStats_Results{s}, err
-}
-
-func (p Runtime_memStats_Results_Promise) Stats() MemStats_Promise {
-	return MemStats_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+	return Configs_configDir_Results{s}, err
 }
 
-type Runtime_stackDump_Params struct{ capnp.Struct }
+type Configs_configDirExists_Params struct{ capnp.Struct }
 
-// Runtime_stackDump_Params_TypeID is the unique identifier for the type Runtime_stackDump_Params.
-const Runtime_stackDump_Params_TypeID = 0xb9c996f05a75ae42
+// Configs_configDirExists_Params_TypeID is the unique identifier for the type Configs_configDirExists_Params.
+const Configs_configDirExists_Params_TypeID = 0x99ad308062b9e970
 
-func NewRuntime_stackDump_Params(s *capnp.Segment) (Runtime_stackDump_Params, error) {
+func NewConfigs_configDirExists_Params(s *capnp.Segment) (Configs_configDirExists_Params, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_stackDump_Params{st}, err
+	return Configs_configDirExists_Params{st}, err
 }
 
-func NewRootRuntime_stackDump_Params(s *capnp.Segment) (Runtime_stackDump_Params, error) {
+func NewRootConfigs_configDirExists_Params(s *capnp.Segment) (Configs_configDirExists_Params, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Runtime_stackDump_Params{st}, err
+	return Configs_configDirExists_Params{st}, err
 }
 
-func ReadRootRuntime_stackDump_Params(msg *capnp.Message) (Runtime_stackDump_Params, error) {
+func ReadRootConfigs_configDirExists_Params(msg *capnp.Message) (Configs_configDirExists_Params, error) {
 	root, err := msg.RootPtr()
-	return Runtime_stackDump_Params{root.Struct()}, err
+	return Configs_configDirExists_Params{root.Struct()}, err
 }
 
-func (s Runtime_stackDump_Params) String() string {
-	str, _ := text.Marshal(0xb9c996f05a75ae42, s.Struct)
+func (s Configs_configDirExists_Params) String() string {
+	str, _ := text.Marshal(0x99ad308062b9e970, s.Struct)
 	return str
 }
 
-// Runtime_stackDump_Params_List is a list of Runtime_stackDump_Params.
-type Runtime_stackDump_Params_List struct{ capnp.List }
+// Configs_configDirExists_Params_List is a list of Configs_configDirExists_Params.
+type Configs_configDirExists_Params_List struct{ capnp.List }
 
-// NewRuntime_stackDump_Params creates a new list of Runtime_stackDump_Params.
-func NewRuntime_stackDump_Params_List(s *capnp.Segment, sz int32) (Runtime_stackDump_Params_List, error) {
+// NewConfigs_configDirExists_Params creates a new list of Configs_configDirExists_Params.
+func NewConfigs_configDirExists_Params_List(s *capnp.Segment, sz int32) (Configs_configDirExists_Params_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Runtime_stackDump_Params_List{l}, err
+	return Configs_configDirExists_Params_List{l}, err
 }
 
-func (s Runtime_stackDump_Params_List) At(i int) Runtime_stackDump_Params {
-	return Runtime_stackDump_Params{s.List.Struct(i)}
+func (s Configs_configDirExists_Params_List) At(i int) Configs_configDirExists_Params {
+	return Configs_configDirExists_Params{s.List.Struct(i)}
 }
 
-func (s Runtime_stackDump_Params_List) Set(i int, v Runtime_stackDump_Params) error {
+func (s Configs_configDirExists_Params_List) Set(i int, v Configs_configDirExists_Params) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Runtime_stackDump_Params_List) String() string {
-	str, _ := text.MarshalList(0xb9c996f05a75ae42, s.List)
+func (s Configs_configDirExists_Params_List) String() string {
+	str, _ := text.MarshalList(0x99ad308062b9e970, s.List)
 	return str
 }
 
-// Runtime_stackDump_Params_Promise is a wrapper for a Runtime_stackDump_Params promised by a client call.
-type Runtime_stackDump_Params_Promise struct{ *capnp.Pipeline }
+// Configs_configDirExists_Params_Promise is a wrapper for a Configs_configDirExists_Params promised by a client call.
+type Configs_configDirExists_Params_Promise struct{ *capnp.Pipeline }
 
-func (p Runtime_stackDump_Params_Promise) Struct() (Runtime_stackDump_Params, error) {
+func (p Configs_configDirExists_Params_Promise) Struct() (Configs_configDirExists_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return Runtime_stackDump_Params{s}, err
+	return Configs_configDirExists_Params{s}, err
 }
 
-type Runtime_stackDump_Results struct{ capnp.Struct }
+type Configs_configDirExists_Results struct{ capnp.Struct }
 
-// Runtime_stackDump_Results_TypeID is the unique identifier for the type Runtime_stackDump_Results.
-const Runtime_stackDump_Results_TypeID = 0xa8fc15721302db2a
+// Configs_configDirExists_Results_TypeID is the unique identifier for the type Configs_configDirExists_Results.
+const Configs_configDirExists_Results_TypeID = 0x9f8ae589a9e0a609
 
-func NewRuntime_stackDump_Results(s *capnp.Segment) (Runtime_stackDump_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_stackDump_Results{st}, err
+func NewConfigs_configDirExists_Results(s *capnp.Segment) (Configs_configDirExists_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Configs_configDirExists_Results{st}, err
 }
 
-func NewRootRuntime_stackDump_Results(s *capnp.Segment) (Runtime_stackDump_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Runtime_stackDump_Results{st}, err
+func NewRootConfigs_configDirExists_Results(s *capnp.Segment) (Configs_configDirExists_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return Configs_configDirExists_Results{st}, err
 }
 
-func ReadRootRuntime_stackDump_Results(msg *capnp.Message) (Runtime_stackDump_Results, error) {
+func ReadRootConfigs_configDirExists_Results(msg *capnp.Message) (Configs_configDirExists_Results, error) {
 	root, err := msg.RootPtr()
-	return Runtime_stackDump_Results{root.Struct()}, err
+	return Configs_configDirExists_Results{root.Struct()}, err
 }
 
-func (s Runtime_stackDump_Results) String() string {
-	str, _ := text.Marshal(0xa8fc15721302db2a, s.Struct)
+func (s Configs_configDirExists_Results) String() string {
+	str, _ := text.Marshal(0x9f8ae589a9e0a609, s.Struct)
 	return str
 }
 
-func (s Runtime_stackDump_Results) StackDump() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return []byte(p.Data()), err
-}
-
-func (s Runtime_stackDump_Results) HasStackDump() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+func (s Configs_configDirExists_Results) Exists() bool {
+	return s.Struct.Bit(0)
 }
 
-func (s Runtime_stackDump_Results) SetStackDump(v []byte) error {
-	return s.Struct.SetData(0, v)
+func (s Configs_configDirExists_Results) SetExists(v bool) {
+	s.Struct.SetBit(0, v)
 }
 
-// Runtime_stackDump_Results_List is a list of Runtime_stackDump_Results.
-type Runtime_stackDump_Results_List struct{ capnp.List }
+// Configs_configDirExists_Results_List is a list of Configs_configDirExists_Results.
+type Configs_configDirExists_Results_List struct{ capnp.List }
 
-// NewRuntime_stackDump_Results creates a new list of Runtime_stackDump_Results.
-func NewRuntime_stackDump_Results_List(s *capnp.Segment, sz int32) (Runtime_stackDump_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return Runtime_stackDump_Results_List{l}, err
+// NewConfigs_configDirExists_Results creates a new list of Configs_configDirExists_Results.
+func NewConfigs_configDirExists_Results_List(s *capnp.Segment, sz int32) (Configs_configDirExists_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return Configs_configDirExists_Results_List{l}, err
 }
 
-func (s Runtime_stackDump_Results_List) At(i int) Runtime_stackDump_Results {
-	return Runtime_stackDump_Results{s.List.Struct(i)}
+func (s Configs_configDirExists_Results_List) At(i int) Configs_configDirExists_Results {
+	return Configs_configDirExists_Results{s.List.Struct(i)}
 }
 
-func (s Runtime_stackDump_Results_List) Set(i int, v Runtime_stackDump_Results) error {
+func (s Configs_configDirExists_Results_List) Set(i int, v Configs_configDirExists_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Runtime_stackDump_Results_List) String() string {
-	str, _ := text.MarshalList(0xa8fc15721302db2a, s.List)
+func (s Configs_configDirExists_Results_List) String() string {
+	str, _ := text.MarshalList(0x9f8ae589a9e0a609, s.List)
 	return str
 }
 
-// Runtime_stackDump_Results_Promise is a wrapper for a Runtime_stackDump_Results promised by a client call.
-type Runtime_stackDump_Results_Promise struct{ *capnp.Pipeline }
+// Configs_configDirExists_Results_Promise is a wrapper for a Configs_configDirExists_Results promised by a client call.
+type Configs_configDirExists_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Runtime_stackDump_Results_Promise) Struct() (Runtime_stackDump_Results, error) {
+func (p Configs_configDirExists_Results_Promise) Struct() (Configs_configDirExists_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Runtime_stackDump_Results{s}, err
+	return Configs_configDirExists_Results{s}, err
 }
 
-type MemStats struct{ capnp.Struct }
+type Configs_serviceIds_Params struct{ capnp.Struct }
 
-// MemStats_TypeID is the unique identifier for the type MemStats.
-const MemStats_TypeID = 0xcdf011e3e3860026
+// Configs_serviceIds_Params_TypeID is the unique identifier for the type Configs_serviceIds_Params.
+const Configs_serviceIds_Params_TypeID = 0xb281d4535d7c4c6e
 
-func NewMemStats(s *capnp.Segment) (MemStats, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3})
-	return MemStats{st}, err
+func NewConfigs_serviceIds_Params(s *capnp.Segment) (Configs_serviceIds_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Configs_serviceIds_Params{st}, err
 }
 
-func NewRootMemStats(s *capnp.Segment) (MemStats, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3})
-	return MemStats{st}, err
+func NewRootConfigs_serviceIds_Params(s *capnp.Segment) (Configs_serviceIds_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return Configs_serviceIds_Params{st}, err
 }
 
-func ReadRootMemStats(msg *capnp.Message) (MemStats, error) {
+func ReadRootConfigs_serviceIds_Params(msg *capnp.Message) (Configs_serviceIds_Params, error) {
 	root, err := msg.RootPtr()
-	return MemStats{root.Struct()}, err
+	return Configs_serviceIds_Params{root.Struct()}, err
 }
 
-func (s MemStats) String() string {
-	str, _ := text.Marshal(0xcdf011e3e3860026, s.Struct)
+func (s Configs_serviceIds_Params) String() string {
+	str, _ := text.Marshal(0xb281d4535d7c4c6e, s.Struct)
 	return str
 }
 
-func (s MemStats) Alloc() uint64 {
-	return s.Struct.Uint64(0)
-}
+// Configs_serviceIds_Params_List is a list of Configs_serviceIds_Params.
+type Configs_serviceIds_Params_List struct{ capnp.List }
 
-func (s MemStats) SetAlloc(v uint64) {
-	s.Struct.SetUint64(0, v)
+// NewConfigs_serviceIds_Params creates a new list of Configs_serviceIds_Params.
+func NewConfigs_serviceIds_Params_List(s *capnp.Segment, sz int32) (Configs_serviceIds_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return Configs_serviceIds_Params_List{l}, err
 }
 
-func (s MemStats) TotalAlloc() uint64 {
-	return s.Struct.Uint64(8)
+func (s Configs_serviceIds_Params_List) At(i int) Configs_serviceIds_Params {
+	return Configs_serviceIds_Params{s.List.Struct(i)}
 }
 
-func (s MemStats) SetTotalAlloc(v uint64) {
-	s.Struct.SetUint64(8, v)
+func (s Configs_serviceIds_Params_List) Set(i int, v Configs_serviceIds_Params) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s MemStats) Sys() uint64 {
-	return s.Struct.Uint64(16)
+func (s Configs_serviceIds_Params_List) String() string {
+	str, _ := text.MarshalList(0xb281d4535d7c4c6e, s.List)
+	return str
 }
 
-func (s MemStats) SetSys(v uint64) {
-	s.Struct.SetUint64(16, v)
-}
+// Configs_serviceIds_Params_Promise is a wrapper for a Configs_serviceIds_Params promised by a client call.
+type Configs_serviceIds_Params_Promise struct{ *capnp.Pipeline }
 
-func (s MemStats) Lookups() uint64 {
-	return s.Struct.Uint64(24)
+func (p Configs_serviceIds_Params_Promise) Struct() (Configs_serviceIds_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return Configs_serviceIds_Params{s}, err
 }
 
-func (s MemStats) SetLookups(v uint64) {
-	s.Struct.SetUint64(24, v)
-}
+type Configs_serviceIds_Results struct{ capnp.Struct }
 
-func (s MemStats) Mallocs() uint64 {
-	return s.Struct.Uint64(32)
+// Configs_serviceIds_Results_TypeID is the unique identifier for the type Configs_serviceIds_Results.
+const Configs_serviceIds_Results_TypeID = 0xa28ac6cb306f77a0
+
+func NewConfigs_serviceIds_Results(s *capnp.Segment) (Configs_serviceIds_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Configs_serviceIds_Results{st}, err
 }
 
-func (s MemStats) SetMallocs(v uint64) {
-	s.Struct.SetUint64(32, v)
+func NewRootConfigs_serviceIds_Results(s *capnp.Segment) (Configs_serviceIds_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return Configs_serviceIds_Results{st}, err
 }
 
-func (s MemStats) Frees() uint64 {
-	return s.Struct.Uint64(40)
+func ReadRootConfigs_serviceIds_Results(msg *capnp.Message) (Configs_serviceIds_Results, error) {
+	root, err := msg.RootPtr()
+	return Configs_serviceIds_Results{root.Struct()}, err
 }
 
-func (s MemStats) SetFrees(v uint64) {
-	s.Struct.SetUint64(40, v)
+func (s Configs_serviceIds_Results) String() string {
+	str, _ := text.Marshal(0xa28ac6cb306f77a0, s.Struct)
+	return str
 }
 
-func (s MemStats) HeapAlloc() uint64 {
-	return s.Struct.Uint64(48)
+func (s Configs_serviceIds_Results) ServiceIds() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.UInt64List{List: p.List()}, err
 }
 
-func (s MemStats) SetHeapAlloc(v uint64) {
-	s.Struct.SetUint64(48, v)
+func (s Configs_serviceIds_Results) HasServiceIds() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s MemStats) HeapSys() uint64 {
-	return s.Struct.Uint64(56)
+func (s Configs_serviceIds_Results) SetServiceIds(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
 }
 
-func (s MemStats) SetHeapSys(v uint64) {
-	s.Struct.SetUint64(56, v)
+// NewServiceIds sets the serviceIds field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Configs_serviceIds_Results) NewServiceIds(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
 }
 
-func (s MemStats) HeapIdle() uint64 {
-	return s.Struct.Uint64(64)
+// Configs_serviceIds_Results_List is a list of Configs_serviceIds_Results.
+type Configs_serviceIds_Results_List struct{ capnp.List }
+
+// NewConfigs_serviceIds_Results creates a new list of Configs_serviceIds_Results.
+func NewConfigs_serviceIds_Results_List(s *capnp.Segment, sz int32) (Configs_serviceIds_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return Configs_serviceIds_Results_List{l}, err
 }
 
-func (s MemStats) SetHeapIdle(v uint64) {
-	s.Struct.SetUint64(64, v)
+func (s Configs_serviceIds_Results_List) At(i int) Configs_serviceIds_Results {
+	return Configs_serviceIds_Results{s.List.Struct(i)}
 }
 
-func (s MemStats) HeapInUse() uint64 {
-	return s.Struct.Uint64(72)
+func (s Configs_serviceIds_Results_List) Set(i int, v Configs_serviceIds_Results) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s MemStats) SetHeapInUse(v uint64) {
-	s.Struct.SetUint64(72, v)
+func (s Configs_serviceIds_Results_List) String() string {
+	str, _ := text.MarshalList(0xa28ac6cb306f77a0, s.List)
+	return str
 }
 
-func (s MemStats) HeapReleased() uint64 {
-	return s.Struct.Uint64(80)
-}
+// Configs_serviceIds_Results_Promise is a wrapper for a Configs_serviceIds_Results promised by a client call.
+type Configs_serviceIds_Results_Promise struct{ *capnp.Pipeline }
 
-func (s MemStats) SetHeapReleased(v uint64) {
-	s.Struct.SetUint64(80, v)
+func (p Configs_serviceIds_Results_Promise) Struct() (Configs_serviceIds_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return Configs_serviceIds_Results{s}, err
 }
 
-func (s MemStats) HeapObjects() uint64 {
-	return s.Struct.Uint64(88)
-}
+type CommandPipeline struct{ Client capnp.Client }
 
-func (s MemStats) SetHeapObjects(v uint64) {
-	s.Struct.SetUint64(88, v)
-}
+// CommandPipeline_TypeID is the unique identifier for the type CommandPipeline.
+const CommandPipeline_TypeID = 0x8639f6277fdeed88
 
-func (s MemStats) StackInUse() uint64 {
-	return s.Struct.Uint64(96)
+func (c CommandPipeline) Id(ctx context.Context, params func(CommandPipeline_id_Params) error, opts ...capnp.CallOption) CommandPipeline_id_Results_Promise {
+	if c.Client == nil {
+		return CommandPipeline_id_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      0,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "id",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(CommandPipeline_id_Params{Struct: s}) }
+	}
+	return CommandPipeline_id_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-func (s MemStats) SetStackInUse(v uint64) {
-	s.Struct.SetUint64(96, v)
+func (c CommandPipeline) Stages(ctx context.Context, params func(CommandPipeline_stages_Params) error, opts ...capnp.CallOption) CommandPipeline_stages_Results_Promise {
+	if c.Client == nil {
+		return CommandPipeline_stages_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      1,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "stages",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(CommandPipeline_stages_Params{Struct: s}) }
+	}
+	return CommandPipeline_stages_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
-
-func (s MemStats) StackSys() uint64 {
-	return s.Struct.Uint64(104)
+func (c CommandPipeline) SetStagePoolSize(ctx context.Context, params func(CommandPipeline_setStagePoolSize_Params) error, opts ...capnp.CallOption) CommandPipeline_setStagePoolSize_Results_Promise {
+	if c.Client == nil {
+		return CommandPipeline_setStagePoolSize_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
+	}
+	call := &capnp.Call{
+		Ctx: ctx,
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      2,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "setStagePoolSize",
+		},
+		Options: capnp.NewCallOptions(opts),
+	}
+	if params != nil {
+		call.ParamsSize = capnp.ObjectSize{DataSize: 8, PointerCount: 0}
+		call.ParamsFunc = func(s capnp.Struct) error { return params(CommandPipeline_setStagePoolSize_Params{Struct: s}) }
+	}
+	return CommandPipeline_setStagePoolSize_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
 }
 
-func (s MemStats) SetStackSys(v uint64) {
-	s.Struct.SetUint64(104, v)
-}
+type CommandPipeline_Server interface {
+	Id(CommandPipeline_id) error
 
-func (s MemStats) MSpanInUse() uint64 {
-	return s.Struct.Uint64(112)
-}
+	Stages(CommandPipeline_stages) error
 
-func (s MemStats) SetMSpanInUse(v uint64) {
-	s.Struct.SetUint64(112, v)
+	SetStagePoolSize(CommandPipeline_setStagePoolSize) error
 }
 
-func (s MemStats) MSpanSys() uint64 {
-	return s.Struct.Uint64(120)
+func CommandPipeline_ServerToClient(s CommandPipeline_Server) CommandPipeline {
+	c, _ := s.(server.Closer)
+	return CommandPipeline{Client: server.New(CommandPipeline_Methods(nil, s), c)}
 }
 
-func (s MemStats) SetMSpanSys(v uint64) {
-	s.Struct.SetUint64(120, v)
-}
+func CommandPipeline_Methods(methods []server.Method, s CommandPipeline_Server) []server.Method {
+	if cap(methods) == 0 {
+		methods = make([]server.Method, 0, 3)
+	}
 
-func (s MemStats) MCacheInUse() uint64 {
-	return s.Struct.Uint64(128)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      0,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "id",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := CommandPipeline_id{c, opts, CommandPipeline_id_Params{Struct: p}, CommandPipeline_id_Results{Struct: r}}
+			return s.Id(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
+	})
 
-func (s MemStats) SetMCacheInUse(v uint64) {
-	s.Struct.SetUint64(128, v)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      1,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "stages",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := CommandPipeline_stages{c, opts, CommandPipeline_stages_Params{Struct: p}, CommandPipeline_stages_Results{Struct: r}}
+			return s.Stages(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
+	})
 
-func (s MemStats) MCacheSys() uint64 {
-	return s.Struct.Uint64(136)
-}
+	methods = append(methods, server.Method{
+		Method: capnp.Method{
+			InterfaceID:   0x8639f6277fdeed88,
+			MethodID:      2,
+			InterfaceName: "app.capnp:CommandPipeline",
+			MethodName:    "setStagePoolSize",
+		},
+		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
+			call := CommandPipeline_setStagePoolSize{c, opts, CommandPipeline_setStagePoolSize_Params{Struct: p}, CommandPipeline_setStagePoolSize_Results{Struct: r}}
+			return s.SetStagePoolSize(call)
+		},
+		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 0},
+	})
 
-func (s MemStats) SetMCacheSys(v uint64) {
-	s.Struct.SetUint64(136, v)
+	return methods
 }
 
-func (s MemStats) BuckHashSys() uint64 {
-	return s.Struct.Uint64(144)
+// CommandPipeline_id holds the arguments for a server call to CommandPipeline.id.
+type CommandPipeline_id struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  CommandPipeline_id_Params
+	Results CommandPipeline_id_Results
 }
 
-func (s MemStats) SetBuckHashSys(v uint64) {
-	s.Struct.SetUint64(144, v)
+// CommandPipeline_stages holds the arguments for a server call to CommandPipeline.stages.
+type CommandPipeline_stages struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  CommandPipeline_stages_Params
+	Results CommandPipeline_stages_Results
 }
 
-func (s MemStats) GCSys() uint64 {
-	return s.Struct.Uint64(152)
+// CommandPipeline_setStagePoolSize holds the arguments for a server call to CommandPipeline.setStagePoolSize.
+type CommandPipeline_setStagePoolSize struct {
+	Ctx     context.Context
+	Options capnp.CallOptions
+	Params  CommandPipeline_setStagePoolSize_Params
+	Results CommandPipeline_setStagePoolSize_Results
 }
 
-func (s MemStats) SetGCSys(v uint64) {
-	s.Struct.SetUint64(152, v)
-}
+type CommandPipeline_id_Params struct{ capnp.Struct }
 
-func (s MemStats) OtherSys() uint64 {
-	return s.Struct.Uint64(160)
-}
+// CommandPipeline_id_Params_TypeID is the unique identifier for the type CommandPipeline_id_Params.
+const CommandPipeline_id_Params_TypeID = 0x9cf6fe32c5821e57
 
-func (s MemStats) SetOtherSys(v uint64) {
-	s.Struct.SetUint64(160, v)
+func NewCommandPipeline_id_Params(s *capnp.Segment) (CommandPipeline_id_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CommandPipeline_id_Params{st}, err
 }
 
-func (s MemStats) NextGC() uint64 {
-	return s.Struct.Uint64(168)
+func NewRootCommandPipeline_id_Params(s *capnp.Segment) (CommandPipeline_id_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CommandPipeline_id_Params{st}, err
 }
 
-func (s MemStats) SetNextGC(v uint64) {
-	s.Struct.SetUint64(168, v)
+func ReadRootCommandPipeline_id_Params(msg *capnp.Message) (CommandPipeline_id_Params, error) {
+	root, err := msg.RootPtr()
+	return CommandPipeline_id_Params{root.Struct()}, err
 }
 
-func (s MemStats) LastGC() uint64 {
-	return s.Struct.Uint64(176)
+func (s CommandPipeline_id_Params) String() string {
+	str, _ := text.Marshal(0x9cf6fe32c5821e57, s.Struct)
+	return str
 }
 
-func (s MemStats) SetLastGC(v uint64) {
-	s.Struct.SetUint64(176, v)
-}
+// CommandPipeline_id_Params_List is a list of CommandPipeline_id_Params.
+type CommandPipeline_id_Params_List struct{ capnp.List }
 
-func (s MemStats) PauseTotalNs() uint64 {
-	return s.Struct.Uint64(184)
+// NewCommandPipeline_id_Params creates a new list of CommandPipeline_id_Params.
+func NewCommandPipeline_id_Params_List(s *capnp.Segment, sz int32) (CommandPipeline_id_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return CommandPipeline_id_Params_List{l}, err
 }
 
-func (s MemStats) SetPauseTotalNs(v uint64) {
-	s.Struct.SetUint64(184, v)
+func (s CommandPipeline_id_Params_List) At(i int) CommandPipeline_id_Params {
+	return CommandPipeline_id_Params{s.List.Struct(i)}
 }
 
-func (s MemStats) PauseNs() (capnp.UInt64List, error) {
-	p, err := s.Struct.Ptr(0)
-	return capnp.UInt64List{List: p.List()}, err
+func (s CommandPipeline_id_Params_List) Set(i int, v CommandPipeline_id_Params) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s MemStats) HasPauseNs() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+func (s CommandPipeline_id_Params_List) String() string {
+	str, _ := text.MarshalList(0x9cf6fe32c5821e57, s.List)
+	return str
 }
 
-func (s MemStats) SetPauseNs(v capnp.UInt64List) error {
-	return s.Struct.SetPtr(0, v.List.ToPtr())
+// CommandPipeline_id_Params_Promise is a wrapper for a CommandPipeline_id_Params promised by a client call.
+type CommandPipeline_id_Params_Promise struct{ *capnp.Pipeline }
+
+func (p CommandPipeline_id_Params_Promise) Struct() (CommandPipeline_id_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return CommandPipeline_id_Params{s}, err
 }
 
-// NewPauseNs sets the pauseNs field to a newly
-// allocated capnp.UInt64List, preferring placement in s's segment.
-func (s MemStats) NewPauseNs(n int32) (capnp.UInt64List, error) {
-	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.UInt64List{}, err
-	}
-	err = s.Struct.SetPtr(0, l.List.ToPtr())
-	return l, err
+type CommandPipeline_id_Results struct{ capnp.Struct }
+
+// CommandPipeline_id_Results_TypeID is the unique identifier for the type CommandPipeline_id_Results.
+const CommandPipeline_id_Results_TypeID = 0x8267b8211dd7d26e
+
+func NewCommandPipeline_id_Results(s *capnp.Segment) (CommandPipeline_id_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CommandPipeline_id_Results{st}, err
 }
 
-func (s MemStats) PauseEnd() (capnp.UInt64List, error) {
-	p, err := s.Struct.Ptr(1)
-	return capnp.UInt64List{List: p.List()}, err
+func NewRootCommandPipeline_id_Results(s *capnp.Segment) (CommandPipeline_id_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CommandPipeline_id_Results{st}, err
 }
 
-func (s MemStats) HasPauseEnd() bool {
-	p, err := s.Struct.Ptr(1)
-	return p.IsValid() || err != nil
+func ReadRootCommandPipeline_id_Results(msg *capnp.Message) (CommandPipeline_id_Results, error) {
+	root, err := msg.RootPtr()
+	return CommandPipeline_id_Results{root.Struct()}, err
 }
 
-func (s MemStats) SetPauseEnd(v capnp.UInt64List) error {
-	return s.Struct.SetPtr(1, v.List.ToPtr())
+func (s CommandPipeline_id_Results) String() string {
+	str, _ := text.Marshal(0x8267b8211dd7d26e, s.Struct)
+	return str
 }
 
-// NewPauseEnd sets the pauseEnd field to a newly
-// allocated capnp.UInt64List, preferring placement in s's segment.
-func (s MemStats) NewPauseEnd(n int32) (capnp.UInt64List, error) {
-	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.UInt64List{}, err
-	}
-	err = s.Struct.SetPtr(1, l.List.ToPtr())
-	return l, err
+func (s CommandPipeline_id_Results) PipelineId() uint64 {
+	return s.Struct.Uint64(0)
 }
 
-func (s MemStats) NumGC() uint32 {
-	return s.Struct.Uint32(192)
+func (s CommandPipeline_id_Results) SetPipelineId(v uint64) {
+	s.Struct.SetUint64(0, v)
 }
 
-func (s MemStats) SetNumGC(v uint32) {
-	s.Struct.SetUint32(192, v)
+// CommandPipeline_id_Results_List is a list of CommandPipeline_id_Results.
+type CommandPipeline_id_Results_List struct{ capnp.List }
+
+// NewCommandPipeline_id_Results creates a new list of CommandPipeline_id_Results.
+func NewCommandPipeline_id_Results_List(s *capnp.Segment, sz int32) (CommandPipeline_id_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return CommandPipeline_id_Results_List{l}, err
 }
 
-func (s MemStats) NumForcedGC() uint32 {
-	return s.Struct.Uint32(196)
+func (s CommandPipeline_id_Results_List) At(i int) CommandPipeline_id_Results {
+	return CommandPipeline_id_Results{s.List.Struct(i)}
 }
 
-func (s MemStats) SetNumForcedGC(v uint32) {
-	s.Struct.SetUint32(196, v)
+func (s CommandPipeline_id_Results_List) Set(i int, v CommandPipeline_id_Results) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s MemStats) GCCPUFraction() float64 {
-	return math.Float64frombits(s.Struct.Uint64(200))
+func (s CommandPipeline_id_Results_List) String() string {
+	str, _ := text.MarshalList(0x8267b8211dd7d26e, s.List)
+	return str
 }
 
-func (s MemStats) SetGCCPUFraction(v float64) {
-	s.Struct.SetUint64(200, math.Float64bits(v))
+// CommandPipeline_id_Results_Promise is a wrapper for a CommandPipeline_id_Results promised by a client call.
+type CommandPipeline_id_Results_Promise struct{ *capnp.Pipeline }
+
+func (p CommandPipeline_id_Results_Promise) Struct() (CommandPipeline_id_Results, error) {
+	s, err := p.Pipeline.Struct()
+	return CommandPipeline_id_Results{s}, err
 }
 
-func (s MemStats) BySize() (MemStats_BySize_List, error) {
-	p, err := s.Struct.Ptr(2)
-	return MemStats_BySize_List{List: p.List()}, err
+type CommandPipeline_stages_Params struct{ capnp.Struct }
+
+// CommandPipeline_stages_Params_TypeID is the unique identifier for the type CommandPipeline_stages_Params.
+const CommandPipeline_stages_Params_TypeID = 0xfd6b2387c16688f6
+
+func NewCommandPipeline_stages_Params(s *capnp.Segment) (CommandPipeline_stages_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CommandPipeline_stages_Params{st}, err
 }
 
-func (s MemStats) HasBySize() bool {
-	p, err := s.Struct.Ptr(2)
-	return p.IsValid() || err != nil
+func NewRootCommandPipeline_stages_Params(s *capnp.Segment) (CommandPipeline_stages_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
+	return CommandPipeline_stages_Params{st}, err
 }
 
-func (s MemStats) SetBySize(v MemStats_BySize_List) error {
-	return s.Struct.SetPtr(2, v.List.ToPtr())
+func ReadRootCommandPipeline_stages_Params(msg *capnp.Message) (CommandPipeline_stages_Params, error) {
+	root, err := msg.RootPtr()
+	return CommandPipeline_stages_Params{root.Struct()}, err
 }
 
-// NewBySize sets the bySize field to a newly
-// allocated MemStats_BySize_List, preferring placement in s's segment.
-func (s MemStats) NewBySize(n int32) (MemStats_BySize_List, error) {
-	l, err := NewMemStats_BySize_List(s.Struct.Segment(), n)
-	if err != nil {
-		return MemStats_BySize_List{}, err
-	}
-	err = s.Struct.SetPtr(2, l.List.ToPtr())
-	return l, err
+func (s CommandPipeline_stages_Params) String() string {
+	str, _ := text.Marshal(0xfd6b2387c16688f6, s.Struct)
+	return str
 }
 
-// MemStats_List is a list of MemStats.
-type MemStats_List struct{ capnp.List }
+// CommandPipeline_stages_Params_List is a list of CommandPipeline_stages_Params.
+type CommandPipeline_stages_Params_List struct{ capnp.List }
 
-// NewMemStats creates a new list of MemStats.
-func NewMemStats_List(s *capnp.Segment, sz int32) (MemStats_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 208, PointerCount: 3}, sz)
-	return MemStats_List{l}, err
+// NewCommandPipeline_stages_Params creates a new list of CommandPipeline_stages_Params.
+func NewCommandPipeline_stages_Params_List(s *capnp.Segment, sz int32) (CommandPipeline_stages_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
+	return CommandPipeline_stages_Params_List{l}, err
 }
 
-func (s MemStats_List) At(i int) MemStats { return MemStats{s.List.Struct(i)} }
+func (s CommandPipeline_stages_Params_List) At(i int) CommandPipeline_stages_Params {
+	return CommandPipeline_stages_Params{s.List.Struct(i)}
+}
 
-func (s MemStats_List) Set(i int, v MemStats) error { return s.List.SetStruct(i, v.Struct) }
+func (s CommandPipeline_stages_Params_List) Set(i int, v CommandPipeline_stages_Params) error {
+	return s.List.SetStruct(i, v.Struct)
+}
 
-func (s MemStats_List) String() string {
-	str, _ := text.MarshalList(0xcdf011e3e3860026, s.List)
+func (s CommandPipeline_stages_Params_List) String() string {
+	str, _ := text.MarshalList(0xfd6b2387c16688f6, s.List)
 	return str
 }
 
-// MemStats_Promise is a wrapper for a MemStats promised by a client call.
-type MemStats_Promise struct{ *capnp.Pipeline }
+// CommandPipeline_stages_Params_Promise is a wrapper for a CommandPipeline_stages_Params promised by a client call.
+type CommandPipeline_stages_Params_Promise struct{ *capnp.Pipeline }
 
-func (p MemStats_Promise) Struct() (MemStats, error) {
+func (p CommandPipeline_stages_Params_Promise) Struct() (CommandPipeline_stages_Params, error) {
 	s, err := p.Pipeline.Struct()
-	return MemStats{s}, err
+	return CommandPipeline_stages_Params{s}, err
 }
 
-type MemStats_BySize struct{ capnp.Struct }
+type CommandPipeline_stages_Results struct{ capnp.Struct }
 
-// MemStats_BySize_TypeID is the unique identifier for the type MemStats_BySize.
-const MemStats_BySize_TypeID = 0xc3e472677f9be8ad
+// CommandPipeline_stages_Results_TypeID is the unique identifier for the type CommandPipeline_stages_Results.
+const CommandPipeline_stages_Results_TypeID = 0xb439f5d67bfb0257
 
-func NewMemStats_BySize(s *capnp.Segment) (MemStats_BySize, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
-	return MemStats_BySize{st}, err
+func NewCommandPipeline_stages_Results(s *capnp.Segment) (CommandPipeline_stages_Results, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CommandPipeline_stages_Results{st}, err
 }
 
-func NewRootMemStats_BySize(s *capnp.Segment) (MemStats_BySize, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
-	return MemStats_BySize{st}, err
+func NewRootCommandPipeline_stages_Results(s *capnp.Segment) (CommandPipeline_stages_Results, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
+	return CommandPipeline_stages_Results{st}, err
 }
 
-func ReadRootMemStats_BySize(msg *capnp.Message) (MemStats_BySize, error) {
+func ReadRootCommandPipeline_stages_Results(msg *capnp.Message) (CommandPipeline_stages_Results, error) {
 	root, err := msg.RootPtr()
-	return MemStats_BySize{root.Struct()}, err
+	return CommandPipeline_stages_Results{root.Struct()}, err
 }
 
-func (s MemStats_BySize) String() string {
-	str, _ := text.Marshal(0xc3e472677f9be8ad, s.Struct)
+func (s CommandPipeline_stages_Results) String() string {
+	str, _ := text.Marshal(0xb439f5d67bfb0257, s.Struct)
 	return str
 }
 
-func (s MemStats_BySize) Size() uint32 {
-	return s.Struct.Uint32(0)
+func (s CommandPipeline_stages_Results) Stages() (PipelineStage_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return PipelineStage_List{List: p.List()}, err
 }
 
-func (s MemStats_BySize) SetSize(v uint32) {
-	s.Struct.SetUint32(0, v)
+func (s CommandPipeline_stages_Results) HasStages() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s MemStats_BySize) Mallocs() uint64 {
-	return s.Struct.Uint64(8)
+func (s CommandPipeline_stages_Results) SetStages(v PipelineStage_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
 }
 
-func (s MemStats_BySize) SetMallocs(v uint64) {
-	s.Struct.SetUint64(8, v)
+// NewStages sets the stages field to a newly
+// allocated PipelineStage_List, preferring placement in s's segment.
+func (s CommandPipeline_stages_Results) NewStages(n int32) (PipelineStage_List, error) {
+	l, err := NewPipelineStage_List(s.Struct.Segment(), n)
+	if err != nil {
+		return PipelineStage_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
 }
 
-func (s MemStats_BySize) Frees() uint64 {
-	return s.Struct.Uint64(16)
-}
+// CommandPipeline_stages_Results_List is a list of CommandPipeline_stages_Results.
+type CommandPipeline_stages_Results_List struct{ capnp.List }
 
-func (s MemStats_BySize) SetFrees(v uint64) {
-	s.Struct.SetUint64(16, v)
+// NewCommandPipeline_stages_Results creates a new list of CommandPipeline_stages_Results.
+func NewCommandPipeline_stages_Results_List(s *capnp.Segment, sz int32) (CommandPipeline_stages_Results_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
+	return CommandPipeline_stages_Results_List{l}, err
 }
 
-// MemStats_BySize_List is a list of MemStats_BySize.
-type MemStats_BySize_List struct{ capnp.List }
-
-// NewMemStats_BySize creates a new list of MemStats_BySize.
-func NewMemStats_BySize_List(s *capnp.Segment, sz int32) (MemStats_BySize_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
-	return MemStats_BySize_List{l}, err
+func (s CommandPipeline_stages_Results_List) At(i int) CommandPipeline_stages_Results {
+	return CommandPipeline_stages_Results{s.List.Struct(i)}
 }
 
-func (s MemStats_BySize_List) At(i int) MemStats_BySize { return MemStats_BySize{s.List.Struct(i)} }
-
-func (s MemStats_BySize_List) Set(i int, v MemStats_BySize) error {
+func (s CommandPipeline_stages_Results_List) Set(i int, v CommandPipeline_stages_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s MemStats_BySize_List) String() string {
-	str, _ := text.MarshalList(0xc3e472677f9be8ad, s.List)
+func (s CommandPipeline_stages_Results_List) String() string {
+	str, _ := text.MarshalList(0xb439f5d67bfb0257, s.List)
 	return str
 }
 
-// MemStats_BySize_Promise is a wrapper for a MemStats_BySize promised by a client call.
-type MemStats_BySize_Promise struct{ *capnp.Pipeline }
+// CommandPipeline_stages_Results_Promise is a wrapper for a CommandPipeline_stages_Results promised by a client call.
+type CommandPipeline_stages_Results_Promise struct{ *capnp.Pipeline }
 
-func (p MemStats_BySize_Promise) Struct() (MemStats_BySize, error) {
+func (p CommandPipeline_stages_Results_Promise) Struct() (CommandPipeline_stages_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return MemStats_BySize{s}, err
+	return CommandPipeline_stages_Results{s}, err
 }
 
-type Configs struct{ Client capnp.Client }
+type CommandPipeline_setStagePoolSize_Params struct{ capnp.Struct }
 
-// Configs_TypeID is the unique identifier for the type Configs.
-const Configs_TypeID = 0xa391f67e209a873d
+// CommandPipeline_setStagePoolSize_Params_TypeID is the unique identifier for the type CommandPipeline_setStagePoolSize_Params.
+const CommandPipeline_setStagePoolSize_Params_TypeID = 0xfbbe1f89ef0e7eb5
 
-func (c Configs) ConfigDir(ctx context.Context, params func(Configs_configDir_Params) error, opts ...capnp.CallOption) Configs_configDir_Results_Promise {
-	if c.Client == nil {
-		return Configs_configDir_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "configDir",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_configDir_Params{Struct: s}) }
-	}
-	return Configs_configDir_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
-}
-func (c Configs) ConfigDirExists(ctx context.Context, params func(Configs_configDirExists_Params) error, opts ...capnp.CallOption) Configs_configDirExists_Results_Promise {
-	if c.Client == nil {
-		return Configs_configDirExists_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "configDirExists",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_configDirExists_Params{Struct: s}) }
-	}
-	return Configs_configDirExists_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+func NewCommandPipeline_setStagePoolSize_Params(s *capnp.Segment) (CommandPipeline_setStagePoolSize_Params, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CommandPipeline_setStagePoolSize_Params{st}, err
 }
-func (c Configs) ServiceIds(ctx context.Context, params func(Configs_serviceIds_Params) error, opts ...capnp.CallOption) Configs_serviceIds_Results_Promise {
-	if c.Client == nil {
-		return Configs_serviceIds_Results_Promise{Pipeline: capnp.NewPipeline(capnp.ErrorAnswer(capnp.ErrNullClient))}
-	}
-	call := &capnp.Call{
-		Ctx: ctx,
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "serviceIds",
-		},
-		Options: capnp.NewCallOptions(opts),
-	}
-	if params != nil {
-		call.ParamsSize = capnp.ObjectSize{DataSize: 0, PointerCount: 0}
-		call.ParamsFunc = func(s capnp.Struct) error { return params(Configs_serviceIds_Params{Struct: s}) }
-	}
-	return Configs_serviceIds_Results_Promise{Pipeline: capnp.NewPipeline(c.Client.Call(call))}
+
+func NewRootCommandPipeline_setStagePoolSize_Params(s *capnp.Segment) (CommandPipeline_setStagePoolSize_Params, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return CommandPipeline_setStagePoolSize_Params{st}, err
 }
 
-type Configs_Server interface {
-	ConfigDir(Configs_configDir) error
+func ReadRootCommandPipeline_setStagePoolSize_Params(msg *capnp.Message) (CommandPipeline_setStagePoolSize_Params, error) {
+	root, err := msg.RootPtr()
+	return CommandPipeline_setStagePoolSize_Params{root.Struct()}, err
+}
 
-	ConfigDirExists(Configs_configDirExists) error
+func (s CommandPipeline_setStagePoolSize_Params) String() string {
+	str, _ := text.Marshal(0xfbbe1f89ef0e7eb5, s.Struct)
+	return str
+}
 
-	ServiceIds(Configs_serviceIds) error
+func (s CommandPipeline_setStagePoolSize_Params) Stage() uint16 {
+	return s.Struct.Uint16(0)
 }
 
-func Configs_ServerToClient(s Configs_Server) Configs {
-	c, _ := s.(server.Closer)
-	return Configs{Client: server.New(Configs_Methods(nil, s), c)}
+func (s CommandPipeline_setStagePoolSize_Params) SetStage(v uint16) {
+	s.Struct.SetUint16(0, v)
 }
 
-func Configs_Methods(methods []server.Method, s Configs_Server) []server.Method {
-	if cap(methods) == 0 {
-		methods = make([]server.Method, 0, 3)
-	}
+func (s CommandPipeline_setStagePoolSize_Params) PoolSize() uint8 {
+	return s.Struct.Uint8(2)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      0,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "configDir",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Configs_configDir{c, opts, Configs_configDir_Params{Struct: p}, Configs_configDir_Results{Struct: r}}
-			return s.ConfigDir(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+func (s CommandPipeline_setStagePoolSize_Params) SetPoolSize(v uint8) {
+	s.Struct.SetUint8(2, v)
+}
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      1,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "configDirExists",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Configs_configDirExists{c, opts, Configs_configDirExists_Params{Struct: p}, Configs_configDirExists_Results{Struct: r}}
-			return s.ConfigDirExists(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 8, PointerCount: 0},
-	})
+// CommandPipeline_setStagePoolSize_Params_List is a list of CommandPipeline_setStagePoolSize_Params.
+type CommandPipeline_setStagePoolSize_Params_List struct{ capnp.List }
 
-	methods = append(methods, server.Method{
-		Method: capnp.Method{
-			InterfaceID:   0xa391f67e209a873d,
-			MethodID:      2,
-			InterfaceName: "app.capnp:Configs",
-			MethodName:    "serviceIds",
-		},
-		Impl: func(c context.Context, opts capnp.CallOptions, p, r capnp.Struct) error {
-			call := Configs_serviceIds{c, opts, Configs_serviceIds_Params{Struct: p}, Configs_serviceIds_Results{Struct: r}}
-			return s.ServiceIds(call)
-		},
-		ResultsSize: capnp.ObjectSize{DataSize: 0, PointerCount: 1},
-	})
+// NewCommandPipeline_setStagePoolSize_Params creates a new list of CommandPipeline_setStagePoolSize_Params.
+func NewCommandPipeline_setStagePoolSize_Params_List(s *capnp.Segment, sz int32) (CommandPipeline_setStagePoolSize_Params_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return CommandPipeline_setStagePoolSize_Params_List{l}, err
+}
 
-	return methods
+func (s CommandPipeline_setStagePoolSize_Params_List) At(i int) CommandPipeline_setStagePoolSize_Params {
+	return CommandPipeline_setStagePoolSize_Params{s.List.Struct(i)}
 }
 
-// Configs_configDir holds the arguments for a server call to Configs.configDir.
-type Configs_configDir struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Configs_configDir_Params
-	Results Configs_configDir_Results
+func (s CommandPipeline_setStagePoolSize_Params_List) Set(i int, v CommandPipeline_setStagePoolSize_Params) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-// Configs_configDirExists holds the arguments for a server call to Configs.configDirExists.
-type Configs_configDirExists struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Configs_configDirExists_Params
-	Results Configs_configDirExists_Results
+func (s CommandPipeline_setStagePoolSize_Params_List) String() string {
+	str, _ := text.MarshalList(0xfbbe1f89ef0e7eb5, s.List)
+	return str
 }
 
-// Configs_serviceIds holds the arguments for a server call to Configs.serviceIds.
-type Configs_serviceIds struct {
-	Ctx     context.Context
-	Options capnp.CallOptions
-	Params  Configs_serviceIds_Params
-	Results Configs_serviceIds_Results
+// CommandPipeline_setStagePoolSize_Params_Promise is a wrapper for a CommandPipeline_setStagePoolSize_Params promised by a client call.
+type CommandPipeline_setStagePoolSize_Params_Promise struct{ *capnp.Pipeline }
+
+func (p CommandPipeline_setStagePoolSize_Params_Promise) Struct() (CommandPipeline_setStagePoolSize_Params, error) {
+	s, err := p.Pipeline.Struct()
+	return CommandPipeline_setStagePoolSize_Params{s}, err
 }
 
-type Configs_configDir_Params struct{ capnp.Struct }
+type CommandPipeline_setStagePoolSize_Results struct{ capnp.Struct }
 
-// Configs_configDir_Params_TypeID is the unique identifier for the type Configs_configDir_Params.
-const Configs_configDir_Params_TypeID = 0x98be837673e8652a
+// CommandPipeline_setStagePoolSize_Results_TypeID is the unique identifier for the type CommandPipeline_setStagePoolSize_Results.
+const CommandPipeline_setStagePoolSize_Results_TypeID = 0xfc66927637304d83
 
-func NewConfigs_configDir_Params(s *capnp.Segment) (Configs_configDir_Params, error) {
+func NewCommandPipeline_setStagePoolSize_Results(s *capnp.Segment) (CommandPipeline_setStagePoolSize_Results, error) {
 	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_configDir_Params{st}, err
+	return CommandPipeline_setStagePoolSize_Results{st}, err
 }
 
-func NewRootConfigs_configDir_Params(s *capnp.Segment) (Configs_configDir_Params, error) {
+func NewRootCommandPipeline_setStagePoolSize_Results(s *capnp.Segment) (CommandPipeline_setStagePoolSize_Results, error) {
 	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_configDir_Params{st}, err
+	return CommandPipeline_setStagePoolSize_Results{st}, err
 }
 
-func ReadRootConfigs_configDir_Params(msg *capnp.Message) (Configs_configDir_Params, error) {
+func ReadRootCommandPipeline_setStagePoolSize_Results(msg *capnp.Message) (CommandPipeline_setStagePoolSize_Results, error) {
 	root, err := msg.RootPtr()
-	return Configs_configDir_Params{root.Struct()}, err
+	return CommandPipeline_setStagePoolSize_Results{root.Struct()}, err
 }
 
-func (s Configs_configDir_Params) String() string {
-	str, _ := text.Marshal(0x98be837673e8652a, s.Struct)
+func (s CommandPipeline_setStagePoolSize_Results) String() string {
+	str, _ := text.Marshal(0xfc66927637304d83, s.Struct)
 	return str
 }
 
-// Configs_configDir_Params_List is a list of Configs_configDir_Params.
-type Configs_configDir_Params_List struct{ capnp.List }
+// CommandPipeline_setStagePoolSize_Results_List is a list of CommandPipeline_setStagePoolSize_Results.
+type CommandPipeline_setStagePoolSize_Results_List struct{ capnp.List }
 
-// NewConfigs_configDir_Params creates a new list of Configs_configDir_Params.
-func NewConfigs_configDir_Params_List(s *capnp.Segment, sz int32) (Configs_configDir_Params_List, error) {
+// NewCommandPipeline_setStagePoolSize_Results creates a new list of CommandPipeline_setStagePoolSize_Results.
+func NewCommandPipeline_setStagePoolSize_Results_List(s *capnp.Segment, sz int32) (CommandPipeline_setStagePoolSize_Results_List, error) {
 	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Configs_configDir_Params_List{l}, err
+	return CommandPipeline_setStagePoolSize_Results_List{l}, err
 }
 
-func (s Configs_configDir_Params_List) At(i int) Configs_configDir_Params {
-	return Configs_configDir_Params{s.List.Struct(i)}
+func (s CommandPipeline_setStagePoolSize_Results_List) At(i int) CommandPipeline_setStagePoolSize_Results {
+	return CommandPipeline_setStagePoolSize_Results{s.List.Struct(i)}
 }
 
-func (s Configs_configDir_Params_List) Set(i int, v Configs_configDir_Params) error {
+func (s CommandPipeline_setStagePoolSize_Results_List) Set(i int, v CommandPipeline_setStagePoolSize_Results) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Configs_configDir_Params_List) String() string {
-	str, _ := text.MarshalList(0x98be837673e8652a, s.List)
+func (s CommandPipeline_setStagePoolSize_Results_List) String() string {
+	str, _ := text.MarshalList(0xfc66927637304d83, s.List)
 	return str
 }
 
-// Configs_configDir_Params_Promise is a wrapper for a Configs_configDir_Params promised by a client call.
-type Configs_configDir_Params_Promise struct{ *capnp.Pipeline }
+// CommandPipeline_setStagePoolSize_Results_Promise is a wrapper for a CommandPipeline_setStagePoolSize_Results promised by a client call.
+type CommandPipeline_setStagePoolSize_Results_Promise struct{ *capnp.Pipeline }
 
-func (p Configs_configDir_Params_Promise) Struct() (Configs_configDir_Params, error) {
+func (p CommandPipeline_setStagePoolSize_Results_Promise) Struct() (CommandPipeline_setStagePoolSize_Results, error) {
 	s, err := p.Pipeline.Struct()
-	return Configs_configDir_Params{s}, err
+	return CommandPipeline_setStagePoolSize_Results{s}, err
 }
 
-type Configs_configDir_Results struct{ capnp.Struct }
+type PipelineStage struct{ capnp.Struct }
 
-// Configs_configDir_Results_TypeID is the unique identifier for the type Configs_configDir_Results.
-const Configs_configDir_Results_TypeID = 0xf6b932063d110fed
+// PipelineStage_TypeID is the unique identifier for the type PipelineStage.
+const PipelineStage_TypeID = 0xee3ea08f08231e22
 
-func NewConfigs_configDir_Results(s *capnp.Segment) (Configs_configDir_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Configs_configDir_Results{st}, err
+func NewPipelineStage(s *capnp.Segment) (PipelineStage, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return PipelineStage{st}, err
+}
+
+func NewRootPipelineStage(s *capnp.Segment) (PipelineStage, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return PipelineStage{st}, err
+}
+
+func ReadRootPipelineStage(msg *capnp.Message) (PipelineStage, error) {
+	root, err := msg.RootPtr()
+	return PipelineStage{root.Struct()}, err
+}
+
+func (s PipelineStage) String() string {
+	str, _ := text.Marshal(0xee3ea08f08231e22, s.Struct)
+	return str
+}
+
+func (s PipelineStage) CommandId() uint64 {
+	return s.Struct.Uint64(0)
 }
 
-func NewRootConfigs_configDir_Results(s *capnp.Segment) (Configs_configDir_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Configs_configDir_Results{st}, err
+func (s PipelineStage) SetCommandId(v uint64) {
+	s.Struct.SetUint64(0, v)
 }
 
-func ReadRootConfigs_configDir_Results(msg *capnp.Message) (Configs_configDir_Results, error) {
-	root, err := msg.RootPtr()
-	return Configs_configDir_Results{root.Struct()}, err
+func (s PipelineStage) PoolSize() uint8 {
+	return s.Struct.Uint8(8)
 }
 
-func (s Configs_configDir_Results) String() string {
-	str, _ := text.Marshal(0xf6b932063d110fed, s.Struct)
-	return str
+func (s PipelineStage) SetPoolSize(v uint8) {
+	s.Struct.SetUint8(8, v)
 }
 
-func (s Configs_configDir_Results) ConfigDir() (string, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.Text(), err
+func (s PipelineStage) BufferSize() uint16 {
+	return s.Struct.Uint16(10)
 }
 
-func (s Configs_configDir_Results) HasConfigDir() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+func (s PipelineStage) SetBufferSize(v uint16) {
+	s.Struct.SetUint16(10, v)
 }
 
-func (s Configs_configDir_Results) ConfigDirBytes() ([]byte, error) {
-	p, err := s.Struct.Ptr(0)
-	return p.TextBytes(), err
+func (s PipelineStage) Autoscaled() bool {
+	return s.Struct.Bit(72)
 }
 
-func (s Configs_configDir_Results) SetConfigDir(v string) error {
-	return s.Struct.SetText(0, v)
+func (s PipelineStage) SetAutoscaled(v bool) {
+	s.Struct.SetBit(72, v)
 }
 
-// Configs_configDir_Results_List is a list of Configs_configDir_Results.
-type Configs_configDir_Results_List struct{ capnp.List }
+// PipelineStage_List is a list of PipelineStage.
+type PipelineStage_List struct{ capnp.List }
 
-// NewConfigs_configDir_Results creates a new list of Configs_configDir_Results.
-func NewConfigs_configDir_Results_List(s *capnp.Segment, sz int32) (Configs_configDir_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return Configs_configDir_Results_List{l}, err
+// NewPipelineStage creates a new list of PipelineStage.
+func NewPipelineStage_List(s *capnp.Segment, sz int32) (PipelineStage_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return PipelineStage_List{l}, err
 }
 
-func (s Configs_configDir_Results_List) At(i int) Configs_configDir_Results {
-	return Configs_configDir_Results{s.List.Struct(i)}
+func (s PipelineStage_List) At(i int) PipelineStage {
+	return PipelineStage{s.List.Struct(i)}
 }
 
-func (s Configs_configDir_Results_List) Set(i int, v Configs_configDir_Results) error {
+func (s PipelineStage_List) Set(i int, v PipelineStage) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Configs_configDir_Results_List) String() string {
-	str, _ := text.MarshalList(0xf6b932063d110fed, s.List)
+func (s PipelineStage_List) String() string {
+	str, _ := text.MarshalList(0xee3ea08f08231e22, s.List)
 	return str
 }
 
-// Configs_configDir_Results_Promise is a wrapper for a Configs_configDir_Results promised by a client call.
-type Configs_configDir_Results_Promise struct{ *capnp.Pipeline }
+// PipelineStage_Promise is a wrapper for a PipelineStage promised by a client call.
+type PipelineStage_Promise struct{ *capnp.Pipeline }
 
-func (p Configs_configDir_Results_Promise) Struct() (Configs_configDir_Results, error) {
+func (p PipelineStage_Promise) Struct() (PipelineStage, error) {
 	s, err := p.Pipeline.Struct()
-	return Configs_configDir_Results{s}, err
+	return PipelineStage{s}, err
 }
 
-type Configs_configDirExists_Params struct{ capnp.Struct }
+type LogEvent struct{ capnp.Struct }
 
-// Configs_configDirExists_Params_TypeID is the unique identifier for the type Configs_configDirExists_Params.
-const Configs_configDirExists_Params_TypeID = 0x99ad308062b9e970
+// LogEvent_TypeID is the unique identifier for the type LogEvent.
+const LogEvent_TypeID = 0xdce17e7ebaa4018d
 
-func NewConfigs_configDirExists_Params(s *capnp.Segment) (Configs_configDirExists_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_configDirExists_Params{st}, err
+func NewLogEvent(s *capnp.Segment) (LogEvent, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return LogEvent{st}, err
 }
 
-func NewRootConfigs_configDirExists_Params(s *capnp.Segment) (Configs_configDirExists_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_configDirExists_Params{st}, err
+func NewRootLogEvent(s *capnp.Segment) (LogEvent, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3})
+	return LogEvent{st}, err
 }
 
-func ReadRootConfigs_configDirExists_Params(msg *capnp.Message) (Configs_configDirExists_Params, error) {
+func ReadRootLogEvent(msg *capnp.Message) (LogEvent, error) {
 	root, err := msg.RootPtr()
-	return Configs_configDirExists_Params{root.Struct()}, err
+	return LogEvent{root.Struct()}, err
 }
 
-func (s Configs_configDirExists_Params) String() string {
-	str, _ := text.Marshal(0x99ad308062b9e970, s.Struct)
+func (s LogEvent) String() string {
+	str, _ := text.Marshal(0xdce17e7ebaa4018d, s.Struct)
 	return str
 }
 
-// Configs_configDirExists_Params_List is a list of Configs_configDirExists_Params.
-type Configs_configDirExists_Params_List struct{ capnp.List }
+func (s LogEvent) Id() uint64 {
+	return s.Struct.Uint64(0)
+}
 
-// NewConfigs_configDirExists_Params creates a new list of Configs_configDirExists_Params.
-func NewConfigs_configDirExists_Params_List(s *capnp.Segment, sz int32) (Configs_configDirExists_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Configs_configDirExists_Params_List{l}, err
+func (s LogEvent) SetId(v uint64) {
+	s.Struct.SetUint64(0, v)
 }
 
-func (s Configs_configDirExists_Params_List) At(i int) Configs_configDirExists_Params {
-	return Configs_configDirExists_Params{s.List.Struct(i)}
+func (s LogEvent) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
 }
 
-func (s Configs_configDirExists_Params_List) Set(i int, v Configs_configDirExists_Params) error {
-	return s.List.SetStruct(i, v.Struct)
+func (s LogEvent) HasName() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s Configs_configDirExists_Params_List) String() string {
-	str, _ := text.MarshalList(0x99ad308062b9e970, s.List)
-	return str
+func (s LogEvent) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
 }
 
-// Configs_configDirExists_Params_Promise is a wrapper for a Configs_configDirExists_Params promised by a client call.
-type Configs_configDirExists_Params_Promise struct{ *capnp.Pipeline }
+func (s LogEvent) SetName(v string) error {
+	return s.Struct.SetText(0, v)
+}
 
-func (p Configs_configDirExists_Params_Promise) Struct() (Configs_configDirExists_Params, error) {
-	s, err := p.Pipeline.Struct()
-	return Configs_configDirExists_Params{s}, err
+func (s LogEvent) Level() LogLevel {
+	return LogLevel(s.Struct.Uint16(8))
 }
 
-type Configs_configDirExists_Results struct{ capnp.Struct }
+func (s LogEvent) SetLevel(v LogLevel) {
+	s.Struct.SetUint16(8, uint16(v))
+}
 
-// Configs_configDirExists_Results_TypeID is the unique identifier for the type Configs_configDirExists_Results.
-const Configs_configDirExists_Results_TypeID = 0x9f8ae589a9e0a609
+func (s LogEvent) Description() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
 
-func NewConfigs_configDirExists_Results(s *capnp.Segment) (Configs_configDirExists_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Configs_configDirExists_Results{st}, err
+func (s LogEvent) HasDescription() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
 }
 
-func NewRootConfigs_configDirExists_Results(s *capnp.Segment) (Configs_configDirExists_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
-	return Configs_configDirExists_Results{st}, err
+func (s LogEvent) DescriptionBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
 }
 
-func ReadRootConfigs_configDirExists_Results(msg *capnp.Message) (Configs_configDirExists_Results, error) {
-	root, err := msg.RootPtr()
-	return Configs_configDirExists_Results{root.Struct()}, err
+func (s LogEvent) SetDescription(v string) error {
+	return s.Struct.SetText(1, v)
 }
 
-func (s Configs_configDirExists_Results) String() string {
-	str, _ := text.Marshal(0x9f8ae589a9e0a609, s.Struct)
-	return str
+func (s LogEvent) Fields() (LogEventField_List, error) {
+	p, err := s.Struct.Ptr(2)
+	return LogEventField_List{List: p.List()}, err
 }
 
-func (s Configs_configDirExists_Results) Exists() bool {
-	return s.Struct.Bit(0)
+func (s LogEvent) HasFields() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
 }
 
-func (s Configs_configDirExists_Results) SetExists(v bool) {
-	s.Struct.SetBit(0, v)
+func (s LogEvent) SetFields(v LogEventField_List) error {
+	return s.Struct.SetPtr(2, v.List.ToPtr())
 }
 
-// Configs_configDirExists_Results_List is a list of Configs_configDirExists_Results.
-type Configs_configDirExists_Results_List struct{ capnp.List }
+// NewFields sets the fields field to a newly
+// allocated LogEventField_List, preferring placement in s's segment.
+func (s LogEvent) NewFields(n int32) (LogEventField_List, error) {
+	l, err := NewLogEventField_List(s.Struct.Segment(), n)
+	if err != nil {
+		return LogEventField_List{}, err
+	}
+	err = s.Struct.SetPtr(2, l.List.ToPtr())
+	return l, err
+}
 
-// NewConfigs_configDirExists_Results creates a new list of Configs_configDirExists_Results.
-func NewConfigs_configDirExists_Results_List(s *capnp.Segment, sz int32) (Configs_configDirExists_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
-	return Configs_configDirExists_Results_List{l}, err
+// LogEvent_List is a list of LogEvent.
+type LogEvent_List struct{ capnp.List }
+
+// NewLogEvent creates a new list of LogEvent.
+func NewLogEvent_List(s *capnp.Segment, sz int32) (LogEvent_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 3}, sz)
+	return LogEvent_List{l}, err
 }
 
-func (s Configs_configDirExists_Results_List) At(i int) Configs_configDirExists_Results {
-	return Configs_configDirExists_Results{s.List.Struct(i)}
+func (s LogEvent_List) At(i int) LogEvent {
+	return LogEvent{s.List.Struct(i)}
 }
 
-func (s Configs_configDirExists_Results_List) Set(i int, v Configs_configDirExists_Results) error {
+func (s LogEvent_List) Set(i int, v LogEvent) error {
 	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Configs_configDirExists_Results_List) String() string {
-	str, _ := text.MarshalList(0x9f8ae589a9e0a609, s.List)
+func (s LogEvent_List) String() string {
+	str, _ := text.MarshalList(0xdce17e7ebaa4018d, s.List)
 	return str
 }
 
-// Configs_configDirExists_Results_Promise is a wrapper for a Configs_configDirExists_Results promised by a client call.
-type Configs_configDirExists_Results_Promise struct{ *capnp.Pipeline }
+// LogEvent_Promise is a wrapper for a LogEvent promised by a client call.
+type LogEvent_Promise struct{ *capnp.Pipeline }
 
-func (p Configs_configDirExists_Results_Promise) Struct() (Configs_configDirExists_Results, error) {
+func (p LogEvent_Promise) Struct() (LogEvent, error) {
 	s, err := p.Pipeline.Struct()
-	return Configs_configDirExists_Results{s}, err
+	return LogEvent{s}, err
 }
 
-type Configs_serviceIds_Params struct{ capnp.Struct }
+type LogEventField struct{ capnp.Struct }
 
-// Configs_serviceIds_Params_TypeID is the unique identifier for the type Configs_serviceIds_Params.
-const Configs_serviceIds_Params_TypeID = 0xb281d4535d7c4c6e
+// LogEventField_TypeID is the unique identifier for the type LogEventField.
+const LogEventField_TypeID = 0xbce2f3921396cb2d
 
-func NewConfigs_serviceIds_Params(s *capnp.Segment) (Configs_serviceIds_Params, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_serviceIds_Params{st}, err
+func NewLogEventField(s *capnp.Segment) (LogEventField, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return LogEventField{st}, err
 }
 
-func NewRootConfigs_serviceIds_Params(s *capnp.Segment) (Configs_serviceIds_Params, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0})
-	return Configs_serviceIds_Params{st}, err
+func NewRootLogEventField(s *capnp.Segment) (LogEventField, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return LogEventField{st}, err
 }
 
-func ReadRootConfigs_serviceIds_Params(msg *capnp.Message) (Configs_serviceIds_Params, error) {
+func ReadRootLogEventField(msg *capnp.Message) (LogEventField, error) {
 	root, err := msg.RootPtr()
-	return Configs_serviceIds_Params{root.Struct()}, err
+	return LogEventField{root.Struct()}, err
 }
 
-func (s Configs_serviceIds_Params) String() string {
-	str, _ := text.Marshal(0xb281d4535d7c4c6e, s.Struct)
+func (s LogEventField) String() string {
+	str, _ := text.Marshal(0xbce2f3921396cb2d, s.Struct)
 	return str
 }
 
-// Configs_serviceIds_Params_List is a list of Configs_serviceIds_Params.
-type Configs_serviceIds_Params_List struct{ capnp.List }
-
-// NewConfigs_serviceIds_Params creates a new list of Configs_serviceIds_Params.
-func NewConfigs_serviceIds_Params_List(s *capnp.Segment, sz int32) (Configs_serviceIds_Params_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 0}, sz)
-	return Configs_serviceIds_Params_List{l}, err
+func (s LogEventField) Name() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
 }
 
-func (s Configs_serviceIds_Params_List) At(i int) Configs_serviceIds_Params {
-	return Configs_serviceIds_Params{s.List.Struct(i)}
+func (s LogEventField) HasName() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
 }
 
-func (s Configs_serviceIds_Params_List) Set(i int, v Configs_serviceIds_Params) error {
-	return s.List.SetStruct(i, v.Struct)
+func (s LogEventField) NameBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
 }
 
-func (s Configs_serviceIds_Params_List) String() string {
-	str, _ := text.MarshalList(0xb281d4535d7c4c6e, s.List)
-	return str
+func (s LogEventField) SetName(v string) error {
+	return s.Struct.SetText(0, v)
 }
 
-// Configs_serviceIds_Params_Promise is a wrapper for a Configs_serviceIds_Params promised by a client call.
-type Configs_serviceIds_Params_Promise struct{ *capnp.Pipeline }
+func (s LogEventField) Type() LogEventField_Type {
+	return LogEventField_Type(s.Struct.Uint16(0))
+}
 
-func (p Configs_serviceIds_Params_Promise) Struct() (Configs_serviceIds_Params, error) {
-	s, err := p.Pipeline.Struct()
-	return Configs_serviceIds_Params{s}, err
+func (s LogEventField) SetType(v LogEventField_Type) {
+	s.Struct.SetUint16(0, uint16(v))
 }
 
-type Configs_serviceIds_Results struct{ capnp.Struct }
+func (s LogEventField) Description() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
 
-// Configs_serviceIds_Results_TypeID is the unique identifier for the type Configs_serviceIds_Results.
-const Configs_serviceIds_Results_TypeID = 0xa28ac6cb306f77a0
+func (s LogEventField) HasDescription() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
 
-func NewConfigs_serviceIds_Results(s *capnp.Segment) (Configs_serviceIds_Results, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Configs_serviceIds_Results{st}, err
+func (s LogEventField) DescriptionBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
 }
 
-func NewRootConfigs_serviceIds_Results(s *capnp.Segment) (Configs_serviceIds_Results, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1})
-	return Configs_serviceIds_Results{st}, err
+func (s LogEventField) SetDescription(v string) error {
+	return s.Struct.SetText(1, v)
 }
 
-func ReadRootConfigs_serviceIds_Results(msg *capnp.Message) (Configs_serviceIds_Results, error) {
-	root, err := msg.RootPtr()
-	return Configs_serviceIds_Results{root.Struct()}, err
+// LogEventField_List is a list of LogEventField.
+type LogEventField_List struct{ capnp.List }
+
+// NewLogEventField creates a new list of LogEventField.
+func NewLogEventField_List(s *capnp.Segment, sz int32) (LogEventField_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return LogEventField_List{l}, err
 }
 
-func (s Configs_serviceIds_Results) String() string {
-	str, _ := text.Marshal(0xa28ac6cb306f77a0, s.Struct)
-	return str
+func (s LogEventField_List) At(i int) LogEventField {
+	return LogEventField{s.List.Struct(i)}
 }
 
-func (s Configs_serviceIds_Results) ServiceIds() (capnp.UInt64List, error) {
-	p, err := s.Struct.Ptr(0)
-	return capnp.UInt64List{List: p.List()}, err
+func (s LogEventField_List) Set(i int, v LogEventField) error {
+	return s.List.SetStruct(i, v.Struct)
 }
 
-func (s Configs_serviceIds_Results) HasServiceIds() bool {
-	p, err := s.Struct.Ptr(0)
-	return p.IsValid() || err != nil
+func (s LogEventField_List) String() string {
+	str, _ := text.MarshalList(0xbce2f3921396cb2d, s.List)
+	return str
 }
 
-func (s Configs_serviceIds_Results) SetServiceIds(v capnp.UInt64List) error {
-	return s.Struct.SetPtr(0, v.List.ToPtr())
+// LogEventField_Promise is a wrapper for a LogEventField promised by a client call.
+type LogEventField_Promise struct{ *capnp.Pipeline }
+
+func (p LogEventField_Promise) Struct() (LogEventField, error) {
+	s, err := p.Pipeline.Struct()
+	return LogEventField{s}, err
 }
 
-// NewServiceIds sets the serviceIds field to a newly
-// allocated capnp.UInt64List, preferring placement in s's segment.
-func (s Configs_serviceIds_Results) NewServiceIds(n int32) (capnp.UInt64List, error) {
-	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
-	if err != nil {
-		return capnp.UInt64List{}, err
+type LogEventField_Type uint16
+
+// LogEventField_Type_TypeID is the unique identifier for the type LogEventField_Type.
+const LogEventField_Type_TypeID = 0x98785db80ec407e2
+
+// Values of LogEventField_Type.
+const (
+	LogEventField_Type_string   LogEventField_Type = 0
+	LogEventField_Type_text     LogEventField_Type = 1
+	LogEventField_Type_id       LogEventField_Type = 2
+	LogEventField_Type_int      LogEventField_Type = 3
+	LogEventField_Type_float    LogEventField_Type = 4
+	LogEventField_Type_bool     LogEventField_Type = 5
+	LogEventField_Type_time     LogEventField_Type = 6
+	LogEventField_Type_duration LogEventField_Type = 7
+	LogEventField_Type_object   LogEventField_Type = 8
+)
+
+// String returns the enum's constant name.
+func (c LogEventField_Type) String() string {
+	switch c {
+	case LogEventField_Type_string:
+		return "string"
+	case LogEventField_Type_text:
+		return "text"
+	case LogEventField_Type_id:
+		return "id"
+	case LogEventField_Type_int:
+		return "int"
+	case LogEventField_Type_float:
+		return "float"
+	case LogEventField_Type_bool:
+		return "bool"
+	case LogEventField_Type_time:
+		return "time"
+	case LogEventField_Type_duration:
+		return "duration"
+	case LogEventField_Type_object:
+		return "object"
+
+	default:
+		return ""
 	}
-	err = s.Struct.SetPtr(0, l.List.ToPtr())
-	return l, err
 }
 
-// Configs_serviceIds_Results_List is a list of Configs_serviceIds_Results.
-type Configs_serviceIds_Results_List struct{ capnp.List }
+// LogEventField_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func LogEventField_TypeFromString(c string) LogEventField_Type {
+	switch c {
+	case "string":
+		return LogEventField_Type_string
+	case "text":
+		return LogEventField_Type_text
+	case "id":
+		return LogEventField_Type_id
+	case "int":
+		return LogEventField_Type_int
+	case "float":
+		return LogEventField_Type_float
+	case "bool":
+		return LogEventField_Type_bool
+	case "time":
+		return LogEventField_Type_time
+	case "duration":
+		return LogEventField_Type_duration
+	case "object":
+		return LogEventField_Type_object
 
-// NewConfigs_serviceIds_Results creates a new list of Configs_serviceIds_Results.
-func NewConfigs_serviceIds_Results_List(s *capnp.Segment, sz int32) (Configs_serviceIds_Results_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 0, PointerCount: 1}, sz)
-	return Configs_serviceIds_Results_List{l}, err
+	default:
+		return 0
+	}
 }
 
-func (s Configs_serviceIds_Results_List) At(i int) Configs_serviceIds_Results {
-	return Configs_serviceIds_Results{s.List.Struct(i)}
-}
+type LogEventField_Type_List struct{ capnp.List }
 
-func (s Configs_serviceIds_Results_List) Set(i int, v Configs_serviceIds_Results) error {
-	return s.List.SetStruct(i, v.Struct)
+func NewLogEventField_Type_List(s *capnp.Segment, sz int32) (LogEventField_Type_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return LogEventField_Type_List{l.List}, err
 }
 
-func (s Configs_serviceIds_Results_List) String() string {
-	str, _ := text.MarshalList(0xa28ac6cb306f77a0, s.List)
-	return str
+func (l LogEventField_Type_List) At(i int) LogEventField_Type {
+	ul := capnp.UInt16List{List: l.List}
+	return LogEventField_Type(ul.At(i))
 }
 
-// Configs_serviceIds_Results_Promise is a wrapper for a Configs_serviceIds_Results promised by a client call.
-type Configs_serviceIds_Results_Promise struct{ *capnp.Pipeline }
-
-func (p Configs_serviceIds_Results_Promise) Struct() (Configs_serviceIds_Results, error) {
-	s, err := p.Pipeline.Struct()
-	return Configs_serviceIds_Results{s}, err
+func (l LogEventField_Type_List) Set(i int, v LogEventField_Type) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
 }
 
-const schema_db8274f9144abc7e = "x\xda\x9cZ}xT\xd5\x99\x7f\xdf{\xe7#_3" +
-	"\x93\xcb\x99($\x86\x19Y\x15a\x95&\xa1>\xad\xd9" +
-	"\xf2\xe4K$\xc9\x1343\x03\xb5F\xf1\xe9d\xe6\x92" +
-	"\x0c\xcc\xdc\x19\xee\x9dAbA\xd0\x9amqe5\xa8" +
-	"Xh\xad\x1f+*}\xc4U\xb6\xecnv\xc5\x0f\x14" +
-	"\x90\"\xdd\x07\x17\xabm\xe5\x11V\xd9\xa2\x95\xaeXk" +
-	"\x17\x84\xce>\xef\x9993w>Ht\xffJ\xe6=" +
-	"\xefy\xbf\xce\xef\x9c\xf3\xbe\xef\xb9M\xdf\xadj\x97\x9a" +
-	"\xad\x17N\x05\x08LE\xab\xed\xdc\x8a\x1b\xb66\xec\xfc" +
-	"\xe0.\xc5\x89\x00\x16;\x00;U\xfd)X\xd2G\x7f" +
-	"\xb8w\xd3;\xbf\xbal\x14\x94\xba\xec\xc0\xdc\xb7\xaag" +
-	"#X\xd2So\x1f\xbdyh\xe9\xb1\x1f\xe6G\xd8\xae" +
-	"\xea3`I\xbf\xfd\xb1\xed\xa0\xfb;\xa7\xee\x85\xbc\xac" +
-	"\xc7\xf8\xc0\xc7\x8e]{\xacw\xfd\xef\xbd\xe0s\xa2\x10" +
-	"6Z-! [_\xdd\x06\x98~\xf9\x82\x0d\x1bn" +
-	"=\xf0\xde\x18(S\xc4\xcc\xed|\xe6#\x89/\x16>" +
-	"\xf0\xda\xe8F\xf0M\xc9\xcd\x1c\xab\xae\xa7\x99\x9b\xf9L" +
-	"\xe3\x9f;6D\xff\xf8\xc4F\xb3\xe8\xf1\x8c\xe8]\x9c" +
-	"a\x86\xfe\xd4\xd3G\x1fj\xb9\xdf\xccp,\xc3p\x9c" +
-	"3<yM\xfb\xbc/^\xdb\xf4\xa0\xc9jk\xcd\x9b" +
-	"`I?\xe0\x9a\x91\xda\xa5.\xdad2\xea\xc3\xea\xff" +
-	"\x02Kz\xb6z\xc2X\xf9\xfd\x17\x7f\x94\x1f\x98{\x90" +
-	"l\xb2\xa4\x13\x1f\x8e\x0f\xaek\xda\xbe\xd94\xb2\xb3\xba" +
-	"\x97F~\xff\xd6\x7fK\xa3\xad\xcfn\x01\xa5\x0a\xd3\xb7" +
-	"\xbf\xd0\xeb>\x9d\xbc\xf3\xb7`\x952A\xfa\x05\xdb^" +
-	"M\xffm\xab\xbe\x150]\xf9\xe4\xd1m\xeb\x8f\xdf\xfd" +
-	"\x88\xd9\xe9\xd1\x1a?\x99<VC&\xff\xb5\xde\xb7\xa9" +
-	"\xbe\xefo\x1e\x07_]\x8e\xe1\xf9\x9a\x16b\x18\xe7\x0c" +
-	"\x8f\xde\x1ao:\xb0\xf7\xee\xc73vX\x91\x18~]" +
-	"3\x9b\x18\x8eq\x86y?\xd8\xe2\xbd\xfd\xf3\xb1\x7f\x00" +
-	"\xa5J\xce\x9b\x03\xc8\xea\x1c\x1bY\xa3\x83l\x99\xe6\xd8" +
-	"\xc7\x1e\xa6\xff\xde\x9b\xd2\xe7\xdd[e\xd9\x9a\x0f \x1b" +
-	"u|Dk\xe7 Q\xef\xbc\xdb\xf7\xee\x13\xa7\xe2[" +
-	"M\xf1\xdb\xe6\xd8\x0d\x96\xf4\xe8C\xcf\xcc\xbe\xe8\x95\xf1" +
-	"'M\xc1X\xef\x98B\xc1h\xfd\xc3\x92i\xf6\x95\xcb" +
-	"\x9f2\xdb\xbf\xc21@\xe6\xad\xe12\x93\x07n\xf9{" +
-	"\xd7k\xef?Ub\xdec\x8e\x9f\xb1md\xd4\xdc\xad" +
-	"\x8e\x05\xc8\xd68\xed\x00\xe9\xd9\xbf\x95\x98^w\xf6i" +
-	"\xb3\xb7\xaas\x06\x89\x8b9\xdb\x00\xff\x1cI\xa4\xbb\xa7" +
-	"O\xd9\x967p\xccI\x10\xb7\xbetp\xf7YO\xe0" +
-	"\x99\x8c\xe5\x99y\xa9\xcc\xbc54/\xbd)\xd6\x11\xdd" +
-	"\xfcE\xf7v\x93k\xcf;?\x02K\xba\xfa\xc4\xca%" +
-	"[\xd4\xf0v\x93k\x0f:%r\xed\xa5\xab\x0e\xfc\xe5" +
-	"\xd5K\x87\x9f5\xc1\x8d\x8d8\xcf\xe4D~\xfd~\xf7" +
-	"\xc7\x17u\xdc\xb4\xa3\xc4\xb3\x87\x9d\x1b\xd9V'\x87\x83" +
-	"s\x1fkt\x91cZ\xdf\xea%\x81\xc3w\xec0\xa9" +
-	"\xb1\xbaf\x90\x9a%W~m\xe0\xa1\x06y\xa7\xc9t" +
-	"v\x92\xab9\xc5\xd5\\Z\xf3\xdc\x9d\xcf]\xb6h<" +
-	"o\xf9\xdc:\x177p\xf5ko\x7f\xeb\x09\xfd\x96\xf1" +
-	"\xcc\xee\xcd8}\xda\xb9\x8c\x9c\xb6\xbahj\xe7\xb3\xa9" +
-	"\x81O6\xed\x1f7)\xbd\xd4\xc5\xd1=\xf2\xc2m\xbd" +
-	"\xcd\xbf|\xf2ES8\x1c.\xda\xa5uW\xdf\xf0\xd9" +
-	"\xe9\xc7o{\xc9l\xcd)\xe7\xa7\x80\xecO\xdc\x9a\x9f" +
-	"\xda\xde\x1b?\xf8\x8d\xe9\xbb\xcd\xeb\xcd\xcdA6\x8d\xeb" +
-	"|\xf1\x07\xc7j\x1fX\xb6\xeeU3\xe2\xe7\xb9\xaa\x88" +
-	"a>g\xd8~\xe2\xc7k\x87\xf4\x0f^\x05\x1fC\xf9" +
-	"\xdce\x7f\xfb\xfe\xfb\xca'\x07\xb9\x05\xaa\xebM\xb6\x82" +
-	"\xa2\xc5b\xae\x7f\x04L?wS\xf4\xf6\x7f\x7f\xe6\xec" +
-	">\xd3\xb9e\xad\xf5\x93\xf9\xcagu\x87\xac\xd5{_" +
-	"7\xc3\xe4\x94\x8b\x9f%\xa7\xb9\x12\xcb\x87+\xee\xd5\x8f" +
-	"\x1dy\xc3d\x05\x9bVKHo\xacm\x03\x14j}" +
-	"UXo\xda\xc22\xb1u\xd4na=\xb5\x17\x02\xcc" +
-	"\xf5\xd5>d\x03L\xffa\xcbm\x0f=={\xdd/" +
-	"A\xa9\x92\x0a\xd6y\xb4~\x0b\xdbPOs\xd6\xd7\x0f" +
-	"\x01\xa6\xf7~o\xd1\x99\xcbg\xdd\xf8\xa6\x190\xdb\xeb" +
-	"\x7f\x01\xc8\x9e\xaf'\xa3*\xbe\xbd\xcfr\xa5\xe2;l" +
-	"\x0a\xfa\xa1\xfa\xdf\x80%\xdds\xc1\xd7~\xfa\xfa\x1d\x86" +
-	"i`\xee\xcez\xbeN\xc1\xad\xff\xb2cc\xb3\xed]" +
-	"S\x08\x1e\xae\x1f\xa4\x91\x8a{\xff\xd2~\xf1\xd1\xc7\x8f" +
-	"\x94\xa0o}\xfdF6FV\xcd\xddP\xbf\x00\x99\xda" +
-	"@\xf0\xbb\xe8\x8a\x1b\x87o\xfau\xe7q\xf3\xaa,l" +
-	"\xe0\xcb\xe6k \xdb\x96\x9c\xfd\xc6\xfd\xb5-O\x1c7" +
-	"GtE\x03\xdf@#\x9c\xe1~mA\xdb\x037\xb0" +
-	"\xdf\x99\x8c\xdf\xdc@\x1bh\xf7\x83\xd7\xbf\xbdzd\xf8" +
-	"\xf7\xe6\xad\xb7\xa6\x81/\xf8(\x9f\xd9mqo|\xf2" +
-	"\xfbu'M(\x1co\xe0\xdeI\x07v4>\xf3\xbd" +
-	"\xd5'\xcd`{\xac\x81\xc0\xb6\x95\xcf\xdc\xac\xfc\xd3]" +
-	"G\x7f\xe7\xff\xa4\xc4\xc7=\x0d:\xdbO\x8e\xcd\xdd\xd3" +
-	"`\x97\xd9+\x8d\xe4\xe3\x8c\xcf.>|\xe2\x83\x1f\x7f" +
-	"b6d[#7\xe4\xf9F\x12\xb7\xe7\xd3\xee\xfb\x1a" +
-	"\xfe*u\xca\x8c\xddC\x8d\xbd\xc4p\x843\xec\xfb\xe3" +
-	"\xe9\xff\xf9\xf3n\xcb\xe7\xa6h\x9fk\xec$KO\xba" +
-	"\x94y\xb6\x96\xf1\xcf\xcd\xe19\xde\xc8\xc3s\x92Ou" +
-	"\x8c\xc4\xfe\xae\xf6?:\xce\x98]Q\xa6\x93+u\xd3" +
-	"i|\xec\xd3G\xce:\xb7E\xcf\x98\x82p\xd5\xf4\x16" +
-	"\x12\xfd\xfe\xe2[6\x9d\xbcr\x8dy\xa4q:?[" +
-	"\xff3P\xb7\xfa\xb2C\x9f\x9f5/\x9auz+)" +
-	"uLo\x83o\xa6\x83\x89\xc4\x9cP0\xa1I\x89\xd6" +
-	"\x8eDbND3\x92A-\xa4^\xd2\xef\x09\xea\xc1" +
-	"\x98\x91\x1b\x97\x13\xad\xfe\xfe\xae\x80\xaa\xaf\x8c\x84\xd49" +
-	"\xb1\xe0\xaa\xae\xb8\xa6\x19\x97\xf4\x07]\x05lR\x01[" +
-	"$|I[\x7f\xb0\x98\x81\xf4\x18\xc9\xa0\x9eT\xc3\xd7" +
-	"k%\x0cr1\x83_5R\xd1\xa4\x01>\x8bl\x01" +
-	"\xb0 \x80\xe2\xf0\x03\xf8jd\xf4M\x950-8\x01" +
-	"5\xb4\x82\x84V@\xb36aK0\x1aY\xa9\x96\xd3" +
-	"&\x18\xa2\xf1\xa1>u\xa5\x1a\xcd(\x94\x93\x86Ya" +
-	"\x0b\x80\xafBF\x9f[BO\x94\xb8\xd0\x95\xdf\xd4\x80" +
-	"\xe82)\xcdz\xa0\xabQ5h\xa8=\xe1I=\x10" +
-	"\x9c\x80a\xac\x04\x09+\xcb\x08K\x84\xb2v^\x92\xf1" +
-	"\xa0@X}\xde:9R*#\x1b\xf3\xe5\x91h4" +
-	"3\x1b\x8dr\x11\x8a\x84i\xd4^\x14\x9e\xae\xb8\xb64" +
-	"2d\xcc\x09\xf1\xbf\xd7Dt.B61Y\xca0" +
-	"\xcd_\x151\x92\x86\xb0\x15\xcc\xea\xaeS\x93\xb7\xc6\xf5" +
-	"\xe5\x1d\xe1\xb0\xae\x1a\x06@?\xa2\xaf\"\xe7\xcb\xacN" +
-	"e\x96\xc7w\xb3\x8c\xbea\x09\x15D7\xc1VQ;" +
-	"\x15\xd5\xe3{ZF\xdf\xcf%\\\xabe$\xf8,(" +
-	"\xa5o\xb9\xff\x11\xdf\xae_\xdd\xbd\x07|\x16\x09;." +
-	"A\xac\x01h\xc6;1\xad\x05c\xaa7\xbe\xd4kM" +
-	"\x0e\xab\xde\xec\x0c\xef\xe5K\xe3\xbaW]\x15\x8c%\xa2" +
-	"\xea\x15\xde\x99\xc9Pb\xe6\x15\xde\x99\xa9pb\xe6," +
-	"\x00\xc0\x1a\x90\xb0\x06pm0c[\x19\x05_\xcf*" +
-	"\xf0Ki#\xa9G\xb4!\xef\xd2\x8a\xb8\x1e#E\xd9" +
-	"I\xc5:\x9a\xafn\x99\xd34\xa7eNsk\xcbU" +
-	"\xa4l\xe6M-MM\xcd\xad\xe1\xc1o\xb6\xb66/" +
-	"i\xfdf\xd3L\x9c\x95W\xfde\x82\x9a\x81\x13\x16\xe0" +
-	"\xb35\x8f\x806\x95\xb3!\x82\x84X\x88\xa4r;\xd8" +
-	"\xafz8:\xcf\x87\xf6P<\xa5%\xb1\x02$\xac(" +
-	"\x14&\xcc32\x12{\xc2\xdc2W\xb1\xac\x81,\xd0" +
-	"/\xa7\xad\x9ae\x059l\xa0\x13\xb0_F\x0eV\xa7" +
-	"I2\x0a\xc9h\x102jd+\xe5t\xd9\x1c\x1b\xc5" +
-	"!\xaa\xf8\xfc )=v\xc4\\\x96\x8d\"QV\xe6" +
-	"\xdd\x09\x92r\x95\x1d\xa5\\\xca\x84\"\x05Vf\x0d\x80" +
-	"\xa4\\lO\x8b\xa0\x02\xea\xed\x98\xfb\x85\xd9\x18C{" +
-	"\xa1\xb1\xed\xd8\x8f%\xfb\xc9\xc8mH~\x06~\x85\x0d" +
-	"i\xcb\x1e\xb6a\xb1A\xc4@\x99\xd3\xa8x\xc3Z\x0a" +
-	"\x961\x1a1\x92\xaa\xa6\xea\x1d\xfct+wr\xe9\xa6" +
-	"\x83Fp\x83\x87\xf3\x97@\x04\x85l\x17\x09\xa7\xf0\xbb" +
-	"y\xf8E\x8d\x87\"uS\xc6\xeaARF)\xfc\"" +
-	"\xc3B\x91\xc6+#:H\xca\x0a\x0a\xbfH=P\xa4" +
-	"\x99\x8aJK\xb3\xc4\x8er\xee\xa2Dq\xa5*\xbe\xc1" +
-	"\xcc\x92\xe6\xabM\x14\xa5\x8d2\xaf\x97/\xa9\x1c\x09\xb7" +
-	"\x97\xb8a\xa2\xa08U\xda1\x1d\x0c%#+\xd5\xae" +
-	"8\xd85\xcdh\xc7\xb4@<\x00\x14.'m\x8c\x94" +
-	"\x96\x8c\xc4T\xbauB\xcb\xafI\xc5\x12<\x94\xf6\"" +
-	"(\x17\xdd:\x9c\x130\x81\x0e\x90\xd0Qz\xde\xe6\x97" +
-	"\xd0S\xf6\x8a\xcb\x9f\xe9\xd9\xbdS\xa2\xf0\xab\xee\x9d\xac" +
-	"b=\xe3M\xc9\xe5l\xcb;\xaa\xa5b]\xfd\x8bK" +
-	"\xe1W`\xb7\xbfM\x9d\xf0h\x98\xec\"D\x01gT" +
-	"\xf3[Y\xd4\xd1(\xf2k\xc5GX\x9aOX\x125" +
-	"\"\x8aB_\xb9\x9a\xd6\xbd\x99\xb0$\x9a\x02(\xf2P" +
-	"\xe5\xd2\x16\x90\x94i\x02\x13Y\xa3\xf9\xeaz\x82\x19\\" +
-	"\x14\xadr\x99\x13\xab\xf8\xc2+N\x83\xca\x85`\xc0\x04" +
-	"\x03\xc1\x08rO\xb8\xe4\x0c\xaf(8'\xb2\xea\x0a\xe3" +
-	"]n+g \\\xf60\xed\xccG_\\RX\x9b" +
-	"\xef&\x00b-L\x02\xec\xe2\xdb[*\xceV\xce\x93" +
-	"\xb1e\x8e\xc8\xf2\xf7\x85\xd9\xac,\x1f*\xf9\xae\x02 " +
-	"*\xe7\xbd\x88\"\x93\xe7G9\xe8\x97\xcf\x8f\x8a0-" +
-	"\xa4\xc1W\xbb\xd1\xa4D\xebB5\x16H\x06\x93\xc6\x9c" +
-	"\xce\x91@\xe46\x01Z!c\xfel\x00_\xbb\x8c\xbe" +
-	">Sb\xd2C\xae_#\xa3\xaf_BE\x92\xdc(" +
-	"\x01(\x0bI[\xb7\x8c\xbeE\x12\xba\x8c\xc8m\xaaP" +
-	"\xb66\x16\x8cF\xe3!Cx\xe1Y\xaa\xab\xaaQ\xe2" +
-	"\xd3DG|\xf1u`\xf2?\x965\x7f\xd2\x04\xd6 " +
-	".\xac\x15\x15l\x11j\x0a3\xc2r(\xfc\xb2k\x83" +
-	"\"\xa4\xdc\x164\xd5\xed\x0a\xb6\xb6\xf1 \xab\xbeUB" +
-	".\xbbZnaW\xcb\x9e@T\x961\xb0J\xceG" +
-	"\x99\xa5\xe4\x016\"{\x02?\xa7\x91\x97\xe5|\xa8\xd9" +
-	".y\x06\xdb%{\x02\x9f\xc92\xfa-\x12*\xb2\xec" +
-	"F\x19\x80\x9d\x93;\xd99\xd9\x13\xf8\x96E\xc6@7" +
-	"\x8dX,n\xb4\x00\xb0\xf9\x96N6\xdf\xe2\x09\xac\xa3" +
-	"\x91{h\xc4ju\xa3\x95JpK\x0b[o\xf1\x04" +
-	"^\xa6\x917h\xc4fs\xa3\x0d\x80\xed\xb7\xf8\xd9A" +
-	"\x8b\xc7o\x951Pc\x95P\xb1\xdb\xdd\xbc>\xab\xb4" +
-	"v\xb2J\xab'\xd0N#}4RQ\xe1\xc6\x0a\x00" +
-	"\xd6c\xede\x0b\xad\x9e\xc0:\x1a\xb9\x87F*+\xdd" +
-	"XIj\xac~\xb6\xc1\xea\x09\xbc@#\xaf\xd3HU" +
-	"\x95\x1b\xab\x00\xd8\x1e\xeb2\xb6\xdf\xea\x09Xl2\x06" +
-	"jm\x12*\xd5\xd5n\xac\x06`\x0e\xdb Sl\x9e" +
-	"@7\x8d,\xa2\x91\x9a\x1a7\xa5\xa2\xccg\x1b\x00\x08" +
-	"\xf4\x13\xfdf\xa2;\x1cnt\x00\xb0\x1bm\xbd\x00\x81" +
-	"\xef\x10=Lt\xa7\xd3\x8dN\x00\x16\xe4\xfc\xdf%z" +
-	"\x94\xe8.\x97\x1b]\x00,\xc2\xf9\x87\x89\x9e$zm" +
-	"\xad\x1bk\x01\xd8\x0a\xdb @ A\xf4\xd5DW\x14" +
-	"7*\x00l\xc4\xe6\x07\x08\xac\"\xfa]D\x9f2\xc5" +
-	"\x8dS\x00\xd8\x1d\x9c\x7f\x1d\xd1\xef!:cnd\xe4" +
-	"\xb5\xad\x05 p\x17\xd1\xef#\xba\xdb\xedF7\x00\xdb" +
-	"\xc0\xf5\xdeC\xf4\x1f\x11\xbd\xae\xce\x8du\x00\xecA[" +
-	"+@\xe0>\xa2\xff\x84\xe8\x17\\\xe0\xc6\x0b\x00\xd8f" +
-	"N\x7f\x80\xe8\x8f\x12\xfd\xc2\x0b\xddx!\x00{\xd8\xb6" +
-	"\x0c \xf0\x13\xa2?m\x93\x10\xa7\xbaq*\x00\xdbj" +
-	"\xeb\x04\x08<J\xe4\x17\x88}\x1a\xbaq\x1a\x00\x1b\xe7" +
-	"j\xff\x95\xe8\x87\x89^\xdf\xe4\xc6z\x00v\x88\x9b\xf9" +
-	"\x06\xd1\xdf!zC\xb3\x1b\x1b\x00\xd8[\xdc\xad\xc3D" +
-	"?J\xf4\x8b\xa6\xb9\xf1\"\x00v\xc4\xa6\x03\x04\xde%" +
-	"\xfa\x09\xa27Jnl\x04`\xc7\xb9\x99G\x89~\xd6" +
-	"&\xd1\x8d\x14\x8d\x87&\xa8Xz1\xddA,\xde\x88" +
-	"a\xf1\x0e\x8e$U\x83\xd7\x14D\x0a&\xd5\xb0wX" +
-	"\x0d&\xbc\xf1\xc1ej(i\x00\xe6\xb6Z2\x9e\x0c" +
-	"F;\xa2Q\x90\xcbJ\xbf\"+\xfd7\x98^\x94\xe5" +
-	"\xb4q\x1d\xdeP*\x96\x8a\x06)C\xcaj\xcb\xab\xa2" +
-	"\xfa\xc5\xa4N6\xe6\x00\x08\x85vc\xa4\\Yty" +
-	"V\xcf\xbfa:0b\x90\xfc\xa4uX\xf5r\xeb\xf2" +
-	"\xde\xc4\xd4X\\\x1f\xf1\xc6\x07\x93\xc1\x88F\x8a\xf4x" +
-	"\xcc\x9bl\x1bV\xbd\xd7\x07L:\xd6F\xe3\xf1\xe5\xa9" +
-	"D9=MY=S\xa4t_\x86\xc9k'eT" +
-	"\xe2\xa5b\x83\xaaNj\x12\xf1\x88\x96TuoV\x8c" +
-	"7\xa1\xeaK\xe3zL\x0d{\x07G8k&?B" +
-	"\xd5\xa42{0O\x1c\xc2\x85\x19&\xaf-\xab\xd2\x14" +
-	"C~\xa7\x90r\xf3:\xe5b*\x87M\xeee\x0e\xfd" +
-	"\x09\x82\xf83L_K,\xde\x88a\xfdrz\x96\xea" +
-	".U5\xebH\xd30\xe1\x09p\"\xd0-\xc3tw" +
-	"\x96\xd1\xc2Q1\x01\xf0\\\x1cy\xf9\x88\x11=0!" +
-	"\x18vd\xa4\x13 \xacf\xd9\\by,\x0c{\x8a" +
-	"\xb0\xc0\x1d\xe9\x09GU\xa0\xfb\xfc\xbc\x8e\xf83\xaa\x88" +
-	"\xd1\x92\xf7#\xa2y#\xe1\xa8\xea\xbd<\xa5\xa5\x0c5" +
-	"<\xcbk$\x82\x9a\x81%\xe2\xb5\xc5\x06\xa0ZF\xbc" +
-	"7+~FV\xbc\x962d\xb5P\xbeve\xcaP" +
-	"I\xb0]3\x8a\x05\xfb\xd5\xa8\x0a\xae\xa0\xa1\x86'\xc0" +
-	"\xd5G\x19\xd9\xc4k#\xd6\x82eH\x0c\x8f\x18\x91P" +
-	"0*\xc2\xa5\xab\xc9\x94N\xe1J\xc6\x09\x19\xae2\xc1" +
-	"\xba~p\x99\x0a\xf6Pr\x12\x84ugY\xad\x84\x9f" +
-	"\x92-T\xe6\xdcq\x85\x92\x05\x0e\xf2\x8c\xb2G[\x0c" +
-	"\xb2\xa1\x16\x12\x03#Tc\xe5h\xb1@\"\xa8\x153" +
-	"rb1cW04\xac\xf6h`_l\xe6\xe4\xd4" +
-	"\xc0\x08`>Q\x1aL\x85\x96w\x07\x8da\xb0\x07F" +
-	"\xf2\xc9\xd4P\x97\xe9W:\x9e\x1cV\xf5B\x0dm\x9a" +
-	"\xba*\xb9\xa0+\xf73\x1a4L?\xd3\x89`\xcaP" +
-	"\x17\xc5\x93\xe0\x0aF\xaf\xcb\x09Z\xcb\xc9\xd7\x95\xd4\\" +
-	"\x9c<_\x0b\x93\xfc\xc2!\x8f\x96\x8a-\xe8\xca%\x98" +
-	"Z*vm\\\x0f\xa9`\x0f\x9b\xa8C]]\xfd\x8b" +
-	"\xaf\xd5\x83\xe0\x09%#q\x0d\xabA\xc2j\xc0\xb6A" +
-	"\x9e\x15\x09\x89\xb5\xf9\x94\x09\xb0\xb8M\xd2\xc7K\x1eY" +
-	"\x8dR\x9eZ\xcb3\xcfY-<\xd9\xbex6\x00J" +
-	"\xca4\xfa#+J\x0b\x80'\xac\x0e\xa6\x86\\\x11m" +
-	"i\xdcukP\xd7<\xaa\xae\xc7\xf5\x92\xb2'<y" +
-	"\xf6\x1cL$z&n1\x8a\xf4s\xe2z\xb7L\x17" +
-	"q\x82b\xa8\xb8NC\x91\xf6\xa2\xa9M!^\x09P" +
-	"\xbcD(c~\x90\x94\xf5TZ\x8a7:\x14\x8fK" +
-	"\xca\x9aV\xd1\xa6\x10\x8du\x14\xddrE]&\xda\x14" +
-	"\xa2\xb5\x8e\xe2\xbdH\xf1\xf5\x8a6\x85x\x1bC\xf1\xe4" +
-	"\xa8\xcc\xf3\xf36Ez(\xfemU7\"q@\xad" +
-	"\x1d\xdb2\x85I;\x87\xc2\x82\xb8\x1eO\x81+\x19\xd1" +
-	"\xd4vL\x8b\x94\x9dW\xad\xe6\x9eBI\xedZ\xd8\xc6" +
-	".W1\x15,R\xd9\xf6\x8e\xa9V\x10\x06je\xfb" +
-	"\x0e\xe6zne\x86\xaf\xa4\xb8-*\x0b\x8b{\x0dr" +
-	"I\xed[\x16Y_\xb5\xc3Q\xd6\x85\xf3\x95\xb4\xd96" +
-	"\xc8d\x15k\x96\x0f\x95\xfc\x83XQ\xc5\x0a\x1e.\x91" +
-	"\x90\xd6\xc4\x91&^\xb9Q\xbc\xd7\xb1\x15X\x0f\x12S" +
-	"\x91\xb0&^FQ|\x8e\xc0nD?H\xcc\x87v" +
-	"\x94\xc4\x17\x16\xf9G[6\x1f{Ab\xf3\x90\xf0&" +
-	"\xbe\x9a@\xf1\x95\x04k\xe6sg\xa1\x1d-\xe2\xe9:" +
-	"\xff\xae\xcc\x1a\xf9\xdc:\xb4\xa35\xf7\xcc\x8b\xe2=\x8d" +
-	"U\xe2\x00H\x0c\xd1\x8e6\xf1nozf\xfaS'" +
-	"H\xca\x87v\xb4\xe7^\x0fQ<\x82+Gt\x90\x94" +
-	"\xb7\xecX\x91\xfbd\x02\xc5\xe3\x98\xb2\x7f\x00$\xe5\x15" +
-	";V\xe6\xbe\x96@\xf1.\xa9\xec\x9c\x0d\x92\xb2\xcd\x8e" +
-	"U\xb9\xe7r\x14Ot\xca\xc3\xa4o\xcc\x8e\xd5\xb9\x97" +
-	"@\x14o\xc5\xca(\x8d\x8dd\x9b9\xa6\x07\x91vS" +
-	"\x8fEl\x12\xf1\xdcS\xdc\xf6)j\xd9\xae\xcd\xfe$" +
-	"\x81\xd9\xa3\x07<\x1c\x8bf\x8aL\x0c.:\xb8\xdas" +
-	"Ph\xcf\xb51J\xf6a\xd1cLYP\x9b\xc1\x95" +
-	"\xb5\x01\x95\xfcW\x0cE\xe0*<\xf8D\xd72\xd3\x9a" +
-	"\xff\x7fu2*\xce+\xb0\xa4\xfdT\xfa\xb83Y\xdf" +
-	"\xd3\xd47?\xdf\x91`\xe4b3\xf1\xbe\xcb\x87&\xf7" +
-	"\x19D\x99NQ\xbe\xb9\xc3\x8fN:8\xcb>N\x16" +
-	"7A&h\x94\x14\xc8\x9a\xac\xc9Y\x10\xe3\xff\x0b\x00" +
-	"\x00\xff\xff\x0c\xe6F\xea"
+const schema_db8274f9144abc7e = "x\xda\xdc{}|T\xd5\x99\xffy\xee=w\xeed" +
+	"\xf2299\x13\xc0(\xce\x98Z\x95\xfc\x94\xc2\xfc\xf4" +
+	"S\x99-\x9b\x10DI\x16jn\"e\x8d\x8b\xed$" +
+	"s\x03\x13\xe6\xcd\xb93H(\x02\xa6\xa5\x02[\xd7\x12" +
+	"-\xd6\xf4E\xa5b\x95\x8f\xd8\x96-\xddB\xc5V\x14" +
+	"\xadX\xbbk\xb6\xd6\x96j\x15\xd4\xadPm\x15\x95\xae" +
+	"\x11\xbc\xfb9\xe7\xde3sg23\xa6\xec\x7f\xfbG" +
+	"\x07s\x9e\xe7</\xe7|\xcf\xb9\xe7y)Q64" +
+	"c\xa9Y\xc2\xf2\xb8\xd4\x86\xe9\xf6s\x01If8\x95" +
+	"\x9a\xd9\x1fN%\xe4T\xa8GO\xaf\x8a\xf6\xeb3c" +
+	"\xc9\xe5\x8b\xf4Uz\xec\xfcn\xdd\xc8\xc6\xe4\x8c\xd1z" +
+	"\xcc4\xcd&\xd0\xb0\x8c\x11\xc2\x80\x10\xa9\x0d\"\xa4\xb9" +
+	"e\xd0|\x12\xf8c\x8c\x19\xbc\xe6\x9fG\xd7\xdc\xf9@" +
+	"\xcb\x86_!\x04\xe0E\xe0\xdfo\x9af\xabS\xc1\xbc" +
+	"TjfZ\x8f\xe9aC\xef\x88X\xd23\x06j}" +
+	"\xcb4M\xa9@z7BZ\x8d\x0c\xda4\x09L1" +
+	"\x01A\x04\xaa\x90\x04U\x08\xfc{KJN\xf5\xdb\x1e" +
+	"\x9c\xdf\x15N\x87\xe3%%7\xe5\xed\x96\xa3y\x81\x85" +
+	"\xa6J\x96\xc0\x95\xd1X\xcc\x12\x05\x86\x9f\x89\x1asr" +
+	"\x88\xd5\x8aF\x18\x8f\x1a\x8e[<G\x9df\xcdO&" +
+	"\x06\xa2\xcb\x8d\x99\xfd\xfc\xdf+\xa2i.N\x8e\x8b\x15" +
+	"\xcd\xf1\xe2\x12\xbc\x0bVG\x8d\x8c!|A\xad\xaf\x9b" +
+	"\xa6\xd9\x09N\x13>\xabgnL\xa6W\xce\x8bD\xd2" +
+	"\xbaa \xc4\x0d8\xa4\xb9s\xee\xceh'3\xfc\xa4" +
+	"W\xd2\xda$ \x00>`\x83\x83\x12\x95@\xa2- " +
+	"\xd1N\x90\xd6',!\x1a\x06\xc9\xbc\xfe\xf6\xbb\xb5\xfd" +
+	"\xbf\xd9r\x10iX\x82y5\x005\x08\x91\xa0\x84\x10" +
+	"\xd4 \xe8\x89\x80\xe2:}\xc3\xd2\x1dg\xefy\xed\xcb" +
+	"\xa4\x0e\x10\xc2*Bt\x8e\xf7\x04\xc2\xe6+\x9b\x9e\xdc" +
+	"\xf6\xdb\xdf\\\xb0\x11\x91F\x9b\xf0\xff\xa7{[\x00a" +
+	"s\xda\xba\x8d\xff\xb4|\xe0\xc8\xa6<\x85*\xdeq\x84" +
+	"\xcd\x17\xdet=\xeb\xfb\xc7wnCyY\xc7\xea\x18" +
+	"\xe1\xcd\xda\xfd\x07\x95/\xff\xf7mH\xab\x03!\xec\xd9" +
+	":\x09\x10\xd0\xe7\xeaZ\x11\x98?\x9fr\xeb\xad7>" +
+	"\xf3\xf2VD\x1a\xc4\xcc\xf7\xf9\xcc\xbbS\x1f.\xbe\xe3" +
+	"\x89\x8d#Hk\x103\xa1\x1b\x01\x1cF`\x1a?\x9e" +
+	"wk\xec\xdd\xfbF\x1cRe&S\x1e\x01\x04fs" +
+	"\xfa{\x0f\xbcrg\xf0v'u\x1f\xa3\xb6K\x08\xcc" +
+	"\xfb\xafh\x9b\xfb\xe1\x13\xdb\xbe\x9e7U\x1e\x94\x106" +
+	"\xef\xf06g\xf7\xeb\xd7l\xcb\x1b\"\x8f\xb0\xf1\x16\xfd" +
+	"\x0dc\xd5\x97\x1e\xfd\x86c\xfc\x00\x1bO\x1d\xdb\xdb\xb7" +
+	"a\xd6\xae\xbb\x1c\xe3\xe3l\xfcO\xcf\xff\x97\xb41\xf4" +
+	"\xf0(\"\x1e0\xd7=\xd2\xe9\xfb 3\xfc{\xa4H" +
+	"\x8c\xa1Y\xa6\xf7\xd62\x17?\x07\xcc\x94\xaa\xfb_y" +
+	"p\xf3\xeb[\xeev\xb8HG\x18\x856I\x8c\xfe\xff" +
+	"\xd2\x8b\xb65-\xfa\xbb\xedHk\xcc\xd1;\x19\x85\x8e" +
+	"q\xfa=7&g=\xf3\xe4\x96\xed\x96\x09\x0a0\xba" +
+	"$3\xfan\xf6k\xce\xbde4\xb0\xee\xe4\xd6\xef\"" +
+	"\xe2\x91\xf3\xa6\xb0\xe9\xb2D\x8f\xca\x12Bt\x1cK\xd4" +
+	"\xa3H\x08\xbd\xdc\xb0(\xf0\xa4\x07\xef\xc8\xaf\x19\x0d*" +
+	"L\xd4>\xf6k\xfe\xf6\xc5E/\xde\xf7Nr\x87c" +
+	"\x83\x0f+\xcc\xdd\x8dw>\xd4r\xcec{\xefw\xec" +
+	"\x9f\xc7\xc5\x08\xa1?/;K]\xb5\xf2{N\xe3\xdb" +
+	"]\xdcx\xf6kf\x9e\xb9\xfe_\xbcO\xbc\xfa\xbd\x09" +
+	"\xc6\x9dpITR\x99q\x07\xdc\x12\x1dsK\x08\x99" +
+	"-\xbf\x97h\xba\xf1\xd4\x03\x05\x9eV1a#\xec\xf7" +
+	"\xaf\xd1\x94\xb9\xf0\xdc\x86\x07\xf3\xc6\xed\xabb6(?" +
+	"{\xf6\xc0)\x7f\xcfC\x96\xd5\xd6\xb4\xe3|\xda\xa8\x87" +
+	"\xd9\xb0->/v\xd7\x87\x0bw9\xbc:\xe0a\x13" +
+	"\xab\xdfX\xb5lT\x8f\xecrxu\x9c\x13~v\xd9" +
+	"3\x1f=\xfe\xc9\x15\x0f;\xb0E\x1b\xaa\xb9D\xf6k" +
+	"^z\xbb\xef\xcds\xe6]\xb7{\x82W\xfb\xaa%z" +
+	"\xa8\x9ay5V#\xd1\xa35\xcc\xab\xc4\xa2\xb5\xcbz" +
+	"~}\xf3n\x87\x96\x86Z\xa6e\xd9%\x9f\xea\xbd\xf3" +
+	"ly\x8f\xd3\xeeP-\xd3r\x88\xfd\x9a\x9f\xac\xf9\xc1" +
+	"\xf0\x0f.\xb8f\xaf\xc3\xee\x13|\xe2\xda'^\xf8\xcc" +
+	"}\xe9\xeb\xf7Z\x07\xd4\x9a\xd8R\xc7\x11\xc1~\xcd\xf6" +
+	"\x87\xb3\xbdoo{z\xafC\xe3\xd1:6q\xe8\x91" +
+	"5\x9d\xb3\x7fu\xff\xa3\x0e\x89\x1e/#4\xceY\xfa" +
+	"\xde\x07\xdb\xd7\xfc\xcciJ\xd0\xcb\x81\xc1~\xcd\xef\xb8" +
+	"^\xde\xfb\xec\xa7\xcf=\xe0\xdc\xe6\xa3\x8cB\xb6\xc8\x08" +
+	"\xccGo9R\x7f\xc7\xe0\x86\xc7\x1d\x10'\xfbd\x04" +
+	"\xb4\x1d\x18y\xd7\x1b\xdf\\\xbf<\xfd\xda\xe3H\xa3 " +
+	"\x9f\xbe\xe0+\xaf\xbeJ\xde~\x96\xcb\x18\x04\x99\x9e\xf0" +
+	"\xb2\xf5:*1\xce\x1f\\\x17[\xf7\xd3\x87N=\xe5" +
+	"\xb8z\x9ad\x19a\x93\xbc\xd7\xf8\x9cR\xfd\xe4/\x9c" +
+	"\xd0\xe8\x94e\x8er6\x11\x1f\xbb\xe1\xb6\xf4\x91\x97~" +
+	"\xe9<d\xe3\x9c\xbe\x05\xcb\x08\x84R\xcd\x03M\x8e\xe3" +
+	"*3\xb6\x9dX\xa6cXF\x88\xfe\xa1\x1a#\xc8\x7f" +
+	"\x14\x89G*\xd8\\\xa9\x06\xd3\x86\x1a\x8c\x10\xdd^\xc3" +
+	"\x18\x9f\xfc\xe25\xe3\x17\xcd\xb8v\xcc\x89\x92C\x8cB" +
+	"\xbbk\x19\xdd\xfd\xb9\xa7\xf0%D\xfb\xb5c\xb5\xd7\xd4" +
+	"b\x84\xcd\x8e)\x9f\xfa\xce/n6\x9c\x84\x9d\x9c\x10" +
+	"\xde\xf1o\xbbGf\xbb^t\xb8\x7f\x94\x13\xdc\xb7}" +
+	"\xd4v\xde+\xdb_\x9a\x008\xa9\x0e\xd3\x86:f\xd3" +
+	"\x98\x17\xd3\xa3^\x8c\x90y\xce\xc5\xd7\xae\xb8\xeew\xed" +
+	"\xaf;\xd7\xc2S\xcf\xec\x1aa\xbf\xe6\xb2S\x9f\xbe\xbd" +
+	">x\xdf\xeb\xce\xb5<\xc0\xe9\x9d\x84\xd1oO\\\xd5" +
+	"z\xc7R\xfaG\x87yi\xc2\xac8\xf0\xf5\xab_X" +
+	";\xb4\xe2ON\x94l'\xdc\xe1\x066q!\xf6\x8d" +
+	"\xdc\xff\xa5\xc6\xb7\x1c\xb8\x1bn`\x13\xa5gvO\x7f" +
+	"\xe8\x8bk\xdfrN\xdc\xc9\xa6\xd0 e\x13\xef\"\xff" +
+	"\xfa\xe5W\xfe\xd8\xfd\xf6\x04\xf7:)\xa6\xbd\x94\xb9\xd7" +
+	"y\x0e\xa6\xa1i\xcc\xbd\xe6\xf7\xce\xfb\xf5\x1b\xaf}\xf3" +
+	"m\xa7\xb0\xf1\xe9L\xd8\xf0\xb9L\xd8\xc1\x13\x0b\xbfv" +
+	"\xf6'\xb2\xef8\xb1\xba\x8fQh\xbb\x9f\xd1\x9fz\xf7" +
+	"\x83\xbf\xfc\xf5\x00>\xe9X\xe4\xb4\x9fY\xf9\x96\x97\xcc" +
+	"u\x05\xf7\x9et\xae\xcbv6\x85\x8e\xf3\x89\xb5C\xf1" +
+	"\x7f\xae\xff\xf7y\xe3\x0e\xc5dPA@N(\x08\xcc" +
+	"\xad'\xee>U\xf7`l\xdc\xe1|3(\x08\x9b\xaf" +
+	".\xb9~\xdb[\x97\xdc\xe4$ts\xc2\x7f\xf64\xae" +
+	"\xbd\xe0\xb9\x93\xa7\x9c\xfb4\x0c\x0a\x02\xea\x91\x14t\xb9" +
+	"\xb9\xe9\xad?\xac\xbf\xf0\xe4\x9c\xafLX\x95\xa0\xa4\xd0" +
+	"vI\xa1\xdd\x92B\xd7\xc8\x0a\xdd\"+\x08\x99K\xcf" +
+	"\x1d>\x18\xfc\xe8\xe4\xb7\x10i\xca\xb9-+\xf4\x90\xac" +
+	"\xd0\xc3\xb2B\x8f\xcb\xcc\xca\xc4\xd8\x0b\xd3\xcf\xfb\xc9\xf2" +
+	"a\xa45\xe5t6a\x85\xb6`\x85\x86\xb0B\xc70" +
+	"\xe3:\xb9i\xe0\xb1[>\xb1\xf2\xb4C\x94\xa4(\xb4" +
+	"AQh\xb3\xa2\xd0\xa0\xc2\x98\x96J\x1f~\xf17\xef" +
+	"\xcf\xf9\x91\xc5d-\xd7\xa0\xa2\xd05\x8aB\xb7(\x0a" +
+	"mw1\xae=\xeb\xea\xfe\xb2\xd9\xff\xe8\x87N\x85\xc3" +
+	".\x85\x8e\xb8\x14\xba\xdd\xa5\xd0}*\xe3\xfa\xd2\xe2Y" +
+	"\x9f^52p\xca\xa1p\\U\xa8\xc7\xad\xd0&\xb7" +
+	"B[\xdc\x8c\xa9\xf9\xdcO\xb8o\xbb\xe7\xef\xff\x8c4" +
+	"\x0f8N\xa6\xf5\xc1t+\xb4\xd7\xad\xd0A\xb7BG" +
+	"=\x0a\x82\xbf\xfcx\x9a\x7f\x896os\x1e\xc3\x87<" +
+	"\x0a=\xecQ\xe8q\x8fB\xc7\x19\x8b\xf9}}\xe0z" +
+	"\xfa\xda\xb7\x1e.\xb8\xf5\xaa\x15\xda^\xad\xd0\xeej\x85" +
+	"zj\x18\xd7?,\x9d~\xf9\xea\xbd\xd7mr\x1e\xf3" +
+	"P\x8dB;k\x14\xda[\xa3\xd0\xe3\x9c\xab\xe5]Y" +
+	"}d\xe9w~\xe8\x94\xd5T\xab\xd0\x96Z\x85\x86j" +
+	"\x15z\xb8\x96q\xdd\x0a\xf7\xed[\xb7\xee\xc8\x8bE." +
+	"X\x17\xd1x\xadB\x9b\xea\x14\xea\xa9Sh\xbb\x97\xb1" +
+	"_\xf2\xcc6:\xf2\xee\xd1G\x18{\xf13\x83JD" +
+	"\xa1A\xa2\xd0\x06\xa2\xd0\x13\x84\xb1\x1fU\x9f\xa8\xfb\xc9" +
+	"\xb2\xd5\xdf@d\x9a\x94\x9f\xcbn\x09\xaa\xd0f\xaa\xd0" +
+	"\x06\xaaP\x8f\x8f\xb1\xce{\xfe\xbbG\\\xffq\xf0>" +
+	"\xc7\x19\x1f\xf3)\xf4\x84O\xa1G}\x0a\x95\x1a\x19S" +
+	"h\xa776\xa0\x8e=_\xb0>\x8d\x0a\xednTh" +
+	"{\xa3BG9W\xdf\xefo\xde{\xcb\xc3\xfb\xeeu" +
+	"\xaeOp\x8aB\xbb\xa7(\xb4}\x8aBG\xa70\xae" +
+	"\x9f\xdeC.\xdd\xf3\xf8\x86\x82\x8f\xb4g\xaaB[\xa6" +
+	"*\xb4i\xaaB\xd3S\x15\x04\xad\xbb\xde6\xcd\xbb\xcf" +
+	"-~\xd6G\x13F&\x9c`Q\x82\x9f?\xad\x9d\x0f" +
+	"\xf6\xee\xae\xf9\xe2]\x1f\x0f\xaf\x9e\x9fL$\xd8\x03\xdc" +
+	"[\xc0&\x15\xb0E#\xe7\xb7v\x85\x8b\x19\x98\x1e#" +
+	"\x13Ng\xf4\xc8\xd5\x89\x09\x0cr1\x83\x08\x85\xca\xc5" +
+	"@\x82\x13A\x02\x14$\xb1S]*\x14\x09\xc7\xa2\xab" +
+	"t\xa1\xad\x0b\xc0L\x84\xe3z 9\x10P2+\xf4" +
+	"\x80\xfd\xd0\x0f\\4\x90L\x07\xf4\xd5\xe1x*\xa6_" +
+	"\x1c\xb80\xd3\x9f\xba\xf0\xe2\xc0\x85\xd9H\xea\xc2\x19V" +
+	"\x901\xcc\xde\xfd\xd2\xe7\xdf4MS\x86\xf5a+\xba" +
+	"\xf0\x7f`\x9af{\x89(\xe1R\xc6\x8dfC\xb7d" +
+	"\x1a\x99t4\xb1<0\xe0N\xa6\xe3L\xad=\xb5X" +
+	"\xe3\xec9\xc1\x99\xb3f\x06g\xce\x0e\x05/c\xaa/" +
+	"\xbc.8k\xd6\xecP\xa4\xef\xf2Ph\xf6\xb2\xd0\xe5" +
+	"\xb3.\x84\x19\x08\xf9\xf7\x98\xa6\xe9wX\xc2b\x90\xcf" +
+	"\xbf\xcd#:\xb9E&p\xe3d\xa2'ke\xc1\xe0" +
+	"\x8eu\x17\x84\x82\xa1|(\xd8\xaasn\x00$\x01\x94" +
+	"\x8a\\K\xe1\xa2[\xf7\xf3]\xe3\xb1\\\xb0lt\xdc" +
+	"\x9f\xcc&2\xe0F\x12\xb8KI\x16\x86\x1b\x96\xf8\x8e" +
+	"\x08\xb7\xd9\x9b\x13\xdcR \xb8\xd7\x06\xc5E\x0c\x14\xf6" +
+	"\x0c$G\x0c\xa8C\xd0%\x03\x8fg\xeb\x10\xf8\x1f," +
+	"P\x03B\x0d\x18\xfewL\xd3\x1c\xe9\x02\xe0\xfb\xa9j" +
+	"5\xfc\xb2\x17\xf1\x0a\x88\x8f\x16\xd1\xba\x91D:T\x80" +
+	"\\\xcc\x02\"\xf2 s\x87\x91D.SA\xca=F" +
+	"AD\x15dF/\x92\xc8y\xaa)\xf6\x01A\xba\x0d" +
+	"r\x7f\x81\xbd-\xa8\xad\xd0\xfc6\xf0gM\xd3|*" +
+	"g\xd7\x84\xb3\x94\x0b\xec\xf9Y\xe4\x81\xee\xf13\x8d\xeb" +
+	"\xa3\x11\x11T\xf3\xd58P1\x07b\xc7\xff|/\x1a" +
+	"\x0a\x02v\x07&bQ#\xa3'\xf4\xf4<~\x00s" +
+	"i\x13\x86\xb9\xde\x82\xfdK;\x0e\xb5\x98\x84\xfc|Z" +
+	"\x0e|{\x8b\xf7\xceR\xe4e\x9a\xb8\xc1;\xf3\xdb\xe7" +
+	"\xe3\xdb'Bl\x10\x0fk\xb2\xb5\x09Id\xa3\x0a\xf9" +
+	"70\x88\xd8\x8a\x0c\xa5\x91Dn`\xdb'\x1e\x88 " +
+	"B\x00\xa2\xb3\xad]\xa6\x82\x9c{\xd7\x80x\x00\x11\xad" +
+	"\xcf\x82D>\xd8\x07\x11l\x92\xb9\x9d\x1c\x12r4\xd2" +
+	"6\xc11\xc7\x08\x88TE\x1b\x98\xe1\xfeLt\x95>" +
+	"?\x89\xd4D\xc2h\x03S\x9c+\x84P\x1b\xb4\x9eg" +
+	"\x9a\xe6U0\x11\x0f\xec,f\x13\x99h\\g\xd7g" +
+	"\xff\xca+\xb2\xf1\x14_pU\x1c\x98f\xa8p\x8b\xf2" +
+	"\x09\x08RP\x8b$\xa8\x9d\xb0\xd86@\xf2\x9b\xef\x0f" +
+	"\xe7\xe0v\xa2|\xbe\xc9>\xb4\xe5l8\xb3Ck\xdb" +
+	"\x92\xb6\xbc-D~\xa9\xe5Hd\xe3\xf3\xbb\x96\x14\xa5" +
+	"\xbe\xcaz\xd6\xddj}r\xb8\xbc\xf1\xff]f\x0f\xc4" +
+	"\xb1\x01\xbd\xf4\xf5\"\xd2$ \xe2*\xa21|.`" +
+	"\xf8\x14\xb9\x00\x10\xb9\x1b2\x87ai6\xc3\xa7\xc8\xf3" +
+	"\x80\x88A\xc8'\x83H\"g\x09\x9c\xd9\xcep\xc4\xf8" +
+	"\xc3\x16\xd6\xfc7\x95\xbeHJ_\xb5v\xe6\xce\xde\xb4" +
+	"\xb2/\x85\xf2\xab\xd5\xeb\xc0\x97`GrG\x84}\xb7" +
+	"\xa0f\x02\xc0\xe4\x82\xeb\xcc\xb6\xa0\xd4~\x95\xb9_\xac" +
+	"\xe3\x93\xffB\xb0\x1bf\xb0\x00l\xed\xf9\xed\x13\x9fn" +
+	"\xa8\xcf'\x98\x10@}\xc9\x0f\xdc\x84CU&S)" +
+	"\x15\xa7q\xed\xe7\x86\xb54\xc5l\xd6\xc5\x9f\xffVZ" +
+	"'\xa9\x9c\xbd67\x90|\x16\x0a\x01\x90\x8f\xf9 G" +
+	"'\xa4\x92s\xbb\xdf\x9a\xd0Wg\xae\x9a_\xf6B\x10" +
+	"\x87\xb1Rj\xb9\xe8|\x09U\x88/\x8c\xe7\x8c>\xfb" +
+	"R*\xb4X\x8f\xf7d\xc2\x19cf\xfbPOt\x0d" +
+	"\xe8V~Y\xab\xc9\x09[\xd0\x82\x90\xd6&\x83\xb6\xc8" +
+	"\x91\xba\xed`\xabu\x85\x0cZ\x97\x04D\x92| !" +
+	"D\x163\xb5\x0be\xd0\xae\x91\xc0kD\xd7\xe8B\xeb" +
+	"\xfax8\x16K\xf6\x1b9\xdf\x06\xd2\xba\x9e\xffk\x81" +
+	"i\x9a\xdf\x9f\xd4\x07M|\x03\xad7T\xa9\xc5\x89\xdb" +
+	"\xeeL\xb6d`0f\xa8\x17\x89\x94\x92\xa8,\xcc\xb1" +
+	"\x0b\xc8O\xfc\xf4\xffm;\x0ab\xed\xe5\x8c\xf5\x18\x1a" +
+	"\xd508\x92J\x04B\xad|O\xac-\x99\xaa\xad\x16" +
+	"\x9a\xe8\x1c9H\xe7\xc8\xfe\x9e\x98,C\xcfj9\xbf" +
+	"/4+\xf7\xd2!\xd9\xdf\xf3#F\xf9\xb9\x9c\xdf\x1c" +
+	"\xba_n\xa6\xfbe\x7f\xcf{\xb2\x0c\xddX\x02\"\xcb" +
+	">\x90\x11\xa2\xa7\xe5vzZ\xf6\xf7|\x06\xcb\xd0\xb3" +
+	"\x90Q0\xf6\x01F\x88.\xc0\xedt\x01\xf6\xf7l`" +
+	"\x94\xaf2\x8a\xa2\xf8@A\x88n\xc6A\xba\x19\xfb{" +
+	"~\xce(\xbfd\x14\x97\xcb\x07.\x84\xe8\xd3\xb8\x9b\xa4" +
+	"1\x19\xc5d\x1f&\xaa\xea\xe3\x01\xd1\x1e\xdcNC\x80" +
+	"i\x1f`:\x0c\x98\xb8\xdd>p#DG\x01\xd3\x06" +
+	"\x09\xd3\x90\x84i\xaf\x84IU\x95\x0f\xaa\x10\xa2i\x09" +
+	"\xd3\xa3\x12\xa6\x1e\x19\xd3\x16\x19\x13\x8f\xc7\x07\x1e\x96\x81" +
+	"\x951=$czB\xc6\xb4\x01cR]\xed\x83j" +
+	"\x96$\xc4\x98\xee\xc4\x98\x8eaLO`Ljj|" +
+	",\x14\xa0\x1e\x05\xf3L0\xa6\xdd\x0a&\xb5\xb5>\xa8" +
+	"\xe5\xc1<\x1b\x1dQ0\xdd\xad`RW\xe7\x83:\x16" +
+	"F\xf3\xd1\x13\x0a\xa6\x0d.L\xbc^\x1fx\x99d\x17" +
+	"\x1b\xedva\x9avaR_\xef\x83z\x84\xe8\x16>" +
+	"\xba\xdb\x85\xe9\x98\x0b\x13B|@X\x8e\x95\x8f6\xa8" +
+	"\x98\x06UL\x1a\x1a|\xd0\xc0\"x\x95\x8d\xa6UL" +
+	"GTL(\xf5\x01E\x88F\xe5 \xcbc\xa8\x98\x1e" +
+	"V1\xf1\xf9|\xe0c\xda9o\x93\x1b\xd3\x90\x1b\x93" +
+	"\xc6F\x1f4\"D\xafts\x09nLG\xdc\x98L" +
+	"\x99\xe2\x83),\x83\xc3G\x0f\xb91=\xee\xc6d\xea" +
+	"T\x1fL\xe5ie6\xdaR\x85ig\x15\x86i>" +
+	"\x98\x86\x10\xed\xe5\x83\xdb\xab0=P\x85\xc9Y\xe0\x83" +
+	"\xb3X\x06\x9c\x8f6{0m\xf7`\xd24\xcb\x07M" +
+	"\xccU\x0f\x1b]\xe3\xc1t\xd4\x83\xc9\xd9\xb3}p6" +
+	"s\x95\x8f\x1e\xf6`:\xee\xc1\xe4\x9c\xb3|p\x0eO" +
+	"\x1d\xb3\xd1P5\xa6\xbd\xd5\x98L\x97|0\x9d-/" +
+	"\x1f\xdd]\x8d\xe9X5\xf6\xf3\x83_\"X;\xdf\x0e" +
+	"\xd6:\xc1\x9c\xc7X\x02Q\x03\x07\xfa\x862\xba\xc1\xe3" +
+	"56\x14\xce\xe8\x91\xc0\x0a=\x9c\x0a$\xfb\x06\xf5\xfe" +
+	"\x8c\x81@\x1c%3\x93\xcc\x84c\xf3b1$\x97\x94" +
+	"~\xb1-\xfd0\x98\xd7\xd8\x9c.\xae#\xd0\x9f\x8dg" +
+	"ca\xf6\xe0\xb3\xb5\xe5U\xb1\xd8\xd0\xa1N6f\"" +
+	"$\x14\xaa\xc6\x90QB\xcfE\xb6\x9e}`\xf6\x0c\x19" +
+	"L~FY\xa1\x07\xb8uyo\xe2z<\x99\x1e\x0a" +
+	"$\xfb2\xe1h\x82)J'\xe3\x81L\xeb\x0a=p" +
+	"u\x8fC\xc7\xfaX2\xb92\x9b*\xa5g\x96\xad\xa7" +
+	"A2\x17YL\x01\x95)c\xc1t6\xde\xa7\xa7\x99" +
+	"\x9aT2\x9a\xc8\xe8\xe9\x80-&\x90\xd2\xd3\x03\xc9t" +
+	"\\\x8f\x04\xfa\x868\xab\xf5\x82\x03\xdd\xa1\xd2\xbe\x98+" +
+	"/\xe1b\x8b)\xe0\xb2U:\xd6\x90\x7f\\\x98r\xe7" +
+	">\xe5\xd6T\x8e8\xdc\xb3.\xfd\x0a\x8b\xb8\x13\xcc+" +
+	"\x19K j(\x93\xd33\x90\xf6\xea\xbaS\x87\xc9\xc8" +
+	"\x0cO\x08\xfa\xd7\xb3\xff\xee\x192\xfc\xcb\xe7\xf7\x0c\x19" +
+	"\x15 8\x08\xe6B{\x1a\xe6\x18\xa9\x00C/\xd3\x8b" +
+	"\x90\xff O\x09T\xe5S\x02U\xb9\x94@\x05\x0fw" +
+	"[\x9a\x18T\x14\xa7\x1e.\xbd4JV\xf8m\x94\xf8" +
+	"\x1f+\xaf\x92\xbb\xdd\x11\x89\xe9\x08!\xff\xfb\xac:[" +
+	"\xc1\xddn\xcb\x08\xc6\x8e\xf3\xdeF\x13\x81h$\xa6\x07" +
+	".\xca&\xb2\x86\x1e\x99\x110R\xe1\x84\x013+\xfb" +
+	"j)N,1\x10\xe8\\qw\x09\xc5\x01[q\xb3" +
+	"\xad8\x915d\xbdPs\xe2\x92\xac\xa13\x95j\x82" +
+	"\x9d:\xff\xd3\x1f\xa3\xb2[\x8f\xe9\xc8\x1b6\xf4\x08\xd7" +
+	":X\x01\xbf\xc7-\xadl\x86\x8bM(\xd8\xe0\xd4\x8a" +
+	"!#\xda\x1f\x8e\x89\xc5O\xeb\x99l\x9a-~&\xc9" +
+	"\x10\xe8\xb5\x97~\xff\xc7\x98su\xdf\xa0\x8e\xd4\xfe\x8c" +
+	"\xc1\xad\xe9\xab\x8c\xf1\x85\xf6\x04\x85!x\xc2!.q" +
+	"\xf3y\xfb3\xc6\xc7\x01\x80\xbf\x96;\x12K\x90lX" +
+	"\x1b\xd1[\x89\xb1g\xc8\xc8!\xa5\x0c_\xbc'\x15N" +
+	"LF g\x9c\x8c\xc0\xf9\xe1\xfe\x15zG\x02\xa9K" +
+	"l\x89}\x159{\x86\x10X\xeb\xd9]\x86\xaf/\xdb" +
+	"\xbfra\xd8X\x81\xd4\x9e!\x8b\xb3\x8c\xc42\xf3\x93" +
+	"\x99\x15z\xfa\xe3-\xa7\xe3^\x89@\xa84\xb15\x16" +
+	"62W\xcd\xe7\x8f\xfc2,f*\x9c5\xf4k\x92" +
+	"\x19\xe4\x0d\xc7>k\x19:X\x9au=g\xfd\xac\x9d" +
+	"\xe2tD\xe5\x9f\x7f\xd1b\xad+\x92\xba \x11\xc9[" +
+	"_\x89\xdd\x9f\xc8\xc6m3\x83\xe0\xce\xebv\xe7\x05&" +
+	"\xb2\xf1+\x93\xe9~\x1d\xa9\x91\xab\xe6\xdb\xcbY\x9as" +
+	"\xf9\xfc\xf9]K\xaeL\x87\x91\xbf?\x13M&8o" +
+	"\x1a\xaa\xf3\xbc\xd5\xf9\xf5\xe9\xb3^\xb0\xd6\xfa\xd8\x16\xd6" +
+	"\xe7\x1f\xb9\x08&\xdaJ\x0fcy6\xdc\xe9r\xbe\x94" +
+	"\x17\xf1\x08[\xd6c\xd6K9\x1f\xd7\xd7\xf3\xc8cF" +
+	"\x90\xc7g\xe7\xb5 \x04\x129\x8b\xfd#\x13\x12D\xc8" +
+	"\x1f\xd1\xfb\xb2\xcb\xbd\xd1\xc4@\xd2{c8\x9d\xf0\xeb" +
+	"\xe9t2\xcd_\xe2\xcbKd\xe3r1\x95\xdd\xc0R" +
+	"&h\x08\xa7R\x1d\x93i\xd9\x111\x08\x97v\xb8r" +
+	"\xda\xa6|?N\x85\xf0[d\x0d^\xe7\xbbU\x90\xaa" +
+	"\xb3\xbf\xf5\xc5y\x10+O'*\x9a j\xa6d+" +
+	"K\xb3nV\x01r\xdd\x03 \xaa\xdf\xe4\xa6\x90\xc8\xd3" +
+	"\x89b \x88\x1a\x1f\xd1\x07E\x9eN\xd4\x03A\xd4\xb4" +
+	"\x89\xd6)\xf2t\xa2r\x0f\xa2\x15\x82\xcc\xed\xe6y:" +
+	"sy\xf2sz\xda\x88&\x11$\xda\xa0\xd5\x0am\xdb" +
+	"8\x18\xafJ\xa6\x93Y\xe4\xcdD\x13,q'\xe2:" +
+	"\x9ebq&\xd0*\xa7\xe8\x0a\x0b\x12\x15\x1a\xbd\x0a\xb6" +
+	"\xb7 \x0bZ6C!LOTJ\xb99\xb3\x0a\xab" +
+	",\xf6\\F\xa6$pD\xa2\xa2l\x9a\xad(mS" +
+	")\x0dpf\xf9\xbe\x92.~L\x12\xc6\xce\x0dN." +
+	"\xbbbs\x03\xc9\xd7\xffKfW\x90\x9fK\xb7\xee\x97" +
+	"<\x84/\xe7\x10\x16== \xda\x15\xe8\x0f\xa1\x09I" +
+	"t\x070\x10\x8b\x8e\x10\x10\xcdV\xf4.\xe8F\x12\xdd" +
+	"\x0a*H\xa2u,\xdf\xa9B7B'\x92\xe8M\xc0" +
+	"\x80,\xda\xc1@\xb4\x7f\xd1\x1b\xf8\xdc(\xa8\x80E\xb3" +
+	"N\xbe\x97\x86.\xe3s\x97\x80\x0aJ\xae\xb7\x05DO" +
+	"\x01\xed\x80^$\xd1y\xa0\x82Kt)\xe5+\xee\xf4" +
+	"2hG\x12\x9d\x01*\xa8\xb9\xf6\x09\x10\x8d?t:" +
+	"\xa4\x91D\x1bA\x05w\xae/\x0cD\x9f\x00\xad\xe2\x92" +
+	"\x01T\xa8\xca\xf5\x85\x81h\xce \xef\xb7 \x89\x1cS" +
+	"\xc1\x93\xeb\x13\x02\xd1\xae@^jG\x12yN\x85\xea" +
+	"\\O\x04\x88N\x19r\x90\xd1\xf6\xaaP#*\xcd\x8e" +
+	"r\xf2\xaeQ\xb2GE@v\xa9P\x9b\xab\x1f\x83(" +
+	"\x11\x93{\x87\xc9\x0eF\xfe\xb6\x9d\x16u4L\xb69" +
+	"\x92\x92\xe2\x04\x8bBbq\x02\xb5\xa8 \xb3\xde\xfe\x93" +
+	"\x09\xb4oL\xe4\xe7\xd8w\x8e\xc8\x8c\xc1\xcbn\xdd\xb6" +
+	"\x1c\xc0\xdar\x89<^\xf9\x89\xc7\xc3\x89H\x17DS" +
+	"z,\x9a\xd0;\xe4\x88\xd1\x05R\x09\x0a\xe2\xc3\xadw" +
+	"\x9d6\xcd[\xe4<\xec\xda\xca\xc0\xee}\x0e\xbbce" +
+	"`\xf7\x12\x87\xce\xf3e`\xf74\x87\xcece`\xb7" +
+	"\x87\xcf\xddU\x06v\xf7\xf2\xb9w\x95\x81\xdd\xad\x1c\x1c" +
+	"\x1b\xcb\xc0n\x88\xc3.^\x06va\x0e\xbbk\xcb\xc0" +
+	"n1\x97\xbc\xa04\xec\xe8\x1chA\x12\xbd\x04J\x02" +
+	"\x8f\x9e\x07\xed6\xa0K@\x8fV1*9]\x1a{" +
+	"\xef\x8c\x92\x0f\x18\xb8\xde)\x8d\xbd\xd7\x87\xc91F>" +
+	"\xa2B]\xae\x0b\x00D\xa5\x9f<\xdfG~\xc7\xc8\xcf" +
+	"\xa9\xe0\xcdU\xf6A\x14\xef\xc9\xc1N\xf24#?\xa6" +
+	"\xb6~p\xda4U\xfc\x7f\x00\xc0\xcc\xa6\x05\xab\xf4D" +
+	"\x06\xa9\x1d\x11\xa3h\x08\xd9<\xe5;\x97+}W\x9c" +
+	"\x97\xb9\xed\x1c\x90|Od\xc9\xcb\xbc\xf0!#jn" +
+	"V\xf9Zh\xb2\x1a\x8b\xcf$\x91]^\xba(k\x1c" +
+	"\xe3O\xea\xca=\xd1\x95\xcbw\xa4Y!\xed\x8a\xb3\xa0" +
+	"\xec\x7f\x9e\x19Q\xba3\xa0\\\xe1xr\x9f\xc8\xfc\xaa" +
+	"\xe6z2\xcb\x16 \xf2e\x01\xfedb\x0f&Q/" +
+	"\xb4;\x02*&\xca\xcb\xd4\x95\xcbI\x16\x15(\xce\x1f" +
+	":\xd3\xaa\xc3|\x1b\xb2\x16bE\xd5!w\xd9v\x81" +
+	"TP\xb7\x13=` \xfa\xbc\x88\xd6D4vby" +
+	"g\x80\xe8\xeb\x02\xd1\xbbE\xe6\x86\xc8\\F\xe6\xcd\x01" +
+	"\xa2W\x0bD;\x16\x99\xb1\x85\xccf\xe4\x19\xecKe" +
+	"]\xf7F&\xbc\\\xb7\x8f\x89\xa1gz\xd8\x9f\xd0\x95" +
+	"L\xc6X\xfcb\x1f\x17\x7f\xbcBI\xcf\xe9\x90\xb3\x19" +
+	"\xdf\x06\xd3D\xdf\xbad<)9E]\x18%\x16\xc9" +
+	"\xf9\xe4#D\xd5\xeae\xd0\xcea1\xa2-\x85\xd5\x01" +
+	"\xbb@\xcaE,{\x8a\xcfN\xb1Vk1\x8a\xce\xce" +
+	"d<(/\xab\xf8\x94Wv#DjU\xfet\xbd" +
+	"XrlM>\x80\xcc\xf5\xcc\xb1\xfb\x16!F\xf0\xef" +
+	"(\xf0J)a\x89\xbd\xadbWs\xd0?\xc2b\xa4" +
+	"R\x169\xfe\x0f\x0cA2C\xd5.\x92A\xbb\x94\x95" +
+	"[$\xab\x0c6\xbb\x93\\\xa6j\x97\xca\xa0\xb5Y\xb5" +
+	"\xa4\xe5:3SE\xec\x7f`\xa6r\xf0a\xf8\x01\x17" +
+	"b\xff\xb3:Ln\xfc\x9b\x0c\xcd\x15\xb3\x98\xa5\xa3\x93" +
+	"\xda\x09)\x15\x12\xf2\xb8,\xbb\xc5\xa4\x84\x93\xf59'" +
+	"\xc3\xddDW\xb5\x88\x0cZ\x8a9\xe9\xb6\x9c\x8cw\x92" +
+	"\x1bT-%\x83\xb6\x96\xd5\x93\x14\xab\xd87\xd4Kn" +
+	"R\xb5\xb52h\x9bX1i!/&\x91\x8d\xbdd" +
+	"\xb3\xaam\x92A\xbbC\xca}\x98\xd8\xf7\xd3\x01\xbf2" +
+	"\xcbb\xf6e\x07\x06\xf4tO\x14\xc9k\x0aV1\x9c" +
+	"\xcd$\x8d\xfep\x0c\xc9:\x17#\x02\xb3c\x1f\x99\xe6" +
+	"\x1f\x9d\xc8\xb3\xc2\xa7\x82\xa5\xec\x88\x9c!\x8a\xcb\xc8\xfa" +
+	"\xdbP\xdc'\x0e\xe3\xc5\xce\xc3h\x7f\x8e\x8b\x83\xb0\x1d" +
+	"\xa5\xea\xf3E&\x88\xa8pRWA\x938C\xd3x" +
+	"+\x92\xf3\x02\xd8;\x19]Eme\x95\x95\x0d\x0bW" +
+	"\x03R\xe9\x07\x09\x90|\xe3\xaf}h\xd9\xe7\xec\x87\xc5" +
+	"5\xd1E\xfc\x89\"'2\"\xd3#v\xc9\x91\xc2\x10" +
+	"Zon\"7\xab\xda\x06\x19\xb4\xafJ J\xd3\x9b" +
+	"[\x1c\x10$\x12\xb6\xe0\xba5H\xb6\xaa\xda\xd7d\xd0" +
+	"\xeeap\x05\x0b\xae\xdf\xee#\xf7\xaa\xda=2h\x0f" +
+	"\xb1\xba\xa7\xc4\xeb\x9e\xe4\xc1\x10yP\xd5\x1e\x90A\xfb" +
+	"\x85\x04\xadq\x9ev-\\@/\xebgd\x7f\x8bP" +
+	"\x9e\xf7\xa7\xb0\x01g\x8b\x8a\xe5\xa5\x17\x81\x19\xd1\x8d\xfe" +
+	"t4\x95Aj4\x99p\xcck\x1d\x88\xea\xb1H\xe1" +
+	"\xd5\x96op\xcd]m\xceC\xbd\xc8~\xc2]\xc9f" +
+	"\xe6\x0eunG\xc0\xd9.\xab\xb4p\xfaT\xef5C" +
+	")\xddQ\xd2\xefh!\x1d\xaa\xa8\xd4\x8bu\xd3Z\x88" +
+	"\xa6j]2h_`\xeb\x06\xd6\xba-\xeb#aU" +
+	"\xfb\x82\x0cZL\xb20\xfa\xfdb\xe7\xbd\x99\xa1\x94n" +
+	"\xf9\x9eS=\x09\xdf\xcb:5\x93\x9bk%>Jl" +
+	"\xff\xf9\xdc\xaeh\x88DU\x00\xa2\xb7\x10]\x05\x89\x84" +
+	"\x9bHX\x05\x99,kf\xd9(L\xae\x0d\x92kU" +
+	"P\xc8\x92\x16\xb2D\x05\x97\xe5\x1d\xa8dq'\xfb\xd7" +
+	"M\x16\x87\xc8b\xd5\x7f\xabi\x9a/\xb7Z\xdd\xa2]" +
+	" y3\xfa\xeaL\x17H\xd6f\xab\xd1\x04\xfb\xc3?" +
+	"\x10K\x86\xd9\x7fx\xfb\x92\xc9\x18\xe7\x8ar\xff\xcdH" +
+	"6\x1df\x09P\xeb\x1ek\xb5\xaaR\x8cPt\xb0\xc4" +
+	"\xab\xdbq\x19\x89W\xf5D\xffJ\xbe\x0a\x8a\xa5\xe4\x9a" +
+	"I\xadG[\x89U\xca\x1fM\xd2G\x1a\xd9\xa1\x01m" +
+	"\x96\x04\xfe\x97\xf8y+\x0e\x0d\x8a\xee\xa2\x12\xcdZ\x9c" +
+	"\xbd\xb0\x0d\xad\xb2\xd6&\xc7C\xc4\xd2Zx\x84\xca\xea" +
+	"(lq\xaa\xac\xa4S\xb8v\xfeD\xd7\xac\x8fK}" +
+	"\xbe7\xdd\xc6d=\x82\xff\x19\x00\xc5Y|k"
 
 func init() {
 	schemas.Register(schema_db8274f9144abc7e,
+		0x8267b8211dd7d26e,
 		0x84e4b51ba5570071,
 		0x8526d6d896c688e0,
+		0x8639f6277fdeed88,
+		0x885bb978381d574b,
 		0x88e166675c857e18,
+		0x89415100551f18b6,
 		0x8ff15814cd06ecd7,
 		0x8ff88405c5bd0dec,
 		0x91dfcb778d8d16c0,
@@ -5685,13 +7485,17 @@ func init() {
 		0x933297e0a8a77222,
 		0x9596c4fb3d4044a6,
 		0x965465bd75220f94,
+		0x98785db80ec407e2,
 		0x98be837673e8652a,
 		0x99ad308062b9e970,
 		0x9aae3a8502e6d5eb,
+		0x9cf6fe32c5821e57,
 		0x9f8ae589a9e0a609,
+		0xa1baae87b981db62,
 		0xa23b4c1a964c722b,
 		0xa28ac6cb306f77a0,
 		0xa391f67e209a873d,
+		0xa4c5d006e1a3d541,
 		0xa504000ac6204c12,
 		0xa56ff1a4dc4cdcd8,
 		0xa6b9c11c2aac9785,
@@ -5700,15 +7504,20 @@ func init() {
 		0xa8fc15721302db2a,
 		0xa9121e4800ff7069,
 		0xac531ffcc2cdbf05,
+		0xac80c3b53411a0bb,
 		0xad48fb996c416d96,
 		0xad64659a5d76e80b,
 		0xae6825c3fecb35bf,
+		0xae9ce4135e6665af,
+		0xb19e57bc0703f32a,
 		0xb25b411cec149334,
 		0xb281d4535d7c4c6e,
+		0xb439f5d67bfb0257,
 		0xb5031b975a2f2d5d,
 		0xb95426b082b00c25,
 		0xb95e72a43cd7c47c,
 		0xb9c996f05a75ae42,
+		0xbce2f3921396cb2d,
 		0xbea6ce314a7abc79,
 		0xbf7aa2f9f4573915,
 		0xc21e37cdb9df069e,
@@ -5722,7 +7531,9 @@ func init() {
 		0xd2592928fa547bc6,
 		0xd451112d04c75608,
 		0xd47381c89e2f1649,
+		0xd5d207666c0faa3a,
 		0xdc063192b2b7a561,
+		0xdce17e7ebaa4018d,
 		0xdda2e02140fe8f08,
 		0xe542d95b68592c1c,
 		0xe5a432109337fc5d,
@@ -5730,6 +7541,7 @@ func init() {
 		0xeb68797cd74f95c2,
 		0xed1583a692140448,
 		0xed7c7bac1db2cb02,
+		0xee3ea08f08231e22,
 		0xf052e7e084b31199,
 		0xf09be4e8d421f422,
 		0xf175231b9048f2c5,
@@ -5738,5 +7550,8 @@ func init() {
 		0xfa41cf108b6d790d,
 		0xfa6ca90efc9ff291,
 		0xfa7d2ded965e55e3,
-		0xfcf6d1267c1553d3)
+		0xfbbe1f89ef0e7eb5,
+		0xfc66927637304d83,
+		0xfcf6d1267c1553d3,
+		0xfd6b2387c16688f6)
 }
@@ -3,6 +3,8 @@
 package config
 
 import (
+	math "math"
+
 	capnp "zombiezen.com/go/capnproto2"
 	text "zombiezen.com/go/capnproto2/encoding/text"
 	schemas "zombiezen.com/go/capnproto2/schemas"
@@ -98,12 +100,12 @@ type Pipeline_Stage struct{ capnp.Struct }
 const Pipeline_Stage_TypeID = 0xa3e64eb06ea97afb
 
 func NewPipeline_Stage(s *capnp.Segment) (Pipeline_Stage, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
 	return Pipeline_Stage{st}, err
 }
 
 func NewRootPipeline_Stage(s *capnp.Segment) (Pipeline_Stage, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
 	return Pipeline_Stage{st}, err
 }
 
@@ -133,12 +135,119 @@ func (s Pipeline_Stage) SetPoolSize(v uint8) {
 	s.Struct.SetUint8(8, v)
 }
 
+func (s Pipeline_Stage) BufferSize() uint16 {
+	return s.Struct.Uint16(10)
+}
+
+func (s Pipeline_Stage) SetBufferSize(v uint16) {
+	s.Struct.SetUint16(10, v)
+}
+
+func (s Pipeline_Stage) OverflowPolicy() Pipeline_Stage_OverflowPolicy {
+	return Pipeline_Stage_OverflowPolicy(s.Struct.Uint16(12))
+}
+
+func (s Pipeline_Stage) SetOverflowPolicy(v Pipeline_Stage_OverflowPolicy) {
+	s.Struct.SetUint16(12, uint16(v))
+}
+
+func (s Pipeline_Stage) Autoscaler() (Pipeline_Stage_Autoscaler, error) {
+	p, err := s.Struct.Ptr(0)
+	return Pipeline_Stage_Autoscaler{Struct: p.Struct()}, err
+}
+
+func (s Pipeline_Stage) HasAutoscaler() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Pipeline_Stage) SetAutoscaler(v Pipeline_Stage_Autoscaler) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewAutoscaler sets the autoscaler field to a newly
+// allocated Pipeline_Stage_Autoscaler struct, preferring placement in s's segment.
+func (s Pipeline_Stage) NewAutoscaler() (Pipeline_Stage_Autoscaler, error) {
+	ss, err := NewPipeline_Stage_Autoscaler(s.Struct.Segment())
+	if err != nil {
+		return Pipeline_Stage_Autoscaler{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Pipeline_Stage) Kind() Pipeline_Stage_Kind {
+	return Pipeline_Stage_Kind(s.Struct.Uint16(14))
+}
+
+func (s Pipeline_Stage) SetKind(v Pipeline_Stage_Kind) {
+	s.Struct.SetUint16(14, uint16(v))
+}
+
+func (s Pipeline_Stage) FanOutCommandIDs() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(1)
+	return capnp.UInt64List{List: p.List()}, err
+}
+
+func (s Pipeline_Stage) HasFanOutCommandIDs() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s Pipeline_Stage) SetFanOutCommandIDs(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(1, v.List.ToPtr())
+}
+
+// NewFanOutCommandIDs sets the fanOutCommandIDs field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Pipeline_Stage) NewFanOutCommandIDs(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(1, l.List.ToPtr())
+	return l, err
+}
+
+func (s Pipeline_Stage) RetryPolicy() (Pipeline_Stage_RetryPolicy, error) {
+	p, err := s.Struct.Ptr(2)
+	return Pipeline_Stage_RetryPolicy{Struct: p.Struct()}, err
+}
+
+func (s Pipeline_Stage) HasRetryPolicy() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s Pipeline_Stage) SetRetryPolicy(v Pipeline_Stage_RetryPolicy) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewRetryPolicy sets the retryPolicy field to a newly
+// allocated Pipeline_Stage_RetryPolicy struct, preferring placement in s's segment.
+func (s Pipeline_Stage) NewRetryPolicy() (Pipeline_Stage_RetryPolicy, error) {
+	ss, err := NewPipeline_Stage_RetryPolicy(s.Struct.Segment())
+	if err != nil {
+		return Pipeline_Stage_RetryPolicy{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Pipeline_Stage) CompensationCommandID() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Pipeline_Stage) SetCompensationCommandID(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
 // Pipeline_Stage_List is a list of Pipeline_Stage.
 type Pipeline_Stage_List struct{ capnp.List }
 
 // NewPipeline_Stage creates a new list of Pipeline_Stage.
 func NewPipeline_Stage_List(s *capnp.Segment, sz int32) (Pipeline_Stage_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
 	return Pipeline_Stage_List{l}, err
 }
 
@@ -159,27 +268,454 @@ func (p Pipeline_Stage_Promise) Struct() (Pipeline_Stage, error) {
 	return Pipeline_Stage{s}, err
 }
 
-const schema_ac5630c48ddf1619 = "x\xdat\x8f\xbfJ\xc3P\x14\x87\x7f\xbf\x9b\xb4i\x07" +
-	"\xa5!\xd2\xeab\xc1IAK+NE\xd0\xc1A\x1d" +
-	"$\xd7\x80\x93\x0e!^C\xa0\xf9\x83)\x16\xfa\x0e." +
-	".>\x80\x93\xe0 >\x83\xab\xcf 8\xb8;J\x90" +
-	"+\x115.N\x07\xbe\x03\xe7\xfbN\xebz[\x0cj" +
-	"\x1d\x01\xc8\xf9Z]\x17\xd3\xdb\xe4\xfe\xe0\xf5\x06\xb2M" +
-	"\xa1\xaf\x9e\x8a\xa5\xe3E\xb7\x80i\x01\xf6\xdb\x8b\xfdQ" +
-	"\xce\xf7\x09X\xad\xe4\x0c\xa9\x17\xda\xcf\x97\x8f\xfd\xa3;" +
-	"\xd4h\x01\xce\x09\x1f\x1c\xc5\x0e\xe0\xc4\x9c`M\x07i" +
-	"r\x16\x85\xbd@\xf8Y\x92\x0d\xdd(S\xa3(Q=" +
-	"ol\xf9\xa1rI\xd90L\xc0$`\xaf\x1c\x02r" +
-	"\xd9\xa0\xdc\x10\xb4\xd9\x98c\x09\x07\xfb\x80\xec\x1b\x94\x9b" +
-	"\x82:H\xe3\xd8ON\xf7\xc0\x1d6!\xd8\x04u\x96" +
-	"\xa6#/\x9a*\x00\xacC\xb0\x0e\xfeJ\xf9#\xed~" +
-	"Y\xa5IVo\xda\\\xefzc?T\xff$\x90\xdf" +
-	"\x05C@\xae\x1a\x94\xbb\x82:W\xe7\x17Q\xa0\xfe\x16" +
-	"l\xe5\xe5\x91\x9c\xb3\xa0k\x90\xad\xca\x00\x96\xf03\x00" +
-	"\x00\xff\xff\xe0*Z\xf4"
+func (p Pipeline_Stage_Promise) Autoscaler() Pipeline_Stage_Autoscaler_Promise {
+	return Pipeline_Stage_Autoscaler_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+func (p Pipeline_Stage_Promise) RetryPolicy() Pipeline_Stage_RetryPolicy_Promise {
+	return Pipeline_Stage_RetryPolicy_Promise{Pipeline: p.Pipeline.GetPipeline(2)}
+}
+
+type Pipeline_Stage_OverflowPolicy uint16
+
+// Pipeline_Stage_OverflowPolicy_TypeID is the unique identifier for the type Pipeline_Stage_OverflowPolicy.
+const Pipeline_Stage_OverflowPolicy_TypeID = 0xd3b0f0a5c4e8a7b1
+
+// Values of Pipeline_Stage_OverflowPolicy.
+const (
+	Pipeline_Stage_OverflowPolicy_block      Pipeline_Stage_OverflowPolicy = 0
+	Pipeline_Stage_OverflowPolicy_dropNewest Pipeline_Stage_OverflowPolicy = 1
+	Pipeline_Stage_OverflowPolicy_dropOldest Pipeline_Stage_OverflowPolicy = 2
+	Pipeline_Stage_OverflowPolicy_reject     Pipeline_Stage_OverflowPolicy = 3
+)
+
+// String returns the enum's constant name.
+func (c Pipeline_Stage_OverflowPolicy) String() string {
+	switch c {
+	case Pipeline_Stage_OverflowPolicy_block:
+		return "block"
+	case Pipeline_Stage_OverflowPolicy_dropNewest:
+		return "dropNewest"
+	case Pipeline_Stage_OverflowPolicy_dropOldest:
+		return "dropOldest"
+	case Pipeline_Stage_OverflowPolicy_reject:
+		return "reject"
+
+	default:
+		return ""
+	}
+}
+
+// Pipeline_Stage_OverflowPolicyFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Pipeline_Stage_OverflowPolicyFromString(c string) Pipeline_Stage_OverflowPolicy {
+	switch c {
+	case "block":
+		return Pipeline_Stage_OverflowPolicy_block
+	case "dropNewest":
+		return Pipeline_Stage_OverflowPolicy_dropNewest
+	case "dropOldest":
+		return Pipeline_Stage_OverflowPolicy_dropOldest
+	case "reject":
+		return Pipeline_Stage_OverflowPolicy_reject
+
+	default:
+		return 0
+	}
+}
+
+type Pipeline_Stage_OverflowPolicy_List struct{ capnp.List }
+
+func NewPipeline_Stage_OverflowPolicy_List(s *capnp.Segment, sz int32) (Pipeline_Stage_OverflowPolicy_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Pipeline_Stage_OverflowPolicy_List{l.List}, err
+}
+
+func (l Pipeline_Stage_OverflowPolicy_List) At(i int) Pipeline_Stage_OverflowPolicy {
+	ul := capnp.UInt16List{List: l.List}
+	return Pipeline_Stage_OverflowPolicy(ul.At(i))
+}
+
+func (l Pipeline_Stage_OverflowPolicy_List) Set(i int, v Pipeline_Stage_OverflowPolicy) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Pipeline_Stage_Autoscaler struct{ capnp.Struct }
+
+// Pipeline_Stage_Autoscaler_TypeID is the unique identifier for the type Pipeline_Stage_Autoscaler.
+const Pipeline_Stage_Autoscaler_TypeID = 0xf74d29eecfeacdde
+
+func NewPipeline_Stage_Autoscaler(s *capnp.Segment) (Pipeline_Stage_Autoscaler, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Pipeline_Stage_Autoscaler{st}, err
+}
+
+func NewRootPipeline_Stage_Autoscaler(s *capnp.Segment) (Pipeline_Stage_Autoscaler, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Pipeline_Stage_Autoscaler{st}, err
+}
+
+func ReadRootPipeline_Stage_Autoscaler(msg *capnp.Message) (Pipeline_Stage_Autoscaler, error) {
+	root, err := msg.RootPtr()
+	return Pipeline_Stage_Autoscaler{root.Struct()}, err
+}
+
+func (s Pipeline_Stage_Autoscaler) String() string {
+	str, _ := text.Marshal(0xf74d29eecfeacdde, s.Struct)
+	return str
+}
+
+func (s Pipeline_Stage_Autoscaler) MinPoolSize() uint8 {
+	return s.Struct.Uint8(0)
+}
+
+func (s Pipeline_Stage_Autoscaler) SetMinPoolSize(v uint8) {
+	s.Struct.SetUint8(0, v)
+}
+
+func (s Pipeline_Stage_Autoscaler) MaxPoolSize() uint8 {
+	return s.Struct.Uint8(1)
+}
+
+func (s Pipeline_Stage_Autoscaler) SetMaxPoolSize(v uint8) {
+	s.Struct.SetUint8(1, v)
+}
+
+func (s Pipeline_Stage_Autoscaler) ScaleUpQueueWaitMSec() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Pipeline_Stage_Autoscaler) SetScaleUpQueueWaitMSec(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Pipeline_Stage_Autoscaler) ScaleDownQueueWaitMSec() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Pipeline_Stage_Autoscaler) SetScaleDownQueueWaitMSec(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Pipeline_Stage_Autoscaler) IntervalSec() uint16 {
+	return s.Struct.Uint16(2) ^ 10
+}
+
+func (s Pipeline_Stage_Autoscaler) SetIntervalSec(v uint16) {
+	s.Struct.SetUint16(2, v^10)
+}
+
+// Pipeline_Stage_Autoscaler_List is a list of Pipeline_Stage_Autoscaler.
+type Pipeline_Stage_Autoscaler_List struct{ capnp.List }
+
+// NewPipeline_Stage_Autoscaler creates a new list of Pipeline_Stage_Autoscaler.
+func NewPipeline_Stage_Autoscaler_List(s *capnp.Segment, sz int32) (Pipeline_Stage_Autoscaler_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return Pipeline_Stage_Autoscaler_List{l}, err
+}
+
+func (s Pipeline_Stage_Autoscaler_List) At(i int) Pipeline_Stage_Autoscaler {
+	return Pipeline_Stage_Autoscaler{s.List.Struct(i)}
+}
+
+func (s Pipeline_Stage_Autoscaler_List) Set(i int, v Pipeline_Stage_Autoscaler) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Pipeline_Stage_Autoscaler_List) String() string {
+	str, _ := text.MarshalList(0xf74d29eecfeacdde, s.List)
+	return str
+}
+
+// Pipeline_Stage_Autoscaler_Promise is a wrapper for a Pipeline_Stage_Autoscaler promised by a client call.
+type Pipeline_Stage_Autoscaler_Promise struct{ *capnp.Pipeline }
+
+func (p Pipeline_Stage_Autoscaler_Promise) Struct() (Pipeline_Stage_Autoscaler, error) {
+	s, err := p.Pipeline.Struct()
+	return Pipeline_Stage_Autoscaler{s}, err
+}
+
+type Pipeline_Stage_Kind uint16
+
+// Pipeline_Stage_Kind_TypeID is the unique identifier for the type Pipeline_Stage_Kind.
+const Pipeline_Stage_Kind_TypeID = 0x8f96a7e933fa41d6
+
+// Values of Pipeline_Stage_Kind.
+const (
+	Pipeline_Stage_Kind_command Pipeline_Stage_Kind = 0
+	Pipeline_Stage_Kind_fanOut  Pipeline_Stage_Kind = 1
+	Pipeline_Stage_Kind_router  Pipeline_Stage_Kind = 2
+	Pipeline_Stage_Kind_filter  Pipeline_Stage_Kind = 3
+)
+
+// String returns the enum's constant name.
+func (c Pipeline_Stage_Kind) String() string {
+	switch c {
+	case Pipeline_Stage_Kind_command:
+		return "command"
+	case Pipeline_Stage_Kind_fanOut:
+		return "fanOut"
+	case Pipeline_Stage_Kind_router:
+		return "router"
+	case Pipeline_Stage_Kind_filter:
+		return "filter"
+
+	default:
+		return ""
+	}
+}
+
+// Pipeline_Stage_KindFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func Pipeline_Stage_KindFromString(c string) Pipeline_Stage_Kind {
+	switch c {
+	case "command":
+		return Pipeline_Stage_Kind_command
+	case "fanOut":
+		return Pipeline_Stage_Kind_fanOut
+	case "router":
+		return Pipeline_Stage_Kind_router
+	case "filter":
+		return Pipeline_Stage_Kind_filter
+
+	default:
+		return 0
+	}
+}
+
+type Pipeline_Stage_Kind_List struct{ capnp.List }
+
+func NewPipeline_Stage_Kind_List(s *capnp.Segment, sz int32) (Pipeline_Stage_Kind_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return Pipeline_Stage_Kind_List{l.List}, err
+}
+
+func (l Pipeline_Stage_Kind_List) At(i int) Pipeline_Stage_Kind {
+	ul := capnp.UInt16List{List: l.List}
+	return Pipeline_Stage_Kind(ul.At(i))
+}
+
+func (l Pipeline_Stage_Kind_List) Set(i int, v Pipeline_Stage_Kind) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type Pipeline_Stage_RetryPolicy struct{ capnp.Struct }
+
+// Pipeline_Stage_RetryPolicy_TypeID is the unique identifier for the type Pipeline_Stage_RetryPolicy.
+const Pipeline_Stage_RetryPolicy_TypeID = 0xc3e7263d79b90758
+
+func NewPipeline_Stage_RetryPolicy(s *capnp.Segment) (Pipeline_Stage_RetryPolicy, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Pipeline_Stage_RetryPolicy{st}, err
+}
+
+func NewRootPipeline_Stage_RetryPolicy(s *capnp.Segment) (Pipeline_Stage_RetryPolicy, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1})
+	return Pipeline_Stage_RetryPolicy{st}, err
+}
+
+func ReadRootPipeline_Stage_RetryPolicy(msg *capnp.Message) (Pipeline_Stage_RetryPolicy, error) {
+	root, err := msg.RootPtr()
+	return Pipeline_Stage_RetryPolicy{root.Struct()}, err
+}
+
+func (s Pipeline_Stage_RetryPolicy) String() string {
+	str, _ := text.Marshal(0xc3e7263d79b90758, s.Struct)
+	return str
+}
+
+func (s Pipeline_Stage_RetryPolicy) MaxAttempts() uint8 {
+	return s.Struct.Uint8(0)
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetMaxAttempts(v uint8) {
+	s.Struct.SetUint8(0, v)
+}
+
+func (s Pipeline_Stage_RetryPolicy) InitialBackoffMSec() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetInitialBackoffMSec(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Pipeline_Stage_RetryPolicy) MaxBackoffMSec() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetMaxBackoffMSec(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s Pipeline_Stage_RetryPolicy) BackoffMultiplier() float32 {
+	return math.Float32frombits(s.Struct.Uint32(12))
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetBackoffMultiplier(v float32) {
+	s.Struct.SetUint32(12, math.Float32bits(v))
+}
+
+func (s Pipeline_Stage_RetryPolicy) ErrorIDs() (capnp.UInt64List, error) {
+	p, err := s.Struct.Ptr(0)
+	return capnp.UInt64List{List: p.List()}, err
+}
+
+func (s Pipeline_Stage_RetryPolicy) HasErrorIDs() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetErrorIDs(v capnp.UInt64List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewErrorIDs sets the errorIDs field to a newly
+// allocated capnp.UInt64List, preferring placement in s's segment.
+func (s Pipeline_Stage_RetryPolicy) NewErrorIDs(n int32) (capnp.UInt64List, error) {
+	l, err := capnp.NewUInt64List(s.Struct.Segment(), n)
+	if err != nil {
+		return capnp.UInt64List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+func (s Pipeline_Stage_RetryPolicy) RetryKnownEdgeCases() bool {
+	return s.Struct.Bit(8)
+}
+
+func (s Pipeline_Stage_RetryPolicy) SetRetryKnownEdgeCases(v bool) {
+	s.Struct.SetBit(8, v)
+}
+
+// Pipeline_Stage_RetryPolicy_List is a list of Pipeline_Stage_RetryPolicy.
+type Pipeline_Stage_RetryPolicy_List struct{ capnp.List }
+
+// NewPipeline_Stage_RetryPolicy creates a new list of Pipeline_Stage_RetryPolicy.
+func NewPipeline_Stage_RetryPolicy_List(s *capnp.Segment, sz int32) (Pipeline_Stage_RetryPolicy_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 1}, sz)
+	return Pipeline_Stage_RetryPolicy_List{l}, err
+}
+
+func (s Pipeline_Stage_RetryPolicy_List) At(i int) Pipeline_Stage_RetryPolicy {
+	return Pipeline_Stage_RetryPolicy{s.List.Struct(i)}
+}
+
+func (s Pipeline_Stage_RetryPolicy_List) Set(i int, v Pipeline_Stage_RetryPolicy) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Pipeline_Stage_RetryPolicy_List) String() string {
+	str, _ := text.MarshalList(0xc3e7263d79b90758, s.List)
+	return str
+}
+
+// Pipeline_Stage_RetryPolicy_Promise is a wrapper for a Pipeline_Stage_RetryPolicy promised by a client call.
+type Pipeline_Stage_RetryPolicy_Promise struct{ *capnp.Pipeline }
+
+func (p Pipeline_Stage_RetryPolicy_Promise) Struct() (Pipeline_Stage_RetryPolicy, error) {
+	s, err := p.Pipeline.Struct()
+	return Pipeline_Stage_RetryPolicy{s}, err
+}
+
+const schema_ac5630c48ddf1619 = "x\xda\xdc\x96\xefk\\Y\x19\xc7\x9f\xef9\xe7\xe6$" +
+	"\xc56sz\x87\xd5\x95\xc6i\x17+\x9b\xb0-\xbb\xab" +
+	"o\x0c\xcal\xd2\x8a\xbaK\xb7s2\xe8J\x10\x96;" +
+	"\x93;\xe1\xda\x99{\x87\x99\x9bf3 \xc6\x97\x96]" +
+	"\x16\x0a\x8a\x88\x05[)\xb5R\xa1\x15\x0b\xad\xb4 \xb1" +
+	"\x85\x0a\x16\xb1\xb6\xd2\xbeQ,\xad\xda\xa2\x92\x04\x7f\xb5" +
+	"\xb59r\xe6\xce\x8f\x9b4\xf8\x07\xf8\xe203\xf7y" +
+	"\xees\x9e\xf3|\xce\xf3}F9\x8bj\xe7\xa2Z\\" +
+	"\xc4\xeak\xe2\x95\xad\xf3\x9c\x98~\xd1\x190OZ\xa7" +
+	"\xc3\xb3o\xfe\xf1\xfb\xa4\x9f\x037G\xaf?y\xe1\xcb" +
+	"\x1f)<!\x87K\"\xf78\xee\xaa\x16\x88\\\xc68" +
+	"\xa1o\xd5[\x01\xf3\xfcs\xbf\x7f\xef\xca\xcb_<C" +
+	"\x0e\xac\xef8\x84[\x82 r\x8f0A{\xcc\xb9S" +
+	"\x7f\xberr\xf9\xecoH\xed`\xfd]\x08\xee\x12\x13" +
+	"\xee\x0d&\xdc\xbbL\xb8'\xb8 \x98\xdf]\x7f\xf8\xab" +
+	"\xbf\x8d\x1e\xf8\x17\xe9\x1dH\xf9\x0a\x1b\xf6\x06\x17\xee]" +
+	".\xdcU.\xdc%\xe6\x10\xcco'\x1e\x7f\xfc\xc1\xa9" +
+	"o\xbd\xffL\xe0U\xe6\xb8k\xccq\xb7s\xc7]\xe2" +
+	"\xd6\xf5K\xf2\xe2\xc2\xa7?\xf6\xa7\x9fo\x08\x9c$\xbc" +
+	"\xca\x1dw\xbbp\\&\x1c\xb7\xe1X\xf7r\x14V\x82" +
+	"\xd9\xbde\xe6\xd5\xc3\xfax!\xa8\xfb\xd5 \xf4\xf7\x16" +
+	"c\xe9\xcd\xfa\xfa\x03@\xeaP\xdbSi\xab\xb1tV" +
+	"\x18\x1b~#\x08g\xcc\xc1\xc3~\xa3R\x8d\xe6)_" +
+	"\x88\xaaAy!\xf7\x0fcL\xcbL\xcc\xc5Q\xb3\xec" +
+	"U\x89\xfb\x8d\xf6\xa3i\x9dY\x17y\xa4\x95\x8a<2" +
+	"\x9d\x8a<2\xd6?\x91z\xbe\x94\xbbc\x8c\xf9\xec3" +
+	"\xfb\xac\xdb!Ie\xca\x8f\x1b\x0b\x85\xa8J2(/" +
+	"\x14\xc0r\x8f\x8c1\x12\xdb\x08\xea\x05\xf1\xf6_\x8c1" +
+	"\x1c\xa6\x91v\xd2;\xb8 \x12 R\xe7\xa7\x88\xf4O" +
+	"8\xf4\xcf\x18\x14\x06\xb3\xb0\x0f/\xbfN\xa4/q\xe8" +
+	"k\x0c\x8a9Y0\"uuZ\xfdB\xeak\x1c\xfa" +
+	"&\x83\xe2\x03Yp\"\xf5\xeb\x96\xba%\xf5M\x0e}" +
+	"\x8f\x01\"\x0bA\xa4\xfe0\xad\xeeK}\x8fC\xff\x9d" +
+	"A92\x0b\x87H\xad\x8c\xa9\x15\xa9\x979\xa6\xc0\xa0" +
+	"\x06\x90\xc5\x00\x91zz\xc4u \x8b\x02\x1c\xc5\x9d`" +
+	"\x96S\xad\xe6\x853\x9f'\xec\xc7\x101\x0c\x11L=" +
+	"\x8a\xaa\xc5\xa0\xe5\x13\x11\x06\x88a\x80`Js\x95\x8a" +
+	"\xdf(\x06\xc4[~\x01\x0c\x92\xec\x82\x896T\xcc\xda" +
+	"\x86\xfb\x04\x88^\x03\x11\x86\x09\xc6K\x95\xd2ze\xfa" +
+	"h:^\x19\xc2\xf0\xa1 \x9cIb\xf4X\xa5bT" +
+	"\xbc\xf0\xe0\\\xbc/B\x92\xf4\xfe&\x91u\xdeF(" +
+	"p\xb4\xf3\xdfF\xc8\x0f\xad\x19\xf3>\xd4*\x14J\xfa" +
+	"\xa3\xbd\xe2\xaf\xd8\xe2/s\xe8\xff\xa4\x8a\xff\xc8\x16\xff" +
+	"\xdf\x1cE\x81~\xf5]`\xba_\xa7\x0c\xfa\x04\xdc\xad" +
+	"h\xb9\x0a\xb2\x98\xe9V\xb0C\xc1\x1d\xc1\xb4\xbb\x0b\xb2" +
+	"\xb8\xd3\x1a^F\x9f\x84\xbb\x07c\xee\x1e\xc8\xe2K\xd6" +
+	"\xf2\xa9\x14\x0d\xf7\x938\xa2\x96\xa0\x1eBM\x0a%Y" +
+	"\xb6\xddK\xff\xe4LM\x09\xd5\x12\xea\x84P\x83,\x8b" +
+	"A\"\xb5$\xd4\x0d\xa1\x1e\x0b\xf7\xc3\x10\xf9\xa7k\xc6" +
+	"\x98\xff\x03t6\xebN\xc7Xno/\x1bc\x18\xfa" +
+	"=\x95\xe9\xb7(\xd1\x84@\xc77\xd9\xae\xe3m\xabP" +
+	"\xf7\xc3\xa6\x878\x88\xc2}Q\xad\x96k\x87\xcf\xad\x18" +
+	"c~\xdc\x8f\x95\xdaj(\xfdr\xa2R\xe8\xaaT\xae" +
+	"-S\xc9\xcbZ\x00}\xa1Sx5W\x8c\xbdY?" +
+	"\xf7Wc\xcc\x07\xf5`\xef^\x8d\xda{\xf5\"\x87\xfe" +
+	"\x04\x03\x90\\\xabW\xc6\x89\xf4K\x1c\xfas\x0c\xa6\xe9" +
+	"7\x0e\x07e?\x0d*\xdf\xb4\xa1\x9a\xdd\x9b\x9bI\x8b" +
+	"\xaf}\x98\xab\x19c\xe6{\xf9\x89\x8d*\xea\xcd\xfa{" +
+	"\xbbbe\xe9\xf1\xf2B\xfe\xbe\x15@\x14\x80\xe4\xc8\xbd" +
+	"\xb3\xebL[QF_U\xa3\x12P\xbb\xa7\xed'\xeb" +
+	"|r\xb5{\\\xed\x96\xb9R5*\x1f*\x80\x99\x99" +
+	"FT\x7f\xd3\x9f\xf7\x897\xe3\xee\xef\x83\xd5\x99\xee\xef" +
+	"|\xc3\xff\x8a_\xb6\xdfr\xc7\x8d1\xb3\xbd\x14\xf9f" +
+	")Z\xf9\xcc\xdb\x9b\xe37\xf2\x0f\x8c1\xe3\x9b\xa4W" +
+	"\x00t\xb6W\xcc\xaf\x96\xd4\xd7\xa5^\xe4\xd0\xef\xda." +
+	"\xed\x94\xf3\x1b%\xf5\x9e\xd4\xefr\xe8o\xdb.E\xa2" +
+	"\x91\xdf\xfc\xa1:&\xf5w9\xf4\x0fl\x87\xb2D#" +
+	"O\xfeT\xfdH\xea3\x1c\xfa\x02\x83\x12\xc8B\x00\xea" +
+	"|I]\x94\xfa\x02\x87\xbe\xc2`jAX\xb0\x9dB" +
+	"2Hz\xa2\xdb+5\xef\x9d\xcd\x0d\xedC|\xa1\xae" +
+	"1\xe7\xcf\xf9oyA<|\xa0\xe8\x97\xad\xc7 \xd9" +
+	"\xd5\xf1\xd8\x1f\xcd#\xd4\x89O>\x887\xfa\x04a\xec" +
+	"7\x0e{U\x92\x1d\x83$\xe6\xc8-\x84\xfc\xb15c" +
+	"r\xf8\xdf\xd5\xb4\xf3\x87\xa8]\xc8-p\x1fs\xa1\xf0" +
+	",\xe5\xc9\x0eeK\x15L\xed\x1aW\xbb,\xe5\x91q" +
+	"5\"\xbf\xd6\x91\x0c\xcb1\xe9\xc76\xd1h.n\xf7" +
+	"u\xbe\x12T\x93o\xb9\xd3\xeb\xd8nz\xfd\xba\x830" +
+	"(/$9M\"\xcd\xb4\x93\xd5\x87zd\x8f\x95\xd4" +
+	"q\xa9\xbf\xc7\xa1\xcf\xa4\xc8\x9e\xfe\x8e:'\xf5Y\x0e" +
+	"}\xc9\x92e\x09\xd9\x8b-uY\xf6f\"\xe7\x09\xd9" +
+	"\xabG\xd5u\xa9\x7f\xc9\xa1o\xf7\xa7\xdf\xad\xd7\xd5\x1d" +
+	"\xa9ow\xa7\xdf`g\xfa\x9dP\x8fdO\xd7\xf3\xda" +
+	"\x18\xf3V\x9b\xeeD\x1c\xfb5\x92\xf5\xb8\x99\xa6\x1b\x84" +
+	"A\x1cx\xd5Ix\xe5CQ\xa5r\xa0\xc8\xd7s\xab" +
+	"y\xefLZ\x13\xe5\xadq\xbd\xad\xd4y\x07s\xd58" +
+	"\xa8W\x03\x1fm\x8d\xdcBv\xc1\xf8\x8dF\xd4h\xcb" +
+	"\xdd&\xa3*\xf9\xa3\xf0F\x18a>\xfc\xcc\xcc\xac\xbf" +
+	"\xcf\x93M\xbf\x9d\x19\xc8.\xfcw\x00\xc0\x90\x13\x0a"
 
 func init() {
 	schemas.Register(schema_ac5630c48ddf1619,
+		0x8f96a7e933fa41d6,
 		0xa3e64eb06ea97afb,
+		0xc3e7263d79b90758,
+		0xd3b0f0a5c4e8a7b1,
+		0xf74d29eecfeacdde,
 		0xfb501e5c22fbcd92)
 }
@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
 )
 
@@ -155,6 +156,42 @@ func withStageCommandID(ctx context.Context, id CommandID) context.Context {
 	return context.WithValue(ctx, ctx_stage_command_id{}, id)
 }
 
+// time.Time - when the Context was put on the stage's input channel
+type ctx_stage_enqueued_on ContextKey
+
+// stageEnqueuedOn is used to compute how long the Context waited in the stage's input queue
+func stageEnqueuedOn(ctx context.Context) (t time.Time, ok bool) {
+	t, ok = ctx.Value(ctx_stage_enqueued_on{}).(time.Time)
+	return
+}
+
+func withStageEnqueuedOn(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctx_stage_enqueued_on{}, time.Now())
+}
+
+// CommandID - the next stage picked by a router stage
+type ctx_route ContextKey
+
+func routedTo(ctx context.Context) (next CommandID, ok bool) {
+	next, ok = ctx.Value(ctx_route{}).(CommandID)
+	return next, ok && next != CommandID(0)
+}
+
+func withRoute(ctx context.Context, next CommandID) context.Context {
+	return context.WithValue(ctx, ctx_route{}, next)
+}
+
+// struct{} - marks the Context as being dropped by a filter stage
+type ctx_filtered ContextKey
+
+func filtered(ctx context.Context) bool {
+	return ctx.Value(ctx_filtered{}) != nil
+}
+
+func withFiltered(ctx context.Context) context.Context {
+	return context.WithValue(ctx, ctx_filtered{}, struct{}{})
+}
+
 // uid.UIDHash - used for tracking purposes.
 type ctx_workflow_id ContextKey
 
@@ -169,6 +206,19 @@ func WithWorkflowID(ctx context.Context) context.Context {
 	return context.WithValue(ctx, ctx_workflow_id{}, uid.NextUIDHash())
 }
 
+// withWorkflowTrace continues the Context's trace, if it carries one. Otherwise, a new trace is started using the workflow id
+// as the trace id, i.e., the workflow id is propagated along with the trace context.
+func withWorkflowTrace(ctx context.Context) context.Context {
+	if spanContext, ok := trace.FromContext(ctx); ok && spanContext.IsValid() {
+		return ctx
+	}
+	traceID := trace.NewTraceID()
+	if workflowID, ok := WorkflowID(ctx); ok && workflowID != 0 {
+		traceID = trace.TraceID(workflowID)
+	}
+	return trace.WithSpanContext(ctx, trace.SpanContext{TraceID: traceID})
+}
+
 // struct{} - used to mark the context as a ping-pong context
 // ping-pong is used to test how long does it take to traverse the pipeline - command functions are not run
 type ctx_ping ContextKey
@@ -0,0 +1,309 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/data/keyvalue"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// ContextCodec is used by a durable pipeline to persist the workflow input carried by a Context.
+type ContextCodec interface {
+	// Encode returns the serialized workflow input. If the Context does not carry a serializable input, then nil is returned,
+	// and the workflow is processed without being persisted.
+	Encode(ctx context.Context) ([]byte, error)
+
+	// Decode restores the workflow input into the specified Context, which is a new Context - see NewContext()
+	Decode(ctx context.Context, data []byte) (context.Context, error)
+}
+
+// DurableQueue persists the pipeline workflow inputs when they are submitted to the pipeline, which enables workflows to
+// be replayed when the pipeline is restarted, e.g., if the process died.
+//
+// Delivery semantics are at-least-once:
+//	- entries are acked, i.e., deleted, once the workflow's output has been delivered successfully or the Context was filtered
+//	- entries for workflows that failed are redelivered until MaxDeliveries is reached. Redelivered workflows are not
+//	  returned on the output channel - only the final attempt is returned. The Context that was submitted is redelivered,
+//	  i.e., the caller's output channel, deadline, and trace context are retained. If the submitted Context is done, then
+//	  the workflow is not redelivered.
+//	- replayed workflows have no caller waiting on them. Their results are discarded once the workflow is done, i.e., they
+//	  are never returned on the pipeline output channel.
+//	- entries for workflows that expired, were dropped, or exceeded MaxDeliveries are moved to the DeadLetterBucket
+//	- pending entries are replayed when the pipeline is started. Replays count as deliveries, i.e., an entry that keeps
+//	  crashing the process ends up in the DeadLetterBucket.
+type DurableQueue struct {
+	// where pending workflow inputs are stored
+	Bucket keyvalue.Bucket
+	// where workflow inputs that keep failing are moved
+	DeadLetterBucket keyvalue.Bucket
+	Codec            ContextCodec
+	// max number of times a workflow input is delivered to the pipeline, including replays. If 0, then 1 is used, i.e.,
+	// failed workflows are not redelivered.
+	MaxDeliveries uint8
+}
+
+func (a *DurableQueue) maxDeliveries() uint8 {
+	if a.MaxDeliveries == 0 {
+		return 1
+	}
+	return a.MaxDeliveries
+}
+
+type durableQueue struct {
+	DurableQueue
+
+	// used to generate unique entry keys
+	seq uint32
+
+	persistedCounter   prometheus.Counter
+	ackedCounter       prometheus.Counter
+	redeliveredCounter prometheus.Counter
+	replayedCounter    prometheus.Counter
+	deadLetterCounter  prometheus.Counter
+}
+
+func newDurableQueue(serviceID app.ServiceID, queue DurableQueue) *durableQueue {
+	return &durableQueue{
+		DurableQueue:       queue,
+		persistedCounter:   app.MetricRegistry.Counter(serviceID, PIPELINE_DURABLE_PERSISTED_COUNT),
+		ackedCounter:       app.MetricRegistry.Counter(serviceID, PIPELINE_DURABLE_ACKED_COUNT),
+		redeliveredCounter: app.MetricRegistry.Counter(serviceID, PIPELINE_DURABLE_REDELIVERED_COUNT),
+		replayedCounter:    app.MetricRegistry.Counter(serviceID, PIPELINE_DURABLE_REPLAYED_COUNT),
+		deadLetterCounter:  app.MetricRegistry.Counter(serviceID, PIPELINE_DURABLE_DEAD_LETTER_COUNT),
+	}
+}
+
+// entry keys sort in the order the entries were persisted, which is the order in which they are replayed
+func (a *durableQueue) nextKey() string {
+	return fmt.Sprintf("%016x%08x", time.Now().UnixNano(), atomic.AddUint32(&a.seq, 1))
+}
+
+// entry values are stored as : [deliveries uint8][encoded workflow input]
+func durableEntryValue(deliveries uint8, data []byte) []byte {
+	value := make([]byte, len(data)+1)
+	value[0] = deliveries
+	copy(value[1:], data)
+	return value
+}
+
+// durableQueueEntry - the Context's durable queue entry
+type ctx_durable_entry ContextKey
+
+type durableQueueEntry struct {
+	key string
+	// the Context that was submitted to the pipeline, which is what gets redelivered
+	submitted context.Context
+	// optional - releases the Context resources for replayed workflows
+	cancel context.CancelFunc
+}
+
+func durableEntry(ctx context.Context) (key string, ok bool) {
+	entry, ok := ctx.Value(ctx_durable_entry{}).(*durableQueueEntry)
+	if !ok {
+		return "", false
+	}
+	return entry.key, true
+}
+
+func withDurableEntry(ctx context.Context, entry *durableQueueEntry) context.Context {
+	return context.WithValue(ctx, ctx_durable_entry{}, entry)
+}
+
+// persist stores the workflow input in the durable queue, if it has not already been persisted.
+// Ping-pong Context(s) are not persisted.
+func (a *Pipeline) persist(ctx context.Context) (context.Context, *app.Error) {
+	if IsPing(ctx) {
+		return ctx, nil
+	}
+	if _, ok := durableEntry(ctx); ok {
+		return ctx, nil
+	}
+	data, err := a.durable.Codec.Encode(ctx)
+	if err != nil {
+		return ctx, durableQueueError(ctx, a, err)
+	}
+	if data == nil {
+		return ctx, nil
+	}
+	key := a.durable.nextKey()
+	if err := a.durable.Bucket.Put(key, durableEntryValue(1, data)); err != nil {
+		return ctx, durableQueueError(ctx, a, err)
+	}
+	a.durable.persistedCounter.Inc()
+	return withDurableEntry(ctx, &durableQueueEntry{key: key, submitted: ctx}), nil
+}
+
+// ack removes the Context's workflow input from the durable queue
+func (a *Pipeline) ack(ctx context.Context) {
+	if a.durable == nil {
+		return
+	}
+	key, ok := durableEntry(ctx)
+	if !ok {
+		return
+	}
+	if err := a.durable.Bucket.Delete(key); err != nil {
+		durableQueueError(ctx, a, err).Log(a.Service.Logger())
+		return
+	}
+	a.durable.ackedCounter.Inc()
+}
+
+// discard removes the Context's workflow input from the durable queue because the Context was not accepted by the pipeline
+func (a *Pipeline) discard(ctx context.Context) {
+	if key, ok := durableEntry(ctx); ok {
+		if err := a.durable.Bucket.Delete(key); err != nil {
+			durableQueueError(ctx, a, err).Log(a.Service.Logger())
+		}
+	}
+}
+
+// nack is called when the workflow failed. If the workflow input has not reached the max number of deliveries, then it
+// is redelivered to the pipeline, and true is returned. Otherwise, the workflow input is moved to the dead letter bucket.
+func (a *Pipeline) nack(ctx context.Context) bool {
+	if a.durable == nil {
+		return false
+	}
+	entry, ok := ctx.Value(ctx_durable_entry{}).(*durableQueueEntry)
+	if !ok {
+		return false
+	}
+	value := a.durable.Bucket.Get(entry.key)
+	if len(value) == 0 {
+		return false
+	}
+	deliveries := value[0]
+	if deliveries >= a.durable.maxDeliveries() || entry.submitted.Err() != nil {
+		a.deadLetter(ctx)
+		return false
+	}
+	// the submitted Context is redelivered, i.e., the workflow result is returned to the caller
+	if err := a.recordDelivery(entry.key, deliveries+1, value[1:]); err != nil {
+		durableQueueError(ctx, a, err).Log(a.Service.Logger())
+		a.deadLetter(ctx)
+		return false
+	}
+	redelivered := withDurableEntry(entry.submitted, entry)
+	a.durable.redeliveredCounter.Inc()
+	contextRedelivered(a, ctx, deliveries+1)
+	a.Service.Go(func() error {
+		a.enqueue(redelivered)
+		return nil
+	})
+	return true
+}
+
+// recordDelivery updates the entry's delivery count
+func (a *Pipeline) recordDelivery(key string, deliveries uint8, data []byte) error {
+	return a.durable.Bucket.Put(key, durableEntryValue(deliveries, data))
+}
+
+// replayContext records the delivery, and decodes the workflow input into a new Context. The replayed workflow has no
+// caller, i.e., its result is sent to the pipeline's replay sink, which discards it - see discardReplayed().
+func (a *Pipeline) replayContext(key string, deliveries uint8, data []byte) (context.Context, error) {
+	if err := a.recordDelivery(key, deliveries, data); err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithCancel(a.Service.Context(NewContext()))
+	ctx, err := a.durable.Codec.Decode(WithOutputChannel(ctx, a.replayed), data)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return withDurableEntry(ctx, &durableQueueEntry{key: key, submitted: ctx, cancel: cancel}), nil
+}
+
+// discardReplayed drains the replayed workflow results until the pipeline service is dead. The results are acked by the
+// last stage once they are delivered, i.e., draining them is what completes the replayed workflows.
+func (a *Pipeline) discardReplayed() {
+	for {
+		select {
+		case <-a.Service.Dying():
+			return
+		case ctx := <-a.replayed:
+			if entry, ok := ctx.Value(ctx_durable_entry{}).(*durableQueueEntry); ok && entry.cancel != nil {
+				entry.cancel()
+			}
+		}
+	}
+}
+
+// deadLetter moves the Context's workflow input from the durable queue to the dead letter bucket
+func (a *Pipeline) deadLetter(ctx context.Context) {
+	if a.durable == nil {
+		return
+	}
+	if key, ok := durableEntry(ctx); ok {
+		a.deadLetterEntry(ctx, key)
+	}
+}
+
+func (a *Pipeline) deadLetterEntry(ctx context.Context, key string) {
+	value := a.durable.Bucket.Get(key)
+	if len(value) == 0 {
+		return
+	}
+	// the entry is put into the dead letter bucket before it is deleted from the queue, i.e., if the process dies in between,
+	// then the entry will be replayed
+	if err := a.durable.DeadLetterBucket.Put(key, value); err != nil {
+		durableQueueError(ctx, a, err).Log(a.Service.Logger())
+		return
+	}
+	if err := a.durable.Bucket.Delete(key); err != nil {
+		durableQueueError(ctx, a, err).Log(a.Service.Logger())
+		return
+	}
+	a.durable.deadLetterCounter.Inc()
+	contextDeadLettered(a, ctx, key)
+}
+
+// replay sends the pending workflow inputs into the pipeline in the order they were persisted
+func (a *Pipeline) replay() {
+	// the entries are read up front because the bucket is updated as the entries are replayed
+	entries := []*keyvalue.KeyValue{}
+	for kv := range a.durable.Bucket.KeyValues("", nil) {
+		entries = append(entries, kv)
+	}
+
+	replayed := 0
+	for _, entry := range entries {
+		if len(entry.Value) == 0 {
+			continue
+		}
+		deliveries := entry.Value[0]
+		if deliveries >= a.durable.maxDeliveries() {
+			a.deadLetterEntry(NewContext(), entry.Key)
+			continue
+		}
+		ctx, err := a.replayContext(entry.Key, deliveries+1, entry.Value[1:])
+		if err != nil {
+			durableQueueError(NewContext(), a, err).Log(a.Service.Logger())
+			a.deadLetterEntry(NewContext(), entry.Key)
+			continue
+		}
+		if !a.enqueue(ctx) {
+			return
+		}
+		a.durable.replayedCounter.Inc()
+		replayed++
+	}
+	durableQueueReplayed(a, replayed)
+}
@@ -18,12 +18,18 @@ import (
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 
 	"context"
+	"fmt"
 	"time"
 )
 
 // ErrSpec(s)
 var (
-	ErrSpec_ContextExpired = app.ErrSpec{app.ErrorID(0xd56f1203ea740414), app.ErrorType_KNOWN_EDGE_CASE, app.ErrorSeverity_MEDIUM}
+	ErrSpec_ContextExpired  = app.ErrSpec{app.ErrorID(0xd56f1203ea740414), app.ErrorType_KNOWN_EDGE_CASE, app.ErrorSeverity_MEDIUM}
+	ErrSpec_StageBufferFull = app.ErrSpec{app.ErrorID(0xa7e8eb0cb78a04c7), app.ErrorType_KNOWN_EDGE_CASE, app.ErrorSeverity_MEDIUM}
+	ErrSpec_StageNotFound   = app.ErrSpec{app.ErrorID(0xd13d611cfbee9575), app.ErrorType_KNOWN_EDGE_CASE, app.ErrorSeverity_LOW}
+	ErrSpec_InvalidPoolSize = app.ErrSpec{app.ErrorID(0x8614e7fcb519d029), app.ErrorType_Config, app.ErrorSeverity_LOW}
+	ErrSpec_RouteNotFound   = app.ErrSpec{app.ErrorID(0xe3c1a06b97d5f210), app.ErrorType_Config, app.ErrorSeverity_HIGH}
+	ErrSpec_DurableQueue    = app.ErrSpec{app.ErrorID(0xa4a44a10bf832c7a), app.ErrorType_KNOWN_EDGE_CASE, app.ErrorSeverity_HIGH}
 )
 
 // as a side effect, update pipeline metrics will be updated
@@ -44,5 +50,65 @@ func pipelineContextExpired(ctx context.Context, pipeline *Pipeline, commandID C
 		}
 	}
 
+	pipeline.deadLetter(ctx)
+
 	return app.NewError(ctx.Err(), "Context expired on Pipeline", ErrSpec_ContextExpired, pipeline.Service.ID(), commandID)
 }
+
+// stageBufferFullError is returned when a stage's input buffer is full and the Context was not accepted by the stage
+func stageBufferFullError(ctx context.Context, pipeline *Pipeline, commandID CommandID) *app.Error {
+	workflowID, _ := WorkflowID(ctx)
+	return app.NewError(
+		fmt.Errorf("Stage input buffer is full : CommandID(0x%x) : workflow(0x%x)", commandID, workflowID),
+		"Context was not accepted by the Pipeline stage",
+		ErrSpec_StageBufferFull,
+		pipeline.Service.ID(),
+		commandID,
+	)
+}
+
+// stageNotFoundError is returned when a stage index is out of range for the pipeline
+func stageNotFoundError(pipeline *Pipeline, stage int) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Stage index is out of range : %d : stage count = %d", stage, len(pipeline.stages)),
+		"Pipeline stage not found",
+		ErrSpec_StageNotFound,
+		pipeline.Service.ID(),
+		nil,
+	)
+}
+
+// invalidPoolSizeError is returned when trying to set a stage's pool size to 0
+func invalidPoolSizeError(pipeline *Pipeline, stage int, poolSize uint8) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Stage pool size must be > 0 : stage = %d : poolSize = %d", stage, poolSize),
+		"Invalid stage pool size",
+		ErrSpec_InvalidPoolSize,
+		pipeline.Service.ID(),
+		nil,
+	)
+}
+
+// routeNotFoundError is returned when a router stage routes a Context to a stage that is not downstream from the router stage
+func routeNotFoundError(ctx context.Context, pipeline *Pipeline, routerID, next CommandID) *app.Error {
+	workflowID, _ := WorkflowID(ctx)
+	return app.NewError(
+		fmt.Errorf("Routed stage is not downstream : CommandID(0x%x) -> CommandID(0x%x) : workflow(0x%x)", routerID, next, workflowID),
+		"Pipeline route not found",
+		ErrSpec_RouteNotFound,
+		pipeline.Service.ID(),
+		routerID,
+	)
+}
+
+// durableQueueError is returned when the pipeline's durable queue fails, i.e., the underlying keyvalue store or ContextCodec failed
+func durableQueueError(ctx context.Context, pipeline *Pipeline, err error) *app.Error {
+	workflowID, _ := WorkflowID(ctx)
+	return app.NewError(
+		fmt.Errorf("Durable queue failure : workflow(0x%x) : %v", workflowID, err),
+		"Pipeline durable queue failure",
+		ErrSpec_DurableQueue,
+		pipeline.Service.ID(),
+		nil,
+	)
+}
@@ -18,13 +18,71 @@ import (
 	"context"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
 )
 
 const (
-	CONTEXT_EXPIRED = app.LogEventID(0xb2c9b8df32d61bd3)
-	CONTEXT_FAILED  = app.LogEventID(0xc82b54ad45672f0a)
+	CONTEXT_EXPIRED  = app.LogEventID(0xb2c9b8df32d61bd3)
+	CONTEXT_FAILED   = app.LogEventID(0xc82b54ad45672f0a)
+	CONTEXT_DROPPED  = app.LogEventID(0xc5ec5008c78b6d61)
+	CONTEXT_REJECTED = app.LogEventID(0x9ef2edaf65ac7499)
+	CONTEXT_FILTERED = app.LogEventID(0xf1b5ce8e2c4a7d93)
+
+	COMMAND_RETRIED     = app.LogEventID(0x815cfbcaf153e3d1)
+	CONTEXT_COMPENSATED = app.LogEventID(0xd2b9d9d68f7f94e8)
+	COMPENSATION_FAILED = app.LogEventID(0xe8e96876b4ee9e60)
+
+	CONTEXT_REDELIVERED    = app.LogEventID(0xc75f4b1c51542ab7)
+	CONTEXT_DEAD_LETTERED  = app.LogEventID(0xf046664281928500)
+	DURABLE_QUEUE_REPLAYED = app.LogEventID(0xbfefda89cf776768)
+
+	STAGE_POOL_RESIZED = app.LogEventID(0xa5f780ce0f83e736)
 )
 
+func init() {
+	workflowField := app.LogEventField{Name: "workflow", Type: app.LogFieldType_ID, Description: "WorkflowID"}
+	commandField := app.LogEventField{Name: "cmd", Type: app.LogFieldType_ID, Description: "CommandID"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: CONTEXT_EXPIRED, Name: "CONTEXT_EXPIRED", Level: zerolog.WarnLevel, Description: "the workflow context expired before the workflow completed",
+			Fields: []app.LogEventField{workflowField}},
+		app.LogEventSpec{ID: CONTEXT_FAILED, Name: "CONTEXT_FAILED", Level: zerolog.WarnLevel, Description: "the workflow failed",
+			Fields: []app.LogEventField{workflowField}},
+		app.LogEventSpec{ID: CONTEXT_DROPPED, Name: "CONTEXT_DROPPED", Level: zerolog.WarnLevel, Description: "the context was dropped because the stage input buffer was full",
+			Fields: []app.LogEventField{workflowField, commandField}},
+		app.LogEventSpec{ID: CONTEXT_REJECTED, Name: "CONTEXT_REJECTED", Level: zerolog.WarnLevel, Description: "the context was rejected because the stage input buffer was full",
+			Fields: []app.LogEventField{workflowField, commandField}},
+		app.LogEventSpec{ID: CONTEXT_FILTERED, Name: "CONTEXT_FILTERED", Level: zerolog.DebugLevel, Description: "the context was dropped by a filter stage",
+			Fields: []app.LogEventField{workflowField, commandField}},
+
+		app.LogEventSpec{ID: COMMAND_RETRIED, Name: "COMMAND_RETRIED", Level: zerolog.WarnLevel, Description: "a failed stage command was retried per the stage's RetryPolicy",
+			Fields: []app.LogEventField{
+				workflowField,
+				commandField,
+				{Name: "retry", Type: app.LogFieldType_INT, Description: "retry attempt"},
+				{Name: "err", Type: app.LogFieldType_ID, Description: "ErrorID of the error that triggered the retry"},
+			}},
+		app.LogEventSpec{ID: CONTEXT_COMPENSATED, Name: "CONTEXT_COMPENSATED", Level: zerolog.WarnLevel, Description: "the compensation commands were run for a failed workflow",
+			Fields: []app.LogEventField{workflowField, {Name: "compensations", Type: app.LogFieldType_INT, Description: "number of compensation commands that were run"}}},
+		app.LogEventSpec{ID: COMPENSATION_FAILED, Name: "COMPENSATION_FAILED", Level: zerolog.ErrorLevel, Description: "a stage compensation command failed",
+			Fields: []app.LogEventField{workflowField, commandField, app.ErrLogEventField}},
+
+		app.LogEventSpec{ID: CONTEXT_REDELIVERED, Name: "CONTEXT_REDELIVERED", Level: zerolog.WarnLevel, Description: "a failed workflow was redelivered from the durable queue",
+			Fields: []app.LogEventField{workflowField, {Name: "delivery", Type: app.LogFieldType_INT, Description: "delivery attempt"}}},
+		app.LogEventSpec{ID: CONTEXT_DEAD_LETTERED, Name: "CONTEXT_DEAD_LETTERED", Level: zerolog.ErrorLevel, Description: "the workflow input was moved from the durable queue to the dead letter bucket",
+			Fields: []app.LogEventField{workflowField, {Name: "key", Type: app.LogFieldType_STRING, Description: "dead letter key"}}},
+		app.LogEventSpec{ID: DURABLE_QUEUE_REPLAYED, Name: "DURABLE_QUEUE_REPLAYED", Level: zerolog.InfoLevel, Description: "the pending durable queue entries were replayed at startup",
+			Fields: []app.LogEventField{{Name: "count", Type: app.LogFieldType_INT, Description: "number of entries that were replayed"}}},
+
+		app.LogEventSpec{ID: STAGE_POOL_RESIZED, Name: "STAGE_POOL_RESIZED", Level: zerolog.InfoLevel, Description: "the stage worker pool was resized",
+			Fields: []app.LogEventField{
+				{Name: "stage", Type: app.LogFieldType_INT, Description: "stage index"},
+				{Name: "from", Type: app.LogFieldType_INT, Description: "previous pool size"},
+				{Name: "to", Type: app.LogFieldType_INT, Description: "new pool size"},
+			}},
+	)
+}
+
 func contextFailed(pipeline *Pipeline, ctx context.Context) {
 	workflowID, _ := WorkflowID(ctx)
 	CONTEXT_FAILED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Msg("context failed")
@@ -34,3 +92,69 @@ func contextExpired(pipeline *Pipeline, ctx context.Context) {
 	workflowID, _ := WorkflowID(ctx)
 	CONTEXT_EXPIRED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Msg("context expired")
 }
+
+// contextDropped is logged when a Context is dropped because the stage input buffer was full
+func contextDropped(pipeline *Pipeline, ctx context.Context, commandID CommandID) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_DROPPED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Uint64("cmd", commandID.UInt64()).Msg("context dropped")
+}
+
+// contextRejected is logged when a Context is rejected because the stage input buffer was full
+func contextRejected(pipeline *Pipeline, ctx context.Context, commandID CommandID) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_REJECTED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Uint64("cmd", commandID.UInt64()).Msg("context rejected")
+}
+
+// contextFiltered is logged when a Context is dropped by a filter stage
+func contextFiltered(pipeline *Pipeline, ctx context.Context, commandID CommandID) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_FILTERED.Log(pipeline.Service.Logger().Debug()).Uint64("workflow", workflowID.UInt64()).Uint64("cmd", commandID.UInt64()).Msg("context filtered")
+}
+
+// commandRetried is logged when a failed stage command is retried per the stage's RetryPolicy
+func commandRetried(pipeline *Pipeline, ctx context.Context, commandID CommandID, retry int, err *app.Error) {
+	workflowID, _ := WorkflowID(ctx)
+	COMMAND_RETRIED.Log(pipeline.Service.Logger().Warn()).
+		Uint64("workflow", workflowID.UInt64()).
+		Uint64("cmd", commandID.UInt64()).
+		Int("retry", retry).
+		Uint64("err", err.ErrorID.UInt64()).
+		Msg("command retried")
+}
+
+// contextCompensated is logged after the compensation commands have been run for a failed workflow
+func contextCompensated(pipeline *Pipeline, ctx context.Context, compensations int) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_COMPENSATED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Int("compensations", compensations).Msg("context compensated")
+}
+
+// compensationFailed is logged when a stage's compensation command fails
+func compensationFailed(pipeline *Pipeline, ctx context.Context, commandID CommandID, err *app.Error) {
+	workflowID, _ := WorkflowID(ctx)
+	COMPENSATION_FAILED.Log(pipeline.Service.Logger().Error()).
+		Uint64("workflow", workflowID.UInt64()).
+		Uint64("cmd", commandID.UInt64()).
+		Err(err).
+		Msg("compensation failed")
+}
+
+// contextRedelivered is logged when a failed workflow is redelivered from the durable queue
+func contextRedelivered(pipeline *Pipeline, ctx context.Context, delivery uint8) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_REDELIVERED.Log(pipeline.Service.Logger().Warn()).Uint64("workflow", workflowID.UInt64()).Uint8("delivery", delivery).Msg("context redelivered")
+}
+
+// contextDeadLettered is logged when a workflow input is moved from the durable queue to the dead letter bucket
+func contextDeadLettered(pipeline *Pipeline, ctx context.Context, key string) {
+	workflowID, _ := WorkflowID(ctx)
+	CONTEXT_DEAD_LETTERED.Log(pipeline.Service.Logger().Error()).Uint64("workflow", workflowID.UInt64()).Str("key", key).Msg("context dead lettered")
+}
+
+// durableQueueReplayed is logged after the pending durable queue entries have been replayed at startup
+func durableQueueReplayed(pipeline *Pipeline, count int) {
+	DURABLE_QUEUE_REPLAYED.Log(pipeline.Service.Logger().Info()).Int("count", count).Msg("durable queue replayed")
+}
+
+func stagePoolResized(pipeline *Pipeline, stage int, from, to uint8) {
+	STAGE_POOL_RESIZED.Log(pipeline.Service.Logger().Info()).Int("stage", stage).Uint8("from", from).Uint8("to", to).Msg("stage pool resized")
+}
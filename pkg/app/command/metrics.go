@@ -14,7 +14,10 @@
 
 package command
 
-import "github.com/oysterpack/oysterpack.go/pkg/app"
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 const (
 	LABEL_COMMAND = "cmd"
@@ -32,6 +35,30 @@ const (
 	// total accumulative command processing time for commands that failed
 	COMMAND_PROCESSING_TIME_SEC_FAILED = app.MetricID(0x86f01c622f5894c5)
 
+	// number of times a Context delivery to the stage blocked because the stage input buffer was full - OverflowPolicy_BLOCK
+	COMMAND_BUFFER_BLOCKED_COUNT = app.MetricID(0xabe6741020a65dfd)
+	// total accumulative time spent blocked waiting for room in the stage input buffer - OverflowPolicy_BLOCK
+	COMMAND_BUFFER_BLOCKED_TIME_SEC = app.MetricID(0xee15b1aff0881ebe)
+	// number of Context(s) dropped because the stage input buffer was full - OverflowPolicy_DROP_NEWEST
+	COMMAND_BUFFER_DROPPED_NEWEST_COUNT = app.MetricID(0x8603c5ea1c48bb84)
+	// number of buffered Context(s) evicted to make room in the stage input buffer - OverflowPolicy_DROP_OLDEST
+	COMMAND_BUFFER_DROPPED_OLDEST_COUNT = app.MetricID(0xd410c5f2b3e720f5)
+	// number of Context(s) rejected because the stage input buffer was full - OverflowPolicy_REJECT
+	COMMAND_BUFFER_REJECTED_COUNT = app.MetricID(0xdc54e43b2af976cb)
+
+	// total accumulative time that contexts waited in the stage input queue before being picked up by a stage worker
+	COMMAND_QUEUE_WAIT_TIME_SEC = app.MetricID(0x9f9133ee69953a86)
+
+	// number of Context(s) dropped by a filter stage
+	COMMAND_FILTERED_COUNT = app.MetricID(0xa86d3f0c5e21b947)
+
+	// number of times the command was retried per the stage's RetryPolicy
+	COMMAND_RETRY_COUNT = app.MetricID(0xebd70450446989cd)
+	// number of times the stage's compensation command was run, i.e., because a downstream stage failed the workflow
+	COMMAND_COMPENSATION_COUNT = app.MetricID(0xd104f5564f490921)
+	// number of times the stage's compensation command failed
+	COMMAND_COMPENSATION_FAILED_COUNT = app.MetricID(0xc75fba1140a5b98d)
+
 	////////////////
 	// Counters ///
 	//////////////
@@ -51,6 +78,17 @@ const (
 	// total accumulative time to deliver the message downstream on the pipeline once it has been processed by the command on the pipeline stage
 	PIPELINE_CHANNEL_DELIVERY_TIME_SEC = app.MetricID(0xca8cbbbb26c8eac7)
 
+	// number of workflow inputs that were persisted to the durable queue
+	PIPELINE_DURABLE_PERSISTED_COUNT = app.MetricID(0xd84817f0c3619174)
+	// number of durable queue entries that were acked
+	PIPELINE_DURABLE_ACKED_COUNT = app.MetricID(0xbb7362c32deb47bc)
+	// number of failed workflows that were redelivered from the durable queue
+	PIPELINE_DURABLE_REDELIVERED_COUNT = app.MetricID(0xb8211d88bfd6be1d)
+	// number of durable queue entries that were replayed when the pipeline was started
+	PIPELINE_DURABLE_REPLAYED_COUNT = app.MetricID(0xb2bf6f1740d72c5b)
+	// number of durable queue entries that were moved to the dead letter bucket
+	PIPELINE_DURABLE_DEAD_LETTER_COUNT = app.MetricID(0xca0dc27628c3c2ee)
+
 	// ping-pong success counter
 	PIPELINE_PING_PONG_COUNT = app.MetricID(0xd6129832e634c841)
 	// total accumulative time for ping-pong messaging
@@ -77,6 +115,13 @@ const (
 	PIPELINE_CONSECUTIVE_FAILURE_COUNT = app.MetricID(0xee4fb31af48e1b6d)
 	// number of consecutive expired contexts
 	PIPELINE_CONSECUTIVE_EXPIRED_COUNT = app.MetricID(0x8720d50302cfcff7)
+
+	/////////////////////
+	// Gauge Vectors ///
+	///////////////////
+
+	// number of workers in the stage pool
+	COMMAND_POOL_SIZE = app.MetricID(0xbf0a7ba0a92ec4c1)
 )
 
 var (
@@ -87,6 +132,24 @@ var (
 		COMMAND_PROCESSING_TIME_SEC_FAILED,
 	}
 
+	// The stage feature metrics are optional. If a metric is not registered, then the stage falls back to an unregistered
+	// metric, i.e., the metric is tracked but not exported.
+	OPTIONAL_COUNTER_VECTOR_METRIC_IDS = []app.MetricID{
+		COMMAND_BUFFER_BLOCKED_COUNT,
+		COMMAND_BUFFER_BLOCKED_TIME_SEC,
+		COMMAND_BUFFER_DROPPED_NEWEST_COUNT,
+		COMMAND_BUFFER_DROPPED_OLDEST_COUNT,
+		COMMAND_BUFFER_REJECTED_COUNT,
+
+		COMMAND_QUEUE_WAIT_TIME_SEC,
+
+		COMMAND_FILTERED_COUNT,
+
+		COMMAND_RETRY_COUNT,
+		COMMAND_COMPENSATION_COUNT,
+		COMMAND_COMPENSATION_FAILED_COUNT,
+	}
+
 	COUNTER_METRIC_IDS = []app.MetricID{
 		PIPELINE_RUN_COUNT,
 		PIPELINE_FAILED_COUNT,
@@ -102,6 +165,15 @@ var (
 		PIPELINE_PING_EXPIRED_TIME_SEC,
 	}
 
+	// required only for durable pipelines - see StartDurablePipeline()
+	DURABLE_COUNTER_METRIC_IDS = []app.MetricID{
+		PIPELINE_DURABLE_PERSISTED_COUNT,
+		PIPELINE_DURABLE_ACKED_COUNT,
+		PIPELINE_DURABLE_REDELIVERED_COUNT,
+		PIPELINE_DURABLE_REPLAYED_COUNT,
+		PIPELINE_DURABLE_DEAD_LETTER_COUNT,
+	}
+
 	GAUGE_METRIC_IDS = []app.MetricID{
 		PIPELINE_LAST_SUCCESS_TIME,
 		PIPELINE_LAST_FAILURE_TIME,
@@ -113,4 +185,26 @@ var (
 		PIPELINE_CONSECUTIVE_FAILURE_COUNT,
 		PIPELINE_CONSECUTIVE_EXPIRED_COUNT,
 	}
+
+	OPTIONAL_GAUGE_VECTOR_METRIC_IDS = []app.MetricID{
+		COMMAND_POOL_SIZE,
+	}
 )
+
+// commandCounter returns the command's counter from the registered counter vector. If the counter vector is not registered,
+// then an unregistered counter is returned.
+func commandCounter(serviceID app.ServiceID, metricID app.MetricID, commandID CommandID) prometheus.Counter {
+	if metric := app.MetricRegistry.CounterVector(serviceID, metricID); metric != nil {
+		return metric.CounterVec.With(prometheus.Labels{LABEL_COMMAND: commandID.Hex()})
+	}
+	return prometheus.NewCounter(prometheus.CounterOpts{Name: metricID.PrometheusName(serviceID)})
+}
+
+// commandGauge returns the command's gauge from the registered gauge vector. If the gauge vector is not registered,
+// then an unregistered gauge is returned.
+func commandGauge(serviceID app.ServiceID, metricID app.MetricID, commandID CommandID) prometheus.Gauge {
+	if metric := app.MetricRegistry.GaugeVector(serviceID, metricID); metric != nil {
+		return metric.GaugeVec.With(prometheus.Labels{LABEL_COMMAND: commandID.Hex()})
+	}
+	return prometheus.NewGauge(prometheus.GaugeOpts{Name: metricID.PrometheusName(serviceID)})
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+// OverflowPolicy determines what happens when a Context is delivered to a stage whose input buffer is full.
+// The values map 1:1 to the config.Pipeline_Stage_OverflowPolicy enum values.
+type OverflowPolicy uint8
+
+func (a OverflowPolicy) UInt8() uint8 {
+	return uint8(a)
+}
+
+func (a OverflowPolicy) String() string {
+	switch a {
+	case OverflowPolicy_BLOCK:
+		return "block"
+	case OverflowPolicy_DROP_NEWEST:
+		return "dropNewest"
+	case OverflowPolicy_DROP_OLDEST:
+		return "dropOldest"
+	case OverflowPolicy_REJECT:
+		return "reject"
+	default:
+		return "unknown"
+	}
+}
+
+// OverflowPolicy enum values
+const (
+	// the upstream stage waits until there is room in the buffer - this is the default
+	OverflowPolicy_BLOCK = OverflowPolicy(iota)
+	// the Context that is being delivered is dropped
+	OverflowPolicy_DROP_NEWEST
+	// the oldest buffered Context is evicted to make room for the Context that is being delivered
+	OverflowPolicy_DROP_OLDEST
+	// the workflow is aborted with an ErrSpec_StageBufferFull error, which is returned on the output channel
+	OverflowPolicy_REJECT
+)
+
+// deliver sends the Context to the stage's input channel. If the stage's input buffer is full, then the stage's
+// OverflowPolicy is applied. true is returned if the Context was delivered to the stage.
+func (a *Pipeline) deliver(ctx context.Context, stage *Stage, in chan context.Context) bool {
+	ctx = withStageEnqueuedOn(ctx)
+	select {
+	case in <- ctx:
+		return true
+	default:
+	}
+
+	switch stage.OverflowPolicy() {
+	case OverflowPolicy_DROP_NEWEST:
+		stage.droppedNewestCounter.Inc()
+		contextDropped(a, ctx, stage.cmd.id)
+		a.deadLetter(ctx)
+		return false
+	case OverflowPolicy_DROP_OLDEST:
+		for {
+			select {
+			case in <- ctx:
+				return true
+			default:
+			}
+			select {
+			case dropped := <-in:
+				stage.droppedOldestCounter.Inc()
+				contextDropped(a, dropped, stage.cmd.id)
+				a.deadLetter(dropped)
+			default:
+				// there is nothing to evict, i.e., the buffer was drained by the stage workers - wait for room
+				select {
+				case <-a.Service.Dying():
+					return false
+				case <-ctx.Done():
+					pipelineContextExpired(ctx, a, stage.cmd.id).Log(a.Service.Logger())
+					return false
+				case in <- ctx:
+					return true
+				}
+			}
+		}
+	case OverflowPolicy_REJECT:
+		stage.rejectedCounter.Inc()
+		a.reject(ctx, stage, stageBufferFullError(ctx, a, stage.cmd.id))
+		return false
+	default:
+		stage.blockedCounter.Inc()
+		start := time.Now()
+		defer func() {
+			stage.blockedTime.Add(time.Now().Sub(start).Seconds())
+		}()
+		select {
+		case <-a.Service.Dying():
+			return false
+		case <-ctx.Done():
+			pipelineContextExpired(ctx, a, stage.cmd.id).Log(a.Service.Logger())
+			return false
+		case in <- ctx:
+			return true
+		}
+	}
+}
+
+// tryDeliver is the non-blocking version of deliver, i.e., OverflowPolicy_BLOCK is treated as OverflowPolicy_REJECT.
+// If the Context was not delivered, then the reason is returned as an error.
+func (a *Pipeline) tryDeliver(ctx context.Context, stage *Stage, in chan context.Context) error {
+	ctx = withStageEnqueuedOn(ctx)
+	select {
+	case in <- ctx:
+		return nil
+	default:
+	}
+
+	switch stage.OverflowPolicy() {
+	case OverflowPolicy_DROP_NEWEST:
+		stage.droppedNewestCounter.Inc()
+		contextDropped(a, ctx, stage.cmd.id)
+	case OverflowPolicy_DROP_OLDEST:
+		for i := 0; i < cap(in); i++ {
+			select {
+			case dropped := <-in:
+				stage.droppedOldestCounter.Inc()
+				contextDropped(a, dropped, stage.cmd.id)
+				a.deadLetter(dropped)
+			default:
+			}
+			select {
+			case in <- ctx:
+				return nil
+			default:
+			}
+		}
+		stage.rejectedCounter.Inc()
+		contextRejected(a, ctx, stage.cmd.id)
+	default:
+		stage.rejectedCounter.Inc()
+		contextRejected(a, ctx, stage.cmd.id)
+	}
+	return stageBufferFullError(ctx, a, stage.cmd.id)
+}
+
+// reject aborts the workflow because the stage rejected the Context, i.e., the completed stages are compensated, and the
+// Context is returned with the error on its output channel - see abort()
+func (a *Pipeline) reject(ctx context.Context, stage *Stage, err *app.Error) {
+	contextRejected(a, ctx, stage.cmd.id)
+	a.consecutiveFailureCounter.Inc()
+	a.consecutiveSuccessCounter.Set(0)
+	a.abort(ctx, stage, err, time.Now())
+}
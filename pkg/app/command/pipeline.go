@@ -24,6 +24,7 @@ import (
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/command/config"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -42,6 +43,7 @@ func PipelineIDs() []PipelineID {
 	i := 0
 	for id := range pipelines {
 		ids[i] = id
+		i++
 	}
 	return ids
 }
@@ -80,6 +82,7 @@ func StartPipelineFromConfig(service *app.Service) *Pipeline {
 	}
 
 	app.SERVICE_STARTING.Log(service.Logger().Info()).Msg("Pipeline starting")
+	app.Events.Publish(app.NewServiceEvent(app.SERVICE_STARTING, service.ID(), nil))
 
 	cfg, err := app.Configs.Config(service.ID())
 	if err != nil {
@@ -101,16 +104,92 @@ func StartPipelineFromConfig(service *app.Service) *Pipeline {
 	stages := make([]Stage, stageList.Len())
 	for i := 0; i < stageList.Len(); i++ {
 		s := stageList.At(i)
-		commandID := CommandID(s.CommandID())
-		command, ok := GetCommand(commandID)
-		if !ok {
-			panic(fmt.Sprintf("Command is not registered : CommandID(0x%x)", commandID))
+		stages[i] = newStageFromConfig(service.ID(), s).
+			WithInputBuffer(s.BufferSize(), OverflowPolicy(s.OverflowPolicy()))
+		if s.HasAutoscaler() {
+			autoscaler, err := s.Autoscaler()
+			if err != nil {
+				panic(err)
+			}
+			stages[i] = stages[i].WithAutoscaler(Autoscaler{
+				MinPoolSize:        autoscaler.MinPoolSize(),
+				MaxPoolSize:        autoscaler.MaxPoolSize(),
+				ScaleUpQueueWait:   time.Duration(autoscaler.ScaleUpQueueWaitMSec()) * time.Millisecond,
+				ScaleDownQueueWait: time.Duration(autoscaler.ScaleDownQueueWaitMSec()) * time.Millisecond,
+				Interval:           time.Duration(autoscaler.IntervalSec()) * time.Second,
+			})
+		}
+		if s.HasRetryPolicy() {
+			retryPolicy, err := s.RetryPolicy()
+			if err != nil {
+				panic(err)
+			}
+			errorIDList, err := retryPolicy.ErrorIDs()
+			if err != nil {
+				panic(err)
+			}
+			errorIDs := make([]app.ErrorID, errorIDList.Len())
+			for i := 0; i < errorIDList.Len(); i++ {
+				errorIDs[i] = app.ErrorID(errorIDList.At(i))
+			}
+			stages[i] = stages[i].WithRetryPolicy(RetryPolicy{
+				MaxAttempts:         retryPolicy.MaxAttempts(),
+				InitialBackoff:      time.Duration(retryPolicy.InitialBackoffMSec()) * time.Millisecond,
+				BackoffMultiplier:   float64(retryPolicy.BackoffMultiplier()),
+				MaxBackoff:          time.Duration(retryPolicy.MaxBackoffMSec()) * time.Millisecond,
+				ErrorIDs:            errorIDs,
+				RetryKnownEdgeCases: retryPolicy.RetryKnownEdgeCases(),
+			})
+		}
+		if s.CompensationCommandID() != 0 {
+			stages[i] = stages[i].WithCompensation(mustGetCommand(CommandID(s.CompensationCommandID())))
 		}
-		stages[i] = NewStage(service.ID(), Command{commandID, command}, s.PoolSize())
 	}
 	return StartPipeline(service, stages...)
 }
 
+func newStageFromConfig(serviceID app.ServiceID, s config.Pipeline_Stage) Stage {
+	commandID := CommandID(s.CommandID())
+	switch StageKind(s.Kind()) {
+	case StageKind_FAN_OUT:
+		join, ok := GetJoinFunc(commandID)
+		if !ok {
+			panic(fmt.Sprintf("JoinFunc is not registered : CommandID(0x%x)", commandID))
+		}
+		commandIDs, err := s.FanOutCommandIDs()
+		if err != nil {
+			panic(err)
+		}
+		commands := make([]Command, commandIDs.Len())
+		for i := 0; i < commandIDs.Len(); i++ {
+			commands[i] = mustGetCommand(CommandID(commandIDs.At(i)))
+		}
+		return NewFanOutStage(serviceID, commandID, join, s.PoolSize(), commands...)
+	case StageKind_ROUTER:
+		router, ok := GetRouterFunc(commandID)
+		if !ok {
+			panic(fmt.Sprintf("RouterFunc is not registered : CommandID(0x%x)", commandID))
+		}
+		return NewRouterStage(serviceID, commandID, router, s.PoolSize())
+	case StageKind_FILTER:
+		filter, ok := GetFilterFunc(commandID)
+		if !ok {
+			panic(fmt.Sprintf("FilterFunc is not registered : CommandID(0x%x)", commandID))
+		}
+		return NewFilterStage(serviceID, commandID, filter, s.PoolSize())
+	default:
+		return NewStage(serviceID, mustGetCommand(commandID), s.PoolSize())
+	}
+}
+
+func mustGetCommand(commandID CommandID) Command {
+	command, ok := GetCommand(commandID)
+	if !ok {
+		panic(fmt.Sprintf("Command is not registered : CommandID(0x%x)", commandID))
+	}
+	return Command{commandID, command}
+}
+
 // StartPipeline will start a new Pipeline and return it - if the pipeline is not registered.
 // If a pipeline is already registered for the specified service, then the registered Pipeline is returned.
 //
@@ -118,8 +197,28 @@ func StartPipelineFromConfig(service *app.Service) *Pipeline {
 //	- if service is nil or not alive
 //	- if there are no stages
 //	- if any of the stages run function is undefined, i.e., nil
-//	- if any required metrics are not registered
+//	- if any of the stages uses the OverflowPolicy_DROP_NEWEST or OverflowPolicy_DROP_OLDEST overflow policy without an input buffer
+//	- if any required metrics are not registered - the OPTIONAL_COUNTER_VECTOR_METRIC_IDS and OPTIONAL_GAUGE_VECTOR_METRIC_IDS
+//	  metrics are not required
 func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
+	return startPipeline(service, nil, stages...)
+}
+
+// StartDurablePipeline will start a new Pipeline that is backed by a DurableQueue, and return it - if the pipeline is not registered.
+// If a pipeline is already registered for the specified service, then the registered Pipeline is returned.
+//
+// The workflow inputs are persisted to the DurableQueue when they are submitted to the pipeline, i.e., before they are
+// buffered by the first stage - see InputChan() and TrySubmit(). Any pending entries are replayed when the pipeline is
+// started. Ping-pong Context(s) are never persisted.
+//
+// In addition to the StartPipeline() panic conditions, the following will trigger a panic:
+//	- if the DurableQueue Bucket, DeadLetterBucket, or Codec is nil
+//	- if any of the DURABLE_COUNTER_METRIC_IDS metrics are not registered
+func StartDurablePipeline(service *app.Service, queue DurableQueue, stages ...Stage) *Pipeline {
+	return startPipeline(service, &queue, stages...)
+}
+
+func startPipeline(service *app.Service, queue *DurableQueue, stages ...Stage) *Pipeline {
 	startPipelineMutex.Lock()
 	defer startPipelineMutex.Unlock()
 
@@ -141,6 +240,18 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 			if stage.Command().run == nil {
 				panic(fmt.Sprintf("Stage Command run function was nil for : ServiceID(0x%x)", service.ID()))
 			}
+			if stage.compensation != nil && stage.compensation.run == nil {
+				panic(fmt.Sprintf("Stage compensation Command run function was nil for : ServiceID(0x%x)", service.ID()))
+			}
+			switch stage.OverflowPolicy() {
+			case OverflowPolicy_DROP_NEWEST, OverflowPolicy_DROP_OLDEST:
+				if stage.BufferSize() == 0 {
+					panic(fmt.Sprintf("The %v overflow policy requires a stage input buffer : ServiceID(0x%x) : CommandID(0x%x)", stage.OverflowPolicy(), service.ID(), stage.Command().id))
+				}
+			}
+		}
+		if stages[len(stages)-1].kind == StageKind_ROUTER {
+			panic(fmt.Sprintf("A router stage cannot be the last stage : ServiceID(0x%x)", service.ID()))
 		}
 
 		serviceID := service.ID()
@@ -159,6 +270,17 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 				panic(fmt.Sprintf("Gauge metric is missing : MetricID(0x%x)", metricID))
 			}
 		}
+
+		if queue != nil {
+			if queue.Bucket == nil || queue.DeadLetterBucket == nil || queue.Codec == nil {
+				panic(fmt.Sprintf("DurableQueue Bucket, DeadLetterBucket, and Codec are required : ServiceID(0x%x)", service.ID()))
+			}
+			for _, metricID := range DURABLE_COUNTER_METRIC_IDS {
+				if app.MetricRegistry.Counter(serviceID, metricID) == nil {
+					panic(fmt.Sprintf("Counter metric is missing : MetricID(0x%x)", metricID))
+				}
+			}
+		}
 	}
 
 	checkArgs()
@@ -168,7 +290,7 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 	pipeline := &Pipeline{
 		Service:   service,
 		startedOn: time.Now(),
-		in:        make(chan context.Context),
+		in:        make(chan context.Context, stages[0].BufferSize()),
 		out:       make(chan context.Context),
 		stages:    stages,
 
@@ -194,157 +316,185 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 		lastPingSuccessTime: app.MetricRegistry.Gauge(serviceID, PIPELINE_LAST_PING_SUCCESS_TIME),
 		lastPingExpiredTime: app.MetricRegistry.Gauge(serviceID, PIPELINE_LAST_PING_EXPIRED_TIME),
 	}
-
-	firstStageCommandID := pipeline.stages[0].cmd.id
-	var build func(stages []Stage, in, out chan context.Context)
-	build = func(stages []Stage, in, out chan context.Context) {
-		createStageWorkers := func(stage Stage, process func(ctx context.Context)) {
-			for i := 0; i < int(stage.PoolSize()); i++ {
-				if stage.cmd.id == firstStageCommandID {
-					service.Go(func() error {
-						for {
-							select {
-							case <-service.Dying():
-								return nil
-							case ctx := <-in:
-								select {
-								case <-ctx.Done():
-									pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-								default:
-									// record the time when the context started the workflow, i.e., entered the first stage of the pipeline
-									ctx = startWorkflowTimer(ctx)
-									pipeline.runCounter.Inc()
-									process(ctx)
-								}
-							}
-						}
-					})
-				} else {
-					service.Go(func() error {
-						for {
-							select {
-							case <-service.Dying():
-								return nil
-							case ctx := <-in:
-								select {
-								case <-ctx.Done():
-									pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-								default:
-									process(ctx)
-								}
-							}
-						}
-					})
-				}
-
-			}
+	for _, stage := range stages {
+		if stage.compensation != nil {
+			pipeline.compensated = true
 		}
-		stage := stages[0]
-		if len(stages) == 1 {
-			createStageWorkers(stage, func(ctx context.Context) {
-				if IsPing(ctx) {
-					// reply with pong
-					ctx = withPong(ctx)
-					out, ok := OutputChannel(ctx)
-					if !ok {
-						out = pipeline.out
-					}
-
-					select {
-					case <-service.Dying():
-					case <-ctx.Done():
-						pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-					case out <- ctx:
-						pipeline.lastPingSuccessTime.Set(float64(time.Now().Unix()))
-					}
-					return
-				}
-
-				result := stage.run(ctx)
-				processedTime := time.Now()
-				processingDuration := time.Now().Sub(WorkflowStartTime(ctx))
-				workflowTime := processingDuration.Seconds()
-				pipeline.processingTime.Add(workflowTime)
-				if err := Error(result); err != nil {
-					contextFailed(pipeline, ctx)
-					pipeline.failedCounter.Inc()
-					pipeline.processingFailedTime.Add(workflowTime)
-					result = WithError(result, stage.Command().id, err)
-					pipeline.lastFailureTime.Set(float64(time.Now().Unix()))
-					pipeline.consecutiveFailureCounter.Inc()
-					pipeline.consecutiveSuccessCounter.Set(0)
-				}
+	}
+	pipeline.submit = make(chan context.Context)
+	if queue != nil {
+		pipeline.durable = newDurableQueue(serviceID, *queue)
+		pipeline.replayed = make(chan context.Context)
+	}
 
-				out, ok := OutputChannel(result)
-				if !ok {
-					out = pipeline.out
-				}
+	// each stage's input channel, which is buffered per the stage's buffer size
+	ins := make([]chan context.Context, len(stages))
+	ins[0] = pipeline.in
+	for i := 1; i < len(stages); i++ {
+		ins[i] = make(chan context.Context, stages[i].BufferSize())
+	}
 
-				select {
-				case <-service.Dying():
-					return
-				case <-result.Done():
-					pipelineContextExpired(result, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-				case out <- result:
-					deliveryTime := time.Now().Sub(processedTime).Seconds()
-					pipeline.channelDeliveryTime.Add(deliveryTime)
-
-					if Error(result) == nil {
-						pipeline.lastSuccessTime.Set(float64(time.Now().Unix()))
-						pipeline.consecutiveSuccessCounter.Inc()
-						pipeline.consecutiveFailureCounter.Set(0)
-						pipeline.consecutiveExpiredCounter.Set(0)
+	// forwards the Context(s) sent via InputChan() to the first stage - see enqueue().
+	// For durable pipelines, the workflow inputs are persisted before they are handed off to the first stage, i.e.,
+	// Context(s) that are buffered on the first stage's input channel are replayed if the process dies
+	service.Go(func() error {
+		for {
+			select {
+			case <-service.Dying():
+				return nil
+			case ctx := <-pipeline.submit:
+				if pipeline.durable != nil {
+					var err *app.Error
+					if ctx, err = pipeline.persist(ctx); err != nil {
+						pipeline.abort(ctx, &pipeline.stages[0], err, time.Now())
+						continue
 					}
 				}
-			})
-			return
+				if !pipeline.enqueue(ctx) {
+					return nil
+				}
+			}
 		}
+	})
+
+	createStageWorkers := func(i int, process func(ctx context.Context)) {
+		stage := &pipeline.stages[i]
+		pool := newStagePool(pipeline, stage, ins[i], func(ctx context.Context) {
+			select {
+			case <-ctx.Done():
+				pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
+			default:
+				if i == 0 {
+					// record the time when the context started the workflow, i.e., entered the first stage of the pipeline
+					ctx = startWorkflowTimer(ctx)
+					ctx = withWorkflowTrace(ctx)
+					pipeline.runCounter.Inc()
+				}
+				process(ctx)
+			}
+		})
+		stage.poolSize = pool.size()
+		pipeline.pools = append(pipeline.pools, pool)
+	}
 
-		createStageWorkers(stage, func(ctx context.Context) {
+	lastStage := len(stages) - 1
+	for i := 0; i < lastStage; i++ {
+		i, stage := i, pipeline.stages[i]
+		createStageWorkers(i, func(ctx context.Context) {
 			if IsPing(ctx) {
 				// send the context downstream, i.e., to the next stage
-				select {
-				case <-service.Dying():
-				case <-ctx.Done():
-					pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-				case out <- ctx:
-				}
+				pipeline.deliver(ctx, &pipeline.stages[i+1], ins[i+1])
 				return
 			}
 
-			result := stage.run(ctx)
+			result := stage.runWithRetries(pipeline, ctx)
 			processedTime := time.Now()
 			if err := Error(result); err != nil {
-				contextFailed(pipeline, ctx)
-				pipeline.failedCounter.Inc()
-				result = WithError(result, stage.Command().id, err)
-				pipeline.lastFailureTime.Set(float64(time.Now().Unix()))
-				select {
-				case <-service.Dying():
-					return
-				case <-result.Done():
-					pipelineContextExpired(result, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-				case pipeline.out <- result:
-					deliveryTime := time.Now().Sub(processedTime).Seconds()
-					pipeline.channelDeliveryTime.Add(deliveryTime)
-				}
-			} else {
-				select {
-				case <-service.Dying():
+				pipeline.abort(result, &stage, err, processedTime)
+				return
+			}
+			if filtered(result) {
+				stage.filteredCounter.Inc()
+				contextFiltered(pipeline, result, stage.cmd.id)
+				pipeline.ack(result)
+				return
+			}
+			if pipeline.compensated {
+				result = withCompletedStage(result, i)
+			}
+
+			next := i + 1
+			if commandID, ok := routedTo(result); ok && stage.kind == StageKind_ROUTER {
+				if next = pipeline.downstreamStage(i, commandID); next < 0 {
+					pipeline.abort(result, &stage, routeNotFoundError(result, pipeline, stage.cmd.id, commandID), processedTime)
 					return
-				case <-result.Done():
-					pipelineContextExpired(result, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
-				case out <- result:
-					deliveryTime := time.Now().Sub(processedTime).Seconds()
-					pipeline.channelDeliveryTime.Add(deliveryTime)
 				}
 			}
+			if pipeline.deliver(result, &pipeline.stages[next], ins[next]) {
+				deliveryTime := time.Now().Sub(processedTime).Seconds()
+				pipeline.channelDeliveryTime.Add(deliveryTime)
+			}
 		})
-
-		build(stages[1:], out, make(chan context.Context))
 	}
 
-	build(stages, pipeline.in, make(chan context.Context))
+	stage := pipeline.stages[lastStage]
+	createStageWorkers(lastStage, func(ctx context.Context) {
+		if IsPing(ctx) {
+			// reply with pong
+			ctx = withPong(ctx)
+			out, ok := OutputChannel(ctx)
+			if !ok {
+				out = pipeline.out
+			}
+
+			select {
+			case <-service.Dying():
+			case <-ctx.Done():
+				pipelineContextExpired(ctx, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
+			case out <- ctx:
+				pipeline.lastPingSuccessTime.Set(float64(time.Now().Unix()))
+			}
+			return
+		}
+
+		result := stage.runWithRetries(pipeline, ctx)
+		if filtered(result) {
+			stage.filteredCounter.Inc()
+			contextFiltered(pipeline, result, stage.cmd.id)
+			pipeline.ack(result)
+			return
+		}
+		processedTime := time.Now()
+		processingDuration := time.Now().Sub(WorkflowStartTime(ctx))
+		workflowTime := processingDuration.Seconds()
+		pipeline.processingTime.Add(workflowTime)
+		if err := Error(result); err != nil {
+			contextFailed(pipeline, ctx)
+			pipeline.failedCounter.Inc()
+			pipeline.processingFailedTime.Add(workflowTime)
+			result = pipeline.compensate(WithError(result, stage.Command().id, err))
+			pipeline.lastFailureTime.Set(float64(time.Now().Unix()))
+			pipeline.consecutiveFailureCounter.Inc()
+			pipeline.consecutiveSuccessCounter.Set(0)
+			if pipeline.nack(result) {
+				// the workflow will be retried
+				return
+			}
+		}
+
+		out, ok := OutputChannel(result)
+		if !ok {
+			out = pipeline.out
+		}
+
+		select {
+		case <-service.Dying():
+			return
+		case <-result.Done():
+			pipelineContextExpired(result, pipeline, stage.Command().CommandID()).Log(pipeline.Service.Logger())
+		case out <- result:
+			deliveryTime := time.Now().Sub(processedTime).Seconds()
+			pipeline.channelDeliveryTime.Add(deliveryTime)
+
+			if Error(result) == nil {
+				pipeline.ack(result)
+				pipeline.lastSuccessTime.Set(float64(time.Now().Unix()))
+				pipeline.consecutiveSuccessCounter.Inc()
+				pipeline.consecutiveFailureCounter.Set(0)
+				pipeline.consecutiveExpiredCounter.Set(0)
+			}
+		}
+	})
+
+	for i, pool := range pipeline.pools {
+		if pool.stage.autoscaler != nil {
+			stageIndex, pool := i, pool
+			service.Go(func() error {
+				pool.autoscale(stageIndex)
+				return nil
+			})
+		}
+	}
 
 	go func() {
 		defer unregisterPipeline(pipeline.ID())
@@ -356,6 +506,18 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 
 	registerPipeline(pipeline)
 	app.SERVICE_STARTED.Log(service.Logger().Info()).Msg("Pipeline started")
+	app.Events.Publish(app.NewServiceEvent(app.SERVICE_STARTED, service.ID(), nil))
+
+	if pipeline.durable != nil {
+		service.Go(func() error {
+			pipeline.discardReplayed()
+			return nil
+		})
+		service.Go(func() error {
+			pipeline.replay()
+			return nil
+		})
+	}
 
 	return pipeline
 }
@@ -379,15 +541,55 @@ func StartPipeline(service *app.Service, stages ...Stage) *Pipeline {
 //
 // What happens if an error is returned by a pipeline stage command ?
 // 	- The error is added to the Context using ctx_cmd_err as the key. The workflow is aborted, and the context is
-// 	  returned immediately on the pipeline output channel.
+// 	  returned immediately on the Context's output channel (see WithOutputChannel()), or on the pipeline output channel
+// 	  if the Context has no output channel.
+//	- If the stage is configured with a RetryPolicy and the error is retryable, then the command is retried before the
+//	  workflow is aborted - see Stage.WithRetryPolicy().
+//	- Before the failed context is returned, the compensation commands for the stages that have already completed are run
+//	  in reverse order, i.e., saga style - see Stage.WithCompensation().
+//
+// What kinds of stages are supported ?
+//	- StageKind_COMMAND - runs a single command - see NewStage()
+//	- StageKind_FAN_OUT - runs N commands in parallel and merges the results using a JoinFunc - see NewFanOutStage()
+//	- StageKind_ROUTER - picks the next downstream stage using a RouterFunc - see NewRouterStage()
+//	- StageKind_FILTER - drops Context(s) that are rejected by a FilterFunc - see NewFilterStage()
+//	- ping-pong Context(s) bypass fan-out commands, routers, and filters, i.e., they flow through every stage in order.
+//
+// Can the number of workers for a stage be changed while the pipeline is running ?
+//	- Yes - see SetStagePoolSize(). When a stage pool is shrunk, the workers that are removed finish processing their
+//	  current Context before they exit, i.e., in-flight contexts are not lost.
+//	- Stages can be configured with an Autoscaler, which adjusts the pool size based on the stage's queue wait time.
+//
+// What happens to in-flight Context(s) if the process dies ?
+//	- By default, they are lost. Pipelines that are started via StartDurablePipeline() persist the workflow inputs to a
+//	  DurableQueue when they are submitted, and replay pending workflow inputs when the pipeline is started.
+//
+// How is backpressure handled on the pipeline ?
+//	- Each stage's input channel can be buffered - see Stage.WithInputBuffer(). By default, stage input channels are unbuffered.
+//	- When a stage's input buffer is full, the stage's OverflowPolicy is applied, i.e., the upstream stage either blocks,
+//	  drops the newest Context, evicts the oldest buffered Context, or rejects the Context with an ErrSpec_StageBufferFull error.
+//	- TrySubmit() can be used to send a Context into the pipeline without blocking.
 type Pipeline struct {
 	*app.Service
 
 	startedOn time.Time
 
 	in, out chan context.Context
-
-	stages []Stage
+	// the channel returned by InputChan(). The Context(s) are forwarded to the first stage's input channel. For durable
+	// pipelines, the workflow inputs are persisted before they are forwarded.
+	submit chan context.Context
+	// durable pipelines only - the output channel for replayed workflows, which is drained by the pipeline
+	replayed chan context.Context
+
+	// protects the stage pool sizes
+	stagesMutex sync.RWMutex
+	stages      []Stage
+	// the worker pool for each stage - indexed by stage
+	pools []*stagePool
+	// true if any of the stages has a compensation command
+	compensated bool
+	// optional - see StartDurablePipeline()
+	durable *durableQueue
 
 	runCounter    prometheus.Counter
 	failedCounter prometheus.Counter
@@ -415,6 +617,33 @@ type Pipeline struct {
 	lastPingExpiredTime prometheus.Gauge
 }
 
+// abort aborts the workflow - the completed stages are compensated, and the failed Context is returned on the Context's
+// output channel, or on the pipeline output channel if the Context has no output channel.
+// For durable pipelines, the workflow is redelivered if it has remaining deliveries - see DurableQueue.
+func (a *Pipeline) abort(result context.Context, stage *Stage, err *app.Error, processedTime time.Time) {
+	contextFailed(a, result)
+	a.failedCounter.Inc()
+	result = a.compensate(WithError(result, stage.Command().id, err))
+	a.lastFailureTime.Set(float64(time.Now().Unix()))
+	if a.nack(result) {
+		// the workflow will be retried
+		return
+	}
+	out, ok := OutputChannel(result)
+	if !ok {
+		out = a.out
+	}
+	select {
+	case <-a.Service.Dying():
+		return
+	case <-result.Done():
+		pipelineContextExpired(result, a, stage.Command().CommandID()).Log(a.Service.Logger())
+	case out <- result:
+		deliveryTime := time.Now().Sub(processedTime).Seconds()
+		a.channelDeliveryTime.Add(deliveryTime)
+	}
+}
+
 // ID return the PipelineID. PipelineID is simply a type alias for ServiceID - in order to provide more type safety.
 func (a *Pipeline) ID() PipelineID {
 	return PipelineID(a.Service.ID())
@@ -424,15 +653,55 @@ func (a *Pipeline) StartedOn() time.Time {
 	return a.startedOn
 }
 
+// InputChan returns the channel used to send Context(s) into the pipeline.
+// For durable pipelines, the workflow input is persisted before the Context is handed off to the first stage.
 func (a *Pipeline) InputChan() chan<- context.Context {
-	return a.in
+	return a.submit
 }
 
 func (a *Pipeline) OutputChan() <-chan context.Context {
 	return a.out
 }
 
+// enqueue sends the Context to the first stage's input channel, blocking until the Context is accepted. The Context is
+// stamped with the time it was enqueued, which is what the first stage's queue wait time is based on - see Autoscaler.
+// false is returned if the pipeline service is dying.
+func (a *Pipeline) enqueue(ctx context.Context) bool {
+	select {
+	case <-a.Service.Dying():
+		return false
+	case a.in <- withStageEnqueuedOn(ctx):
+		return true
+	}
+}
+
+// TrySubmit sends the Context into the pipeline without blocking.
+// If the first stage's input buffer is full, then the first stage's OverflowPolicy is applied, where OverflowPolicy_BLOCK
+// is treated as OverflowPolicy_REJECT. An *app.Error (ErrSpec_StageBufferFull) is returned if the Context was not accepted.
+//
+// For durable pipelines, the workflow input is persisted before the Context is delivered. If the Context is not accepted,
+// then the durable queue entry is discarded, i.e., the caller is responsible for resubmitting the Context.
+func (a *Pipeline) TrySubmit(ctx context.Context) error {
+	if !a.Service.Alive() {
+		return app.ServiceNotAliveError(a.Service.ID())
+	}
+	if a.durable == nil {
+		return a.tryDeliver(ctx, &a.stages[0], a.in)
+	}
+	ctx, appErr := a.persist(ctx)
+	if appErr != nil {
+		return appErr
+	}
+	if err := a.tryDeliver(ctx, &a.stages[0], a.in); err != nil {
+		a.discard(ctx)
+		return err
+	}
+	return nil
+}
+
 func (a *Pipeline) Stages() []Stage {
+	a.stagesMutex.RLock()
+	defer a.stagesMutex.RUnlock()
 	stages := make([]Stage, len(a.stages))
 	for i := 0; i < len(stages); i++ {
 		stages[i] = a.stages[i]
@@ -440,6 +709,52 @@ func (a *Pipeline) Stages() []Stage {
 	return stages
 }
 
+// downstreamStage returns the index of the first stage after the specified stage that matches the CommandID.
+// -1 is returned if there is no match.
+func (a *Pipeline) downstreamStage(stage int, commandID CommandID) int {
+	for i := stage + 1; i < len(a.stages); i++ {
+		if a.stages[i].cmd.id == commandID {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetStagePoolSize grows or shrinks the worker pool for the stage while the pipeline is running.
+// Stages are identified by their index within the pipeline.
+// If the stage is configured with an Autoscaler, then the pool size will be bounded by the autoscaler's min and max pool size.
+//
+// errors:
+//	- ErrSpec_StageNotFound
+//	- ErrSpec_InvalidPoolSize - if pool size is 0
+//	- app.ErrSpec_ServiceNotAlive
+func (a *Pipeline) SetStagePoolSize(stage int, poolSize uint8) error {
+	if stage < 0 || stage >= len(a.stages) {
+		return stageNotFoundError(a, stage)
+	}
+	if poolSize == 0 {
+		return invalidPoolSizeError(a, stage, poolSize)
+	}
+	if !a.Service.Alive() {
+		return app.ServiceNotAliveError(a.Service.ID())
+	}
+
+	a.stagesMutex.Lock()
+	defer a.stagesMutex.Unlock()
+	if autoscaler := a.stages[stage].autoscaler; autoscaler != nil {
+		poolSize = autoscaler.bound(poolSize)
+	}
+	from := a.pools[stage].size()
+	if from == poolSize {
+		return nil
+	}
+	a.pools[stage].resize(poolSize)
+	a.stages[stage].poolSize = poolSize
+	stagePoolResized(a, stage, from, poolSize)
+	return nil
+}
+
+// NewStage returns a new Stage with an unbuffered input channel. To configure the stage's input buffer see Stage.WithInputBuffer().
 func NewStage(serviceID app.ServiceID, cmd Command, poolSize uint8) Stage {
 	return Stage{cmd: cmd,
 		poolSize:             poolSize,
@@ -447,6 +762,21 @@ func NewStage(serviceID app.ServiceID, cmd Command, poolSize uint8) Stage {
 		failedCounter:        app.MetricRegistry.CounterVector(serviceID, COMMAND_FAILED_COUNT).CounterVec.With(prometheus.Labels{LABEL_COMMAND: cmd.CommandID().Hex()}),
 		processingTime:       app.MetricRegistry.CounterVector(serviceID, COMMAND_PROCESSING_TIME_SEC).CounterVec.With(prometheus.Labels{LABEL_COMMAND: cmd.CommandID().Hex()}),
 		processingFailedTime: app.MetricRegistry.CounterVector(serviceID, COMMAND_PROCESSING_TIME_SEC_FAILED).CounterVec.With(prometheus.Labels{LABEL_COMMAND: cmd.CommandID().Hex()}),
+
+		blockedCounter:       commandCounter(serviceID, COMMAND_BUFFER_BLOCKED_COUNT, cmd.CommandID()),
+		blockedTime:          commandCounter(serviceID, COMMAND_BUFFER_BLOCKED_TIME_SEC, cmd.CommandID()),
+		droppedNewestCounter: commandCounter(serviceID, COMMAND_BUFFER_DROPPED_NEWEST_COUNT, cmd.CommandID()),
+		droppedOldestCounter: commandCounter(serviceID, COMMAND_BUFFER_DROPPED_OLDEST_COUNT, cmd.CommandID()),
+		rejectedCounter:      commandCounter(serviceID, COMMAND_BUFFER_REJECTED_COUNT, cmd.CommandID()),
+
+		queueWaitTime: commandCounter(serviceID, COMMAND_QUEUE_WAIT_TIME_SEC, cmd.CommandID()),
+		poolSizeGauge: commandGauge(serviceID, COMMAND_POOL_SIZE, cmd.CommandID()),
+
+		filteredCounter: commandCounter(serviceID, COMMAND_FILTERED_COUNT, cmd.CommandID()),
+
+		retryCounter:              commandCounter(serviceID, COMMAND_RETRY_COUNT, cmd.CommandID()),
+		compensationCounter:       commandCounter(serviceID, COMMAND_COMPENSATION_COUNT, cmd.CommandID()),
+		compensationFailedCounter: commandCounter(serviceID, COMMAND_COMPENSATION_FAILED_COUNT, cmd.CommandID()),
 	}
 }
 
@@ -455,10 +785,48 @@ type Stage struct {
 	cmd      Command
 	poolSize uint8
 
+	kind StageKind
+	// fan-out commands
+	branches []Stage
+
+	// input channel buffer size
+	bufferSize     uint16
+	overflowPolicy OverflowPolicy
+
+	// optional
+	autoscaler   *Autoscaler
+	retryPolicy  *RetryPolicy
+	compensation *Command
+
 	runCounter           prometheus.Counter
 	failedCounter        prometheus.Counter
 	processingTime       prometheus.Counter
 	processingFailedTime prometheus.Counter
+
+	blockedCounter       prometheus.Counter
+	blockedTime          prometheus.Counter
+	droppedNewestCounter prometheus.Counter
+	droppedOldestCounter prometheus.Counter
+	rejectedCounter      prometheus.Counter
+
+	queueWaitTime prometheus.Counter
+	poolSizeGauge prometheus.Gauge
+
+	filteredCounter prometheus.Counter
+
+	retryCounter              prometheus.Counter
+	compensationCounter       prometheus.Counter
+	compensationFailedCounter prometheus.Counter
+}
+
+// WithInputBuffer returns a copy of the stage configured with a buffered input channel. The overflow policy is applied
+// when a Context is delivered to the stage while the input buffer is full.
+//
+// OverflowPolicy_DROP_NEWEST and OverflowPolicy_DROP_OLDEST require bufferSize > 0 - see StartPipeline()
+func (a Stage) WithInputBuffer(bufferSize uint16, overflowPolicy OverflowPolicy) Stage {
+	a.bufferSize = bufferSize
+	a.overflowPolicy = overflowPolicy
+	return a
 }
 
 // Command returns the stage's command
@@ -466,6 +834,24 @@ func (a *Stage) Command() Command {
 	return a.cmd
 }
 
+// Kind returns the stage kind
+func (a *Stage) Kind() StageKind {
+	return a.kind
+}
+
+// FanOutCommands returns the commands that are run in parallel by a fan-out stage.
+// For any other kind of stage, nil is returned.
+func (a *Stage) FanOutCommands() []Command {
+	if len(a.branches) == 0 {
+		return nil
+	}
+	commands := make([]Command, len(a.branches))
+	for i := range a.branches {
+		commands[i] = a.branches[i].cmd
+	}
+	return commands
+}
+
 // PoolSize returns the number of concurrent command instances to run in this stage
 func (a *Stage) PoolSize() uint8 {
 	if a.poolSize == 0 {
@@ -474,16 +860,80 @@ func (a *Stage) PoolSize() uint8 {
 	return a.poolSize
 }
 
+// WithAutoscaler returns a copy of the stage configured with an Autoscaler, which will adjust the stage's pool size
+// while the pipeline is running.
+func (a Stage) WithAutoscaler(autoscaler Autoscaler) Stage {
+	a.autoscaler = &autoscaler
+	return a
+}
+
+// Autoscaler returns the stage's Autoscaler, or nil if the stage is not autoscaled
+func (a *Stage) Autoscaler() *Autoscaler {
+	if a.autoscaler == nil {
+		return nil
+	}
+	autoscaler := *a.autoscaler
+	return &autoscaler
+}
+
+// WithRetryPolicy returns a copy of the stage configured with a RetryPolicy, which is applied when the stage command fails.
+func (a Stage) WithRetryPolicy(retryPolicy RetryPolicy) Stage {
+	a.retryPolicy = &retryPolicy
+	return a
+}
+
+// RetryPolicy returns the stage's RetryPolicy, or nil if failed commands are not retried
+func (a *Stage) RetryPolicy() *RetryPolicy {
+	if a.retryPolicy == nil {
+		return nil
+	}
+	retryPolicy := *a.retryPolicy
+	return &retryPolicy
+}
+
+// WithCompensation returns a copy of the stage configured with a compensation command. If a downstream stage fails the
+// workflow, then the compensation command is run to undo the work that was done by this stage.
+func (a Stage) WithCompensation(compensation Command) Stage {
+	a.compensation = &compensation
+	return a
+}
+
+// Compensation returns the stage's compensation command. false is returned if the stage has no compensation command.
+func (a *Stage) Compensation() (Command, bool) {
+	if a.compensation == nil {
+		return Command{}, false
+	}
+	return *a.compensation, true
+}
+
+// BufferSize returns the stage's input channel buffer size. 0 means the input channel is unbuffered.
+func (a *Stage) BufferSize() uint16 {
+	return a.bufferSize
+}
+
+// OverflowPolicy returns the policy that is applied when the stage's input buffer is full
+func (a *Stage) OverflowPolicy() OverflowPolicy {
+	return a.overflowPolicy
+}
+
 func (a *Stage) run(in context.Context) context.Context {
 	a.runCounter.Inc()
 	in = withStageCommandID(in, a.cmd.id)
+	spanCtx, span := trace.StartSpan(in, a.cmd.id.Hex(), trace.SpanKind_INTERNAL)
 	start := time.Now()
-	out := a.cmd.Run(in)
+	out := a.cmd.Run(spanCtx)
 	runTime := time.Now().Sub(start).Seconds()
 	a.processingTime.Add(runTime)
 	if err := Error(out); err != nil {
 		a.failedCounter.Inc()
 		a.processingFailedTime.Add(runTime)
+		span.Finish(err)
+	} else {
+		span.Finish(nil)
+	}
+	// the stage span ends with the stage, i.e., the next stage span is a sibling
+	if parent, ok := trace.FromContext(in); ok {
+		out = trace.WithSpanContext(out, parent)
 	}
 	if stageOutputChannel, ok := StageOutputChannel(in); ok {
 		select {
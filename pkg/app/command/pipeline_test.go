@@ -16,17 +16,24 @@ package command_test
 
 import (
 	"context"
+	"errors"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
 	"testing"
 
 	"os"
 
 	"math/rand"
 
+	"time"
+
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	"github.com/oysterpack/oysterpack.go/pkg/app/command/config"
 	appconfig "github.com/oysterpack/oysterpack.go/pkg/app/config"
 	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"github.com/oysterpack/oysterpack.go/pkg/data/keyvalue"
 	"zombiezen.com/go/capnproto2"
 )
 
@@ -52,7 +59,7 @@ func initMetricsConfig(serviceID app.ServiceID) error {
 	metricsServiceSpec.SetMetricSpecs(metricsSpecs)
 
 	createCounters := func() error {
-		counters, err := metricsSpecs.NewCounterSpecs(10)
+		counters, err := metricsSpecs.NewCounterSpecs(15)
 		if err != nil {
 			return err
 		}
@@ -177,15 +184,69 @@ func initMetricsConfig(serviceID app.ServiceID) error {
 		}
 		counters.Set(9, pipelinePingExpiredTime)
 
+		/////////////
+		pipelineCounter := func(i int, metricID app.MetricID, help string) error {
+			counter, err := appconfig.NewCounterMetricSpec(seg)
+			if err != nil {
+				return err
+			}
+			counter.SetServiceId(serviceID.UInt64())
+			counter.SetMetricId(metricID.UInt64())
+			if err := counter.SetHelp(help); err != nil {
+				return err
+			}
+			return counters.Set(i, counter)
+		}
+		if err := pipelineCounter(10, command.PIPELINE_DURABLE_PERSISTED_COUNT, "Total number of workflow inputs persisted to the durable queue"); err != nil {
+			return err
+		}
+		if err := pipelineCounter(11, command.PIPELINE_DURABLE_ACKED_COUNT, "Total number of durable queue entries that were acked"); err != nil {
+			return err
+		}
+		if err := pipelineCounter(12, command.PIPELINE_DURABLE_REDELIVERED_COUNT, "Total number of failed workflows that were redelivered"); err != nil {
+			return err
+		}
+		if err := pipelineCounter(13, command.PIPELINE_DURABLE_REPLAYED_COUNT, "Total number of durable queue entries that were replayed"); err != nil {
+			return err
+		}
+		if err := pipelineCounter(14, command.PIPELINE_DURABLE_DEAD_LETTER_COUNT, "Total number of durable queue entries that were moved to the dead letter bucket"); err != nil {
+			return err
+		}
+
 		return nil
 	}
 
 	createCounterVectors := func() error {
-		counters, err := metricsSpecs.NewCounterVectorSpecs(4)
+		counters, err := metricsSpecs.NewCounterVectorSpecs(14)
 		if err != nil {
 			return err
 		}
 
+		commandCounterVector := func(i int, metricID app.MetricID, help string) error {
+			counterVector, err := appconfig.NewCounterVectorMetricSpec(seg)
+			if err != nil {
+				return err
+			}
+			counter, err := appconfig.NewCounterMetricSpec(seg)
+			if err != nil {
+				return err
+			}
+			counter.SetServiceId(serviceID.UInt64())
+			counter.SetMetricId(metricID.UInt64())
+			if err := counter.SetHelp(help); err != nil {
+				return err
+			}
+			if err := counterVector.SetMetricSpec(counter); err != nil {
+				return err
+			}
+			labels, err := counterVector.NewLabelNames(1)
+			if err != nil {
+				return err
+			}
+			labels.Set(0, command.LABEL_COMMAND)
+			return counters.Set(i, counterVector)
+		}
+
 		//////////
 		commandRunCountVector, err := appconfig.NewCounterVectorMetricSpec(seg)
 		if err != nil {
@@ -273,6 +334,38 @@ func initMetricsConfig(serviceID app.ServiceID) error {
 		labels.Set(0, command.LABEL_COMMAND)
 		counters.Set(3, commandFailedProcessingTimeVector)
 
+		/////////////
+		if err := commandCounterVector(4, command.COMMAND_BUFFER_BLOCKED_COUNT, "Total number of times a stage delivery blocked because the stage input buffer was full"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(5, command.COMMAND_BUFFER_BLOCKED_TIME_SEC, "Total time in seconds spent blocked waiting for room in the stage input buffer"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(6, command.COMMAND_BUFFER_DROPPED_NEWEST_COUNT, "Total number of Context(s) dropped because the stage input buffer was full"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(7, command.COMMAND_BUFFER_DROPPED_OLDEST_COUNT, "Total number of buffered Context(s) evicted to make room in the stage input buffer"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(8, command.COMMAND_BUFFER_REJECTED_COUNT, "Total number of Context(s) rejected because the stage input buffer was full"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(9, command.COMMAND_QUEUE_WAIT_TIME_SEC, "Total time in seconds that Context(s) waited in the stage input queue"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(10, command.COMMAND_FILTERED_COUNT, "Total number of Context(s) dropped by a filter stage"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(11, command.COMMAND_RETRY_COUNT, "Total number of times a failed command was retried"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(12, command.COMMAND_COMPENSATION_COUNT, "Total number of times a stage compensation command was run"); err != nil {
+			return err
+		}
+		if err := commandCounterVector(13, command.COMMAND_COMPENSATION_FAILED_COUNT, "Total number of times a stage compensation command failed"); err != nil {
+			return err
+		}
+
 		return nil
 	}
 
@@ -377,6 +470,38 @@ func initMetricsConfig(serviceID app.ServiceID) error {
 		return nil
 	}
 
+	createGaugeVectors := func() error {
+		gauges, err := metricsSpecs.NewGaugeVectorSpecs(1)
+		if err != nil {
+			return err
+		}
+
+		commandPoolSizeVector, err := appconfig.NewGaugeVectorMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		commandPoolSize, err := appconfig.NewGaugeMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		commandPoolSize.SetServiceId(serviceID.UInt64())
+		commandPoolSize.SetMetricId(command.COMMAND_POOL_SIZE.UInt64())
+		if err := commandPoolSize.SetHelp("The number of workers in the stage pool"); err != nil {
+			return err
+		}
+		if err := commandPoolSizeVector.SetMetricSpec(commandPoolSize); err != nil {
+			return err
+		}
+		labels, err := commandPoolSizeVector.NewLabelNames(1)
+		if err != nil {
+			return err
+		}
+		labels.Set(0, command.LABEL_COMMAND)
+		gauges.Set(0, commandPoolSizeVector)
+
+		return nil
+	}
+
 	if err := createCounters(); err != nil {
 		return err
 	}
@@ -389,6 +514,10 @@ func initMetricsConfig(serviceID app.ServiceID) error {
 		return err
 	}
 
+	if err := createGaugeVectors(); err != nil {
+		return err
+	}
+
 	// store the config
 	serviceConfigPath := app.Configs.ServiceConfigPath(app.METRICS_SERVICE_ID)
 	configFile, err := os.Create(serviceConfigPath)
@@ -504,6 +633,8 @@ func TestStartPipeline(t *testing.T) {
 				}
 				stage.SetCommandID(command.CommandID(i).UInt64())
 				stage.SetPoolSize(uint8(1 + rand.Intn(4)))
+				stage.SetBufferSize(uint16(rand.Intn(4)))
+				stage.SetOverflowPolicy(config.Pipeline_Stage_OverflowPolicy_block)
 				stageList.Set(i-1, stage)
 			}
 
@@ -715,4 +846,1039 @@ func TestStartPipeline(t *testing.T) {
 			t.Logf("pong : [%v], workflow duration = %v", pongTime, pongTime.Sub(command.WorkflowStartTime(result)))
 		}
 	})
+	t.Run("TrySubmit - stage buffer full", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		gate := make(chan struct{})
+		stage := command.NewStage(
+			SERVICE_ID,
+			command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				<-gate
+				return ctx
+			}),
+			1,
+		).WithInputBuffer(1, command.OverflowPolicy_REJECT)
+		p := command.StartPipeline(service, stage)
+
+		// the first context is picked up by the stage worker, which blocks on the gate
+		if err := p.TrySubmit(command.NewContext()); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Millisecond * 50)
+		// the second context is buffered
+		if err := p.TrySubmit(command.NewContext()); err != nil {
+			t.Fatal(err)
+		}
+		// the buffer is full
+		if err := p.TrySubmit(command.NewContext()); !app.IsError(err, command.ErrSpec_StageBufferFull.ErrorID) {
+			t.Errorf("The context should have been rejected : %v", err)
+		}
+
+		close(gate)
+		for i := 0; i < 2; i++ {
+			if ctx := <-p.OutputChan(); command.Error(ctx) != nil {
+				t.Error(command.Error(ctx))
+			}
+		}
+	})
+
+	t.Run("stage overflow policy - reject", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		gate := make(chan struct{})
+		p := command.StartPipeline(service,
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+					return ctx
+				}),
+				1,
+			),
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+					<-gate
+					return ctx
+				}),
+				1,
+			).WithInputBuffer(1, command.OverflowPolicy_REJECT),
+		)
+
+		// 1 context is being processed by the second stage, 1 context is buffered, and 1 context is rejected
+		for i := 0; i < 3; i++ {
+			p.InputChan() <- command.NewContext()
+			if i == 0 {
+				// give the second stage time to pick up the first context
+				time.Sleep(time.Millisecond * 50)
+			}
+		}
+
+		ctx := <-p.OutputChan()
+		if err := command.Error(ctx); err == nil || err.ErrorID != command.ErrSpec_StageBufferFull.ErrorID {
+			t.Errorf("The context should have been rejected : %v", err)
+		}
+
+		close(gate)
+		for i := 0; i < 2; i++ {
+			if ctx := <-p.OutputChan(); command.Error(ctx) != nil {
+				t.Error(command.Error(ctx))
+			}
+		}
+	})
+
+	t.Run("stage overflow policy - drop newest", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		gate := make(chan struct{})
+		p := command.StartPipeline(service,
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+					return ctx
+				}),
+				1,
+			),
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+					<-gate
+					return ctx
+				}),
+				1,
+			).WithInputBuffer(1, command.OverflowPolicy_DROP_NEWEST),
+		)
+
+		for i := 0; i < 3; i++ {
+			p.InputChan() <- command.NewContext()
+			if i == 0 {
+				// give the second stage time to pick up the first context
+				time.Sleep(time.Millisecond * 50)
+			}
+		}
+		time.Sleep(time.Millisecond * 50)
+		close(gate)
+
+		count := 0
+	Loop:
+		for {
+			select {
+			case <-p.OutputChan():
+				count++
+			case <-time.After(time.Millisecond * 100):
+				break Loop
+			}
+		}
+		if count != 2 {
+			t.Errorf("1 context should have been dropped : count = %d", count)
+		}
+	})
+	t.Run("stage overflow policy - drop oldest", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		gate := make(chan struct{})
+		p := command.StartPipeline(service,
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+					return ctx
+				}),
+				1,
+			),
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+					<-gate
+					return ctx
+				}),
+				1,
+			).WithInputBuffer(1, command.OverflowPolicy_DROP_OLDEST),
+		)
+
+		type Key int
+		const N = Key(0)
+		for i := 0; i < 3; i++ {
+			p.InputChan() <- context.WithValue(command.NewContext(), N, i)
+			if i == 0 {
+				// give the second stage time to pick up the first context
+				time.Sleep(time.Millisecond * 50)
+			}
+		}
+		time.Sleep(time.Millisecond * 50)
+		close(gate)
+
+		received := []int{}
+	Loop:
+		for {
+			select {
+			case ctx := <-p.OutputChan():
+				received = append(received, ctx.Value(N).(int))
+			case <-time.After(time.Millisecond * 100):
+				break Loop
+			}
+		}
+		// the buffered context was evicted by the newest context
+		if len(received) != 2 || received[0] != 0 || received[1] != 2 {
+			t.Errorf("the oldest buffered context should have been dropped : %v", received)
+		}
+	})
+	t.Run("stage overflow policy - requires input buffer", func(t *testing.T) {
+		for _, overflowPolicy := range []command.OverflowPolicy{command.OverflowPolicy_DROP_NEWEST, command.OverflowPolicy_DROP_OLDEST} {
+			func() {
+				app.ResetWithConfigDir(configDir)
+				defer app.Reset()
+
+				service := app.NewService(SERVICE_ID)
+				defer func() {
+					if p := recover(); p == nil {
+						t.Errorf("StartPipeline should have panicked : %v", overflowPolicy)
+					}
+				}()
+				command.StartPipeline(service,
+					command.NewStage(
+						SERVICE_ID,
+						command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+							return ctx
+						}),
+						1,
+					).WithInputBuffer(0, overflowPolicy),
+				)
+			}()
+		}
+	})
+
+	t.Run("SetStagePoolSize", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		type Key int
+
+		const (
+			N = Key(iota)
+		)
+		stage := command.NewStage(
+			SERVICE_ID,
+			command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				time.Sleep(time.Millisecond)
+				n := ctx.Value(N).(int)
+				return context.WithValue(ctx, N, n+1)
+			}),
+			1,
+		)
+		p := command.StartPipeline(service, stage, stage, stage)
+
+		const COUNT = 100
+		go func() {
+			for i := 0; i < COUNT; i++ {
+				p.InputChan() <- context.WithValue(command.NewContext(), N, 0)
+			}
+		}()
+
+		// resize the stage pools while contexts are flowing through the pipeline
+		for _, poolSize := range []uint8{4, 2, 8, 1} {
+			for i := 0; i < 3; i++ {
+				if err := p.SetStagePoolSize(i, poolSize); err != nil {
+					t.Fatal(err)
+				}
+				if p.Stages()[i].PoolSize() != poolSize {
+					t.Errorf("Stage pool size did not match : %d != %d", p.Stages()[i].PoolSize(), poolSize)
+				}
+			}
+			time.Sleep(time.Millisecond * 5)
+		}
+
+		// no contexts should be lost
+		for i := 0; i < COUNT; i++ {
+			ctx := <-p.OutputChan()
+			if n := ctx.Value(N).(int); n != 3 {
+				t.Errorf("The pipeline did not process the workflow correctly : n = %d", n)
+			}
+		}
+
+		if err := p.SetStagePoolSize(3, 1); !app.IsError(err, command.ErrSpec_StageNotFound.ErrorID) {
+			t.Errorf("Expected ErrSpec_StageNotFound : %v", err)
+		}
+		if err := p.SetStagePoolSize(0, 0); !app.IsError(err, command.ErrSpec_InvalidPoolSize.ErrorID) {
+			t.Errorf("Expected ErrSpec_InvalidPoolSize : %v", err)
+		}
+	})
+
+	t.Run("stage autoscaler", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		stage := command.NewStage(
+			SERVICE_ID,
+			command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				time.Sleep(time.Millisecond * 10)
+				return ctx
+			}),
+			1,
+		).WithInputBuffer(100, command.OverflowPolicy_BLOCK).WithAutoscaler(command.Autoscaler{
+			MinPoolSize:        1,
+			MaxPoolSize:        4,
+			ScaleUpQueueWait:   time.Millisecond,
+			ScaleDownQueueWait: time.Microsecond,
+			Interval:           time.Millisecond * 20,
+		})
+		p := command.StartPipeline(service, stage)
+
+		const COUNT = 100
+		// the results are received concurrently, i.e., the workers are not blocked delivering the results
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < COUNT; i++ {
+				<-p.OutputChan()
+			}
+		}()
+		for i := 0; i < COUNT; i++ {
+			if err := p.TrySubmit(command.NewContext()); err != nil {
+				t.Fatal(err)
+			}
+		}
+		time.Sleep(time.Millisecond * 100)
+		if poolSize := p.Stages()[0].PoolSize(); poolSize == 1 {
+			t.Error("The stage pool should have been scaled up")
+		} else {
+			t.Logf("poolSize = %d", poolSize)
+		}
+		<-done
+
+		// when the pipeline is idle, the stage pool should scale back down to the min pool size
+		time.Sleep(time.Millisecond * 200)
+		if poolSize := p.Stages()[0].PoolSize(); poolSize != 1 {
+			t.Errorf("The stage pool should have been scaled down : %d", poolSize)
+		}
+	})
+
+	t.Run("stage autoscaler - InputChan", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		stage := command.NewStage(
+			SERVICE_ID,
+			command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				time.Sleep(time.Millisecond * 10)
+				return ctx
+			}),
+			1,
+		).WithInputBuffer(100, command.OverflowPolicy_BLOCK).WithAutoscaler(command.Autoscaler{
+			MinPoolSize:        1,
+			MaxPoolSize:        4,
+			ScaleUpQueueWait:   time.Millisecond,
+			ScaleDownQueueWait: time.Microsecond,
+			Interval:           time.Millisecond * 20,
+		})
+		p := command.StartPipeline(service, stage)
+
+		// the first stage's queue wait time must also be tracked for Context(s) that are sent via the input channel
+		const COUNT = 100
+		// the results are received concurrently, i.e., the workers are not blocked delivering the results
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for i := 0; i < COUNT; i++ {
+				<-p.OutputChan()
+			}
+		}()
+		for i := 0; i < COUNT; i++ {
+			p.InputChan() <- command.NewContext()
+		}
+		time.Sleep(time.Millisecond * 100)
+		if poolSize := p.Stages()[0].PoolSize(); poolSize == 1 {
+			t.Error("The stage pool should have been scaled up")
+		} else {
+			t.Logf("poolSize = %d", poolSize)
+		}
+		<-done
+	})
+
+	t.Run("fan-out stage", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		type Key int
+
+		const (
+			N = Key(iota)
+			SUM
+		)
+
+		increment := func(id command.CommandID, delta int) command.Command {
+			return command.NewCommand(id, func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, N, ctx.Value(N).(int)+delta)
+			})
+		}
+		join := func(in context.Context, results []context.Context) context.Context {
+			sum := 0
+			for _, result := range results {
+				if err := command.Error(result); err != nil {
+					return command.WithError(in, command.CommandID(100), err)
+				}
+				sum += result.Value(N).(int)
+			}
+			return context.WithValue(in, SUM, sum)
+		}
+
+		p := command.StartPipeline(service,
+			command.NewStage(SERVICE_ID, increment(command.CommandID(1), 1), 1),
+			command.NewFanOutStage(SERVICE_ID, command.CommandID(100), join, 2,
+				increment(command.CommandID(2), 1),
+				increment(command.CommandID(3), 10),
+				increment(command.CommandID(4), 100),
+			),
+		)
+
+		if kind := p.Stages()[1].Kind(); kind != command.StageKind_FAN_OUT {
+			t.Errorf("Wrong stage kind : %v", kind)
+		}
+		if commands := p.Stages()[1].FanOutCommands(); len(commands) != 3 {
+			t.Errorf("Wrong number of fan-out commands : %d", len(commands))
+		}
+
+		p.InputChan() <- context.WithValue(command.NewContext(), N, 0)
+		ctx := <-p.OutputChan()
+		// (1+1) + (1+10) + (1+100)
+		if sum := ctx.Value(SUM).(int); sum != 114 {
+			t.Errorf("The fan-out results were not joined correctly : sum = %d", sum)
+		}
+
+		// ping-pong should bypass the fan-out commands
+		p.InputChan() <- command.NewPingContext()
+		ctx = <-p.OutputChan()
+		if _, ok := command.PongTime(ctx); !ok {
+			t.Error("pong time should have been set")
+		}
+	})
+
+	t.Run("router stage", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		type Key int
+
+		const (
+			MSG_TYPE = Key(iota)
+			PATH
+		)
+
+		visit := func(id command.CommandID) command.Command {
+			return command.NewCommand(id, func(ctx context.Context) context.Context {
+				path, _ := ctx.Value(PATH).([]command.CommandID)
+				return context.WithValue(ctx, PATH, append(append([]command.CommandID{}, path...), id))
+			})
+		}
+		router := func(ctx context.Context) (command.CommandID, bool) {
+			switch ctx.Value(MSG_TYPE).(string) {
+			case "b":
+				return command.CommandID(3), true
+			case "c":
+				return command.CommandID(4), true
+			case "invalid":
+				return command.CommandID(1), true
+			default:
+				return command.CommandID(0), false
+			}
+		}
+
+		p := command.StartPipeline(service,
+			command.NewStage(SERVICE_ID, visit(command.CommandID(1)), 1),
+			command.NewRouterStage(SERVICE_ID, command.CommandID(100), router, 1),
+			command.NewStage(SERVICE_ID, visit(command.CommandID(2)), 1),
+			command.NewStage(SERVICE_ID, visit(command.CommandID(3)), 1),
+			command.NewStage(SERVICE_ID, visit(command.CommandID(4)), 1),
+		)
+
+		routes := map[string][]command.CommandID{
+			"a": {1, 2, 3, 4},
+			"b": {1, 3, 4},
+			"c": {1, 4},
+		}
+		for msgType, expected := range routes {
+			p.InputChan() <- context.WithValue(command.NewContext(), MSG_TYPE, msgType)
+			ctx := <-p.OutputChan()
+			path := ctx.Value(PATH).([]command.CommandID)
+			if len(path) != len(expected) {
+				t.Errorf("%s : Wrong route : %v", msgType, path)
+				continue
+			}
+			for i := range path {
+				if path[i] != expected[i] {
+					t.Errorf("%s : Wrong route : %v", msgType, path)
+					break
+				}
+			}
+		}
+
+		// routing upstream is not allowed
+		p.InputChan() <- context.WithValue(command.NewContext(), MSG_TYPE, "invalid")
+		ctx := <-p.OutputChan()
+		if err := command.Error(ctx); !app.IsError(err, command.ErrSpec_RouteNotFound.ErrorID) {
+			t.Errorf("Expected ErrSpec_RouteNotFound : %v", err)
+		}
+
+		// ping-pong should flow through every stage
+		p.InputChan() <- command.NewPingContext()
+		ctx = <-p.OutputChan()
+		if _, ok := command.PongTime(ctx); !ok {
+			t.Error("pong time should have been set")
+		}
+	})
+
+	t.Run("router stage - cannot be the last stage", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		defer func() {
+			if p := recover(); p == nil {
+				t.Error("StartPipeline should have panicked")
+			}
+		}()
+		command.StartPipeline(service,
+			command.NewRouterStage(SERVICE_ID, command.CommandID(100), func(ctx context.Context) (command.CommandID, bool) {
+				return command.CommandID(0), false
+			}, 1),
+		)
+	})
+
+	t.Run("filter stage", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		type Key int
+
+		const (
+			N = Key(iota)
+		)
+
+		p := command.StartPipeline(service,
+			command.NewFilterStage(SERVICE_ID, command.CommandID(100), func(ctx context.Context) bool {
+				return ctx.Value(N).(int)%2 == 0
+			}, 1),
+			command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				return ctx
+			}), 1),
+		)
+
+		const COUNT = 10
+		go func() {
+			for i := 0; i < COUNT; i++ {
+				p.InputChan() <- context.WithValue(command.NewContext(), N, i)
+			}
+		}()
+		for i := 0; i < COUNT/2; i++ {
+			ctx := <-p.OutputChan()
+			if n := ctx.Value(N).(int); n%2 != 0 {
+				t.Errorf("The Context should have been filtered : %d", n)
+			}
+		}
+		select {
+		case ctx := <-p.OutputChan():
+			t.Errorf("The Context should have been filtered : %d", ctx.Value(N).(int))
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		// ping-pong should bypass the filter
+		p.InputChan() <- command.NewPingContext()
+		ctx := <-p.OutputChan()
+		if _, ok := command.PongTime(ctx); !ok {
+			t.Error("pong time should have been set")
+		}
+	})
+
+	t.Run("pipeline - from config - fan-out, router, and filter stages", func(t *testing.T) {
+		const (
+			FILTER_ID  = command.CommandID(10)
+			FAN_OUT_ID = command.CommandID(20)
+			ROUTER_ID  = command.CommandID(30)
+		)
+		storePipelineConfig := func() {
+			msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+			if err != nil {
+				panic(err)
+			}
+
+			pipelineConfig, err := config.NewRootPipeline(seg)
+			if err != nil {
+				panic(err)
+			}
+			pipelineConfig.SetServiceID(SERVICE_ID.UInt64())
+			stageList, err := pipelineConfig.NewStages(4)
+			if err != nil {
+				panic(err)
+			}
+
+			filterStage := stageList.At(0)
+			filterStage.SetCommandID(FILTER_ID.UInt64())
+			filterStage.SetKind(config.Pipeline_Stage_Kind_filter)
+
+			fanOutStage := stageList.At(1)
+			fanOutStage.SetCommandID(FAN_OUT_ID.UInt64())
+			fanOutStage.SetKind(config.Pipeline_Stage_Kind_fanOut)
+			fanOutStage.SetPoolSize(2)
+			commandIDs, err := fanOutStage.NewFanOutCommandIDs(2)
+			if err != nil {
+				panic(err)
+			}
+			commandIDs.Set(0, command.CommandID(1).UInt64())
+			commandIDs.Set(1, command.CommandID(2).UInt64())
+
+			routerStage := stageList.At(2)
+			routerStage.SetCommandID(ROUTER_ID.UInt64())
+			routerStage.SetKind(config.Pipeline_Stage_Kind_router)
+
+			commandStage := stageList.At(3)
+			commandStage.SetCommandID(command.CommandID(3).UInt64())
+
+			serviceConfigPath := app.Configs.ServiceConfigPath(SERVICE_ID)
+			configFile, err := os.Create(serviceConfigPath)
+			if err != nil {
+				panic(err)
+			}
+			app.MarshalCapnpMessage(msg, configFile)
+			configFile.Close()
+		}
+
+		storePipelineConfig()
+
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		app.Services.Register(service)
+		type Key int
+
+		const (
+			N = Key(iota)
+		)
+		for i := 1; i <= 3; i++ {
+			command.MustRegisterCommand(command.CommandID(i), func(ctx context.Context) context.Context {
+				return context.WithValue(ctx, N, ctx.Value(N).(int)+1)
+			})
+		}
+		command.MustRegisterFilterFunc(FILTER_ID, func(ctx context.Context) bool {
+			return ctx.Value(N).(int) >= 0
+		})
+		command.MustRegisterJoinFunc(FAN_OUT_ID, func(in context.Context, results []context.Context) context.Context {
+			sum := 0
+			for _, result := range results {
+				sum += result.Value(N).(int)
+			}
+			return context.WithValue(in, N, sum)
+		})
+		command.MustRegisterRouterFunc(ROUTER_ID, func(ctx context.Context) (command.CommandID, bool) {
+			return command.CommandID(0), false
+		})
+
+		p := command.StartPipelineFromConfig(service)
+		stages := p.Stages()
+		kinds := []command.StageKind{command.StageKind_FILTER, command.StageKind_FAN_OUT, command.StageKind_ROUTER, command.StageKind_COMMAND}
+		for i, kind := range kinds {
+			if stages[i].Kind() != kind {
+				t.Errorf("stage %d : expected %v but was %v", i, kind, stages[i].Kind())
+			}
+		}
+
+		p.InputChan() <- context.WithValue(command.NewContext(), N, 0)
+		ctx := <-p.OutputChan()
+		// (0+1) + (0+1) + 1
+		if n := ctx.Value(N).(int); n != 3 {
+			t.Errorf("The pipeline did not process the workflow correctly : n = %d", n)
+		}
+	})
+
+	t.Run("stage retry policy", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		var (
+			ErrSpec_Transient = app.ErrSpec{ErrorID: app.ErrorID(0x967c6be45b2082b1), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+			ErrSpec_Fatal     = app.ErrSpec{ErrorID: app.ErrorID(0x92d8c4545a2e52fe), ErrorType: app.ErrorType_BUG, ErrorSeverity: app.ErrorSeverity_HIGH}
+		)
+		type Key int
+
+		const (
+			FAILURES = Key(iota)
+			ERR_SPEC
+		)
+
+		var attempts int32
+		p := command.StartPipeline(service,
+			command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				attempt := atomic.AddInt32(&attempts, 1)
+				if attempt <= ctx.Value(FAILURES).(int32) {
+					return command.WithError(ctx, command.CommandID(1), app.NewError(errors.New("failure"), "failure", ctx.Value(ERR_SPEC).(app.ErrSpec), SERVICE_ID, nil))
+				}
+				return ctx
+			}), 1).WithRetryPolicy(command.RetryPolicy{
+				MaxAttempts:         3,
+				InitialBackoff:      time.Millisecond,
+				MaxBackoff:          time.Millisecond * 2,
+				RetryKnownEdgeCases: true,
+			}),
+			command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+				return ctx
+			}), 1),
+		)
+
+		// the command fails twice, and succeeds on the third attempt
+		atomic.StoreInt32(&attempts, 0)
+		ctx := context.WithValue(command.NewContext(), FAILURES, int32(2))
+		p.InputChan() <- context.WithValue(ctx, ERR_SPEC, ErrSpec_Transient)
+		ctx = <-p.OutputChan()
+		if err := command.Error(ctx); err != nil {
+			t.Errorf("The command should have succeeded after retrying : %v", err)
+		}
+		if attempts := atomic.LoadInt32(&attempts); attempts != 3 {
+			t.Errorf("The command should have been run 3 times : %d", attempts)
+		}
+
+		// max attempts are exceeded
+		atomic.StoreInt32(&attempts, 0)
+		ctx = context.WithValue(command.NewContext(), FAILURES, int32(5))
+		p.InputChan() <- context.WithValue(ctx, ERR_SPEC, ErrSpec_Transient)
+		ctx = <-p.OutputChan()
+		if err := command.Error(ctx); !app.IsError(err, ErrSpec_Transient.ErrorID) {
+			t.Errorf("The workflow should have failed : %v", err)
+		}
+		if attempts := atomic.LoadInt32(&attempts); attempts != 3 {
+			t.Errorf("The command should have been run 3 times : %d", attempts)
+		}
+
+		// errors that are not covered by the retry policy are not retried
+		atomic.StoreInt32(&attempts, 0)
+		ctx = context.WithValue(command.NewContext(), FAILURES, int32(1))
+		p.InputChan() <- context.WithValue(ctx, ERR_SPEC, ErrSpec_Fatal)
+		ctx = <-p.OutputChan()
+		if err := command.Error(ctx); !app.IsError(err, ErrSpec_Fatal.ErrorID) {
+			t.Errorf("The workflow should have failed : %v", err)
+		}
+		if attempts := atomic.LoadInt32(&attempts); attempts != 1 {
+			t.Errorf("The command should not have been retried : %d", attempts)
+		}
+	})
+
+	t.Run("stage compensation", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		ErrSpec_Failure := app.ErrSpec{ErrorID: app.ErrorID(0xdd132fce663ca52c), ErrorType: app.ErrorType_BUG, ErrorSeverity: app.ErrorSeverity_HIGH}
+
+		compensations := make(chan command.CommandID, 10)
+		compensate := func(id command.CommandID) command.Command {
+			return command.NewCommand(id, func(ctx context.Context) context.Context {
+				if command.Error(ctx) == nil {
+					t.Error("The compensation command should have received the failed Context")
+				}
+				compensations <- id
+				return ctx
+			})
+		}
+		stage := func(id command.CommandID, fail bool) command.Stage {
+			return command.NewStage(SERVICE_ID, command.NewCommand(id, func(ctx context.Context) context.Context {
+				if fail {
+					return command.WithError(ctx, id, app.NewError(errors.New("failure"), "failure", ErrSpec_Failure, SERVICE_ID, nil))
+				}
+				return ctx
+			}), 1)
+		}
+
+		p := command.StartPipeline(service,
+			stage(command.CommandID(1), false).WithCompensation(compensate(command.CommandID(101))),
+			stage(command.CommandID(2), false),
+			stage(command.CommandID(3), false).WithCompensation(compensate(command.CommandID(103))),
+			stage(command.CommandID(4), true).WithCompensation(compensate(command.CommandID(104))),
+			stage(command.CommandID(5), false),
+		)
+
+		p.InputChan() <- command.NewContext()
+		ctx := <-p.OutputChan()
+		if err := command.Error(ctx); !app.IsError(err, ErrSpec_Failure.ErrorID) {
+			t.Errorf("The workflow should have failed : %v", err)
+		}
+		// the completed stages are compensated in reverse order - the failed stage is not compensated
+		for _, expected := range []command.CommandID{command.CommandID(103), command.CommandID(101)} {
+			select {
+			case id := <-compensations:
+				if id != expected {
+					t.Errorf("The stages were not compensated in reverse order : %v != %v", id, expected)
+				}
+			default:
+				t.Errorf("The stage was not compensated : %v", expected)
+			}
+		}
+		if len(compensations) != 0 {
+			t.Errorf("Only the completed stages should have been compensated : %d", len(compensations))
+		}
+	})
+
+	t.Run("stage compensation - reject overflow", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		compensations := make(chan command.CommandID, 10)
+		gate := make(chan struct{})
+		p := command.StartPipeline(service,
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+					return ctx
+				}),
+				1,
+			).WithCompensation(command.NewCommand(command.CommandID(101), func(ctx context.Context) context.Context {
+				if err := command.Error(ctx); err == nil || err.ErrorID != command.ErrSpec_StageBufferFull.ErrorID {
+					t.Errorf("The compensation command should have received the rejected Context : %v", err)
+				}
+				compensations <- command.CommandID(101)
+				return ctx
+			})),
+			command.NewStage(
+				SERVICE_ID,
+				command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+					<-gate
+					return ctx
+				}),
+				1,
+			).WithInputBuffer(1, command.OverflowPolicy_REJECT),
+		)
+
+		// 1 context is being processed by the second stage, 1 context is buffered, and 1 context is rejected
+		for i := 0; i < 3; i++ {
+			p.InputChan() <- command.NewContext()
+			if i == 0 {
+				// give the second stage time to pick up the first context
+				time.Sleep(time.Millisecond * 50)
+			}
+		}
+
+		ctx := <-p.OutputChan()
+		if err := command.Error(ctx); err == nil || err.ErrorID != command.ErrSpec_StageBufferFull.ErrorID {
+			t.Errorf("The context should have been rejected : %v", err)
+		}
+		// the stage that completed before the rejection is compensated
+		select {
+		case <-compensations:
+		default:
+			t.Error("The first stage should have been compensated")
+		}
+
+		close(gate)
+		for i := 0; i < 2; i++ {
+			if ctx := <-p.OutputChan(); command.Error(ctx) != nil {
+				t.Error(command.Error(ctx))
+			}
+		}
+		if len(compensations) != 0 {
+			t.Errorf("Only the rejected workflow should have been compensated : %d", len(compensations))
+		}
+	})
+
+	t.Run("failed stage - with reply channel", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		service := app.NewService(SERVICE_ID)
+		ErrSpec_Failure := app.ErrSpec{ErrorID: app.ErrorID(0xc1ff2a4a9e3b5d70), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+
+		p := command.StartPipeline(service,
+			command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+				return command.WithError(ctx, command.CommandID(1), app.NewError(errors.New("failure"), "failure", ErrSpec_Failure, SERVICE_ID, nil))
+			}), 1),
+			command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+				t.Error("The workflow should have been aborted by the first stage")
+				return ctx
+			}), 1),
+		)
+
+		// the failed Context must be returned on the Context's output channel - the pipeline output channel is not read
+		outputChan := make(chan context.Context)
+		p.InputChan() <- command.WithOutputChannel(command.NewContext(), outputChan)
+		select {
+		case ctx := <-outputChan:
+			if err := command.Error(ctx); !app.IsError(err, ErrSpec_Failure.ErrorID) {
+				t.Errorf("The workflow should have failed : %v", err)
+			}
+		case ctx := <-p.OutputChan():
+			t.Errorf("The failed Context was returned on the pipeline output channel : %v", command.Error(ctx))
+		case <-time.After(5 * time.Second):
+			t.Error("The failed Context was not returned")
+		}
+	})
+
+	t.Run("durable pipeline", func(t *testing.T) {
+		app.ResetWithConfigDir(configDir)
+		defer app.Reset()
+
+		db, err := keyvalue.CreateDatabase(filepath.Join(configDir, "durable_pipeline.db"), "durable_pipeline", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer db.Close()
+		queueBucket, err := db.CreateBucketIfNotExists("queue")
+		if err != nil {
+			t.Fatal(err)
+		}
+		deadLetterBucket, err := db.CreateBucketIfNotExists("dead_letter")
+		if err != nil {
+			t.Fatal(err)
+		}
+		queue := command.DurableQueue{
+			Bucket:           queueBucket,
+			DeadLetterBucket: deadLetterBucket,
+			Codec:            intCodec{},
+			MaxDeliveries:    2,
+		}
+		keyCount := func(bucket keyvalue.BucketView) int {
+			count := 0
+			for range bucket.Keys("", nil) {
+				count++
+			}
+			return count
+		}
+
+		ErrSpec_Failure := app.ErrSpec{ErrorID: app.ErrorID(0x933399442d4d64a4), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+		var runs int32
+		block := make(chan struct{})
+		startPipeline := func() *command.Pipeline {
+			return command.StartDurablePipeline(app.NewService(SERVICE_ID), queue,
+				command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+					atomic.AddInt32(&runs, 1)
+					switch n := ctx.Value(intCodecKey{}).(int); {
+					case n < 0:
+						return command.WithError(ctx, command.CommandID(1), app.NewError(errors.New("failure"), "failure", ErrSpec_Failure, SERVICE_ID, nil))
+					case n == 0:
+						<-block
+					}
+					return ctx
+				}), 1),
+			)
+		}
+		p := startPipeline()
+
+		// successful workflows are acked
+		p.InputChan() <- context.WithValue(command.NewContext(), intCodecKey{}, 1)
+		ctx := <-p.OutputChan()
+		if err := command.Error(ctx); err != nil {
+			t.Errorf("The workflow should have succeeded : %v", err)
+		}
+		time.Sleep(time.Millisecond * 50)
+		if count := keyCount(queueBucket); count != 0 {
+			t.Errorf("The durable queue entry should have been acked : %d", count)
+		}
+
+		// failed workflows are redelivered, and then moved to the dead letter bucket
+		atomic.StoreInt32(&runs, 0)
+		p.InputChan() <- context.WithValue(command.NewContext(), intCodecKey{}, -1)
+		ctx = <-p.OutputChan()
+		if err := command.Error(ctx); !app.IsError(err, ErrSpec_Failure.ErrorID) {
+			t.Errorf("The workflow should have failed : %v", err)
+		}
+		if runs := atomic.LoadInt32(&runs); runs != 2 {
+			t.Errorf("The workflow should have been delivered twice : %d", runs)
+		}
+		if count := keyCount(deadLetterBucket); count != 1 {
+			t.Errorf("The durable queue entry should have been dead lettered : %d", count)
+		}
+		if count := keyCount(queueBucket); count != 0 {
+			t.Errorf("The durable queue entry should have been removed : %d", count)
+		}
+
+		// the submitted Context is redelivered, i.e., the workflow is returned on the Context's output channel, and the
+		// Context's deadline and values are retained
+		atomic.StoreInt32(&runs, 0)
+		outputChan := make(chan context.Context, 1)
+		type callerKey struct{}
+		submitted, cancel := context.WithTimeout(context.WithValue(command.NewContext(), callerKey{}, "caller"), time.Minute)
+		defer cancel()
+		submittedDeadline, _ := submitted.Deadline()
+		p.InputChan() <- command.WithOutputChannel(context.WithValue(submitted, intCodecKey{}, -1), outputChan)
+		select {
+		case ctx = <-outputChan:
+			if err := command.Error(ctx); !app.IsError(err, ErrSpec_Failure.ErrorID) {
+				t.Errorf("The workflow should have failed : %v", err)
+			}
+			if deadline, ok := ctx.Deadline(); !ok || !deadline.Equal(submittedDeadline) {
+				t.Errorf("The redelivered workflow should have retained the deadline : %v != %v", deadline, submittedDeadline)
+			}
+			if ctx.Value(callerKey{}) != "caller" {
+				t.Error("The redelivered workflow should have retained the submitted Context values")
+			}
+		case ctx = <-p.OutputChan():
+			t.Errorf("The failed workflow should have been returned on the Context's output channel : %v", command.Error(ctx))
+		case <-time.After(time.Second * 5):
+			t.Fatal("The failed workflow was not returned")
+		}
+		if runs := atomic.LoadInt32(&runs); runs != 2 {
+			t.Errorf("The workflow should have been delivered twice : %d", runs)
+		}
+
+		// pending workflows are replayed when the pipeline is restarted, including the workflows that are waiting to be
+		// handed off to the first stage
+		p.InputChan() <- context.WithValue(command.NewContext(), intCodecKey{}, 0)
+		p.InputChan() <- context.WithValue(command.NewContext(), intCodecKey{}, 2)
+		time.Sleep(time.Millisecond * 50)
+		if count := keyCount(queueBucket); count != 2 {
+			t.Errorf("The workflow inputs should have been persisted : %d", count)
+		}
+		app.ResetWithConfigDir(configDir)
+		close(block)
+		time.Sleep(time.Millisecond * 50)
+
+		// replayed workflows have no caller, i.e., they are completed without being returned on the pipeline output channel
+		atomic.StoreInt32(&runs, 0)
+		p = startPipeline()
+		for i := 0; i < 100 && keyCount(queueBucket) > 0; i++ {
+			time.Sleep(time.Millisecond * 50)
+		}
+		if count := keyCount(queueBucket); count != 0 {
+			t.Errorf("The replayed durable queue entries should have been acked : %d", count)
+		}
+		if runs := atomic.LoadInt32(&runs); runs != 2 {
+			t.Errorf("The pending workflows should have been replayed : %d", runs)
+		}
+		select {
+		case ctx = <-p.OutputChan():
+			t.Errorf("The replayed workflow should not have been returned on the pipeline output channel : %v", ctx.Value(intCodecKey{}))
+		case <-time.After(time.Millisecond * 50):
+		}
+	})
+}
+
+type intCodecKey struct{}
+
+// intCodec is a command.ContextCodec for Context(s) that carry an int value
+type intCodec struct{}
+
+func (a intCodec) Encode(ctx context.Context) ([]byte, error) {
+	n, ok := ctx.Value(intCodecKey{}).(int)
+	if !ok {
+		return nil, nil
+	}
+	return []byte(strconv.Itoa(n)), nil
+}
+
+func (a intCodec) Decode(ctx context.Context, data []byte) (context.Context, error) {
+	n, err := strconv.Atoi(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return context.WithValue(ctx, intCodecKey{}, n), nil
 }
@@ -22,6 +22,11 @@ import (
 var (
 	commandsMutex sync.RWMutex
 	commands      = make(map[CommandID]CommandFunc)
+
+	// JoinFunc(s), RouterFunc(s), and FilterFunc(s) are registered in order to be able to reference them from the pipeline config
+	joinFuncs   = make(map[CommandID]JoinFunc)
+	routerFuncs = make(map[CommandID]RouterFunc)
+	filterFuncs = make(map[CommandID]FilterFunc)
 )
 
 // RegisterCommand will register a command.
@@ -60,9 +65,85 @@ func RegisteredCommandIDs() []CommandID {
 	return ids
 }
 
-// ClearCommandRegistry is only exposed for testing purposes
+// MustRegisterJoinFunc registers the JoinFunc for a fan-out stage.
+// A panic is triggered if a JoinFunc is already registered using the same CommandID
+func MustRegisterJoinFunc(id CommandID, f JoinFunc) {
+	if id == CommandID(0) {
+		panic("CommandID(0) is illegal.")
+	}
+	if f == nil {
+		panic("The JoinFunc must not be nil")
+	}
+	commandsMutex.Lock()
+	defer commandsMutex.Unlock()
+	if _, ok := joinFuncs[id]; ok {
+		panic(fmt.Sprintf("A JoinFunc is already registered for CommandID(0x%x", id))
+	}
+	joinFuncs[id] = f
+}
+
+func GetJoinFunc(id CommandID) (f JoinFunc, ok bool) {
+	commandsMutex.RLock()
+	defer commandsMutex.RUnlock()
+	f, ok = joinFuncs[id]
+	return
+}
+
+// MustRegisterRouterFunc registers the RouterFunc for a router stage.
+// A panic is triggered if a RouterFunc is already registered using the same CommandID
+func MustRegisterRouterFunc(id CommandID, f RouterFunc) {
+	if id == CommandID(0) {
+		panic("CommandID(0) is illegal.")
+	}
+	if f == nil {
+		panic("The RouterFunc must not be nil")
+	}
+	commandsMutex.Lock()
+	defer commandsMutex.Unlock()
+	if _, ok := routerFuncs[id]; ok {
+		panic(fmt.Sprintf("A RouterFunc is already registered for CommandID(0x%x", id))
+	}
+	routerFuncs[id] = f
+}
+
+func GetRouterFunc(id CommandID) (f RouterFunc, ok bool) {
+	commandsMutex.RLock()
+	defer commandsMutex.RUnlock()
+	f, ok = routerFuncs[id]
+	return
+}
+
+// MustRegisterFilterFunc registers the FilterFunc for a filter stage.
+// A panic is triggered if a FilterFunc is already registered using the same CommandID
+func MustRegisterFilterFunc(id CommandID, f FilterFunc) {
+	if id == CommandID(0) {
+		panic("CommandID(0) is illegal.")
+	}
+	if f == nil {
+		panic("The FilterFunc must not be nil")
+	}
+	commandsMutex.Lock()
+	defer commandsMutex.Unlock()
+	if _, ok := filterFuncs[id]; ok {
+		panic(fmt.Sprintf("A FilterFunc is already registered for CommandID(0x%x", id))
+	}
+	filterFuncs[id] = f
+}
+
+func GetFilterFunc(id CommandID) (f FilterFunc, ok bool) {
+	commandsMutex.RLock()
+	defer commandsMutex.RUnlock()
+	f, ok = filterFuncs[id]
+	return
+}
+
+// ClearCommandRegistry is only exposed for testing purposes.
+// JoinFunc(s), RouterFunc(s), and FilterFunc(s) are cleared as well.
 func ClearCommandRegistry() {
 	commandsMutex.Lock()
 	defer commandsMutex.Unlock()
 	commands = make(map[CommandID]CommandFunc)
+	joinFuncs = make(map[CommandID]JoinFunc)
+	routerFuncs = make(map[CommandID]RouterFunc)
+	filterFuncs = make(map[CommandID]FilterFunc)
 }
@@ -0,0 +1,160 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+const (
+	// used when RetryPolicy.BackoffMultiplier is not set
+	DEFAULT_BACKOFF_MULTIPLIER = 2.0
+)
+
+// RetryPolicy determines when a failed stage command is retried.
+//
+// Only errors that are explicitly listed by ErrorID are retried, or errors of type ErrorType_KNOWN_EDGE_CASE if
+// RetryKnownEdgeCases is true. Each retry runs the command against the Context that was originally received by the stage.
+// The retry backoff is aborted if the Context expires or the pipeline service is killed.
+type RetryPolicy struct {
+	// max number of times the command is run, including the first attempt. If <= 1, then the command is not retried.
+	MaxAttempts uint8
+	// how long to wait before the first retry
+	InitialBackoff time.Duration
+	// the backoff is multiplied by the BackoffMultiplier after each retry - if not set, then DEFAULT_BACKOFF_MULTIPLIER is used
+	BackoffMultiplier float64
+	// if > 0, then the backoff will not grow larger than MaxBackoff
+	MaxBackoff time.Duration
+
+	// errors that are retried
+	ErrorIDs []app.ErrorID
+	// if true, then errors of type ErrorType_KNOWN_EDGE_CASE are retried
+	RetryKnownEdgeCases bool
+}
+
+// retryable returns true if the error is covered by the retry policy
+func (a *RetryPolicy) retryable(err *app.Error) bool {
+	if a.RetryKnownEdgeCases && err.ErrorType == app.ErrorType_KNOWN_EDGE_CASE {
+		return true
+	}
+	for _, id := range a.ErrorIDs {
+		if id == err.ErrorID {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the specified retry, where the first retry is 1
+func (a *RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := a.BackoffMultiplier
+	if multiplier <= 0 {
+		multiplier = DEFAULT_BACKOFF_MULTIPLIER
+	}
+	backoff := float64(a.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		backoff *= multiplier
+		if a.MaxBackoff > 0 && backoff >= float64(a.MaxBackoff) {
+			return a.MaxBackoff
+		}
+	}
+	return time.Duration(backoff)
+}
+
+// runWithRetries runs the stage command, retrying it per the stage's RetryPolicy.
+// The Context result from the last attempt is returned.
+func (a *Stage) runWithRetries(pipeline *Pipeline, in context.Context) context.Context {
+	out := a.run(in)
+	if a.retryPolicy == nil {
+		return out
+	}
+	for attempt := 1; attempt < int(a.retryPolicy.MaxAttempts); attempt++ {
+		err := Error(out)
+		if err == nil || !a.retryPolicy.retryable(err) {
+			return out
+		}
+		backoff := a.retryPolicy.backoff(attempt)
+		if backoff > 0 {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-pipeline.Service.Dying():
+				timer.Stop()
+				return out
+			case <-in.Done():
+				timer.Stop()
+				return out
+			case <-timer.C:
+			}
+		}
+		a.retryCounter.Inc()
+		commandRetried(pipeline, in, a.cmd.id, attempt, err)
+		out = a.run(in)
+	}
+	return out
+}
+
+// []int - the indexes of the stages that have successfully processed the Context, in the order they were processed.
+// The stages are only tracked if the pipeline has compensating stages.
+type ctx_completed_stages ContextKey
+
+func completedStages(ctx context.Context) []int {
+	stages, _ := ctx.Value(ctx_completed_stages{}).([]int)
+	return stages
+}
+
+func withCompletedStage(ctx context.Context, stage int) context.Context {
+	completed := completedStages(ctx)
+	stages := make([]int, len(completed), len(completed)+1)
+	copy(stages, completed)
+	return context.WithValue(ctx, ctx_completed_stages{}, append(stages, stage))
+}
+
+// compensate runs the compensation commands for the stages that completed before the workflow failed, in reverse order.
+// The compensation commands receive the failed Context, i.e., the workflow error can be retrieved via Error().
+//
+// If a compensation command fails, then the failure is logged and the remaining compensation commands are still run.
+// The workflow error is preserved, i.e., the returned Context always carries the original workflow error. Thus, compensation
+// results that do not carry the workflow error are discarded.
+func (a *Pipeline) compensate(ctx context.Context) context.Context {
+	if !a.compensated {
+		return ctx
+	}
+	err := Error(ctx)
+	stages := completedStages(ctx)
+	compensations := 0
+	for i := len(stages) - 1; i >= 0; i-- {
+		stage := &a.stages[stages[i]]
+		if stage.compensation == nil {
+			continue
+		}
+		compensations++
+		stage.compensationCounter.Inc()
+		result := stage.compensation.Run(ctx)
+		switch compensationErr := Error(result); {
+		case compensationErr == err:
+			ctx = result
+		case compensationErr != nil:
+			stage.compensationFailedCounter.Inc()
+			compensationFailed(a, ctx, stage.cmd.id, compensationErr)
+		}
+	}
+	if compensations > 0 {
+		contextCompensated(a, ctx, compensations)
+	}
+	return ctx
+}
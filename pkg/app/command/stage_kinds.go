@@ -0,0 +1,149 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"sync"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+// StageKind determines how a stage processes a Context.
+// The values map 1:1 to the config.Pipeline_Stage_Kind enum values.
+type StageKind uint8
+
+func (a StageKind) UInt8() uint8 {
+	return uint8(a)
+}
+
+func (a StageKind) String() string {
+	switch a {
+	case StageKind_COMMAND:
+		return "command"
+	case StageKind_FAN_OUT:
+		return "fanOut"
+	case StageKind_ROUTER:
+		return "router"
+	case StageKind_FILTER:
+		return "filter"
+	default:
+		return "unknown"
+	}
+}
+
+// StageKind enum values
+const (
+	// runs the stage command - this is the default
+	StageKind_COMMAND = StageKind(iota)
+	// sends the Context to N commands in parallel, and merges the results using a JoinFunc
+	StageKind_FAN_OUT
+	// picks the next stage by inspecting the Context using a RouterFunc
+	StageKind_ROUTER
+	// drops Context(s) that are rejected by a FilterFunc
+	StageKind_FILTER
+)
+
+// JoinFunc merges the results of a fan-out stage into a single Context.
+// The results are in the same order as the fan-out commands.
+// If any of the results failed, then it is up to the JoinFunc to decide whether the merged Context fails - see WithError().
+type JoinFunc func(in context.Context, results []context.Context) context.Context
+
+// RouterFunc returns the CommandID of the stage that the Context should be sent to next.
+// The stage must be downstream from the router stage. If false is returned, then the Context continues on to the next stage.
+type RouterFunc func(ctx context.Context) (next CommandID, ok bool)
+
+// FilterFunc returns true if the Context should continue on the pipeline. Otherwise, the Context is dropped.
+type FilterFunc func(ctx context.Context) bool
+
+// NewFanOutStage returns a stage that runs the commands in parallel, and then merges the results using the JoinFunc.
+// The CommandID identifies the stage, i.e., it is used to track the stage as a whole. Each of the fan-out commands is
+// also tracked using its own CommandID.
+func NewFanOutStage(serviceID app.ServiceID, id CommandID, join JoinFunc, poolSize uint8, commands ...Command) Stage {
+	if join == nil {
+		panic("JoinFunc must not be nil")
+	}
+	if len(commands) == 0 {
+		panic("A fan-out stage must have at least 1 command")
+	}
+	branches := make([]Stage, len(commands))
+	for i, cmd := range commands {
+		branches[i] = NewStage(serviceID, cmd, 1)
+	}
+	stage := NewStage(serviceID, NewCommand(id, fanOutCommand(branches, join)), poolSize)
+	stage.kind = StageKind_FAN_OUT
+	stage.branches = branches
+	return stage
+}
+
+func fanOutCommand(branches []Stage, join JoinFunc) CommandFunc {
+	return func(ctx context.Context) context.Context {
+		results := make([]context.Context, len(branches))
+		var wait sync.WaitGroup
+		wait.Add(len(branches))
+		for i := range branches {
+			go func(i int) {
+				defer wait.Done()
+				results[i] = branches[i].run(ctx)
+			}(i)
+		}
+		wait.Wait()
+		return join(ctx, results)
+	}
+}
+
+// NewRouterStage returns a stage that picks the next stage using the RouterFunc. A router stage cannot be the last stage.
+//
+// Routing only skips stages, i.e., after a routed stage, the Context continues on to the stage that follows it.
+// Exclusive branches can be built by following each branch with another router stage.
+func NewRouterStage(serviceID app.ServiceID, id CommandID, router RouterFunc, poolSize uint8) Stage {
+	if router == nil {
+		panic("RouterFunc must not be nil")
+	}
+	stage := NewStage(serviceID, NewCommand(id, routeCommand(router)), poolSize)
+	stage.kind = StageKind_ROUTER
+	return stage
+}
+
+func routeCommand(router RouterFunc) CommandFunc {
+	return func(ctx context.Context) context.Context {
+		next, ok := router(ctx)
+		if !ok {
+			// clear any route that was set by an upstream router
+			next = CommandID(0)
+		}
+		return withRoute(ctx, next)
+	}
+}
+
+// NewFilterStage returns a stage that drops Context(s) that are rejected by the FilterFunc.
+// Dropped Context(s) are not delivered to the pipeline output channel.
+func NewFilterStage(serviceID app.ServiceID, id CommandID, filter FilterFunc, poolSize uint8) Stage {
+	if filter == nil {
+		panic("FilterFunc must not be nil")
+	}
+	stage := NewStage(serviceID, NewCommand(id, filterCommand(filter)), poolSize)
+	stage.kind = StageKind_FILTER
+	return stage
+}
+
+func filterCommand(filter FilterFunc) CommandFunc {
+	return func(ctx context.Context) context.Context {
+		if filter(ctx) {
+			return ctx
+		}
+		return withFiltered(ctx)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package command
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	DEFAULT_AUTOSCALER_INTERVAL = 10 * time.Second
+)
+
+// Autoscaler adjusts a stage's pool size between MinPoolSize and MaxPoolSize, based on the average time that contexts
+// wait in the stage's input queue before a worker picks them up.
+//
+// The pool size is evaluated every Interval:
+//	- if the average queue wait time is greater than ScaleUpQueueWait, then 1 worker is added to the pool
+//	- if the average queue wait time is less than ScaleDownQueueWait, then 1 worker is removed from the pool
+type Autoscaler struct {
+	MinPoolSize uint8
+	MaxPoolSize uint8
+
+	ScaleUpQueueWait   time.Duration
+	ScaleDownQueueWait time.Duration
+
+	// defaults to DEFAULT_AUTOSCALER_INTERVAL
+	Interval time.Duration
+}
+
+func (a *Autoscaler) interval() time.Duration {
+	if a.Interval <= 0 {
+		return DEFAULT_AUTOSCALER_INTERVAL
+	}
+	return a.Interval
+}
+
+func (a *Autoscaler) minPoolSize() uint8 {
+	if a.MinPoolSize == 0 {
+		return 1
+	}
+	return a.MinPoolSize
+}
+
+func (a *Autoscaler) maxPoolSize() uint8 {
+	if a.MaxPoolSize < a.minPoolSize() {
+		return a.minPoolSize()
+	}
+	return a.MaxPoolSize
+}
+
+// bound returns the pool size bounded by the autoscaler's min and max pool size
+func (a *Autoscaler) bound(poolSize uint8) uint8 {
+	if poolSize < a.minPoolSize() {
+		return a.minPoolSize()
+	}
+	if poolSize > a.maxPoolSize() {
+		return a.maxPoolSize()
+	}
+	return poolSize
+}
+
+// stagePool manages the worker goroutines for a pipeline stage.
+//
+// Workers can be added and removed while the pipeline is running. A worker that is removed finishes processing its
+// current Context before it exits, i.e., in-flight contexts are not lost.
+type stagePool struct {
+	sync.Mutex
+
+	pipeline *Pipeline
+	stage    *Stage
+	in       chan context.Context
+	process  func(ctx context.Context)
+
+	// each worker has its own quit channel
+	workers []chan struct{}
+
+	poolSize      prometheus.Gauge
+	queueWaitTime prometheus.Counter
+
+	// used by the autoscaler to compute the average queue wait time since the last evaluation
+	queueWaitNanos int64
+	queueWaitCount int64
+}
+
+func newStagePool(pipeline *Pipeline, stage *Stage, in chan context.Context, process func(ctx context.Context)) *stagePool {
+	pool := &stagePool{
+		pipeline:      pipeline,
+		stage:         stage,
+		in:            in,
+		process:       process,
+		poolSize:      stage.poolSizeGauge,
+		queueWaitTime: stage.queueWaitTime,
+	}
+	poolSize := stage.PoolSize()
+	if stage.autoscaler != nil {
+		poolSize = stage.autoscaler.bound(poolSize)
+	}
+	pool.resize(poolSize)
+	return pool
+}
+
+func (a *stagePool) size() uint8 {
+	a.Lock()
+	defer a.Unlock()
+	return uint8(len(a.workers))
+}
+
+// resize grows or shrinks the number of workers
+func (a *stagePool) resize(poolSize uint8) {
+	a.Lock()
+	defer a.Unlock()
+	service := a.pipeline.Service
+	for len(a.workers) < int(poolSize) {
+		quit := make(chan struct{})
+		a.workers = append(a.workers, quit)
+		service.Go(func() error {
+			for {
+				select {
+				case <-quit:
+					return nil
+				default:
+				}
+
+				select {
+				case <-service.Dying():
+					return nil
+				case <-quit:
+					return nil
+				case ctx := <-a.in:
+					a.recordQueueWait(ctx)
+					a.process(ctx)
+				}
+			}
+		})
+	}
+	for len(a.workers) > int(poolSize) {
+		last := len(a.workers) - 1
+		close(a.workers[last])
+		a.workers = a.workers[:last]
+	}
+	a.poolSize.Set(float64(len(a.workers)))
+}
+
+func (a *stagePool) recordQueueWait(ctx context.Context) {
+	if enqueuedOn, ok := stageEnqueuedOn(ctx); ok {
+		wait := time.Now().Sub(enqueuedOn)
+		a.queueWaitTime.Add(wait.Seconds())
+		atomic.AddInt64(&a.queueWaitNanos, int64(wait))
+		atomic.AddInt64(&a.queueWaitCount, 1)
+	}
+}
+
+// averageQueueWait returns the average queue wait time since the last time it was called
+func (a *stagePool) averageQueueWait() time.Duration {
+	nanos := atomic.SwapInt64(&a.queueWaitNanos, 0)
+	count := atomic.SwapInt64(&a.queueWaitCount, 0)
+	if count == 0 {
+		return 0
+	}
+	return time.Duration(nanos / count)
+}
+
+// autoscale runs until the pipeline service is dead. It is only run for stages that have an Autoscaler configured.
+func (a *stagePool) autoscale(stageIndex int) {
+	autoscaler := a.stage.autoscaler
+	ticker := time.NewTicker(autoscaler.interval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.pipeline.Service.Dying():
+			return
+		case <-ticker.C:
+			poolSize := a.size()
+			queueWait := a.averageQueueWait()
+			switch {
+			case queueWait > autoscaler.ScaleUpQueueWait && poolSize < autoscaler.maxPoolSize():
+				a.pipeline.SetStagePoolSize(stageIndex, poolSize+1)
+			case queueWait < autoscaler.ScaleDownQueueWait && poolSize > autoscaler.minPoolSize():
+				a.pipeline.SetStagePoolSize(stageIndex, poolSize-1)
+			}
+		}
+	}
+}
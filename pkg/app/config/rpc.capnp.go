@@ -105,6 +105,14 @@ func (s RPCServerSpec) SetMaxConns(v uint32) {
 	s.Struct.SetUint32(0, v)
 }
 
+func (s RPCServerSpec) BusyRetryAfterMSec() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s RPCServerSpec) SetBusyRetryAfterMSec(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
 // RPCServerSpec_List is a list of RPCServerSpec.
 type RPCServerSpec_List struct{ capnp.List }
 
@@ -268,12 +276,12 @@ type RPCServiceSpec struct{ capnp.Struct }
 const RPCServiceSpec_TypeID = 0xb6e32df5c504ebf2
 
 func NewRPCServiceSpec(s *capnp.Segment) (RPCServiceSpec, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
 	return RPCServiceSpec{st}, err
 }
 
 func NewRootRPCServiceSpec(s *capnp.Segment) (RPCServiceSpec, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
 	return RPCServiceSpec{st}, err
 }
 
@@ -319,12 +327,37 @@ func (s RPCServiceSpec) SetPort(v uint16) {
 	s.Struct.SetUint16(24, v)
 }
 
+func (s RPCServiceSpec) Network() (NetworkSpec, error) {
+	p, err := s.Struct.Ptr(0)
+	return NetworkSpec{Struct: p.Struct()}, err
+}
+
+func (s RPCServiceSpec) HasNetwork() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s RPCServiceSpec) SetNetwork(v NetworkSpec) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewNetwork sets the network field to a newly
+// allocated NetworkSpec struct, preferring placement in s's segment.
+func (s RPCServiceSpec) NewNetwork() (NetworkSpec, error) {
+	ss, err := NewNetworkSpec(s.Struct.Segment())
+	if err != nil {
+		return NetworkSpec{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
 // RPCServiceSpec_List is a list of RPCServiceSpec.
 type RPCServiceSpec_List struct{ capnp.List }
 
 // NewRPCServiceSpec creates a new list of RPCServiceSpec.
 func NewRPCServiceSpec_List(s *capnp.Segment, sz int32) (RPCServiceSpec_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1}, sz)
 	return RPCServiceSpec_List{l}, err
 }
 
@@ -345,6 +378,156 @@ func (p RPCServiceSpec_Promise) Struct() (RPCServiceSpec, error) {
 	return RPCServiceSpec{s}, err
 }
 
+func (p RPCServiceSpec_Promise) Network() NetworkSpec_Promise {
+	return NetworkSpec_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type NetworkSpec struct{ capnp.Struct }
+
+// NetworkSpec_TypeID is the unique identifier for the type NetworkSpec.
+const NetworkSpec_TypeID = 0x9bb504cb37fb684a
+
+func NewNetworkSpec(s *capnp.Segment) (NetworkSpec, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return NetworkSpec{st}, err
+}
+
+func NewRootNetworkSpec(s *capnp.Segment) (NetworkSpec, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return NetworkSpec{st}, err
+}
+
+func ReadRootNetworkSpec(msg *capnp.Message) (NetworkSpec, error) {
+	root, err := msg.RootPtr()
+	return NetworkSpec{root.Struct()}, err
+}
+
+func (s NetworkSpec) String() string {
+	str, _ := text.Marshal(0x9bb504cb37fb684a, s.Struct)
+	return str
+}
+
+func (s NetworkSpec) Type() NetworkSpec_Type {
+	return NetworkSpec_Type(s.Struct.Uint16(0))
+}
+
+func (s NetworkSpec) SetType(v NetworkSpec_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s NetworkSpec) Address() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s NetworkSpec) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s NetworkSpec) AddressBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s NetworkSpec) SetAddress(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s NetworkSpec) FileMode() uint32 {
+	return s.Struct.Uint32(4) ^ 384
+}
+
+func (s NetworkSpec) SetFileMode(v uint32) {
+	s.Struct.SetUint32(4, v^384)
+}
+
+// NetworkSpec_List is a list of NetworkSpec.
+type NetworkSpec_List struct{ capnp.List }
+
+// NewNetworkSpec creates a new list of NetworkSpec.
+func NewNetworkSpec_List(s *capnp.Segment, sz int32) (NetworkSpec_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return NetworkSpec_List{l}, err
+}
+
+func (s NetworkSpec_List) At(i int) NetworkSpec { return NetworkSpec{s.List.Struct(i)} }
+
+func (s NetworkSpec_List) Set(i int, v NetworkSpec) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s NetworkSpec_List) String() string {
+	str, _ := text.MarshalList(0x9bb504cb37fb684a, s.List)
+	return str
+}
+
+// NetworkSpec_Promise is a wrapper for a NetworkSpec promised by a client call.
+type NetworkSpec_Promise struct{ *capnp.Pipeline }
+
+func (p NetworkSpec_Promise) Struct() (NetworkSpec, error) {
+	s, err := p.Pipeline.Struct()
+	return NetworkSpec{s}, err
+}
+
+type NetworkSpec_Type uint16
+
+// NetworkSpec_Type_TypeID is the unique identifier for the type NetworkSpec_Type.
+const NetworkSpec_Type_TypeID = 0x95749e64ebec62e2
+
+// Values of NetworkSpec_Type.
+const (
+	NetworkSpec_Type_tcp  NetworkSpec_Type = 0
+	NetworkSpec_Type_unix NetworkSpec_Type = 1
+	NetworkSpec_Type_pipe NetworkSpec_Type = 2
+)
+
+// String returns the enum's constant name.
+func (c NetworkSpec_Type) String() string {
+	switch c {
+	case NetworkSpec_Type_tcp:
+		return "tcp"
+	case NetworkSpec_Type_unix:
+		return "unix"
+	case NetworkSpec_Type_pipe:
+		return "pipe"
+
+	default:
+		return ""
+	}
+}
+
+// NetworkSpec_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func NetworkSpec_TypeFromString(c string) NetworkSpec_Type {
+	switch c {
+	case "tcp":
+		return NetworkSpec_Type_tcp
+	case "unix":
+		return NetworkSpec_Type_unix
+	case "pipe":
+		return NetworkSpec_Type_pipe
+
+	default:
+		return 0
+	}
+}
+
+type NetworkSpec_Type_List struct{ capnp.List }
+
+func NewNetworkSpec_Type_List(s *capnp.Segment, sz int32) (NetworkSpec_Type_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return NetworkSpec_Type_List{l.List}, err
+}
+
+func (l NetworkSpec_Type_List) At(i int) NetworkSpec_Type {
+	ul := capnp.UInt16List{List: l.List}
+	return NetworkSpec_Type(ul.At(i))
+}
+
+func (l NetworkSpec_Type_List) Set(i int, v NetworkSpec_Type) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
 type X509KeyPair struct{ capnp.Struct }
 
 // X509KeyPair_TypeID is the unique identifier for the type X509KeyPair.
@@ -424,44 +607,73 @@ func (p X509KeyPair_Promise) Struct() (X509KeyPair, error) {
 	return X509KeyPair{s}, err
 }
 
-const schema_99f3cbccce65aee8 = "x\xda\xac\x92Oh\xd3n\x1c\xc6\x9f\xe7}\xb3_\xb7" +
-	"\xb1\xb1\x86\xf4\xde\xfdD\x0f\x1bL\xa6 h/U\xba" +
-	"\x1e\xaa\x0e\xfan\x14\xa6\xa0\x18\xd2\xcc\x06\xdb4K\xb3" +
-	"\xd9\x0d\x05\xff\x9d\xbc*\x88'o\x1e\x15<x\xd3\xc3" +
-	"`\"S<\x08\x0a\x1ev\xf20\xc1\x9b\x7fnB$" +
-	"\xa9K\xc3\x14\x14\xf1\xf6\xcd\x9b'o>\xcf\xf7y\xa6" +
-	"\xeb<*\x0e\x0c\xe4%\xa0\xf6\x0e\xfc\x17~\xfa\xa8m" +
-	"|\x9dz\xff\x18j\x98Z\xf8\xe1\x81\xfd\xea\xe5\x8b\xcf" +
-	"w\xa1e\x00c\x99\x9b\xc6UF\xd3en\x83\xe1\xca" +
-	"\x9d\xd7\xb7\xaf7\x9e=\x85>\xcc\xbet@F\x8aS" +
-	"b\xdd0E4\x9d\x11\x0f\xc1\xf0\xbe\xe7\x16\xff\xefl" +
-	"}\xd9\xa5\x8d\x15\xfb\xe4#c*\xfejB^\x04\xc3" +
-	"\xc6\xe6\xd2\xf9\xf2s\xe3[\xc4\xf0\xd3\xc5o\xe4\xba\xb1" +
-	"\x15O\xef\xe46\x0e\x87\xbeg\xed\xb7L\xcf\x15^a" +
-	"\xaeZ\x9a\xb7\xfd\x15\xc7\xb2\xe7=\xdb\x02\xaa\xa4\xcaJ" +
-	"\x0d\xd0\x08\xe8\xe6q@\x9d\x93TMA\x9d\xcc1:" +
-	"t\x0e\x02\xaa.\xa9<A]\x88\x1c\x05\xa0\xb7\xe6\x00" +
-	"\xd5\x94T]A]\x8e\xe4(\x01}y\x12P\x9e\xa4" +
-	"\xba$\x18\xd6\xdb-\xd3q+3\x008\x04\xc1!0" +
-	"oz^\xa5\xbe\xf3\x14vz \x1509\x1b\xf3\xda" +
-	"~\xc0\x0c\x043\xe0.\xeeR\xd3\xb1\xdd \xc6\x8e\xa8" +
-	"G\x12\xea\xf2\x1a\xa0f$U5E={\x1aP'" +
-	"%\xd5B\x8a\xbaV\xd0ky\xd5\x95T7D|}" +
-	"\xbc\x0b\x14{\xdb`\xb6\x1f-\xc8,\x18Z\xf1?K" +
-	"6\xa4\x1f0\xdbO\xa8\xf7\xbah\x99%\xdb\x0f\x94F" +
-	"\x11\x9e\xbduO=y{s\x03J\x13<\x96#G" +
-	"\x00\x9d\xd7\xc2jyv|\xd1i\xda\x1c_l\xfb-" +
-	"3\x88\xd61\x0a\xc1\xd1\x94?z\x85\x85C\xd3GN" +
-	"\xd8\xf9\xd5\xaa\xe9\xf8\x91\xbb\xc1\xc4\xdd\xc4\x1e}\"\x9f" +
-	"8\xd9\xb1W\x9bL9\xc9\\\xb0W\xff\x16b\xcc\xfa" +
-	"\x17\x0e\xfa\xcd\xb2\xfd$\xa1T\xaf\xd6~\xd5\xab(\xa1" +
-	"\x86\xa4\x0aR\x09-\x15\xfa\xbd\xa2\xfcQ\xab\xa8\x95\x81" +
-	"\xa4\xba\xf2g\xa1ub\x8c\xdf\x84\x96Xh\x99\xddR" +
-	"\xdbu;\x91\xadA\x08\x0e\x82\xdf\x03\x00\x00\xff\xff(" +
-	"\x94\xfc\xae"
+const schema_99f3cbccce65aee8 = "x\xda\xbc\x94Mh\x1ce\x18\xc7\x9f\xff\xfb\xce\xce\xa4" +
+	"a\xcb\xe6e\xb6J\x8525\xb7.Z\x8c\"j." +
+	"I\x93\xe6\x90ht\xdf|@\xc9\xc1\xb8\xdd\x9d\x98m" +
+	"\x92\xdd\xe9\xec\xa4\xcd\x06\xa5\xeb\x17\xb4\xd2Kk\x8a_" +
+	"\xf8qP\x0f\x1e\xac\x04\xf4 \xb4\x07\xa1\xa2\xa9(x" +
+	"\xe8!\xe0\xa5\x82\x96\xb4\x82V{0~<2\xb3\xee" +
+	"\xec\x18\x04E\xc1\xd3\xee\xcc\xfc\xdf\xf7y~\xcf\xf3\x7f" +
+	"\x1ee6\xd4\xee~\xd5\xdfP\x8d\xbb\xfa\x8d\x9e\xed\x9f" +
+	"K\x12\xfa\xb6\x94\xc9\xd7\xaf\x1a\x17n\xdc\xfe\xd5\xfb\xa4" +
+	";a\xf0\x95w\xdc\xcf>\xbd\xf8\xc3\x8b\x94\x82E\xa4" +
+	"\xbaa\x7f\x12\xfd\xb9\x04\x02\x1fy\xfe\x8b\x95\xa7f?" +
+	":O\xaa\x13\x09\xa5\x0c\x05\x9bP\x9d\x92\xc8\xbe\x0cI" +
+	"\xe0\xb7\xbcJ\xdf\xad\xb5/\x7f\xdc\xa2\x14\xa1\xd24\xd4" +
+	"-\x06\x91\xfd\x01\x0c\x02\xcf\xae\x1d~t\xe8c\xfb\x97" +
+	"0\xfc\xd6K\xedu\x18\xf6\x06Bq7Rt/\x8f" +
+	"\xcc\xfe|\xcfE\xe3\xbd\x97\xb7\x8a\xc3\x14\xedU\x91\xb2" +
+	"\xd7E\xca\xfeZ\xa4\xd4y\x93\xc0\x97\x0f^\xbbZz" +
+	"58C\xea&\xd1>JP\x1b\xa6\xda4\xedN\x98" +
+	"\xf6i\x98\x84<\xc0\xbeW\xdc[,x\x15\xe1\xf5\x8e" +
+	"\xe5\x07\xc7]\xffH\xb9\xe8\x8e{n\x91\xc8\xf9\x96\x99" +
+	"\xd7`\x91\x98\xbe\xc6\xcc\x12\xb0\x08\xd3\xdf1\xb3@\x1e" +
+	"\xc2\xf9\x89\x99-t%\"P?\x88\xa6\xaf4\xc5]" +
+	"\xb1X\x09\xd1\x03gk\xac\xc1\xf9\xb2[\x09\xa2P\xce" +
+	"\xf7\xcc\xfc\xa1\xceJ\x83\xc8\x00\x91z|\x84H?&" +
+	"\xa1\x8f\x0b( \x8b\xf0\xe53w\x12\xe9\x86\x84>\x19" +
+	"]\x99\x85 R'\xc6\x88\xf4q\x09\xbd\"\xa0d:" +
+	"\x0bI\xa4N\xe5\x88\xd4\x08\xd4!\xc0\xc8\x86eT\xf5" +
+	"\x01\xb5\x0c\xf56\xd4\x1a\xb8T](\x94+\xc3\xfb\x89" +
+	"\x08\xdbH`\x1b\xc1)x\xdep\xa9\xf5\xc4\xb5f\x19" +
+	"\x86\x09\xf1\xbb\x8cW\xf5\x83c\x1578Z\xf5\xe7\xf2" +
+	"@\x93^\xa7\xe3\x94\x87\x96\x89\xf4~\x09\x9dO\xa4<" +
+	":E\xa4\x1f\x90\xd0\x07\x12)O\xf6\xaaIG/I" +
+	"\xe8\xa7ET\x94\xa8\xea\xd4\xd7\xac;\xba\xda\xce\xa4\xa8" +
+	"\x8a\\\x8c*5\xe8\x92\xf4\x03t\xb5=\xd6\xfc\xdcW" +
+	",\x0c\xba~\xa0\x0d\x08~\xf8\xb9\xd7\xf4\xb9K\xcf^" +
+	" m\x08\xec\xcb\x02i\"\x85'9?4\xba{\xa6" +
+	"<\xefb\xf7L\xd5_(\x04D\x84\xed$\xb0\x9d\xe0" +
+	"\xec`\xe6\xb3qo\xe0\xf5\x1e\xb8\xfb\x8e\xfb\xeew\x9d" +
+	"z\xbeP\xf6\xed\x0dH\x85\xd56pG\x0c\xbc\xa7[" +
+	"\xedqb\xb8\x16\xf1d.\x01g\xcd\xb9\xf5\x7f\x9bW" +
+	"\xa6\xf8_\xa0z\x98\xf9\xe8_\x98\xdb\xf5\x13\x86kS" +
+	"u\xc5T\x85\xb0\x8d\x8fH\xe8\xf9\x04T9l\xe3\xac" +
+	"\x84\x0e\x12m<\xdcK\xa4\xe7%\xf4\x92\x00d\xd3x" +
+	"\x8b\xa1o\x03\x09\xdd\xf8g\x9d\xadE)\xfdMg[" +
+	"P\xbcPX\x1a\xacV*5\"B\x07\x09t\x10\x9c" +
+	"_\x7fc\xfe&\x0f\x91\x18\x9e'\x96\x13s\xd2B8" +
+	"1\x95\x98\x93\x16\xc2\xa9\x10\xe1\xa4\x84~\xa1\x8dp&" +
+	"DX\x91\xd0\xaf\x0b(\x03\xcd\xf1y\xe5%\xf5\xa6\xa5" +
+	"\xdf\x90\xd0\xef\x0a\xf4-0\xb3\xf3\xff\xf0\xf1\xc1\xc5Z" +
+	"}\xcc\x0d|\xd4\xf7\xcd\x04\xae?:.\xddb\x1e\"" +
+	"\xfe\x9e\xf0\xed\x83\xd1p:sa.Q\x83W\xb5\x81" +
+	"\xe46D.3Q\xf7\xdch\xaf\xddloJC\xe1" +
+	"O\x13\x9cSCV4\xc2\x13\x02\xad\xba\xe9\x01\xa5-" +
+	"\xd5m\xaa\x01S\x09d!\x005e\xaaC\xa6:m" +
+	"\xaaU3\x13\xd4=7\xcc'\xd3\x8e\xd3\\\x83\xc8\x10" +
+	"\x8e\x15J%\xdf\xad\xd5BA\xba\xbdF\xd3\xf1f\xe4" +
+	"\xd0\xbf\xa3\xd5\x92KD\xce\x0df\x1ei/\xd6\x8e\xf8" +
+	"@OG\x03\xf1\x11\xfb\xbaH)\x9cMz\xbb\x09\x1e" +
+	"q\xef\x9d\xa8\xcb?\x08\xd7c{\xc7w\xeat\xd4\xf6" +
+	"]\xddj\x97\x05\xa8\x9d9\xb5\xd3\x82P;rj\x87" +
+	"e\x05E/\x0f\x91Y\xac\x94\x97\xc2_\xaf\x1c\x819" +
+	"\xe7\x98\xf9\xa1\xdf\x07\x00\xec/\x149"
 
 func init() {
 	schemas.Register(schema_99f3cbccce65aee8,
+		0x95749e64ebec62e2,
+		0x9bb504cb37fb684a,
 		0xb6e32df5c504ebf2,
 		0xbec6688394d29776,
 		0xf4dd73213f6e70a6,
@@ -0,0 +1,185 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+// DEFAULT_ANNOUNCE_TTL is used if the Announcer TTL is not specified. Instances are re-announced every TTL/3.
+const DEFAULT_ANNOUNCE_TTL = 15 * time.Second
+
+// Announcer announces the service instances that are running in this app instance. Instances are re-announced every
+// TTL/3. The instance health is refreshed with each announcement from the instance's health checks, i.e., if any of the
+// health checks are failing, then the instance is announced as unhealthy.
+//
+// The Announcer is bound to the service lifecycle. When the service is killed, all instances are withdrawn, i.e.,
+// re-announced with a zero TTL, which removes them from the registries.
+//
+// Log events:
+//	- ANNOUNCE_FAILED
+type Announcer struct {
+	service    *app.Service
+	ttl        time.Duration
+	transports []Transport
+
+	mutex         sync.Mutex
+	announcements map[app.ServiceID]*announcement
+}
+
+type announcement struct {
+	instance     ServiceInstance
+	healthChecks []app.HealthCheckID
+}
+
+// NewAnnouncer starts the announcer. If the TTL is zero, then DEFAULT_ANNOUNCE_TTL is used.
+func NewAnnouncer(service *app.Service, ttl time.Duration, transports ...Transport) (*Announcer, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if len(transports) == 0 {
+		return nil, app.IllegalArgumentError("At least 1 transport is required")
+	}
+	if ttl < 0 {
+		return nil, app.IllegalArgumentError("TTL cannot be negative")
+	}
+	if ttl == 0 {
+		ttl = DEFAULT_ANNOUNCE_TTL
+	}
+	announcer := &Announcer{
+		service:       service,
+		ttl:           ttl,
+		transports:    transports,
+		announcements: make(map[app.ServiceID]*announcement),
+	}
+	service.Go(announcer.run)
+	return announcer, nil
+}
+
+func (a *Announcer) TTL() time.Duration {
+	return a.ttl
+}
+
+func (a *Announcer) run() error {
+	ticker := time.NewTicker(a.ttl / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.service.Dying():
+			a.withdrawAll()
+			return nil
+		case <-ticker.C:
+			a.mutex.Lock()
+			for _, announcement := range a.announcements {
+				// errors are logged
+				a.publish(announcement)
+			}
+			a.mutex.Unlock()
+		}
+	}
+}
+
+// Announce publishes the instance, and keeps on re-announcing it until it is withdrawn. The instance health is
+// determined by the specified health checks. If no health checks are specified, then the instance is always healthy.
+// The instance TTL is set to the Announcer TTL.
+//
+// If the service is already announced, then it is replaced.
+//
+// errors:
+//	- app.IllegalArgumentError if the instance is not valid
+//	- AnnounceError if the instance failed to be published on all transports - it will be retried on the next announce
+func (a *Announcer) Announce(instance ServiceInstance, healthChecks ...app.HealthCheckID) error {
+	if err := instance.Validate(); err != nil {
+		return err
+	}
+	instance.TTL = a.ttl
+	announcement := &announcement{instance, healthChecks}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.announcements[instance.ServiceID] = announcement
+	return a.publish(announcement)
+}
+
+// Withdraw stops announcing the service, and notifies the registries that the instance has been removed
+func (a *Announcer) Withdraw(serviceID app.ServiceID) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	announcement := a.announcements[serviceID]
+	if announcement == nil {
+		return nil
+	}
+	delete(a.announcements, serviceID)
+	announcement.instance.TTL = 0
+	return a.publish(announcement)
+}
+
+// ServiceIDs returns the services that are currently being announced
+func (a *Announcer) ServiceIDs() []app.ServiceID {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	ids := make([]app.ServiceID, 0, len(a.announcements))
+	for id := range a.announcements {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func (a *Announcer) withdrawAll() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for serviceID, announcement := range a.announcements {
+		announcement.instance.TTL = 0
+		a.publish(announcement)
+		delete(a.announcements, serviceID)
+	}
+}
+
+// publish must be called while holding the lock
+func (a *Announcer) publish(announcement *announcement) error {
+	instance := &announcement.instance
+	instance.AnnouncedAt = time.Now()
+	instance.Healthy = healthy(announcement.healthChecks)
+	var lastErr error
+	published := false
+	for _, transport := range a.transports {
+		if err := transport.Publish(*instance); err != nil {
+			ANNOUNCE_FAILED.Log(a.service.Logger().Warn()).
+				Uint64("service", uint64(instance.ServiceID)).
+				Str("addr", instance.Addr()).
+				Err(err).
+				Msg("failed to publish service announcement")
+			lastErr = err
+			continue
+		}
+		published = true
+	}
+	if !published {
+		return AnnounceError(a.service.ID(), lastErr)
+	}
+	return nil
+}
+
+func healthy(healthChecks []app.HealthCheckID) bool {
+	for _, id := range healthChecks {
+		result, err := app.HealthChecks.HealthCheckResult(id)
+		if err != nil || result.Err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,271 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery_test
+
+import (
+	"io/ioutil"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/discovery"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+)
+
+const (
+	DOMAIN_ID  = app.DomainID(0xed5cf026e8734361)
+	APP_ID     = app.AppID(0xd113a2e016e12f0f)
+	SERVICE_ID = app.ServiceID(0xe49214fa20b35ba8)
+
+	DISCOVERY_SERVICE_ID = app.ServiceID(0x8d1e5bc8f95f5a8b)
+)
+
+// memTransport records the published instances, and delivers the announcements that are sent on its channel
+type memTransport struct {
+	sync.Mutex
+	published []discovery.ServiceInstance
+	c         chan []byte
+}
+
+func (a *memTransport) Publish(instance discovery.ServiceInstance) error {
+	a.Lock()
+	defer a.Unlock()
+	a.published = append(a.published, instance)
+	return nil
+}
+
+func (a *memTransport) Subscribe(done <-chan struct{}) (<-chan []byte, error) {
+	return a.c, nil
+}
+
+func (a *memTransport) last() (discovery.ServiceInstance, int) {
+	a.Lock()
+	defer a.Unlock()
+	if len(a.published) == 0 {
+		return discovery.ServiceInstance{}, 0
+	}
+	return a.published[len(a.published)-1], len(a.published)
+}
+
+func instance(instanceID app.InstanceID, healthy bool) discovery.ServiceInstance {
+	return discovery.ServiceInstance{
+		DomainID:    DOMAIN_ID,
+		AppID:       APP_ID,
+		ServiceID:   SERVICE_ID,
+		InstanceID:  instanceID,
+		Kind:        discovery.KIND_RPC,
+		Host:        "localhost",
+		Port:        44222,
+		TTL:         time.Minute,
+		Healthy:     healthy,
+		AnnouncedAt: time.Now(),
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	service := app.NewService(DISCOVERY_SERVICE_ID)
+	defer service.Kill(nil)
+	registry, err := discovery.NewRegistry(service, nil, &memTransport{c: make(chan []byte)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := registry.Resolve(SERVICE_ID); err == nil {
+		t.Error("no instances are registered")
+	} else if appErr, ok := err.(*app.Error); !ok || appErr.ErrorID != discovery.ErrSpec_ServiceNotFound.ErrorID {
+		t.Errorf("ServiceNotFoundError was expected : %v", err)
+	}
+
+	watch := registry.Watch(SERVICE_ID)
+	defer watch.Close()
+
+	registry.Register(instance(1, true))
+	registry.Register(instance(2, true))
+	registry.Register(instance(3, false))
+	if len(registry.Instances(SERVICE_ID)) != 3 || len(registry.HealthyInstances(SERVICE_ID)) != 2 {
+		t.Errorf("instances = %v", registry.Instances(SERVICE_ID))
+	}
+	for i := 0; i < 3; i++ {
+		if event := <-watch.C; event.Type != discovery.EVENT_REGISTERED {
+			t.Errorf("registered event was expected : %v", event.Type)
+		}
+	}
+
+	t.Run("Resolve round robin across healthy instances", func(t *testing.T) {
+		selected := make(map[app.InstanceID]int)
+		for i := 0; i < 10; i++ {
+			instance, err := registry.Resolve(SERVICE_ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			selected[instance.InstanceID]++
+		}
+		if len(selected) != 2 || selected[1] != 5 || selected[2] != 5 {
+			t.Errorf("instances were not balanced : %v", selected)
+		}
+	})
+
+	t.Run("stale announcements are ignored", func(t *testing.T) {
+		stale := instance(1, false)
+		stale.AnnouncedAt = time.Now().Add(-time.Second)
+		registry.Register(stale)
+		if len(registry.HealthyInstances(SERVICE_ID)) != 2 {
+			t.Error("stale announcement should have been ignored")
+		}
+	})
+
+	t.Run("health change", func(t *testing.T) {
+		registry.Register(instance(1, false))
+		if event := <-watch.C; event.Type != discovery.EVENT_UPDATED || event.InstanceID != 1 || event.Healthy {
+			t.Errorf("updated event was expected : %v : %v", event.Type, event.ServiceInstance)
+		}
+		for i := 0; i < 5; i++ {
+			if instance, _ := registry.Resolve(SERVICE_ID); instance.InstanceID != 2 {
+				t.Errorf("only instance 2 is healthy : %v", instance)
+			}
+		}
+	})
+
+	t.Run("withdraw", func(t *testing.T) {
+		withdrawn := instance(2, true)
+		withdrawn.TTL = 0
+		registry.Register(withdrawn)
+		if event := <-watch.C; event.Type != discovery.EVENT_REMOVED || event.InstanceID != 2 {
+			t.Errorf("removed event was expected : %v : %v", event.Type, event.ServiceInstance)
+		}
+		if _, err := registry.Resolve(SERVICE_ID); err == nil {
+			t.Error("there are no healthy instances")
+		}
+	})
+
+	t.Run("expire", func(t *testing.T) {
+		expiring := instance(4, true)
+		expiring.TTL = 10 * time.Millisecond
+		registry.Register(expiring)
+		if event := <-watch.C; event.Type != discovery.EVENT_REGISTERED {
+			t.Errorf("registered event was expected : %v", event.Type)
+		}
+		select {
+		case event := <-watch.C:
+			if event.Type != discovery.EVENT_REMOVED || event.InstanceID != 4 {
+				t.Errorf("removed event was expected : %v : %v", event.Type, event.ServiceInstance)
+			}
+		case <-time.After(discovery.EXPIRY_CHECK_INTERVAL * 3):
+			t.Error("instance should have expired")
+		}
+	})
+
+	t.Run("clock skew", func(t *testing.T) {
+		// the announcer's clock is behind
+		behind := instance(5, true)
+		behind.AnnouncedAt = time.Now().Add(-time.Hour)
+		registry.Register(behind)
+		if event := <-watch.C; event.Type != discovery.EVENT_REGISTERED || event.InstanceID != 5 {
+			t.Errorf("registered event was expected : %v : %v", event.Type, event.ServiceInstance)
+		}
+
+		// the announcer's clock is ahead
+		ahead := instance(6, true)
+		ahead.AnnouncedAt = time.Now().Add(time.Hour)
+		ahead.TTL = 10 * time.Millisecond
+		registry.Register(ahead)
+		if event := <-watch.C; event.Type != discovery.EVENT_REGISTERED || event.InstanceID != 6 {
+			t.Errorf("registered event was expected : %v : %v", event.Type, event.ServiceInstance)
+		}
+		select {
+		case event := <-watch.C:
+			if event.Type != discovery.EVENT_REMOVED || event.InstanceID != 6 {
+				t.Errorf("removed event was expected : %v : %v", event.Type, event.ServiceInstance)
+			}
+		case <-time.After(discovery.EXPIRY_CHECK_INTERVAL * 3):
+			t.Error("instance should have expired")
+		}
+	})
+
+	watch.Close()
+	if _, ok := <-watch.C; ok {
+		t.Error("watch channel should be closed")
+	}
+}
+
+func TestAnnouncer(t *testing.T) {
+	service := app.NewService(DISCOVERY_SERVICE_ID)
+	transport := &memTransport{}
+	announcer, err := discovery.NewAnnouncer(service, 30*time.Millisecond, transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := announcer.Announce(instance(0, true)); err == nil {
+		t.Error("InstanceID cannot be 0")
+	}
+	if err := announcer.Announce(instance(app.Instance(), false)); err != nil {
+		t.Fatal(err)
+	}
+	announced, _ := transport.last()
+	if !announced.Healthy || announced.TTL != announcer.TTL() {
+		t.Errorf("instance should have been announced as healthy with the announcer TTL : %v", announced)
+	}
+
+	time.Sleep(50 * time.Millisecond)
+	if _, count := transport.last(); count < 2 {
+		t.Errorf("instance should have been re-announced : %d", count)
+	}
+
+	service.Kill(nil)
+	service.Wait()
+	if withdrawn, _ := transport.last(); !withdrawn.Withdrawn() {
+		t.Errorf("instance should have been withdrawn : %v", withdrawn)
+	}
+	if len(announcer.ServiceIDs()) != 0 {
+		t.Errorf("no services should be announced : %v", announcer.ServiceIDs())
+	}
+}
+
+func TestFileTransport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "discovery")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	transport, err := discovery.NewFileTransport(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	service := app.NewService(DISCOVERY_SERVICE_ID)
+	defer service.Kill(nil)
+	registry, err := discovery.NewRegistry(service, nil, transport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watch := registry.Watch(SERVICE_ID)
+
+	unixInstance := instance(app.Instance(), true)
+	unixInstance.Network = opnet.Network{Type: opnet.NETWORK_UNIX, Address: "/tmp/discovery.sock"}
+	if err := transport.Publish(unixInstance); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-watch.C:
+		if event.Type != discovery.EVENT_REGISTERED || event.Addr() != unixInstance.Addr() || event.Kind != discovery.KIND_RPC {
+			t.Errorf("registered event does not match : %v", event.ServiceInstance)
+		}
+	case <-time.After(discovery.FILE_TRANSPORT_POLL_INTERVAL * 3):
+		t.Fatal("announcement was not delivered")
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"fmt"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+var (
+	ErrSpec_ServiceNotFound = app.ErrSpec{ErrorID: app.ErrorID(0xbbd274f9bbfc1c5b), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+	ErrSpec_AnnounceFailed  = app.ErrSpec{ErrorID: app.ErrorID(0xc8d5563351c45866), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_HIGH}
+)
+
+// ServiceNotFoundError is returned when there are no live instances registered for the service, or none of the live
+// instances are healthy
+func ServiceNotFoundError(serviceID app.ServiceID, targetServiceID app.ServiceID, instances int) *app.Error {
+	return app.NewError(
+		fmt.Errorf("No healthy service instance found : ServiceID(0x%x) : live instances = %d", targetServiceID, instances),
+		"",
+		ErrSpec_ServiceNotFound,
+		serviceID,
+		nil,
+	)
+}
+
+// AnnounceError is returned when the announcement failed to be published on all transports
+func AnnounceError(serviceID app.ServiceID, err error) *app.Error {
+	return app.NewError(
+		err,
+		"Service announcement failed",
+		ErrSpec_AnnounceFailed,
+		serviceID,
+		nil,
+	)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+const (
+	// how often the FileTransport checks for new announcements
+	FILE_TRANSPORT_POLL_INTERVAL = time.Second
+	// announcement files that have not been updated within the retention period are deleted
+	FILE_TRANSPORT_RETENTION = time.Hour
+
+	ANNOUNCEMENT_FILE_EXT = ".announcement"
+)
+
+// FileTransport is a file based service registry. Each instance announcement is stored in its own file within the
+// registry dir, e.g.,
+//
+//	/var/lib/oysterpack/discovery/d113a2e016e12f0f_e49214fa20b35ba8.announcement
+//
+// It is meant to be used as the fallback Transport, when NATS is not available. The registry dir may be a shared volume
+// for apps running on different hosts.
+type FileTransport struct {
+	dir string
+}
+
+// NewFileTransport creates the registry dir if it does not exist
+func NewFileTransport(dir string) (*FileTransport, error) {
+	if strings.TrimSpace(dir) == "" {
+		return nil, app.IllegalArgumentError("Registry dir is required")
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	return &FileTransport{dir}, nil
+}
+
+func (a *FileTransport) Dir() string {
+	return a.dir
+}
+
+// Publish writes the announcement file. The file is written atomically, i.e., subscribers never see partial files.
+func (a *FileTransport) Publish(instance ServiceInstance) error {
+	data, err := instance.Marshal()
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%x_%x%s", uint64(instance.ServiceID), uint64(instance.InstanceID), ANNOUNCEMENT_FILE_EXT)
+	tmp, err := ioutil.TempFile(a.dir, name)
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(a.dir, name))
+}
+
+// Subscribe polls the registry dir every FILE_TRANSPORT_POLL_INTERVAL, and delivers announcement files that are new or
+// have been updated since the last poll. Stale announcement files are deleted - see FILE_TRANSPORT_RETENTION.
+func (a *FileTransport) Subscribe(done <-chan struct{}) (<-chan []byte, error) {
+	if _, err := os.Stat(a.dir); err != nil {
+		return nil, err
+	}
+	c := make(chan []byte)
+	go func() {
+		defer close(c)
+		modTimes := make(map[string]time.Time)
+		ticker := time.NewTicker(FILE_TRANSPORT_POLL_INTERVAL)
+		defer ticker.Stop()
+		for {
+			if !a.poll(modTimes, c, done) {
+				return
+			}
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	return c, nil
+}
+
+// poll returns false if done is closed
+func (a *FileTransport) poll(modTimes map[string]time.Time, c chan<- []byte, done <-chan struct{}) bool {
+	files, err := ioutil.ReadDir(a.dir)
+	if err != nil {
+		// the dir may be a network mount that is temporarily unavailable - try again on the next poll
+		return true
+	}
+	now := time.Now()
+	found := make(map[string]bool, len(files))
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || filepath.Ext(name) != ANNOUNCEMENT_FILE_EXT {
+			continue
+		}
+		path := filepath.Join(a.dir, name)
+		if now.Sub(file.ModTime()) > FILE_TRANSPORT_RETENTION {
+			os.Remove(path)
+			continue
+		}
+		found[name] = true
+		if modTime, ok := modTimes[name]; ok && modTime.Equal(file.ModTime()) {
+			continue
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		modTimes[name] = file.ModTime()
+		select {
+		case <-done:
+			return false
+		case c <- data:
+		}
+	}
+	for name := range modTimes {
+		if !found[name] {
+			delete(modTimes, name)
+		}
+	}
+	return true
+}
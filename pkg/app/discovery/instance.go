@@ -0,0 +1,254 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+// Kind is the kind of service that is announced
+type Kind uint8
+
+const (
+	// capnp RPC service - see the rpc/capnp package
+	KIND_RPC Kind = iota
+	// net.Server
+	KIND_SERVER
+)
+
+func (a Kind) String() string {
+	switch a {
+	case KIND_RPC:
+		return "rpc"
+	case KIND_SERVER:
+		return "server"
+	default:
+		return fmt.Sprintf("Kind(%d)", a)
+	}
+}
+
+// ServiceInstance is a running instance of a service, i.e., there may be many instances of the same service running
+// in different app processes. The instance is identified by its (ServiceID, InstanceID).
+//
+// Instances are announced with a TTL. The instance is removed from the Registry if it is not announced again before
+// the TTL expires.
+type ServiceInstance struct {
+	DomainID   app.DomainID
+	AppID      app.AppID
+	ServiceID  app.ServiceID
+	InstanceID app.InstanceID
+
+	Kind Kind
+
+	// For unix and pipe networks, the Network.Address is the socket path or the pipe name
+	Network opnet.Network
+	// TCP host
+	Host string
+	// TCP port
+	Port uint16
+
+	TTL     time.Duration
+	Healthy bool
+	// when the instance was announced, according to the announcer's clock - used to order the instance announcements
+	AnnouncedAt time.Time
+	// when the Registry received the announcement, according to the local clock - see Registry.Register().
+	// The instance expires TTL after the announcement was received, i.e., clock skew between the announcer and the
+	// Registry does not affect expiry.
+	ReceivedAt time.Time
+}
+
+// ServerInstance returns the ServiceInstance for the net.Server running in this app instance.
+// If the host is blank, then the os hostname is used.
+func ServerInstance(spec *opnet.ServerServiceSpec, host string) (ServiceInstance, error) {
+	instance := ServiceInstance{
+		DomainID:   spec.DomainID(),
+		AppID:      spec.AppID(),
+		ServiceID:  spec.ServiceID(),
+		InstanceID: app.Instance(),
+		Kind:       KIND_SERVER,
+		Network:    spec.Network(),
+		Host:       host,
+		Port:       uint16(spec.ServerPort()),
+	}
+	return instance, instance.resolveHost()
+}
+
+func (a *ServiceInstance) resolveHost() (err error) {
+	if a.Network.Type == opnet.NETWORK_TCP && a.Host == "" {
+		a.Host, err = os.Hostname()
+	}
+	return
+}
+
+// NewServiceInstance converts the Announcement
+func NewServiceInstance(announcement Announcement) (ServiceInstance, error) {
+	instance := ServiceInstance{
+		DomainID:    app.DomainID(announcement.DomainId()),
+		AppID:       app.AppID(announcement.AppId()),
+		ServiceID:   app.ServiceID(announcement.ServiceId()),
+		InstanceID:  app.InstanceID(announcement.InstanceId()),
+		Port:        announcement.Port(),
+		TTL:         time.Duration(announcement.TtlMsec()) * time.Millisecond,
+		Healthy:     announcement.Healthy(),
+		AnnouncedAt: time.Unix(0, announcement.AnnouncedAt()),
+	}
+	switch announcement.Kind() {
+	case Announcement_Kind_rpc:
+		instance.Kind = KIND_RPC
+	case Announcement_Kind_server:
+		instance.Kind = KIND_SERVER
+	default:
+		return instance, app.IllegalArgumentError(fmt.Sprintf("Unsupported kind : %v", announcement.Kind()))
+	}
+	network, err := announcement.Network()
+	if err != nil {
+		return instance, err
+	}
+	if instance.Network.Type, err = opnet.ParseNetworkType(network); err != nil {
+		return instance, err
+	}
+	address, err := announcement.Address()
+	if err != nil {
+		return instance, err
+	}
+	if instance.Network.Type == opnet.NETWORK_TCP {
+		instance.Host = address
+	} else {
+		instance.Network.Address = address
+	}
+	return instance, instance.Validate()
+}
+
+func (a *ServiceInstance) ToCapnp(s *capnp.Segment) (Announcement, error) {
+	announcement, err := NewRootAnnouncement(s)
+	if err != nil {
+		return announcement, err
+	}
+	announcement.SetDomainId(uint64(a.DomainID))
+	announcement.SetAppId(uint64(a.AppID))
+	announcement.SetServiceId(uint64(a.ServiceID))
+	announcement.SetInstanceId(uint64(a.InstanceID))
+	if a.Kind == KIND_SERVER {
+		announcement.SetKind(Announcement_Kind_server)
+	} else {
+		announcement.SetKind(Announcement_Kind_rpc)
+	}
+	if err := announcement.SetNetwork(a.Network.Type.String()); err != nil {
+		return announcement, err
+	}
+	if err := announcement.SetAddress(a.address()); err != nil {
+		return announcement, err
+	}
+	announcement.SetPort(a.Port)
+	announcement.SetTtlMsec(uint32(a.TTL / time.Millisecond))
+	announcement.SetHealthy(a.Healthy)
+	announcement.SetAnnouncedAt(a.AnnouncedAt.UnixNano())
+	return announcement, nil
+}
+
+// Marshal encodes the instance as a packed capnp Announcement message
+func (a *ServiceInstance) Marshal() ([]byte, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.ToCapnp(seg); err != nil {
+		return nil, err
+	}
+	return msg.MarshalPacked()
+}
+
+// UnmarshalServiceInstance decodes a packed capnp Announcement message
+func UnmarshalServiceInstance(data []byte) (ServiceInstance, error) {
+	msg, err := capnp.UnmarshalPacked(data)
+	if err != nil {
+		return ServiceInstance{}, err
+	}
+	announcement, err := ReadRootAnnouncement(msg)
+	if err != nil {
+		return ServiceInstance{}, err
+	}
+	return NewServiceInstance(announcement)
+}
+
+func (a *ServiceInstance) Validate() error {
+	if a.DomainID == app.DomainID(0) {
+		return app.IllegalArgumentError("DomainID cannot be 0")
+	}
+	if a.AppID == app.AppID(0) {
+		return app.IllegalArgumentError("AppID cannot be 0")
+	}
+	if a.ServiceID == app.ServiceID(0) {
+		return app.IllegalArgumentError("ServiceID cannot be 0")
+	}
+	if a.InstanceID == app.InstanceID(0) {
+		return app.IllegalArgumentError("InstanceID cannot be 0")
+	}
+	if a.Network.Type == opnet.NETWORK_TCP {
+		if a.Host == "" {
+			return app.IllegalArgumentError("Host is required")
+		}
+		if a.Port == 0 {
+			return app.IllegalArgumentError("Port cannot be 0")
+		}
+	}
+	return a.Network.Validate()
+}
+
+// Withdrawn returns true if the instance was announced with a zero TTL, i.e., the instance is shutting down
+func (a *ServiceInstance) Withdrawn() bool {
+	return a.TTL == 0
+}
+
+// Expired returns true if the instance announcement was not received again within the TTL
+func (a *ServiceInstance) Expired(now time.Time) bool {
+	return now.Sub(a.ReceivedAt) > a.TTL
+}
+
+// address returns the TCP host, unix socket path, or pipe name
+func (a *ServiceInstance) address() string {
+	if a.Network.Type == opnet.NETWORK_TCP {
+		return a.Host
+	}
+	return a.Network.Address
+}
+
+// Addr returns the network address, which is used for logging purposes
+func (a *ServiceInstance) Addr() string {
+	if a.Network.Type == opnet.NETWORK_TCP {
+		return fmt.Sprintf("%s://%s:%d", a.Network.Type, a.Host, a.Port)
+	}
+	return fmt.Sprintf("%s://%s", a.Network.Type, a.Network.Address)
+}
+
+// Dial connects to the instance. The TLS config is ignored for unix sockets.
+func (a *ServiceInstance) Dial(tlsConfig *tls.Config) (net.Conn, error) {
+	if !a.Network.TLS() {
+		tlsConfig = nil
+	}
+	return a.Network.Dial(a.Host, a.Port, tlsConfig)
+}
+
+func (a *ServiceInstance) String() string {
+	return fmt.Sprintf("%v(0x%x/0x%x) %s healthy=%v ttl=%v", a.Kind, uint64(a.ServiceID), uint64(a.InstanceID), a.Addr(), a.Healthy, a.TTL)
+}
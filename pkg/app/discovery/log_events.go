@@ -0,0 +1,47 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
+
+const (
+	INSTANCE_REGISTERED  = app.LogEventID(0x9018fddfdd2f760b)
+	INSTANCE_REMOVED     = app.LogEventID(0xea4ac1c27e43375b)
+	ANNOUNCE_FAILED      = app.LogEventID(0xe87d05f6f8723098)
+	INVALID_ANNOUNCEMENT = app.LogEventID(0xadbb75e0dd4cd6c6)
+	WATCH_EVENT_DROPPED  = app.LogEventID(0xaf935f3859af1384)
+)
+
+func init() {
+	serviceField := app.LogEventField{Name: "service", Type: app.LogFieldType_ID, Description: "ServiceID of the announced service"}
+	instanceField := app.LogEventField{Name: "service-instance", Type: app.LogFieldType_ID, Description: "InstanceID of the app instance running the service"}
+	addrField := app.LogEventField{Name: "addr", Type: app.LogFieldType_STRING, Description: "service instance address"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: INSTANCE_REGISTERED, Name: "INSTANCE_REGISTERED", Level: zerolog.InfoLevel, Description: "a service instance was registered",
+			Fields: []app.LogEventField{serviceField, instanceField, addrField, {Name: "healthy", Type: app.LogFieldType_BOOL, Description: "service instance health"}}},
+		app.LogEventSpec{ID: INSTANCE_REMOVED, Name: "INSTANCE_REMOVED", Level: zerolog.InfoLevel, Description: "a service instance was removed",
+			Fields: []app.LogEventField{serviceField, instanceField, addrField, {Name: "reason", Type: app.LogFieldType_STRING, Description: "withdrawn or expired"}}},
+		app.LogEventSpec{ID: ANNOUNCE_FAILED, Name: "ANNOUNCE_FAILED", Level: zerolog.WarnLevel, Description: "the service announcement failed to be published on a transport",
+			Fields: []app.LogEventField{serviceField, addrField, app.ErrLogEventField}},
+		app.LogEventSpec{ID: INVALID_ANNOUNCEMENT, Name: "INVALID_ANNOUNCEMENT", Level: zerolog.WarnLevel, Description: "an invalid service announcement was received",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: WATCH_EVENT_DROPPED, Name: "WATCH_EVENT_DROPPED", Level: zerolog.WarnLevel, Description: "a registry watch event was dropped because the watch channel was full",
+			Fields: []app.LogEventField{serviceField, instanceField, {Name: "watch-event", Type: app.LogFieldType_STRING, Description: "registry event type"}}},
+	)
+}
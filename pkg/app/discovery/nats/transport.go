@@ -0,0 +1,89 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package nats provides the NATS discovery.Transport, which broadcasts the service announcements via a messaging.Conn
+package nats
+
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/discovery"
+	"github.com/oysterpack/oysterpack.go/pkg/messaging"
+)
+
+// DISCOVERY_TOPIC is the default topic that service announcements are published to
+const DISCOVERY_TOPIC = messaging.Topic("oysterpack.discovery")
+
+// Transport publishes announcements to the discovery topic, and subscribes to the discovery topic
+type Transport struct {
+	conn  messaging.Conn
+	topic messaging.Topic
+}
+
+// NewTransport returns a Transport that uses the DISCOVERY_TOPIC
+func NewTransport(conn messaging.Conn) (*Transport, error) {
+	return NewTopicTransport(conn, DISCOVERY_TOPIC)
+}
+
+// NewTopicTransport returns a Transport for the specified topic, e.g., to isolate environments sharing the same cluster
+func NewTopicTransport(conn messaging.Conn, topic messaging.Topic) (*Transport, error) {
+	if conn == nil {
+		return nil, app.IllegalArgumentError("Conn cannot be nil")
+	}
+	topic = topic.TrimSpace()
+	if err := topic.Validate(); err != nil {
+		return nil, err
+	}
+	return &Transport{conn, topic}, nil
+}
+
+func (a *Transport) Topic() messaging.Topic {
+	return a.topic
+}
+
+func (a *Transport) Publish(instance discovery.ServiceInstance) error {
+	data, err := instance.Marshal()
+	if err != nil {
+		return err
+	}
+	return a.conn.Publish(a.topic, data)
+}
+
+// Subscribe subscribes to the topic. The subscription is unsubscribed when done is closed.
+func (a *Transport) Subscribe(done <-chan struct{}) (<-chan []byte, error) {
+	subscription, err := a.conn.Subscribe(a.topic, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := make(chan []byte)
+	go func() {
+		defer close(c)
+		defer subscription.Unsubscribe()
+		for {
+			select {
+			case <-done:
+				return
+			case msg, ok := <-subscription.Channel():
+				if !ok {
+					return
+				}
+				select {
+				case <-done:
+					return
+				case c <- msg.Data:
+				}
+			}
+		}
+	}()
+	return c, nil
+}
@@ -0,0 +1,395 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+import (
+	"crypto/tls"
+	"math/rand"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+const (
+	// how often the Registry checks for expired instances
+	EXPIRY_CHECK_INTERVAL = time.Second
+
+	WATCH_CHANNEL_SIZE = 16
+)
+
+// EventType is the type of Registry change
+type EventType uint8
+
+const (
+	// a new instance was announced
+	EVENT_REGISTERED EventType = iota
+	// the instance health or network address changed
+	EVENT_UPDATED
+	// the instance was withdrawn, or its TTL expired
+	EVENT_REMOVED
+)
+
+func (a EventType) String() string {
+	switch a {
+	case EVENT_REGISTERED:
+		return "registered"
+	case EVENT_UPDATED:
+		return "updated"
+	case EVENT_REMOVED:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// Event is a Registry change notification
+type Event struct {
+	Type EventType
+	ServiceInstance
+}
+
+// Watch delivers Registry change notifications for a service
+type Watch struct {
+	// the channel is closed when the watch is closed, or when the Registry service is killed
+	C <-chan Event
+
+	registry  *Registry
+	serviceID app.ServiceID
+	c         chan Event
+}
+
+// Close stops the notifications and closes the channel
+func (a *Watch) Close() {
+	a.registry.unwatch(a)
+}
+
+// LoadBalancer selects an instance. It is only called with healthy instances, and there will be at least 1 instance.
+// The instances are sorted by InstanceID.
+type LoadBalancer func(serviceID app.ServiceID, instances []ServiceInstance) ServiceInstance
+
+// RoundRobin selects the instances in turn per service
+func RoundRobin() LoadBalancer {
+	var mutex sync.Mutex
+	counters := make(map[app.ServiceID]uint)
+	return func(serviceID app.ServiceID, instances []ServiceInstance) ServiceInstance {
+		mutex.Lock()
+		i := counters[serviceID]
+		counters[serviceID] = i + 1
+		mutex.Unlock()
+		return instances[i%uint(len(instances))]
+	}
+}
+
+// Random selects instances at random
+func Random() LoadBalancer {
+	return func(serviceID app.ServiceID, instances []ServiceInstance) ServiceInstance {
+		return instances[rand.Intn(len(instances))]
+	}
+}
+
+// Registry tracks the service instances that are announced over its transports. Clients use the Registry to resolve
+// services by ServiceID - see Resolve() and Dial().
+//
+// The Registry is bound to the service lifecycle, i.e., when the service is killed, the transport subscriptions are
+// closed, and all watches are closed.
+//
+// Log events:
+//	- INSTANCE_REGISTERED
+//	- INSTANCE_REMOVED
+//	- INVALID_ANNOUNCEMENT
+//	- WATCH_EVENT_DROPPED
+type Registry struct {
+	service      *app.Service
+	loadBalancer LoadBalancer
+
+	mutex     sync.RWMutex
+	instances map[app.ServiceID]map[app.InstanceID]ServiceInstance
+	watches   map[app.ServiceID]map[*Watch]struct{}
+}
+
+// NewRegistry subscribes to the transports. If the load balancer is nil, then RoundRobin is used.
+func NewRegistry(service *app.Service, loadBalancer LoadBalancer, transports ...Transport) (*Registry, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if len(transports) == 0 {
+		return nil, app.IllegalArgumentError("At least 1 transport is required")
+	}
+	if loadBalancer == nil {
+		loadBalancer = RoundRobin()
+	}
+	registry := &Registry{
+		service:      service,
+		loadBalancer: loadBalancer,
+		instances:    make(map[app.ServiceID]map[app.InstanceID]ServiceInstance),
+		watches:      make(map[app.ServiceID]map[*Watch]struct{}),
+	}
+	for _, transport := range transports {
+		announcements, err := transport.Subscribe(service.Dying())
+		if err != nil {
+			return nil, err
+		}
+		service.Go(func() error {
+			registry.subscribe(announcements)
+			return nil
+		})
+	}
+	service.Go(registry.expire)
+	return registry, nil
+}
+
+func (a *Registry) subscribe(announcements <-chan []byte) {
+	for {
+		select {
+		case <-a.service.Dying():
+			return
+		case data, ok := <-announcements:
+			if !ok {
+				return
+			}
+			instance, err := UnmarshalServiceInstance(data)
+			if err != nil {
+				INVALID_ANNOUNCEMENT.Log(a.service.Logger().Warn()).Err(err).Msg("invalid service announcement")
+				continue
+			}
+			a.Register(instance)
+		}
+	}
+}
+
+func (a *Registry) expire() error {
+	ticker := time.NewTicker(EXPIRY_CHECK_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.service.Dying():
+			a.closeWatches()
+			return nil
+		case now := <-ticker.C:
+			a.mutex.Lock()
+			for serviceID, instances := range a.instances {
+				for _, instance := range instances {
+					if instance.Expired(now) {
+						a.remove(instance, "expired")
+					}
+				}
+				if len(instances) == 0 {
+					delete(a.instances, serviceID)
+				}
+			}
+			a.mutex.Unlock()
+		}
+	}
+}
+
+// Register applies the instance announcement. Announcements that are older than the registered instance's announcement
+// are ignored. The instance ReceivedAt is set to the local time, i.e., the instance expires TTL after the announcement
+// was received.
+func (a *Registry) Register(instance ServiceInstance) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	instance.ReceivedAt = time.Now()
+	instances := a.instances[instance.ServiceID]
+	current, registered := instances[instance.InstanceID]
+	if registered && !instance.AnnouncedAt.After(current.AnnouncedAt) {
+		return
+	}
+	if instance.Withdrawn() {
+		if registered {
+			a.remove(instance, "withdrawn")
+		}
+		return
+	}
+	if instances == nil {
+		instances = make(map[app.InstanceID]ServiceInstance)
+		a.instances[instance.ServiceID] = instances
+	}
+	instances[instance.InstanceID] = instance
+	switch {
+	case !registered:
+		INSTANCE_REGISTERED.Log(a.service.Logger().Info()).
+			Uint64("service", uint64(instance.ServiceID)).
+			Uint64("service-instance", uint64(instance.InstanceID)).
+			Str("addr", instance.Addr()).
+			Bool("healthy", instance.Healthy).
+			Msg("service instance registered")
+		a.notify(Event{EVENT_REGISTERED, instance})
+	case current.Healthy != instance.Healthy || current.Addr() != instance.Addr():
+		a.notify(Event{EVENT_UPDATED, instance})
+	}
+}
+
+// remove must be called while holding the lock
+func (a *Registry) remove(instance ServiceInstance, reason string) {
+	delete(a.instances[instance.ServiceID], instance.InstanceID)
+	INSTANCE_REMOVED.Log(a.service.Logger().Info()).
+		Uint64("service", uint64(instance.ServiceID)).
+		Uint64("service-instance", uint64(instance.InstanceID)).
+		Str("addr", instance.Addr()).
+		Str("reason", reason).
+		Msg("service instance removed")
+	a.notify(Event{EVENT_REMOVED, instance})
+}
+
+// notify must be called while holding the lock. Watchers must keep up - if the watch channel is full, then the event is dropped.
+func (a *Registry) notify(event Event) {
+	for watch := range a.watches[event.ServiceID] {
+		select {
+		case watch.c <- event:
+		default:
+			WATCH_EVENT_DROPPED.Log(a.service.Logger().Warn()).
+				Uint64("service", uint64(event.ServiceID)).
+				Uint64("service-instance", uint64(event.InstanceID)).
+				Str("watch-event", event.Type.String()).
+				Msg("watch channel is full")
+		}
+	}
+}
+
+// ServiceIDs returns the services that have live instances
+func (a *Registry) ServiceIDs() []app.ServiceID {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	ids := make([]app.ServiceID, 0, len(a.instances))
+	for id, instances := range a.instances {
+		if len(instances) > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// Instances returns the live instances for the service, healthy or not, sorted by InstanceID
+func (a *Registry) Instances(serviceID app.ServiceID) []ServiceInstance {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	now := time.Now()
+	instances := make([]ServiceInstance, 0, len(a.instances[serviceID]))
+	for _, instance := range a.instances[serviceID] {
+		if !instance.Expired(now) {
+			instances = append(instances, instance)
+		}
+	}
+	sort.Slice(instances, func(i, j int) bool { return instances[i].InstanceID < instances[j].InstanceID })
+	return instances
+}
+
+// HealthyInstances returns the live instances that are healthy, sorted by InstanceID
+func (a *Registry) HealthyInstances(serviceID app.ServiceID) []ServiceInstance {
+	instances := a.Instances(serviceID)
+	healthy := instances[:0]
+	for _, instance := range instances {
+		if instance.Healthy {
+			healthy = append(healthy, instance)
+		}
+	}
+	return healthy
+}
+
+// Resolve selects a healthy instance using the LoadBalancer
+//
+// errors:
+//	- ServiceNotFoundError
+func (a *Registry) Resolve(serviceID app.ServiceID) (ServiceInstance, error) {
+	instances := a.Instances(serviceID)
+	healthy := make([]ServiceInstance, 0, len(instances))
+	for _, instance := range instances {
+		if instance.Healthy {
+			healthy = append(healthy, instance)
+		}
+	}
+	if len(healthy) == 0 {
+		return ServiceInstance{}, ServiceNotFoundError(a.service.ID(), serviceID, len(instances))
+	}
+	return a.loadBalancer(serviceID, healthy), nil
+}
+
+// Dial resolves the service and connects to the selected instance. If the connection fails, then the other healthy
+// instances are tried in turn. The TLS config is ignored for unix sockets.
+//
+// errors:
+//	- ServiceNotFoundError
+//	- the last dial error, if all healthy instances failed to connect
+func (a *Registry) Dial(serviceID app.ServiceID, tlsConfig *tls.Config) (net.Conn, ServiceInstance, error) {
+	selected, err := a.Resolve(serviceID)
+	if err != nil {
+		return nil, selected, err
+	}
+	conn, err := selected.Dial(tlsConfig)
+	if err == nil {
+		return conn, selected, nil
+	}
+	for _, instance := range a.HealthyInstances(serviceID) {
+		if instance.InstanceID == selected.InstanceID {
+			continue
+		}
+		var dialErr error
+		if conn, dialErr = instance.Dial(tlsConfig); dialErr == nil {
+			return conn, instance, nil
+		}
+		err = dialErr
+	}
+	return nil, selected, err
+}
+
+// Watch returns a Watch for the service. The current live instances are delivered first as EVENT_REGISTERED events.
+func (a *Registry) Watch(serviceID app.ServiceID) *Watch {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	now := time.Now()
+	size := WATCH_CHANNEL_SIZE
+	if len(a.instances[serviceID]) > size {
+		size = len(a.instances[serviceID])
+	}
+	c := make(chan Event, size)
+	watch := &Watch{C: c, registry: a, serviceID: serviceID, c: c}
+	for _, instance := range a.instances[serviceID] {
+		if !instance.Expired(now) {
+			c <- Event{EVENT_REGISTERED, instance}
+		}
+	}
+	if a.service.Alive() {
+		if a.watches[serviceID] == nil {
+			a.watches[serviceID] = make(map[*Watch]struct{})
+		}
+		a.watches[serviceID][watch] = struct{}{}
+	} else {
+		close(c)
+	}
+	return watch
+}
+
+func (a *Registry) unwatch(watch *Watch) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if _, ok := a.watches[watch.serviceID][watch]; ok {
+		delete(a.watches[watch.serviceID], watch)
+		close(watch.c)
+	}
+}
+
+func (a *Registry) closeWatches() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for _, watches := range a.watches {
+		for watch := range watches {
+			close(watch.c)
+		}
+	}
+	a.watches = make(map[app.ServiceID]map[*Watch]struct{})
+}
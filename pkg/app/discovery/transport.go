@@ -0,0 +1,28 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package discovery
+
+// Transport broadcasts service announcements. The Announcer publishes to all of its transports, i.e., if one transport
+// is down, e.g., NATS, then the announcements are still delivered over the other transports, e.g., the FileTransport.
+//
+// Announcements may be delivered more than once, and may be delivered over multiple transports. The Registry ignores
+// announcements that are older than what it already has.
+type Transport interface {
+	// Publish broadcasts the instance announcement
+	Publish(instance ServiceInstance) error
+
+	// Subscribe delivers the encoded announcements, until done is closed - see UnmarshalServiceInstance()
+	Subscribe(done <-chan struct{}) (<-chan []byte, error)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventbridge bridges the app event bus to NATS via a messaging.Conn. Each app instance publishes its events to
+// its own topic, which enables a control plane to watch a fleet of app instances using topic wildcards, e.g.,
+// all instances within a domain, or all instances of an app.
+package eventbridge
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/messaging"
+)
+
+// EVENTS_TOPIC_PREFIX is the topic prefix that events are published under.
+// Events are published to : oysterpack.events.{DomainID}.{AppID}.{InstanceID}, where the ids are formatted as hex.
+const EVENTS_TOPIC_PREFIX = "oysterpack.events"
+
+// EVENTS_TOPIC matches events for all app instances
+const EVENTS_TOPIC = messaging.Topic(EVENTS_TOPIC_PREFIX + ".>")
+
+// EVENTS_FLUSH_TIMEOUT is how long the bridge keeps publishing events after its service is killed when the app is shutting
+// down. APP_STOPPED is published after all services have stopped, i.e., the bridge needs to outlive its service.
+const EVENTS_FLUSH_TIMEOUT = 30 * time.Second
+
+// InstanceTopic returns the topic that the app instance publishes its events to
+func InstanceTopic(domainID app.DomainID, appID app.AppID, instanceID app.InstanceID) messaging.Topic {
+	return messaging.Topic(fmt.Sprintf("%s.%x.%x.%x", EVENTS_TOPIC_PREFIX, domainID, appID, instanceID))
+}
+
+// DomainTopic matches events for all app instances within the domain
+func DomainTopic(domainID app.DomainID) messaging.Topic {
+	return messaging.Topic(fmt.Sprintf("%s.%x.>", EVENTS_TOPIC_PREFIX, domainID))
+}
+
+// AppTopic matches events for all instances of the app
+func AppTopic(domainID app.DomainID, appID app.AppID) messaging.Topic {
+	return messaging.Topic(fmt.Sprintf("%s.%x.%x.*", EVENTS_TOPIC_PREFIX, domainID, appID))
+}
+
+// Bridge publishes the events received on an app.EventSubscription to this app instance's topic - see InstanceTopic().
+//
+// The Bridge is bound to the service lifecycle. When the service is killed, the events that are buffered on the subscription
+// are published, and then the subscription is closed. If the app is shutting down, then the bridge keeps publishing until
+// APP_STOPPED is bridged, or EVENTS_FLUSH_TIMEOUT has elapsed.
+//
+// Each published event is traced, i.e., a producer span is started for each event, and the trace context is propagated
+// via the message data - see trace.InjectData(). Watch() extracts the trace context - see InstanceEvent.SpanContext.
+//
+// Log events:
+//	- EVENT_PUBLISH_FAILED
+//	- EVENTS_DROPPED - if the bridge is not keeping up with the app event bus
+type Bridge struct {
+	service      *app.Service
+	conn         messaging.Conn
+	topic        messaging.Topic
+	subscription *app.EventSubscription
+}
+
+// NewBridge starts bridging the subscribed events to NATS. The bridge takes ownership of the subscription.
+func NewBridge(service *app.Service, conn messaging.Conn, subscription *app.EventSubscription) (*Bridge, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if conn == nil {
+		return nil, app.IllegalArgumentError("Conn cannot be nil")
+	}
+	if subscription == nil {
+		return nil, app.IllegalArgumentError("EventSubscription cannot be nil")
+	}
+	bridge := &Bridge{
+		service:      service,
+		conn:         conn,
+		topic:        InstanceTopic(app.Domain(), app.ID(), app.Instance()),
+		subscription: subscription,
+	}
+	service.Go(bridge.run)
+	return bridge, nil
+}
+
+func (a *Bridge) Topic() messaging.Topic {
+	return a.topic
+}
+
+func (a *Bridge) run() error {
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+	var dropped uint64
+	for {
+		select {
+		case <-a.service.Dying():
+			// e.g., APP_STOPPING is published before the services are killed
+			a.drain()
+			select {
+			case <-app.Dying():
+				// APP_STOPPED is published after the bridge service has stopped
+				go a.flush(EVENTS_FLUSH_TIMEOUT)
+			default:
+				a.subscription.Close()
+			}
+			return nil
+		case <-ticker.C:
+			if n := a.subscription.Dropped(); n > dropped {
+				EVENTS_DROPPED.Log(a.service.Logger().Warn()).Uint64("count", n-dropped).Msg("events were dropped")
+				dropped = n
+			}
+		case event, ok := <-a.subscription.Channel():
+			if !ok {
+				return nil
+			}
+			// errors are logged
+			a.publish(event)
+		}
+	}
+}
+
+// drain publishes the events that are buffered on the subscription
+func (a *Bridge) drain() {
+	for {
+		select {
+		case event, ok := <-a.subscription.Channel():
+			if !ok {
+				return
+			}
+			a.publish(event)
+		default:
+			return
+		}
+	}
+}
+
+// flush publishes events until APP_STOPPED is published or the timeout elapses, and then closes the subscription
+func (a *Bridge) flush(timeout time.Duration) {
+	defer a.subscription.Close()
+	expired := time.After(timeout)
+	for {
+		select {
+		case <-expired:
+			return
+		case event, ok := <-a.subscription.Channel():
+			if !ok {
+				return
+			}
+			a.publish(event)
+			if event.EventID() == app.APP_STOPPED {
+				return
+			}
+		}
+	}
+}
+
+func (a *Bridge) publish(event app.Event) error {
+	ctx, span := trace.StartSpan(context.Background(), "eventbridge.publish", trace.SpanKind_PRODUCER)
+	span.SetAttribute("topic", string(a.topic))
+	span.SetAttribute("event_id", fmt.Sprintf("%x", event.EventID()))
+	instanceEvent := NewInstanceEvent(event)
+	data, err := instanceEvent.Marshal()
+	if err == nil {
+		err = a.conn.Publish(a.topic, trace.InjectData(ctx, data))
+	}
+	span.Finish(err)
+	if err != nil {
+		EVENT_PUBLISH_FAILED.Log(a.service.Logger().Warn()).
+			Uint64("event-id", uint64(event.EventID())).
+			Err(err).
+			Msg("failed to publish event")
+	}
+	return err
+}
+
+// Watch subscribes to the topic, e.g., EVENTS_TOPIC, DomainTopic(), AppTopic(), or InstanceTopic(). Events that fail to
+// be decoded are logged and skipped. The publisher's trace context is extracted from the message data - see InstanceEvent.SpanContext.
+// The subscription is unsubscribed when the service is killed.
+//
+// Log events:
+//	- INVALID_EVENT
+func Watch(service *app.Service, conn messaging.Conn, topic messaging.Topic) (<-chan InstanceEvent, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if conn == nil {
+		return nil, app.IllegalArgumentError("Conn cannot be nil")
+	}
+	topic = topic.TrimSpace()
+	if err := topic.Validate(); err != nil {
+		return nil, err
+	}
+	subscription, err := conn.Subscribe(topic, nil)
+	if err != nil {
+		return nil, err
+	}
+	c := make(chan InstanceEvent)
+	service.Go(func() error {
+		defer close(c)
+		defer subscription.Unsubscribe()
+		for {
+			select {
+			case <-service.Dying():
+				return nil
+			case msg, ok := <-subscription.Channel():
+				if !ok {
+					return nil
+				}
+				ctx, data := trace.ExtractData(context.Background(), msg.Data)
+				event, err := UnmarshalInstanceEvent(data)
+				if err != nil {
+					INVALID_EVENT.Log(service.Logger().Warn()).Str("topic", string(msg.Topic)).Err(err).Msg("invalid event")
+					continue
+				}
+				event.SpanContext, _ = trace.FromContext(ctx)
+				select {
+				case <-service.Dying():
+					return nil
+				case c <- event:
+				}
+			}
+		}
+	})
+	return c, nil
+}
@@ -0,0 +1,156 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge
+
+import (
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"zombiezen.com/go/capnproto2"
+)
+
+// InstanceEvent is an app event that was published by an app instance.
+// Only the fields that apply to the event type are set, i.e., ServiceID is set for app.ServiceEvent(s), and the health
+// check fields are set for app.HealthCheckEvent(s).
+type InstanceEvent struct {
+	DomainID   app.DomainID
+	AppID      app.AppID
+	ReleaseID  app.ReleaseID
+	InstanceID app.InstanceID
+
+	EventID app.LogEventID
+	Time    time.Time
+
+	ServiceID app.ServiceID
+	// the error message
+	Err string
+
+	HealthCheckID app.HealthCheckID
+	// how long it took to run the health check
+	Duration time.Duration
+	// how many times the health check has failed consecutively
+	ErrCount uint
+
+	// the publisher's trace context, which is propagated via the message data, i.e., it is not part of the capnp Event
+	// message - see Watch(). The SpanContext is not valid if the event was not traced.
+	SpanContext trace.SpanContext
+}
+
+// NewInstanceEvent returns the InstanceEvent for an event published on this app instance
+func NewInstanceEvent(event app.Event) InstanceEvent {
+	instanceEvent := InstanceEvent{
+		DomainID:   app.Domain(),
+		AppID:      app.ID(),
+		ReleaseID:  app.Release(),
+		InstanceID: app.Instance(),
+		EventID:    event.EventID(),
+		Time:       event.EventTime(),
+	}
+	switch event := event.(type) {
+	case app.ServiceEvent:
+		instanceEvent.ServiceID = event.ServiceID
+		if event.Err != nil {
+			instanceEvent.Err = event.Err.Error()
+		}
+	case app.HealthCheckEvent:
+		instanceEvent.ServiceID = app.HEALTHCHECK_SERVICE_ID
+		instanceEvent.HealthCheckID = event.HealthCheckID
+		instanceEvent.Duration = event.Result.Duration
+		instanceEvent.ErrCount = event.Result.ErrCount
+		if event.Result.Err != nil {
+			instanceEvent.Err = event.Result.Err.Error()
+		}
+	}
+	return instanceEvent
+}
+
+// NewInstanceEventFromCapnp converts the Event
+func NewInstanceEventFromCapnp(event Event) (InstanceEvent, error) {
+	instanceEvent := InstanceEvent{
+		DomainID:      app.DomainID(event.DomainId()),
+		AppID:         app.AppID(event.AppId()),
+		ReleaseID:     app.ReleaseID(event.ReleaseId()),
+		InstanceID:    app.InstanceID(event.InstanceId()),
+		EventID:       app.LogEventID(event.EventId()),
+		Time:          time.Unix(0, event.Time()),
+		ServiceID:     app.ServiceID(event.ServiceId()),
+		HealthCheckID: app.HealthCheckID(event.HealthCheckId()),
+		Duration:      time.Duration(event.Duration()),
+		ErrCount:      uint(event.ErrCount()),
+	}
+	errMsg, err := event.Err()
+	if err != nil {
+		return instanceEvent, err
+	}
+	instanceEvent.Err = errMsg
+	return instanceEvent, instanceEvent.Validate()
+}
+
+func (a *InstanceEvent) ToCapnp(s *capnp.Segment) (Event, error) {
+	event, err := NewRootEvent(s)
+	if err != nil {
+		return event, err
+	}
+	event.SetDomainId(uint64(a.DomainID))
+	event.SetAppId(uint64(a.AppID))
+	event.SetReleaseId(uint64(a.ReleaseID))
+	event.SetInstanceId(uint64(a.InstanceID))
+	event.SetEventId(uint64(a.EventID))
+	event.SetTime(a.Time.UnixNano())
+	event.SetServiceId(uint64(a.ServiceID))
+	if err := event.SetErr(a.Err); err != nil {
+		return event, err
+	}
+	event.SetHealthCheckId(uint64(a.HealthCheckID))
+	event.SetDuration(int64(a.Duration))
+	event.SetErrCount(uint32(a.ErrCount))
+	return event, nil
+}
+
+// Marshal encodes the event as a packed capnp Event message
+func (a *InstanceEvent) Marshal() ([]byte, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := a.ToCapnp(seg); err != nil {
+		return nil, err
+	}
+	return msg.MarshalPacked()
+}
+
+// UnmarshalInstanceEvent decodes a packed capnp Event message
+func UnmarshalInstanceEvent(data []byte) (InstanceEvent, error) {
+	msg, err := capnp.UnmarshalPacked(data)
+	if err != nil {
+		return InstanceEvent{}, err
+	}
+	event, err := ReadRootEvent(msg)
+	if err != nil {
+		return InstanceEvent{}, err
+	}
+	return NewInstanceEventFromCapnp(event)
+}
+
+func (a *InstanceEvent) Validate() error {
+	if a.InstanceID == app.InstanceID(0) {
+		return app.IllegalArgumentError("InstanceID cannot be 0")
+	}
+	if a.EventID == app.LogEventID(0) {
+		return app.IllegalArgumentError("EventID cannot be 0")
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/eventbridge"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/messaging"
+)
+
+func TestTopics(t *testing.T) {
+	if topic := eventbridge.InstanceTopic(app.DomainID(0xa), app.AppID(0xb), app.InstanceID(0xc)); topic != "oysterpack.events.a.b.c" {
+		t.Errorf("InstanceTopic : %v", topic)
+	}
+	if topic := eventbridge.AppTopic(app.DomainID(0xa), app.AppID(0xb)); topic != "oysterpack.events.a.b.*" {
+		t.Errorf("AppTopic : %v", topic)
+	}
+	if topic := eventbridge.DomainTopic(app.DomainID(0xa)); topic != "oysterpack.events.a.>" {
+		t.Errorf("DomainTopic : %v", topic)
+	}
+}
+
+func TestInstanceEvent_Marshal(t *testing.T) {
+	serviceID := app.ServiceID(0xd4a7c0e5b3f2e1a9)
+	event := eventbridge.NewInstanceEvent(app.NewServiceEvent(app.SERVICE_STOPPING, serviceID, errors.New("BOOM")))
+	if event.InstanceID != app.Instance() || event.ServiceID != serviceID || event.Err != "BOOM" {
+		t.Errorf("event was not converted correctly : %v", event)
+	}
+
+	data, err := event.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event2, err := eventbridge.UnmarshalInstanceEvent(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !event2.Time.Equal(event.Time) {
+		t.Errorf("time did not match : %v != %v", event2.Time, event.Time)
+	}
+	event2.Time = event.Time
+	if event2 != event {
+		t.Errorf("unmarshalled event did not match : %v != %v", event2, event)
+	}
+
+	healthCheckEvent := eventbridge.NewInstanceEvent(app.HealthCheckEvent{
+		Time:          time.Now(),
+		HealthCheckID: app.HealthCheckID(0xe5b3f2e1a9d4a7c0),
+		Result: app.HealthCheckResult{
+			Err:      errors.New("DB is down"),
+			Duration: time.Second,
+			ErrCount: 3,
+		},
+	})
+	if healthCheckEvent.EventID != app.HEALTHCHECK_RESULT || healthCheckEvent.ErrCount != 3 || healthCheckEvent.Duration != time.Second {
+		t.Errorf("health check event was not converted correctly : %v", healthCheckEvent)
+	}
+
+	if _, err := eventbridge.UnmarshalInstanceEvent([]byte("invalid")); err == nil {
+		t.Error("unmarshalling invalid data should have failed")
+	}
+}
+
+// APP_STOPPING and APP_STOPPED must be bridged when the app shuts down, even though APP_STOPPED is published after the
+// bridge service has stopped
+func TestBridge_AppShutdown(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	service := app.NewService(app.ServiceID(0xb6e2d94f0a7c3158))
+	app.Services.Register(service)
+	conn := &publishConn{published: make(chan []byte, 10)}
+	if _, err := eventbridge.NewBridge(service, conn, app.Events.Subscribe(app.APP_STOPPING, app.APP_STOPPED)); err != nil {
+		t.Fatal(err)
+	}
+
+	app.Reset()
+	for _, eventID := range []app.LogEventID{app.APP_STOPPING, app.APP_STOPPED} {
+		select {
+		case data := <-conn.published:
+			_, data = trace.ExtractData(context.Background(), data)
+			event, err := eventbridge.UnmarshalInstanceEvent(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if event.EventID != eventID {
+				t.Errorf("event did not match : %x != %x", event.EventID, eventID)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event was not bridged : %x", eventID)
+		}
+	}
+}
+
+// publishConn records the published message data - the rest of the messaging.Conn methods are not implemented
+type publishConn struct {
+	messaging.Conn
+	published chan []byte
+}
+
+func (a *publishConn) Publish(topic messaging.Topic, data []byte) error {
+	a.published <- data
+	return nil
+}
@@ -0,0 +1,37 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge
+
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
+
+const (
+	EVENT_PUBLISH_FAILED = app.LogEventID(0xfc4bbc53dadd6a9f)
+	EVENTS_DROPPED       = app.LogEventID(0xb010b8dac236ec66)
+	INVALID_EVENT        = app.LogEventID(0xd5d182e4d35cab1b)
+)
+
+func init() {
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: EVENT_PUBLISH_FAILED, Name: "EVENT_PUBLISH_FAILED", Level: zerolog.WarnLevel, Description: "an app event failed to be published to NATS",
+			Fields: []app.LogEventField{{Name: "event-id", Type: app.LogFieldType_ID, Description: "LogEventID of the app event"}, app.ErrLogEventField}},
+		app.LogEventSpec{ID: EVENTS_DROPPED, Name: "EVENTS_DROPPED", Level: zerolog.WarnLevel, Description: "app events were dropped because the bridge is not keeping up with the app event bus",
+			Fields: []app.LogEventField{{Name: "count", Type: app.LogFieldType_INT, Description: "number of events dropped since the last report"}}},
+		app.LogEventSpec{ID: INVALID_EVENT, Name: "INVALID_EVENT", Level: zerolog.WarnLevel, Description: "an invalid event was received on the watched topic",
+			Fields: []app.LogEventField{{Name: "topic", Type: app.LogFieldType_STRING, Description: "NATS topic"}, app.ErrLogEventField}},
+	)
+}
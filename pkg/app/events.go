@@ -0,0 +1,215 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DEFAULT_EVENT_SUBSCRIPTION_CHAN_SIZE is the default buffer size for the EventSubscription channel
+const DEFAULT_EVENT_SUBSCRIPTION_CHAN_SIZE = 256
+
+var (
+	eventsMutex        sync.RWMutex
+	eventSubscriptions = make(map[*EventSubscription]struct{})
+)
+
+// Event is an app lifecycle event that is published to the app event bus.
+// Events are identified by the same LogEventID that is used to log the event.
+type Event interface {
+	EventID() LogEventID
+
+	EventTime() time.Time
+}
+
+// AppEvent is an app level lifecycle event, e.g., APP_STARTED, APP_STOPPING
+type AppEvent struct {
+	ID   LogEventID
+	Time time.Time
+}
+
+func (a AppEvent) EventID() LogEventID { return a.ID }
+
+func (a AppEvent) EventTime() time.Time { return a.Time }
+
+// NewAppEvent returns a new AppEvent timestamped with the current time
+func NewAppEvent(id LogEventID) AppEvent {
+	return AppEvent{id, time.Now()}
+}
+
+// ServiceEvent is a service lifecycle event, e.g., SERVICE_REGISTERED, SERVICE_STOPPING.
+// Err is set if the service is stopping because of an error.
+type ServiceEvent struct {
+	ID        LogEventID
+	Time      time.Time
+	ServiceID ServiceID
+	Err       error
+}
+
+func (a ServiceEvent) EventID() LogEventID { return a.ID }
+
+func (a ServiceEvent) EventTime() time.Time { return a.Time }
+
+// NewServiceEvent returns a new ServiceEvent timestamped with the current time
+func NewServiceEvent(id LogEventID, serviceID ServiceID, err error) ServiceEvent {
+	return ServiceEvent{id, time.Now(), serviceID, err}
+}
+
+// HealthCheckEvent is published each time a health check is run - see HEALTHCHECK_RESULT
+type HealthCheckEvent struct {
+	Time          time.Time
+	HealthCheckID HealthCheckID
+	Result        HealthCheckResult
+}
+
+func (a HealthCheckEvent) EventID() LogEventID { return HEALTHCHECK_RESULT }
+
+func (a HealthCheckEvent) EventTime() time.Time { return a.Time }
+
+// EventFilter returns true if the event should be delivered to the subscriber
+type EventFilter func(event Event) bool
+
+// ServiceEventFilter matches ServiceEvent(s) for the specified services
+func ServiceEventFilter(ids ...ServiceID) EventFilter {
+	return func(event Event) bool {
+		if event, ok := event.(ServiceEvent); ok {
+			for _, id := range ids {
+				if event.ServiceID == id {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// ErrEventFilter matches ServiceEvent(s) that carry an error and failed health check results
+func ErrEventFilter(event Event) bool {
+	switch event := event.(type) {
+	case ServiceEvent:
+		return event.Err != nil
+	case HealthCheckEvent:
+		return event.Result.Err != nil
+	default:
+		return false
+	}
+}
+
+// AppEvents is the app event bus. The app publishes its lifecycle events to the bus, i.e., the same events that are logged.
+// Services may publish their own events.
+//
+// Events are delivered asynchronously to subscribers. Publishing never blocks. If a subscriber's channel is full, then the
+// event is dropped for that subscriber and counted - see EventSubscription.Dropped()
+type AppEvents struct{}
+
+// Publish publishes the event to all matching subscribers
+func (a AppEvents) Publish(event Event) {
+	if event == nil {
+		return
+	}
+	eventsMutex.RLock()
+	defer eventsMutex.RUnlock()
+	for subscription := range eventSubscriptions {
+		if !subscription.matches(event) {
+			continue
+		}
+		select {
+		case subscription.c <- event:
+		default:
+			atomic.AddUint64(&subscription.dropped, 1)
+		}
+	}
+}
+
+// Subscribe subscribes to the specified events. If no LogEventID(s) are specified, then all events are subscribed to.
+func (a AppEvents) Subscribe(ids ...LogEventID) *EventSubscription {
+	return a.SubscribeWithFilter(nil, ids...)
+}
+
+// SubscribeWithFilter subscribes to the specified events, which must also match the filter. If no LogEventID(s) are
+// specified, then all events are subscribed to. The filter is optional.
+func (a AppEvents) SubscribeWithFilter(filter EventFilter, ids ...LogEventID) *EventSubscription {
+	subscription := &EventSubscription{
+		filter: filter,
+		c:      make(chan Event, DEFAULT_EVENT_SUBSCRIPTION_CHAN_SIZE),
+	}
+	if len(ids) > 0 {
+		subscription.ids = make(map[LogEventID]struct{}, len(ids))
+		for _, id := range ids {
+			subscription.ids[id] = struct{}{}
+		}
+	}
+
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+	eventSubscriptions[subscription] = struct{}{}
+	return subscription
+}
+
+// SubscriptionCount returns the number of active subscriptions
+func (a AppEvents) SubscriptionCount() int {
+	eventsMutex.RLock()
+	defer eventsMutex.RUnlock()
+	return len(eventSubscriptions)
+}
+
+// EventSubscription is used to receive events from the app event bus. When the subscription is no longer needed, it
+// must be closed.
+type EventSubscription struct {
+	ids    map[LogEventID]struct{}
+	filter EventFilter
+
+	c       chan Event
+	dropped uint64
+}
+
+func (a *EventSubscription) matches(event Event) bool {
+	if a.ids != nil {
+		if _, ok := a.ids[event.EventID()]; !ok {
+			return false
+		}
+	}
+	return a.filter == nil || a.filter(event)
+}
+
+// Channel returns the channel that events are delivered on. The channel is closed when the subscription is closed.
+func (a *EventSubscription) Channel() <-chan Event {
+	return a.c
+}
+
+// Dropped returns the number of events that were dropped because the subscription channel was full
+func (a *EventSubscription) Dropped() uint64 {
+	return atomic.LoadUint64(&a.dropped)
+}
+
+// Close unsubscribes from the app event bus and closes the subscription channel. Close is idempotent.
+func (a *EventSubscription) Close() {
+	eventsMutex.Lock()
+	defer eventsMutex.Unlock()
+	if _, ok := eventSubscriptions[a]; ok {
+		delete(eventSubscriptions, a)
+		close(a.c)
+	}
+}
+
+func publishAppEvent(id LogEventID) {
+	Events.Publish(NewAppEvent(id))
+}
+
+func publishServiceEvent(id LogEventID, service *Service, err error) {
+	Events.Publish(NewServiceEvent(id, service.ID(), err))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+)
+
+func nextEvent(t *testing.T, subscription *app.EventSubscription) app.Event {
+	t.Helper()
+	select {
+	case event := <-subscription.Channel():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+		return nil
+	}
+}
+
+func TestEvents_ServiceLifecycle(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	serviceID := app.ServiceID(0xd4a7c0e5b3f2e1a9)
+	subscription := app.Events.SubscribeWithFilter(app.ServiceEventFilter(serviceID), app.SERVICE_REGISTERED, app.SERVICE_STOPPING, app.SERVICE_STOPPED)
+	defer subscription.Close()
+
+	service := app.NewService(serviceID)
+	app.Services.Register(service)
+	event := nextEvent(t, subscription)
+	if event.EventID() != app.SERVICE_REGISTERED {
+		t.Errorf("expected SERVICE_REGISTERED : %x", event.EventID())
+	}
+	if event.(app.ServiceEvent).ServiceID != serviceID {
+		t.Errorf("wrong service id : %v", event)
+	}
+
+	err := errors.New("BOOM")
+	service.Kill(err)
+	event = nextEvent(t, subscription)
+	if event.EventID() != app.SERVICE_STOPPING {
+		t.Errorf("expected SERVICE_STOPPING : %x", event.EventID())
+	}
+	if event.(app.ServiceEvent).Err != err {
+		t.Errorf("the service error should have been set : %v", event)
+	}
+	event = nextEvent(t, subscription)
+	if event.EventID() != app.SERVICE_STOPPED {
+		t.Errorf("expected SERVICE_STOPPED : %x", event.EventID())
+	}
+	if !app.ErrEventFilter(event) {
+		t.Errorf("SERVICE_STOPPED should have been matched by the ErrEventFilter : %v", event)
+	}
+}
+
+func TestEvents_Subscription(t *testing.T) {
+	count := app.Events.SubscriptionCount()
+	subscription := app.Events.Subscribe(app.APP_RESET)
+	if app.Events.SubscriptionCount() != count+1 {
+		t.Errorf("subscription count should have been incremented : %d", app.Events.SubscriptionCount())
+	}
+
+	// events that are not subscribed to are not delivered
+	app.Events.Publish(app.NewAppEvent(app.APP_STARTED))
+	app.Events.Publish(app.NewAppEvent(app.APP_RESET))
+	if event := nextEvent(t, subscription); event.EventID() != app.APP_RESET {
+		t.Errorf("expected APP_RESET : %x", event.EventID())
+	}
+
+	// publishing never blocks - events are dropped when the subscriber is not keeping up
+	for i := 0; i < app.DEFAULT_EVENT_SUBSCRIPTION_CHAN_SIZE+10; i++ {
+		app.Events.Publish(app.NewAppEvent(app.APP_RESET))
+	}
+	if subscription.Dropped() != 10 {
+		t.Errorf("expected 10 events to be dropped : %d", subscription.Dropped())
+	}
+
+	subscription.Close()
+	subscription.Close()
+	if app.Events.SubscriptionCount() != count {
+		t.Errorf("subscription count should have been decremented : %d", app.Events.SubscriptionCount())
+	}
+	n := 0
+	for range subscription.Channel() {
+		n++
+	}
+	if n != app.DEFAULT_EVENT_SUBSCRIPTION_CHAN_SIZE {
+		t.Errorf("the buffered events should still be readable after the subscription is closed : %d", n)
+	}
+}
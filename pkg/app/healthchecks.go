@@ -146,6 +146,7 @@ func (a *registeredHealthCheck) logHealthCheckResult() {
 			Dur("duration", a.Duration).
 			Msg("")
 	}
+	Events.Publish(HealthCheckEvent{time.Now(), a.HealthCheckID, a.HealthCheckResult})
 }
 
 // HealthCheck is used to run the health check.
@@ -0,0 +1,305 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rs/zerolog"
+)
+
+// LOG_EVENT_INDEX_PREFIX is the Elasticsearch index prefix for log events.
+// Each LogEvent is indexed into its own indices, i.e., LOG_EVENT_INDEX_PREFIX + {LogEventID hex} + "-*"
+const LOG_EVENT_INDEX_PREFIX = "oysterpack-logevent-"
+
+var (
+	logEventsMutex sync.RWMutex
+	logEventSpecs  = make(map[LogEventID]*LogEventSpec)
+)
+
+// LogFieldType is the type of a log event field, which determines how the field is mapped in Elasticsearch and in
+// the JSON schema
+type LogFieldType uint8
+
+// LogFieldType enum values
+const (
+	// indexed as is, e.g., names, addresses
+	LogFieldType_STRING LogFieldType = iota
+	// full text, e.g., error messages
+	LogFieldType_TEXT
+	// uint64 id, e.g., ServiceID - ids are indexed as keywords because they overflow the Elasticsearch long type
+	LogFieldType_ID
+	LogFieldType_INT
+	LogFieldType_FLOAT
+	LogFieldType_BOOL
+	// RFC3339 with nanosecond precision
+	LogFieldType_TIME
+	// time.Duration logged in milliseconds - see zerolog.DurationFieldUnit
+	LogFieldType_DURATION
+	LogFieldType_OBJECT
+)
+
+func (a LogFieldType) String() string {
+	switch a {
+	case LogFieldType_STRING:
+		return "string"
+	case LogFieldType_TEXT:
+		return "text"
+	case LogFieldType_ID:
+		return "id"
+	case LogFieldType_INT:
+		return "int"
+	case LogFieldType_FLOAT:
+		return "float"
+	case LogFieldType_BOOL:
+		return "bool"
+	case LogFieldType_TIME:
+		return "time"
+	case LogFieldType_DURATION:
+		return "duration"
+	case LogFieldType_OBJECT:
+		return "object"
+	default:
+		return fmt.Sprintf("LogFieldType(%d)", a)
+	}
+}
+
+func (a LogFieldType) elasticsearchMapping() map[string]interface{} {
+	switch a {
+	case LogFieldType_TEXT:
+		return map[string]interface{}{"type": "text"}
+	case LogFieldType_INT:
+		return map[string]interface{}{"type": "long"}
+	case LogFieldType_FLOAT, LogFieldType_DURATION:
+		return map[string]interface{}{"type": "double"}
+	case LogFieldType_BOOL:
+		return map[string]interface{}{"type": "boolean"}
+	case LogFieldType_TIME:
+		return map[string]interface{}{"type": "date"}
+	case LogFieldType_OBJECT:
+		return map[string]interface{}{"type": "object"}
+	default:
+		return map[string]interface{}{"type": "keyword"}
+	}
+}
+
+func (a LogFieldType) jsonSchema() map[string]interface{} {
+	switch a {
+	case LogFieldType_ID, LogFieldType_INT:
+		return map[string]interface{}{"type": "integer"}
+	case LogFieldType_FLOAT, LogFieldType_DURATION:
+		return map[string]interface{}{"type": "number"}
+	case LogFieldType_BOOL:
+		return map[string]interface{}{"type": "boolean"}
+	case LogFieldType_TIME:
+		return map[string]interface{}{"type": "string", "format": "date-time"}
+	case LogFieldType_OBJECT:
+		return map[string]interface{}{"type": "object"}
+	default:
+		return map[string]interface{}{"type": "string"}
+	}
+}
+
+// LogEventField describes a field that the log event carries
+type LogEventField struct {
+	Name        string
+	Type        LogFieldType
+	Description string
+}
+
+// ErrLogEventField is the field that is logged via zerolog.Event.Err()
+var ErrLogEventField = LogEventField{Name: zerolog.ErrorFieldName, Type: LogFieldType_TEXT, Description: "error message"}
+
+// the fields that are common to all log events, i.e., they are added by the app and service loggers
+var logEventBaseFields = []LogEventField{
+	{Name: zerolog.TimestampFieldName, Type: LogFieldType_TIME, Description: "when the event was logged"},
+	{Name: zerolog.LevelFieldName, Type: LogFieldType_STRING, Description: "log level"},
+	{Name: zerolog.MessageFieldName, Type: LogFieldType_TEXT, Description: "log message"},
+	{Name: "event", Type: LogFieldType_ID, Description: "LogEventID"},
+	{Name: "domain", Type: LogFieldType_ID, Description: "DomainID"},
+	{Name: "app", Type: LogFieldType_ID, Description: "AppID"},
+	{Name: "release", Type: LogFieldType_ID, Description: "ReleaseID"},
+	{Name: "instance", Type: LogFieldType_ID, Description: "InstanceID"},
+	{Name: "svc", Type: LogFieldType_ID, Description: "ServiceID - set when the event is logged via a service logger"},
+}
+
+// the base fields that are present on every log event
+var logEventRequiredFields = []string{zerolog.TimestampFieldName, zerolog.LevelFieldName, "event", "domain", "app", "release", "instance"}
+
+// LogEventBaseFields returns the fields that are common to all log events, i.e., they are added by the app and service loggers
+func LogEventBaseFields() []LogEventField {
+	fields := make([]LogEventField, len(logEventBaseFields))
+	copy(fields, logEventBaseFields)
+	return fields
+}
+
+// LogEventSpec declares a log event.
+//
+// The Level is the level that the event is normally logged at. It must be one of [DEBUG,INFO,WARN,ERROR]. Events that
+// are logged at panic or fatal levels are declared at the ERROR level.
+// Fields are the event specific fields, i.e., the LogEventBaseFields() are not declared.
+type LogEventSpec struct {
+	ID          LogEventID
+	Name        string
+	Level       zerolog.Level
+	Description string
+	Fields      []LogEventField
+}
+
+// Validate checks that the spec is complete and that the field names are unique
+func (a *LogEventSpec) Validate() error {
+	if a.ID == LogEventID(0) {
+		return IllegalArgumentError("LogEventID cannot be 0")
+	}
+	if strings.TrimSpace(a.Name) == "" {
+		return IllegalArgumentError(fmt.Sprintf("LogEventID(0x%x) : Name cannot be blank", a.ID))
+	}
+	switch a.Level {
+	case zerolog.DebugLevel, zerolog.InfoLevel, zerolog.WarnLevel, zerolog.ErrorLevel:
+	default:
+		return IllegalArgumentError(fmt.Sprintf("%s : Level must be one of [DEBUG,INFO,WARN,ERROR] : %v", a.Name, a.Level))
+	}
+	names := make(map[string]bool, len(logEventBaseFields)+len(a.Fields))
+	for _, field := range logEventBaseFields {
+		names[field.Name] = true
+	}
+	for _, field := range a.Fields {
+		if strings.TrimSpace(field.Name) == "" {
+			return IllegalArgumentError(fmt.Sprintf("%s : field name cannot be blank", a.Name))
+		}
+		if names[field.Name] {
+			return IllegalArgumentError(fmt.Sprintf("%s : duplicate field : %s", a.Name, field.Name))
+		}
+		if field.Type > LogFieldType_OBJECT {
+			return IllegalArgumentError(fmt.Sprintf("%s : invalid field type : %s : %v", a.Name, field.Name, field.Type))
+		}
+		names[field.Name] = true
+	}
+	return nil
+}
+
+// IndexPattern returns the Elasticsearch index pattern for the log event
+func (a *LogEventSpec) IndexPattern() string {
+	return LOG_EVENT_INDEX_PREFIX + a.ID.Hex() + "-*"
+}
+
+// ElasticsearchIndexTemplate returns the Elasticsearch (6.x) index template for the log event, which maps the log event
+// fields into their own indices - see IndexPattern(). The template should be registered under the name
+// LOG_EVENT_INDEX_PREFIX + {LogEventID hex}
+func (a *LogEventSpec) ElasticsearchIndexTemplate() ([]byte, error) {
+	properties := make(map[string]interface{}, len(logEventBaseFields)+len(a.Fields))
+	for _, field := range logEventBaseFields {
+		properties[field.Name] = field.Type.elasticsearchMapping()
+	}
+	for _, field := range a.Fields {
+		properties[field.Name] = field.Type.elasticsearchMapping()
+	}
+	template := map[string]interface{}{
+		"index_patterns": []string{a.IndexPattern()},
+		"mappings": map[string]interface{}{
+			"doc": map[string]interface{}{
+				"_meta": map[string]interface{}{
+					"event":       a.ID.Hex(),
+					"name":        a.Name,
+					"level":       a.Level.String(),
+					"description": a.Description,
+				},
+				"properties": properties,
+			},
+		},
+	}
+	return json.MarshalIndent(template, "", "  ")
+}
+
+// JSONSchema returns the JSON schema (draft 6) for the log event
+func (a *LogEventSpec) JSONSchema() ([]byte, error) {
+	properties := make(map[string]interface{}, len(logEventBaseFields)+len(a.Fields))
+	for _, fields := range [][]LogEventField{logEventBaseFields, a.Fields} {
+		for _, field := range fields {
+			property := field.Type.jsonSchema()
+			property["description"] = field.Description
+			properties[field.Name] = property
+		}
+	}
+	properties["event"] = map[string]interface{}{"type": "integer", "const": uint64(a.ID), "description": "LogEventID"}
+	schema := map[string]interface{}{
+		"$schema":     "http://json-schema.org/draft-06/schema#",
+		"title":       a.Name,
+		"description": a.Description,
+		"type":        "object",
+		"properties":  properties,
+		"required":    logEventRequiredFields,
+	}
+	return json.MarshalIndent(schema, "", "  ")
+}
+
+// AppLogEvents is the log event catalogue. Each package registers its LogEventSpec(s) when the package is initialized.
+type AppLogEvents struct{}
+
+// Register registers the log events. The same spec may be registered more than once.
+//
+// Panics if the spec is invalid, or if a different spec is already registered for the LogEventID, i.e., LogEventID
+// collisions are detected when the app starts.
+func (a AppLogEvents) Register(specs ...LogEventSpec) {
+	logEventsMutex.Lock()
+	defer logEventsMutex.Unlock()
+	for i := range specs {
+		spec := specs[i]
+		if err := spec.Validate(); err != nil {
+			panic(err)
+		}
+		if registered, ok := logEventSpecs[spec.ID]; ok && registered.Name != spec.Name {
+			panic(IllegalArgumentError(fmt.Sprintf("LogEventID(0x%x) is already registered for %s : %s", spec.ID, registered.Name, spec.Name)))
+		}
+		logEventSpecs[spec.ID] = &spec
+	}
+}
+
+// LogEventIDs returns the registered LogEventID(s) in sorted order
+func (a AppLogEvents) LogEventIDs() []LogEventID {
+	logEventsMutex.RLock()
+	defer logEventsMutex.RUnlock()
+	ids := make([]LogEventID, 0, len(logEventSpecs))
+	for id := range logEventSpecs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// LogEvent returns the LogEventSpec for the LogEventID, or nil if it is not registered
+func (a AppLogEvents) LogEvent(id LogEventID) *LogEventSpec {
+	logEventsMutex.RLock()
+	defer logEventsMutex.RUnlock()
+	return logEventSpecs[id]
+}
+
+// LogEventSpecs returns all registered LogEventSpec(s) sorted by LogEventID
+func (a AppLogEvents) LogEventSpecs() []*LogEventSpec {
+	ids := a.LogEventIDs()
+	logEventsMutex.RLock()
+	defer logEventsMutex.RUnlock()
+	specs := make([]*LogEventSpec, 0, len(ids))
+	for _, id := range ids {
+		if spec, ok := logEventSpecs[id]; ok {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
+
+func TestLogEvents_Registered(t *testing.T) {
+	for _, id := range []app.LogEventID{app.APP_STARTED, app.SERVICE_STARTED, app.CONFIG_LOADING_ERR} {
+		if app.LogEvents.LogEvent(id) == nil {
+			t.Errorf("LogEventID(0x%x) is not registered", id)
+		}
+	}
+	ids := app.LogEvents.LogEventIDs()
+	specs := app.LogEvents.LogEventSpecs()
+	if len(ids) != len(specs) {
+		t.Fatalf("ids and specs do not match : %d != %d", len(ids), len(specs))
+	}
+	for i, spec := range specs {
+		if spec.ID != ids[i] {
+			t.Errorf("specs are not sorted by id : %v", spec)
+		}
+		if err := spec.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+	if app.LogEvents.LogEvent(app.LogEventID(1)) != nil {
+		t.Error("LogEventID(1) should not be registered")
+	}
+}
+
+func TestLogEvents_Register(t *testing.T) {
+	mustPanic := func(name string, spec app.LogEventSpec) {
+		t.Helper()
+		defer func() {
+			if p := recover(); p == nil {
+				t.Errorf("%s : Register should have panicked", name)
+			} else {
+				t.Logf("%s : %v", name, p)
+			}
+		}()
+		app.LogEvents.Register(spec)
+	}
+
+	spec := app.LogEventSpec{
+		ID:          app.LogEventID(0xe5a6c2b2fd1b4d9a),
+		Name:        "TEST_EVENT",
+		Level:       zerolog.InfoLevel,
+		Description: "test event",
+		Fields:      []app.LogEventField{{Name: "count", Type: app.LogFieldType_INT}, app.ErrLogEventField},
+	}
+	app.LogEvents.Register(spec)
+	// registering the same spec again is ok
+	app.LogEvents.Register(spec)
+	if registered := app.LogEvents.LogEvent(spec.ID); registered == nil || registered.Name != spec.Name {
+		t.Fatalf("spec was not registered : %v", registered)
+	}
+
+	duplicateID := spec
+	duplicateID.Name = "TEST_EVENT_2"
+	mustPanic("duplicate id", duplicateID)
+
+	noID := spec
+	noID.ID = 0
+	mustPanic("no id", noID)
+
+	blankName := spec
+	blankName.ID, blankName.Name = app.LogEventID(0xb2d6fbcc52f1c1a3), "  "
+	mustPanic("blank name", blankName)
+
+	panicLevel := spec
+	panicLevel.ID, panicLevel.Level = app.LogEventID(0xb2d6fbcc52f1c1a3), zerolog.PanicLevel
+	mustPanic("panic level", panicLevel)
+
+	duplicateField := spec
+	duplicateField.ID = app.LogEventID(0xb2d6fbcc52f1c1a3)
+	duplicateField.Fields = []app.LogEventField{{Name: "count", Type: app.LogFieldType_INT}, {Name: "count", Type: app.LogFieldType_STRING}}
+	mustPanic("duplicate field", duplicateField)
+
+	baseField := spec
+	baseField.ID = app.LogEventID(0xb2d6fbcc52f1c1a3)
+	baseField.Fields = []app.LogEventField{{Name: "instance", Type: app.LogFieldType_STRING}}
+	mustPanic("base field", baseField)
+
+	if app.LogEvents.LogEvent(app.LogEventID(0xb2d6fbcc52f1c1a3)) != nil {
+		t.Error("invalid spec should not have been registered")
+	}
+}
+
+func TestLogEventSpec_ElasticsearchIndexTemplate(t *testing.T) {
+	spec := app.LogEvents.LogEvent(app.SERVICE_STOPPED)
+	data, err := spec.ElasticsearchIndexTemplate()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%s", data)
+	template := struct {
+		IndexPatterns []string `json:"index_patterns"`
+		Mappings      struct {
+			Doc struct {
+				Properties map[string]map[string]interface{} `json:"properties"`
+			} `json:"doc"`
+		} `json:"mappings"`
+	}{}
+	if err := json.Unmarshal(data, &template); err != nil {
+		t.Fatal(err)
+	}
+	if len(template.IndexPatterns) != 1 || template.IndexPatterns[0] != spec.IndexPattern() {
+		t.Errorf("index patterns did not match : %v", template.IndexPatterns)
+	}
+	properties := template.Mappings.Doc.Properties
+	for _, field := range append(app.LogEventBaseFields(), spec.Fields...) {
+		if _, ok := properties[field.Name]; !ok {
+			t.Errorf("field is not mapped : %s", field.Name)
+		}
+	}
+	if properties["time"]["type"] != "date" || properties["event"]["type"] != "keyword" {
+		t.Errorf("base fields are not mapped correctly : %v", properties)
+	}
+}
+
+func TestLogEventSpec_JSONSchema(t *testing.T) {
+	spec := app.LogEvents.LogEvent(app.SERVICE_STOPPED)
+	data, err := spec.JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Logf("%s", data)
+	schema := struct {
+		Title      string                            `json:"title"`
+		Properties map[string]map[string]interface{} `json:"properties"`
+		Required   []string                          `json:"required"`
+	}{}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatal(err)
+	}
+	if schema.Title != spec.Name {
+		t.Errorf("title did not match : %s", schema.Title)
+	}
+	if schema.Properties["event"]["const"] != float64(uint64(spec.ID)) {
+		t.Errorf("event const did not match : %v", schema.Properties["event"])
+	}
+	for _, field := range spec.Fields {
+		if _, ok := schema.Properties[field.Name]; !ok {
+			t.Errorf("field is missing : %s", field.Name)
+		}
+	}
+	if len(schema.Required) == 0 {
+		t.Error("required fields are missing")
+	}
+}
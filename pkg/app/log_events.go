@@ -14,6 +14,8 @@
 
 package app
 
+import "github.com/rs/zerolog"
+
 // log events
 const (
 	APP_STARTED          = LogEventID(0xa482715a50d67a5f)
@@ -54,3 +56,64 @@ const (
 	HEALTHCHECK_RESUMED    = LogEventID(0xc4dc7b2938caf3a9)
 	HEALTHCHECK_RESULT     = LogEventID(0xa68e0475cc1839be)
 )
+
+func init() {
+	addrField := LogEventField{Name: "addr", Type: LogFieldType_STRING, Description: "HTTP server address"}
+	healthCheckIDField := LogEventField{Name: HEALTHCHECK_ID_LOG_FIELD, Type: LogFieldType_ID, Description: "HealthCheckID"}
+
+	LogEvents.Register(
+		LogEventSpec{ID: APP_STARTED, Name: "APP_STARTED", Level: zerolog.InfoLevel, Description: "the app server has started"},
+		LogEventSpec{ID: APP_STOPPING, Name: "APP_STOPPING", Level: zerolog.InfoLevel, Description: "the app has been killed and is stopping all registered services"},
+		LogEventSpec{ID: APP_STOPPING_TIMEOUT, Name: "APP_STOPPING_TIMEOUT", Level: zerolog.WarnLevel, Description: "the app timed out waiting for the registered services to stop"},
+		LogEventSpec{ID: APP_STOPPED, Name: "APP_STOPPED", Level: zerolog.InfoLevel, Description: "the app has stopped"},
+		LogEventSpec{ID: APP_RESET, Name: "APP_RESET", Level: zerolog.InfoLevel, Description: "the app was reset - only applies to tests"},
+
+		LogEventSpec{ID: SERVICE_STARTING, Name: "SERVICE_STARTING", Level: zerolog.InfoLevel, Description: "the service is starting",
+			Fields: []LogEventField{{Name: "cmdsvr", Type: LogFieldType_STRING, Description: "CommandServer name"}}},
+		LogEventSpec{ID: SERVICE_STARTED, Name: "SERVICE_STARTED", Level: zerolog.InfoLevel, Description: "the service has started",
+			Fields: []LogEventField{{Name: "cmdsvr", Type: LogFieldType_STRING, Description: "CommandServer name"}}},
+		LogEventSpec{ID: SERVICE_KILLED, Name: "SERVICE_KILLED", Level: zerolog.InfoLevel, Description: "the service was killed because the app is stopping"},
+		LogEventSpec{ID: SERVICE_STOPPING, Name: "SERVICE_STOPPING", Level: zerolog.InfoLevel, Description: "the service is stopping - logged at ERROR level if the service is stopping because of an error",
+			Fields: []LogEventField{ErrLogEventField}},
+		LogEventSpec{ID: SERVICE_STOPPING_TIMEOUT, Name: "SERVICE_STOPPING_TIMEOUT", Level: zerolog.WarnLevel, Description: "the service is taking too long to stop"},
+		LogEventSpec{ID: SERVICE_STOPPED, Name: "SERVICE_STOPPED", Level: zerolog.InfoLevel, Description: "the service has stopped",
+			Fields: []LogEventField{ErrLogEventField, {Name: "err-type", Type: LogFieldType_STRING, Description: "the error's Go type"}}},
+		LogEventSpec{ID: SERVICE_REGISTERED, Name: "SERVICE_REGISTERED", Level: zerolog.InfoLevel, Description: "the service was registered with the app"},
+		LogEventSpec{ID: SERVICE_UNREGISTERED, Name: "SERVICE_UNREGISTERED", Level: zerolog.InfoLevel, Description: "the service was unregistered from the app"},
+
+		LogEventSpec{ID: METRICS_SERVICE_CONFIG_ERROR, Name: "METRICS_SERVICE_CONFIG_ERROR", Level: zerolog.ErrorLevel, Description: "the metrics service config is invalid - the app panics",
+			Fields: []LogEventField{ErrLogEventField, {Name: "metric", Type: LogFieldType_ID, Description: "MetricID"}}},
+		LogEventSpec{ID: METRICS_HTTP_REPORTER_SHUTDOWN_ERROR, Name: "METRICS_HTTP_REPORTER_SHUTDOWN_ERROR", Level: zerolog.WarnLevel, Description: "the metrics HTTP server failed to shutdown cleanly",
+			Fields: []LogEventField{ErrLogEventField}},
+		LogEventSpec{ID: METRICS_HTTP_REPORTER_START_ERROR, Name: "METRICS_HTTP_REPORTER_START_ERROR", Level: zerolog.ErrorLevel, Description: "the metrics HTTP reporter failed to start - the app panics",
+			Fields: []LogEventField{ErrLogEventField}},
+		LogEventSpec{ID: METRICS_HTTP_REPORTER_SHUTDOWN_WHILE_SERVICE_RUNNING, Name: "METRICS_HTTP_REPORTER_SHUTDOWN_WHILE_SERVICE_RUNNING", Level: zerolog.ErrorLevel, Description: "the metrics HTTP server stopped while the metrics service is still running"},
+		LogEventSpec{ID: METRICS_HTTP_SERVER_STARTING, Name: "METRICS_HTTP_SERVER_STARTING", Level: zerolog.InfoLevel, Description: "the metrics HTTP server is starting",
+			Fields: []LogEventField{addrField}},
+		LogEventSpec{ID: METRICS_HTTP_SERVER_STARTED, Name: "METRICS_HTTP_SERVER_STARTED", Level: zerolog.InfoLevel, Description: "the metrics HTTP server has started",
+			Fields: []LogEventField{addrField}},
+		LogEventSpec{ID: METRICS_HTTP_SERVER_STOPPED, Name: "METRICS_HTTP_SERVER_STOPPED", Level: zerolog.InfoLevel, Description: "the metrics HTTP server has stopped",
+			Fields: []LogEventField{{Name: "reason", Type: LogFieldType_TEXT, Description: "why the server stopped"}}},
+
+		LogEventSpec{ID: CONFIG_LOADING_ERR, Name: "CONFIG_LOADING_ERR", Level: zerolog.ErrorLevel, Description: "a service config failed to load - the app panics",
+			Fields: []LogEventField{ErrLogEventField}},
+		LogEventSpec{ID: CAPNP_ERR, Name: "CAPNP_ERR", Level: zerolog.ErrorLevel, Description: "a capnp message failed to be created - the app panics",
+			Fields: []LogEventField{ErrLogEventField}},
+
+		LogEventSpec{ID: ZERO_HEALTHCHECKS, Name: "ZERO_HEALTHCHECKS", Level: zerolog.WarnLevel, Description: "no health checks are configured"},
+		LogEventSpec{ID: HEALTHCHECK_REGISTERED, Name: "HEALTHCHECK_REGISTERED", Level: zerolog.InfoLevel, Description: "a health check was registered",
+			Fields: []LogEventField{healthCheckIDField, {Name: "spec", Type: LogFieldType_OBJECT, Description: "run-interval and timeout durations"}}},
+		LogEventSpec{ID: HEALTHCHECK_PAUSED, Name: "HEALTHCHECK_PAUSED", Level: zerolog.InfoLevel, Description: "a health check was paused",
+			Fields: []LogEventField{healthCheckIDField}},
+		LogEventSpec{ID: HEALTHCHECK_RESUMED, Name: "HEALTHCHECK_RESUMED", Level: zerolog.InfoLevel, Description: "a health check was resumed",
+			Fields: []LogEventField{healthCheckIDField}},
+		LogEventSpec{ID: HEALTHCHECK_RESULT, Name: "HEALTHCHECK_RESULT", Level: zerolog.InfoLevel, Description: "a health check was run - logged at ERROR level if the health check failed",
+			Fields: []LogEventField{
+				healthCheckIDField,
+				ErrLogEventField,
+				{Name: "start", Type: LogFieldType_TIME, Description: "when the health check started running"},
+				{Name: "duration", Type: LogFieldType_DURATION, Description: "how long it took to run the health check"},
+				{Name: "err-count", Type: LogFieldType_INT, Description: "how many times the health check has failed consecutively"},
+			}},
+	)
+}
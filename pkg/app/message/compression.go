@@ -0,0 +1,236 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package message
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4"
+	"zombiezen.com/go/capnproto2"
+)
+
+// DEFAULT_MIN_COMPRESSION_SIZE is the default data size threshold in bytes for compression. Compressing smaller data usually
+// does not pay off because of the compression format overhead.
+const DEFAULT_MIN_COMPRESSION_SIZE = 512
+
+// DEFAULT_MAX_MESSAGE_SIZE is the default max size in bytes for decompressed message data, which matches the capnp default
+// traversal limit
+const DEFAULT_MAX_MESSAGE_SIZE = 64 << 20
+
+var (
+	compressionPolicyMutex sync.RWMutex
+	compressionPolicy      *CompressionPolicy
+	maxMessageSize         int64 = DEFAULT_MAX_MESSAGE_SIZE
+
+	// the encoder is only used via EncodeAll(), which is safe for concurrent use
+	zstdEncoder, _ = zstd.NewWriter(nil)
+)
+
+// SetMaxMessageSize sets the max size in bytes for decompressed message data, i.e., compressed data that would decompress
+// to more than the max size is rejected with a MaxMessageSizeExceededError. If size is 0, then the max size is reset to
+// DEFAULT_MAX_MESSAGE_SIZE.
+func SetMaxMessageSize(size uint32) {
+	compressionPolicyMutex.Lock()
+	defer compressionPolicyMutex.Unlock()
+	if size == 0 {
+		maxMessageSize = DEFAULT_MAX_MESSAGE_SIZE
+		return
+	}
+	maxMessageSize = int64(size)
+}
+
+func registeredMaxMessageSize() int64 {
+	compressionPolicyMutex.RLock()
+	defer compressionPolicyMutex.RUnlock()
+	return maxMessageSize
+}
+
+// SetCompressionPolicy registers the policy that is used by SetData() to decide how message data is compressed and packed.
+// Setting the policy to nil unregisters the policy, i.e., SetData() will use the message's compression and packed settings.
+func SetCompressionPolicy(policy *CompressionPolicy) {
+	compressionPolicyMutex.Lock()
+	defer compressionPolicyMutex.Unlock()
+	compressionPolicy = policy
+}
+
+func registeredCompressionPolicy() *CompressionPolicy {
+	compressionPolicyMutex.RLock()
+	defer compressionPolicyMutex.RUnlock()
+	return compressionPolicy
+}
+
+// DataEncoding specifies how message data is compressed and packed
+type DataEncoding struct {
+	Compression Message_Compression
+	Packed      bool
+	// data that is smaller than MinCompressionSize bytes, after it is marshalled, is not compressed
+	MinCompressionSize int
+}
+
+// CompressionPolicy decides how message data is compressed and packed, based on the message type and the data size.
+//
+// *** NOTE *** turn on compression / packing only after proving that it is needed and provides benefit
+type CompressionPolicy struct {
+	// applied to message types that have no DataEncoding registered
+	Default DataEncoding
+	// message type -> DataEncoding
+	MessageTypes map[uint64]DataEncoding
+}
+
+// NewCompressionPolicy returns a new policy that compresses data that is at least DEFAULT_MIN_COMPRESSION_SIZE bytes
+// using the specified compression. Data is always packed.
+func NewCompressionPolicy(compression Message_Compression) *CompressionPolicy {
+	return &CompressionPolicy{
+		Default: DataEncoding{
+			Compression:        compression,
+			Packed:             true,
+			MinCompressionSize: DEFAULT_MIN_COMPRESSION_SIZE,
+		},
+		MessageTypes: map[uint64]DataEncoding{},
+	}
+}
+
+// DataEncoding returns the DataEncoding for the message type
+func (a *CompressionPolicy) DataEncoding(messageType uint64) DataEncoding {
+	if encoding, ok := a.MessageTypes[messageType]; ok {
+		return encoding
+	}
+	return a.Default
+}
+
+// SetData sets the message compression and packed settings according to the policy, and then sets the message data.
+//
+// The data is sent uncompressed if it is smaller than the DataEncoding.MinCompressionSize, or if compressing it does not
+// reduce its size.
+//
+// errors:
+//	- UnsupportedCompressionError
+//	- capnp errors
+func (a *CompressionPolicy) SetData(msg *Message, data *capnp.Message) error {
+	encoding := a.DataEncoding(msg.Type())
+	dataBytes, err := marshal(data, encoding.Packed)
+	if err != nil {
+		return err
+	}
+	msg.SetPacked(encoding.Packed)
+	msg.SetCompression(Message_Compression_none)
+	if encoding.Compression != Message_Compression_none && len(dataBytes) >= encoding.MinCompressionSize {
+		compressed, err := compress(encoding.Compression, dataBytes)
+		if err != nil {
+			return err
+		}
+		if len(compressed) < len(dataBytes) {
+			msg.SetCompression(encoding.Compression)
+			dataBytes = compressed
+		}
+	}
+	return msg.SetData(dataBytes)
+}
+
+// SupportedCompression returns true if the compression is supported, i.e., message data can be compressed and decompressed.
+func SupportedCompression(compression Message_Compression) bool {
+	switch compression {
+	case Message_Compression_none, Message_Compression_zlib, Message_Compression_lz4, Message_Compression_zstd:
+		return true
+	default:
+		return false
+	}
+}
+
+func compress(compression Message_Compression, data []byte) ([]byte, error) {
+	switch compression {
+	case Message_Compression_none:
+		return data, nil
+	case Message_Compression_zlib:
+		buf := new(bytes.Buffer)
+		writer := zlib.NewWriter(buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case Message_Compression_lz4:
+		buf := new(bytes.Buffer)
+		writer := lz4.NewWriter(buf)
+		if _, err := writer.Write(data); err != nil {
+			return nil, err
+		}
+		if err := writer.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case Message_Compression_zstd:
+		return zstdEncoder.EncodeAll(data, nil), nil
+	default:
+		return nil, UnsupportedCompressionError{compression}
+	}
+}
+
+// decompress decompresses the data, which must not decompress to more than the max message size - see SetMaxMessageSize()
+//
+// errors:
+//	- MaxMessageSizeExceededError
+//	- UnsupportedCompressionError
+func decompress(compression Message_Compression, data []byte) ([]byte, error) {
+	maxSize := registeredMaxMessageSize()
+	switch compression {
+	case Message_Compression_none:
+		return data, nil
+	case Message_Compression_zlib:
+		reader, err := zlib.NewReader(bytes.NewBuffer(data))
+		if err != nil {
+			return nil, err
+		}
+		return readAll(reader, maxSize)
+	case Message_Compression_lz4:
+		return readAll(lz4.NewReader(bytes.NewBuffer(data)), maxSize)
+	case Message_Compression_zstd:
+		// frames that declare a content size that exceeds the max are rejected up front
+		var header zstd.Header
+		if err := header.Decode(data); err != nil {
+			return nil, err
+		}
+		if header.HasFCS && header.FrameContentSize > uint64(maxSize) {
+			return nil, MaxMessageSizeExceededError{maxSize}
+		}
+		reader, err := zstd.NewReader(bytes.NewReader(data), zstd.WithDecoderConcurrency(1), zstd.WithDecoderMaxMemory(uint64(maxSize)))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+		return readAll(reader, maxSize)
+	default:
+		return nil, UnsupportedCompressionError{compression}
+	}
+}
+
+// readAll reads at most maxSize bytes
+func readAll(reader io.Reader, maxSize int64) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(reader, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, MaxMessageSizeExceededError{maxSize}
+	}
+	return data, nil
+}
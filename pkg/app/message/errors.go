@@ -31,3 +31,12 @@ type UnsupportedCompressionError struct {
 func (a UnsupportedCompressionError) Error() string {
 	return fmt.Sprintf("Unsupported compression : %v", a.Message_Compression)
 }
+
+// MaxMessageSizeExceededError indicates that the message data decompresses to more than the max message size - see SetMaxMessageSize()
+type MaxMessageSizeExceededError struct {
+	MaxSize int64
+}
+
+func (a MaxMessageSizeExceededError) Error() string {
+	return fmt.Sprintf("Decompressed message data exceeds max message size : %d", a.MaxSize)
+}
@@ -35,12 +35,12 @@ func (w Message_deadline_Which) String() string {
 const Message_TypeID = 0xc768aaf640842a35
 
 func NewMessage(s *capnp.Segment) (Message, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 3})
 	return Message{st}, err
 }
 
 func NewRootMessage(s *capnp.Segment) (Message, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 48, PointerCount: 3})
 	return Message{st}, err
 }
 
@@ -116,6 +116,56 @@ func (s Message) SetData(v []byte) error {
 	return s.Struct.SetData(0, v)
 }
 
+func (s Message) Trace() (Message_Trace, error) {
+	p, err := s.Struct.Ptr(1)
+	return Message_Trace{Struct: p.Struct()}, err
+}
+
+func (s Message) HasTrace() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s Message) SetTrace(v Message_Trace) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewTrace sets the trace field to a newly
+// allocated Message_Trace struct, preferring placement in s's segment.
+func (s Message) NewTrace() (Message_Trace, error) {
+	ss, err := NewMessage_Trace(s.Struct.Segment())
+	if err != nil {
+		return Message_Trace{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s Message) Stream() (Message_Stream, error) {
+	p, err := s.Struct.Ptr(2)
+	return Message_Stream{Struct: p.Struct()}, err
+}
+
+func (s Message) HasStream() bool {
+	p, err := s.Struct.Ptr(2)
+	return p.IsValid() || err != nil
+}
+
+func (s Message) SetStream(v Message_Stream) error {
+	return s.Struct.SetPtr(2, v.Struct.ToPtr())
+}
+
+// NewStream sets the stream field to a newly
+// allocated Message_Stream struct, preferring placement in s's segment.
+func (s Message) NewStream() (Message_Stream, error) {
+	ss, err := NewMessage_Stream(s.Struct.Segment())
+	if err != nil {
+		return Message_Stream{}, err
+	}
+	err = s.Struct.SetPtr(2, ss.Struct.ToPtr())
+	return ss, err
+}
+
 func (s Message) Deadline() Message_deadline { return Message_deadline(s) }
 
 func (s Message_deadline) Which() Message_deadline_Which {
@@ -150,7 +200,7 @@ type Message_List struct{ capnp.List }
 
 // NewMessage creates a new list of Message.
 func NewMessage_List(s *capnp.Segment, sz int32) (Message_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 1}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 48, PointerCount: 3}, sz)
 	return Message_List{l}, err
 }
 
@@ -175,6 +225,14 @@ func (p Message_Promise) Deadline() Message_deadline_Promise {
 	return Message_deadline_Promise{p.Pipeline}
 }
 
+func (p Message_Promise) Trace() Message_Trace_Promise {
+	return Message_Trace_Promise{Pipeline: p.Pipeline.GetPipeline(1)}
+}
+
+func (p Message_Promise) Stream() Message_Stream_Promise {
+	return Message_Stream_Promise{Pipeline: p.Pipeline.GetPipeline(2)}
+}
+
 // Message_deadline_Promise is a wrapper for a Message_deadline promised by a client call.
 type Message_deadline_Promise struct{ *capnp.Pipeline }
 
@@ -192,6 +250,8 @@ const Message_Compression_TypeID = 0xf8f433c185247295
 const (
 	Message_Compression_none Message_Compression = 0
 	Message_Compression_zlib Message_Compression = 1
+	Message_Compression_lz4  Message_Compression = 2
+	Message_Compression_zstd Message_Compression = 3
 )
 
 // String returns the enum's constant name.
@@ -201,6 +261,10 @@ func (c Message_Compression) String() string {
 		return "none"
 	case Message_Compression_zlib:
 		return "zlib"
+	case Message_Compression_lz4:
+		return "lz4"
+	case Message_Compression_zstd:
+		return "zstd"
 
 	default:
 		return ""
@@ -215,6 +279,10 @@ func Message_CompressionFromString(c string) Message_Compression {
 		return Message_Compression_none
 	case "zlib":
 		return Message_Compression_zlib
+	case "lz4":
+		return Message_Compression_lz4
+	case "zstd":
+		return Message_Compression_zstd
 
 	default:
 		return 0
@@ -238,6 +306,164 @@ func (l Message_Compression_List) Set(i int, v Message_Compression) {
 	ul.Set(i, uint16(v))
 }
 
+type Message_Trace struct{ capnp.Struct }
+
+// Message_Trace_TypeID is the unique identifier for the type Message_Trace.
+const Message_Trace_TypeID = 0xf9bd0c8f82a9219e
+
+func NewMessage_Trace(s *capnp.Segment) (Message_Trace, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Message_Trace{st}, err
+}
+
+func NewRootMessage_Trace(s *capnp.Segment) (Message_Trace, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0})
+	return Message_Trace{st}, err
+}
+
+func ReadRootMessage_Trace(msg *capnp.Message) (Message_Trace, error) {
+	root, err := msg.RootPtr()
+	return Message_Trace{root.Struct()}, err
+}
+
+func (s Message_Trace) String() string {
+	str, _ := text.Marshal(0xf9bd0c8f82a9219e, s.Struct)
+	return str
+}
+
+func (s Message_Trace) TraceID() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Message_Trace) SetTraceID(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Message_Trace) SpanID() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s Message_Trace) SetSpanID(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s Message_Trace) ParentSpanID() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s Message_Trace) SetParentSpanID(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+// Message_Trace_List is a list of Message_Trace.
+type Message_Trace_List struct{ capnp.List }
+
+// NewMessage_Trace creates a new list of Message_Trace.
+func NewMessage_Trace_List(s *capnp.Segment, sz int32) (Message_Trace_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 0}, sz)
+	return Message_Trace_List{l}, err
+}
+
+func (s Message_Trace_List) At(i int) Message_Trace {
+	return Message_Trace{s.List.Struct(i)}
+}
+
+func (s Message_Trace_List) Set(i int, v Message_Trace) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Message_Trace_List) String() string {
+	str, _ := text.MarshalList(0xf9bd0c8f82a9219e, s.List)
+	return str
+}
+
+// Message_Trace_Promise is a wrapper for a Message_Trace promised by a client call.
+type Message_Trace_Promise struct{ *capnp.Pipeline }
+
+func (p Message_Trace_Promise) Struct() (Message_Trace, error) {
+	s, err := p.Pipeline.Struct()
+	return Message_Trace{s}, err
+}
+
+type Message_Stream struct{ capnp.Struct }
+
+// Message_Stream_TypeID is the unique identifier for the type Message_Stream.
+const Message_Stream_TypeID = 0xc33a406bec7ab669
+
+func NewMessage_Stream(s *capnp.Segment) (Message_Stream, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Message_Stream{st}, err
+}
+
+func NewRootMessage_Stream(s *capnp.Segment) (Message_Stream, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return Message_Stream{st}, err
+}
+
+func ReadRootMessage_Stream(msg *capnp.Message) (Message_Stream, error) {
+	root, err := msg.RootPtr()
+	return Message_Stream{root.Struct()}, err
+}
+
+func (s Message_Stream) String() string {
+	str, _ := text.Marshal(0xc33a406bec7ab669, s.Struct)
+	return str
+}
+
+func (s Message_Stream) Sequence() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s Message_Stream) SetSequence(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s Message_Stream) Credits() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s Message_Stream) SetCredits(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s Message_Stream) Final() bool {
+	return s.Struct.Bit(64)
+}
+
+func (s Message_Stream) SetFinal(v bool) {
+	s.Struct.SetBit(64, v)
+}
+
+// Message_Stream_List is a list of Message_Stream.
+type Message_Stream_List struct{ capnp.List }
+
+// NewMessage_Stream creates a new list of Message_Stream.
+func NewMessage_Stream_List(s *capnp.Segment, sz int32) (Message_Stream_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return Message_Stream_List{l}, err
+}
+
+func (s Message_Stream_List) At(i int) Message_Stream {
+	return Message_Stream{s.List.Struct(i)}
+}
+
+func (s Message_Stream_List) Set(i int, v Message_Stream) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Message_Stream_List) String() string {
+	str, _ := text.MarshalList(0xc33a406bec7ab669, s.List)
+	return str
+}
+
+// Message_Stream_Promise is a wrapper for a Message_Stream promised by a client call.
+type Message_Stream_Promise struct{ *capnp.Pipeline }
+
+func (p Message_Stream_Promise) Struct() (Message_Stream, error) {
+	s, err := p.Pipeline.Struct()
+	return Message_Stream{s}, err
+}
+
 type Ping struct{ capnp.Struct }
 
 // Ping_TypeID is the unique identifier for the type Ping.
@@ -530,65 +756,317 @@ func (p SupportedMessageTypes_Response_Promise) Struct() (SupportedMessageTypes_
 	return SupportedMessageTypes_Response{s}, err
 }
 
-const schema_aa44738dedfed9a1 = "x\xda\x9cT=l\x1cE\x18}of\xd6{\x96\xb0" +
-	"\xcf\x93]\x12~\x12\x9dA\x17\x91\x9cD\x84\xb9D!" +
-	"i\x1c\x87\x14`\xc9\xc2\xe3#\x12\x0dH{\xbe\x91Y" +
-	"\xe3\xdb]n\xd7\"I\x13\x0a\x10\x7fB !\x9aP" +
-	"D4H\xa4\xe2G\x14\x14)(\"7\x04\x91\x02\xe4" +
-	"HP A\x19\x0a\x04\x06Qd\xd0\xdeq\xf6\x85\x1f" +
-	"\x09\xd1\xed\xbc}\xf3\xbeo\xde\xbco\x1ex\x93'\xc4" +
-	"\x8cw^\x01\xe6\xb07\xe6\xf2\xd7\xe6\xa3o\xdf\xf8\xf8" +
-	"y\xe8:\xdd\xaf\x1f\xca='\xd3\xee/P>\xd0\xdc" +
-	"/\xda\x0c\x8e\x09\x1f\xd2\xad\xbe|\xed\x83\xa3\x17\xcf\xbe" +
-	"t+\xcbcI\xbb]\xac28(| \xd8/f" +
-	"A\xf7\x8a\xabo\xdc\x1d}\xf1\x0e\xf4$\xdd\xbb\xd7o" +
-	"\xdex=?u\xa9/\x19\x9c\x16\x17\x82'\xfb\x8aG" +
-	"\x1a/\x9c\xd8\xba\xf4\xf4\x06\xcc$\xc7vX}\xc9\xe0" +
-	"\x98\xf8(\x98\x13{\x80\xe6\x82\xd8 \xe8&7c\xb3" +
-	"\xf2\xde\xdc\x8f0\xbb9\xb6\xb3\xf74}\x0a\x06\xc1\xfd" +
-	"\xeaw0\x98Q\xcfa\xa4\xbb\xbfUo^V\xf72" +
-	"\xb8\xaa\xee\x83t[?\xddH\x0f\xac>\xb2\xf5\x0f=" +
-	"^Q\x17\x82\xab\xaa\xec\xf1\xed^\xfd\xc5\xcf\x9a?\xff" +
-	"\x06\xbd[\xec\x14\x05\x9b\xef\xab]\x0c>\xed\xb3?Q" +
-	"G\xf1\x90\xeb\xda<\x8fV\xec!\xb5\x1ceIv\xbc" +
-	"\xb5\x9eei\xaf\xb0\x9d\x85\x01\xfe\xf8\xd9\xcc\xe6\x87\x96" +
-	"\xec\xb3\xeb\xbe\xcd\x8bE\xf2\xbfn\xc8\xb3j\x9a\xe4v" +
-	"\x914J*@\x11\xd0\x13\x0f\x02\xa6\"i\xea\x82\xb5" +
-	"\xa2dr\x12\\\x94\xe48D\xf9\xb9\xad\xce\x81\xfab" +
-	",\x93\x95\xd1\xaa\x7f\xe2\x0b\xb6\xd6_\x1bE\x8e\x1c\x96" +
-	"m\xf7p\xda\xcdz6\xcf\xe1\xc7ib\xa6\xb7K_" +
-	"\xbb\x0b0\x9fK\x9aMAM\x86,\xc1\xaf\x1a\x80\xf9" +
-	"R\xd2|#\xa8\x85\x08)\x00}\xbd\x07\x98MI\xf3" +
-	"\xbd\xa0\x962\xa4\x04\xf4wK\xfa\x87Z\xabB\xc9V" +
-	"HA\xad\xa6B*2\xd0l\x03\xad\xa9\x12\xdfK\xc1" +
-	"\x19o\x8a!=2\xb8\x93\xc7\x81VX\xfe\x98\xa6 " +
-	"\xc7B\x8e\x01\xc1>6\x80\xd6\x1d%\\/a\x9f#" +
-	"\x11\x09\xee\xe1<\x84\x8c;};\xc6\xc1j\xe9\xd1p" +
-	"\xe1\x96\xd3^\xcf\xaeE\x05jq\x9a<zj\x1b/" +
-	"\xe2\xae\xcd\x8b\xa8\x0bfFQ\xb8\xa7\xde\xbah.\x7f" +
-	"\xfd\xea\x15\x18%8\x17\x92\xb7\x01\x9a\xe7\xdcz\x12\x9f" +
-	"\x99N\xa2\x04\xb3\xe9t\xb9\x07\xa0\x07A\xaf/=\xe2" +
-	"\x1a\xab;\x96\x82\xac\x82\xb3Y\xb4\xfc\x8c\xed\x90\x10$" +
-	"X\xedDE\xc4\x09\x08N\x80\xaec\xa3\xceZ\x9cX" +
-	"\x00\xdb\xd7$\x86\xd74Xv\xec\xec\x80c*R\xed" +
-	"unWX\x0e\x8b>\xd8\x06\xcc\x01IsXp\x1f" +
-	"o:/d\x05\xd03K\xfaH\xcd<!i:b" +
-	"p\xbat\xbdX\x80\xdf\xb2\xcb\xf4!\xe8\x83\xce\x9e\xc9" +
-	"\xe2\x9e\xcd\x1f\x03\x93\xff{\xe6a\xaf\xf2\xdf\x82\xecg" +
-	"67\x15r\xe4\xa5\x19?9\xf2\xa0x\xf3\xe7\xcb\xc1" +
-	"\xb0y\xe1\xca\xbc\x97q\x1f\xf5`\x18\xe1\xf4/\x11\x96" +
-	"\xb7z3\x0cl\x9c2)\xa7\xa5\xd2\x0f\xa1n\x94\xd6" +
-	"\xeb\xf1\x06PM\xd2\xc4V\xcf\xad\xc5\xed?\x02\x00\x00" +
-	"\xff\xff\x82;gt"
+type Error struct{ capnp.Struct }
+
+// Error_TypeID is the unique identifier for the type Error.
+const Error_TypeID = 0xf7d32c566d82cac4
+
+func NewError(s *capnp.Segment) (Error, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Error{st}, err
+}
+
+func NewRootError(s *capnp.Segment) (Error, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return Error{st}, err
+}
+
+func ReadRootError(msg *capnp.Message) (Error, error) {
+	root, err := msg.RootPtr()
+	return Error{root.Struct()}, err
+}
+
+func (s Error) String() string {
+	str, _ := text.Marshal(0xf7d32c566d82cac4, s.Struct)
+	return str
+}
+
+func (s Error) ErrorID() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s Error) SetErrorID(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s Error) Message() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s Error) HasMessage() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s Error) MessageBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s Error) SetMessage(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+// Error_List is a list of Error.
+type Error_List struct{ capnp.List }
+
+// NewError creates a new list of Error.
+func NewError_List(s *capnp.Segment, sz int32) (Error_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return Error_List{l}, err
+}
+
+func (s Error_List) At(i int) Error {
+	return Error{s.List.Struct(i)}
+}
+
+func (s Error_List) Set(i int, v Error) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s Error_List) String() string {
+	str, _ := text.MarshalList(0xf7d32c566d82cac4, s.List)
+	return str
+}
+
+// Error_Promise is a wrapper for a Error promised by a client call.
+type Error_Promise struct{ *capnp.Pipeline }
+
+func (p Error_Promise) Struct() (Error, error) {
+	s, err := p.Pipeline.Struct()
+	return Error{s}, err
+}
+
+type GoAway struct{ capnp.Struct }
+
+// GoAway_TypeID is the unique identifier for the type GoAway.
+const GoAway_TypeID = 0xdc1d6344fd853001
+
+func NewGoAway(s *capnp.Segment) (GoAway, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return GoAway{st}, err
+}
+
+func NewRootGoAway(s *capnp.Segment) (GoAway, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return GoAway{st}, err
+}
+
+func ReadRootGoAway(msg *capnp.Message) (GoAway, error) {
+	root, err := msg.RootPtr()
+	return GoAway{root.Struct()}, err
+}
+
+func (s GoAway) String() string {
+	str, _ := text.Marshal(0xdc1d6344fd853001, s.Struct)
+	return str
+}
+
+func (s GoAway) Deadline() int64 {
+	return int64(s.Struct.Uint64(0))
+}
+
+func (s GoAway) SetDeadline(v int64) {
+	s.Struct.SetUint64(0, uint64(v))
+}
+
+// GoAway_List is a list of GoAway.
+type GoAway_List struct{ capnp.List }
+
+// NewGoAway creates a new list of GoAway.
+func NewGoAway_List(s *capnp.Segment, sz int32) (GoAway_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return GoAway_List{l}, err
+}
+
+func (s GoAway_List) At(i int) GoAway {
+	return GoAway{s.List.Struct(i)}
+}
+
+func (s GoAway_List) Set(i int, v GoAway) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s GoAway_List) String() string {
+	str, _ := text.MarshalList(0xdc1d6344fd853001, s.List)
+	return str
+}
+
+// GoAway_Promise is a wrapper for a GoAway promised by a client call.
+type GoAway_Promise struct{ *capnp.Pipeline }
+
+func (p GoAway_Promise) Struct() (GoAway, error) {
+	s, err := p.Pipeline.Struct()
+	return GoAway{s}, err
+}
+
+type ServerBusy struct{ capnp.Struct }
+
+// ServerBusy_TypeID is the unique identifier for the type ServerBusy.
+const ServerBusy_TypeID = 0xb4f77c4d969e92e0
+
+func NewServerBusy(s *capnp.Segment) (ServerBusy, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ServerBusy{st}, err
+}
+
+func NewRootServerBusy(s *capnp.Segment) (ServerBusy, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0})
+	return ServerBusy{st}, err
+}
+
+func ReadRootServerBusy(msg *capnp.Message) (ServerBusy, error) {
+	root, err := msg.RootPtr()
+	return ServerBusy{root.Struct()}, err
+}
+
+func (s ServerBusy) String() string {
+	str, _ := text.Marshal(0xb4f77c4d969e92e0, s.Struct)
+	return str
+}
+
+func (s ServerBusy) RetryAfterMSec() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s ServerBusy) SetRetryAfterMSec(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+// ServerBusy_List is a list of ServerBusy.
+type ServerBusy_List struct{ capnp.List }
+
+// NewServerBusy creates a new list of ServerBusy.
+func NewServerBusy_List(s *capnp.Segment, sz int32) (ServerBusy_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 0}, sz)
+	return ServerBusy_List{l}, err
+}
+
+func (s ServerBusy_List) At(i int) ServerBusy {
+	return ServerBusy{s.List.Struct(i)}
+}
+
+func (s ServerBusy_List) Set(i int, v ServerBusy) error {
+	return s.List.SetStruct(i, v.Struct)
+}
+
+func (s ServerBusy_List) String() string {
+	str, _ := text.MarshalList(0xb4f77c4d969e92e0, s.List)
+	return str
+}
+
+// ServerBusy_Promise is a wrapper for a ServerBusy promised by a client call.
+type ServerBusy_Promise struct{ *capnp.Pipeline }
+
+func (p ServerBusy_Promise) Struct() (ServerBusy, error) {
+	s, err := p.Pipeline.Struct()
+	return ServerBusy{s}, err
+}
+
+const schema_aa44738dedfed9a1 = "x\xda\x9cU_\x88\\W\x19\xff~\xe7\xdc\x99;+" +
+	"\xbb;sr&\x95\x8d\x09\x93\x84\x80\xc9\xa2\xa5\xbbm" +
+	"\x88,\xcal\xc6\x0d&K\x17\xe7dRiA\x0cw" +
+	"gN\xd7\xd9\xee\xde\xb9\xde{\xd7v\x17aC\xb0\xa8" +
+	"\x11-T\xf4!B\x8b}\x10\xdc\x17m\xfc\xf3P\xe8" +
+	"\x83Q)\xe4A\xb0\x82\xa8X\xd0}RiD\x17\x93" +
+	"\xd8\xa0\xfd\xe4\xdcq\xfe\xec&\x11\xf1\xe12s\x7f\xf7" +
+	";\xbf\xefw~\xe7\xfb\xbe\xa3\xbcK\xfbpI]\x8a" +
+	"f==\x93\x07\x09s\\zD\x1e\x88\xf4\x08\x0e\x10" +
+	"5<H4J\x10P@\x19\x0e\x1f\xc3$Q\xa3\xe0" +
+	"\xf0\xb2\xc3\x85(C\x10i\x85\x98\xa8Qr\xf8A\x87" +
+	"KY\x86$\xd2\x138\xaf\x0f\xa1\xd2\x98u_\x1ew" +
+	"_\xbcR\x19\x1e\xa0\xcfa\x91\xa8q\x16\x12\xfa\xae\x8f" +
+	"\xa9\\\x09e\xe4\x00}\x063D\xea\xa8P5\x81|" +
+	"\x19y\"\xfdA\x97T-\x0buE\xc0\x07x\xfc\xd7" +
+	"m\xb3\xf4\xed\xd3\x7f!\xf5\x8a \xa1\x0a(c\x84H" +
+	"]\x13\xea\xbaP;B\xef\x83P#\xa2\x8c\xf7\x10\xe9" +
+	"\xa3\x10z\x1aB/B\xe8\xcb\x10\xb2\xdd\xc2\x08\x09\x8c" +
+	"\x10\x8a\xe9zd{/\xdc\xec\xc4\xb1]\x09R\xaa\xb4" +
+	";\xe1\xb9\xb9>\x9e\xb6Wm\x92\x06\xab\x84\xc8x\x10" +
+	"\xfc\xa9\xaf\xbdl^\xff\xd5\x95\x9f\x91\xf1\x04N\x97\x81" +
+	"Q\"\x85\x0d^\x0b\xdb\xcf\x1d\x0e\x83\x90\xaa\x9d\xc3n" +
+	"\x0d\x11r$\x90\xcb\xa8W\xa3\xd8&\x09\xf9\xedN\x88" +
+	"\"\x7f=>\xf6\xfc\x8f\x1f\xfd\xfb?\x88P\x8d\x82\xe6" +
+	"3\xb6Ul\x05i`\xce\xe6\xf2\x9c|y>x\xeb" +
+	"\x85\xef_\"u\x0c|\xe7U\xf9\xdeZg\xf5\x16y" +
+	">\xd1\xa39\xb9\x08=!}\x92\xbc\xfc\xc5_|\xef" +
+	"\xd4\xcb\xeb_\xd8\x1d\x95\x83\x0b\xbb%\x96\xa1G\xa4O" +
+	"\xa4s\xb2J\xe0/\xf1\xb17\xde\x17\xfc\xfc\x9b\xa4\xc6" +
+	"\xc1\xdf\xfa\xcd\xbb7\xbf\x92\xccme\x94\xfa\xa4\xbc\xaa" +
+	"?\x921\x9e\x9c\xfc\xfc\xec\xed\xadO\xbfAf\x1c\xf9" +
+	"AT.\xe3\x99\x90\xd7\xf4\x11y\x8aH\x7f\x15\x82\x86" +
+	"\xfc7\x0f!?X\xfa\x04|\x08h\xfd\x9a\x0b\xd2\x07" +
+	"\xa4\xa0!q\xf7&\x9f\x97B\xbf(\x05I\xbe\xbds" +
+	"\xb3s|\xf9\xec\xed\xfb\x04]\x93B_\xcf\x82\xfa\xae" +
+	"\xa9\x87\xc4 'A\xefH\xa1\xf2\x92HmI\xfa\x10" +
+	"\xbft\xe4;\x97_\x18}\xfd\x1d\xa7mh[\x8eL" +
+	"\xbd)\xd5\xb6T;R_\x11\x92\xc0?\xbdqy\xf5" +
+	"\x13\x1f\xf8\xe5\x1d\xb7\xe7\xa1\xbc\x99\x8dzKH\xfd\x9a" +
+	"\x90\xfa\x86\x90\xfa\xb7\xd2\x85\xb7\x7f\xb4\xf1\xf63\xb33" +
+	"?q\xccb\x0fs\xdeS\x07<5\xe9\xe9\xeb\xf0\x08" +
+	"\x8cG\x9e\xff\xd7\\\xf3\xd0\xef\xf60g;\xda\x86\xa7" +
+	"w\xe0i!<}U\xb8\xe8\xdf\xbf\xf8\xd27\x16>" +
+	"w\xe7\x07\xf7\x8b\xbe.<\xbd-<\xfd\xa6\xf0\xf4\xa4" +
+	"\xf4\x08\xd5?\xfc\x93\xf9T\x9eWm\x92\x04K\xf6a" +
+	"\xaf\x19Da4\xd3X\x8b\xa2N\x9c\xda\xd6B\x17\xbf" +
+	"\xb0\x1e\xd9\xe4\xe1\xf3\xf63k\xbeM\xd2:\xf0\xbf." +
+	"H\xa2b'Ll\x1d0^o\x1a\xa8\xb1i\"S" +
+	"\x900\xc7\x04*\xaem\x12\x8c\x13\xea\x12Y\x9b\x8c\xd3" +
+	"\x80\x1d]\xf6z[\x86Ku\xdc\x83/\xd8J\xf6n" +
+	"F\x81\xa1\x13U\x8b\x83sSjz`\xb5\x1a\x9b\xe1" +
+	"\x8f\x0e\xb7O\xe5B\x1c4m\xb5\x91\xc66XE\x91" +
+	"p\xf1\xaf\xcc,\x00\x90\xb8\xf863K\xf7\xb7\x8f\x8e" +
+	"\x0d\xd0\xb1>\xca-\x1b\xb4V\xda\xa1%\xa2\xca-f" +
+	"\x9e\xaf\xa4\x8e\xb5\xf2\x0e3O\xd7!\xb2?>J\x03" +
+	"QD\xb3 \xba\xf8\xc7.U\xa9OUM2!\xd9" +
+	"\x82\x99\xe1\x95\xfd\x1d<h%&\xa7pJ\xf4\xfd\x11" +
+	"=\x7f\xba\xaf-[\xedJ\xac\xdcd\xe6\xbb\xa6 \xbd" +
+	"\x83\xcc\xfb\xcap\xc5vb\x91\xc8\x1c\x970\x8f\x09\x1c" +
+	"\xc2\xbb\x9c+\xa3@\xa4\xa6\xce\xab\x93\x15\xf3\xa4\x84i" +
+	"\x89\xee\xdc\xea\xac\xa5\x0b\xe47l\x13>\x09\xf8\x04\xb6" +
+	"\xcfE\xed\xd8&\x1f'\x84\xff\xe74\xab\x18f~\xb6" +
+	"\xaf[>\xa8\x9a\xfc\xc8&\xd5?1\xf3Q\x98\x020" +
+	"4\xd8FjC\xf3+7\xbf\xe9\x8a\xd4&)\xbb\xda" +
+	"s\xa5\xe7N\xe5-f~\xff=E\xd5\x91\xe1R\xe5" +
+	"o\xcc|\xb5\x0et\xad\xde+\xa4g`\xafh\xda\x1d" +
+	"\x84\x99\x8c}\xa8\x03\xfa\xae\x14\x0a\xbe)A8\x1f'" +
+	"\x89\x00u\xc4\xfd\x085qTM\xf8\x90j\xff\xa4\xda" +
+	"\xef\x17\xc3Nh\x8b\x1b+\xedE\x7fe\xe3\xb1:D" +
+	"q#I[\xee\x80_e\xe6\xa5\x07\x1d\xdb\x85\xd8\x0f" +
+	"\x9a\xdd3\xdb\xeeK\xec\x97\x85\x19\xed7\xd4\x99\x9a:" +
+	"\xe3\x9b9\x09S\x1f\\\xaejaF-\xf8\xe6q\x09" +
+	"\xf3\xe4\xe0jUO,\xab\xa7\xfc\xde\xb9nf\x95z" +
+	"n\xae\x0e\xd1\xbb\xa2\xaaI\x14\x84\xbb\x10\x8e\x82\xd8\x86" +
+	"i#\xa2\xe2\x9e/\x95\x93\xcc\xfc\xdd\xbd\xc6\x9e\x89\xfd" +
+	"\xb8\x13g\xce\xber\x1f\xd9\x85\xbe\xec\x135u\xc2\xef" +
+	"\x95^O\xf5TMM\xf9\xe6\x11\x09\xf3a\x81M\x1b" +
+	"\xc7\x9dxW\xce\xcd\xffds\xd0(\xb9\x07\x95\xf5]" +
+	"E\xb4\xc7\xc5FZtM\xa5\xb7\xa5T\xf8\xf3\x7f\xf7" +
+	"q^\x9d\xf3\xcdY\x09sa\xc8GSS\xc67u" +
+	"\x09\xf3I\xe7\xe3l\xd7\xc7\xa7\xa6\x87|\xe4\xc4U]" +
+	"\xd8\xb4D\xe4\x84\x15\xc8=\xd8l\xc6\xb6\xd5N\x93!" +
+	"\xa8\xf2t;\x0cV\x1c\x00r\xcf\x03<\xfcX\xa7x" +
+	"\xfa\xd9`=3q\xeb>\x9a\x87\x86\xe9\xbcR\xbe)" +
+	"I\x98\x83\xbbg\x91\xcb\xd1\xeb\xb2\x1f2su\xafA" +
+	"\x0d\x1b\x7f\xd6\xc6\xb5\xb5d\x9d(+\xb2\x1b}\xfe~" +
+	"\xc6\xa1DjC\xed\xf7MY\xc2\x1c\x16\xc8Z\xaa\xca" +
+	"\xb1M\xe3\xf5\xd3O\xa7T\xb5\xf1B\xc36\x876\xfa" +
+	"\xef\x01\x00\xad\xe4\xffu"
 
 func init() {
 	schemas.Register(schema_aa44738dedfed9a1,
 		0x80b38fdd614a8b73,
 		0x87799f37b0d1886a,
 		0x9bce611bc724ff89,
+		0xb4f77c4d969e92e0,
+		0xc33a406bec7ab669,
 		0xc768aaf640842a35,
+		0xdc1d6344fd853001,
 		0xee41a6675169d80e,
 		0xf56d6f421703b1f7,
 		0xf6486a286fedf2f6,
-		0xf8f433c185247295)
+		0xf7d32c566d82cac4,
+		0xf8f433c185247295,
+		0xf9bd0c8f82a9219e)
 }
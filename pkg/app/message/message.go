@@ -15,17 +15,16 @@
 package message
 
 import (
-	"bytes"
-	"compress/zlib"
-	"io/ioutil"
-
 	"zombiezen.com/go/capnproto2"
 )
 
-// Data returns the message data as a capnp.Message.
+// Data returns the message data as a capnp.Message. The data is decompressed and unpacked according to the message's
+// compression and packed settings.
 //
 // errors:
 //	- ERR_NODATA
+//	- UnsupportedCompressionError
+//	- MaxMessageSizeExceededError - see SetMaxMessageSize()
 //	- capnp errors
 func Data(msg *Message) (*capnp.Message, error) {
 	if !msg.HasData() {
@@ -36,57 +35,35 @@ func Data(msg *Message) (*capnp.Message, error) {
 	if err != nil {
 		return nil, err
 	}
-	switch msg.Compression() {
-	case Message_Compression_none:
-		if msg.Packed() {
-			return capnp.UnmarshalPacked(data)
-		}
-		return capnp.Unmarshal(data)
-	case Message_Compression_zlib:
-		buf := bytes.NewBuffer(data)
-		reader, err := zlib.NewReader(buf)
-		if err != nil {
-			return nil, err
-		}
-		data, err = ioutil.ReadAll(reader)
-		if err != nil {
-			return nil, err
-		}
-		if msg.Packed() {
-			return capnp.UnmarshalPacked(data)
-		}
-		return capnp.Unmarshal(data)
-	default:
-		return nil, UnsupportedCompressionError{msg.Compression()}
+	if data, err = decompress(msg.Compression(), data); err != nil {
+		return nil, err
 	}
+	if msg.Packed() {
+		return capnp.UnmarshalPacked(data)
+	}
+	return capnp.Unmarshal(data)
 }
 
-// SetData will compress the data based on the message's compression setting
+// SetData will compress the data based on the message's compression setting.
+// If a CompressionPolicy is registered, then the message compression and packed settings are first set by the policy
+// - see SetCompressionPolicy()
 func SetData(msg *Message, data *capnp.Message) error {
-	var dataBytes []byte
-	var err error
-	if msg.Packed() {
-		dataBytes, err = data.MarshalPacked()
-	} else {
-		dataBytes, err = data.Marshal()
+	if policy := registeredCompressionPolicy(); policy != nil {
+		return policy.SetData(msg, data)
 	}
+	dataBytes, err := marshal(data, msg.Packed())
 	if err != nil {
 		return err
 	}
-	switch msg.Compression() {
-	case Message_Compression_none:
-		return msg.SetData(dataBytes)
-	case Message_Compression_zlib:
-		buf := new(bytes.Buffer)
-		writer := zlib.NewWriter(buf)
-		if _, err = writer.Write(dataBytes); err != nil {
-			return err
-		}
-		if err := writer.Close(); err != nil {
-			return err
-		}
-		return msg.SetData(buf.Bytes())
-	default:
-		return UnsupportedCompressionError{msg.Compression()}
+	if dataBytes, err = compress(msg.Compression(), dataBytes); err != nil {
+		return err
+	}
+	return msg.SetData(dataBytes)
+}
+
+func marshal(data *capnp.Message, packed bool) ([]byte, error) {
+	if packed {
+		return data.MarshalPacked()
 	}
+	return data.Marshal()
 }
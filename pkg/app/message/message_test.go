@@ -15,6 +15,7 @@
 package message_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -88,6 +89,167 @@ func TestData(t *testing.T) {
 		}
 	})
 
+	for _, compression := range []message.Message_Compression{message.Message_Compression_lz4, message.Message_Compression_zstd} {
+		for _, packed := range []bool{false, true} {
+			t.Run(fmt.Sprintf("compression = %v, packed = %v", compression, packed), func(t *testing.T) {
+				_, msg, err := newMessage()
+				if err != nil {
+					t.Fatal(err)
+				}
+				msg.SetCompression(compression)
+				msg.SetPacked(packed)
+
+				capnpMsgData, msgData, err := newMessage()
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := message.SetData(msg, capnpMsgData); err != nil {
+					t.Fatal(err)
+				}
+
+				unmarshalledMsg, err := message.Data(msg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				msgData2, err := message.ReadRootMessage(unmarshalledMsg)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if msgData.Id() != msgData2.Id() {
+					t.Error("message ids do not match")
+				}
+			})
+		}
+	}
+
+	t.Run("unsupported compression", func(t *testing.T) {
+		_, msg, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.SetCompression(message.Message_Compression(99))
+		capnpMsgData, _, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := message.SetData(msg, capnpMsgData); err == nil {
+			t.Error("SetData should have failed")
+		} else if _, ok := err.(message.UnsupportedCompressionError); !ok {
+			t.Errorf("Expected UnsupportedCompressionError : %T", err)
+		}
+	})
+}
+
+func TestCompressionPolicy(t *testing.T) {
+	app.Reset()
+
+	const COMPRESSED_TYPE = uint64(0x9d7c1fd25b8cf2f4)
+
+	policy := message.NewCompressionPolicy(message.Message_Compression_zlib)
+	policy.MessageTypes[COMPRESSED_TYPE] = message.DataEncoding{Compression: message.Message_Compression_zstd}
+	message.SetCompressionPolicy(policy)
+	defer message.SetCompressionPolicy(nil)
+
+	check := func(t *testing.T, messageType uint64, data *capnp.Message, compression message.Message_Compression, packed bool) {
+		_, msg, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.SetType(messageType)
+		if err := message.SetData(msg, data); err != nil {
+			t.Fatal(err)
+		}
+		if msg.Compression() != compression || msg.Packed() != packed {
+			t.Errorf("compression = %v, packed = %v", msg.Compression(), msg.Packed())
+		}
+		if _, err := message.Data(msg); err != nil {
+			t.Error(err)
+		}
+	}
+
+	t.Run("small data is not compressed", func(t *testing.T) {
+		data, _, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, uid.NextUIDHash().UInt64(), data, message.Message_Compression_none, true)
+	})
+
+	t.Run("large data is compressed", func(t *testing.T) {
+		data, err := newCompressibleData(message.DEFAULT_MIN_COMPRESSION_SIZE * 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, uid.NextUIDHash().UInt64(), data, message.Message_Compression_zlib, true)
+	})
+
+	t.Run("message type policy", func(t *testing.T) {
+		data, _, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		check(t, COMPRESSED_TYPE, data, message.Message_Compression_zstd, false)
+	})
+}
+
+func TestMaxMessageSize(t *testing.T) {
+	app.Reset()
+
+	const MAX_SIZE = 1024
+	message.SetMaxMessageSize(MAX_SIZE)
+	defer message.SetMaxMessageSize(0)
+
+	isMaxMessageSizeExceeded := func(err error) bool {
+		_, ok := err.(message.MaxMessageSizeExceededError)
+		return ok
+	}
+
+	for _, compression := range []message.Message_Compression{message.Message_Compression_zlib, message.Message_Compression_lz4, message.Message_Compression_zstd} {
+		t.Run(fmt.Sprintf("compression = %v", compression), func(t *testing.T) {
+			data, err := newCompressibleData(MAX_SIZE * 4)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, msg, err := newMessage()
+			if err != nil {
+				t.Fatal(err)
+			}
+			msg.SetCompression(compression)
+			if err := message.SetData(msg, data); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := message.Data(msg); !isMaxMessageSizeExceeded(err) {
+				t.Errorf("MaxMessageSizeExceededError was expected : %v", err)
+			}
+
+			// data within the max size is decompressed
+			if data, _, err = newMessage(); err != nil {
+				t.Fatal(err)
+			}
+			if err := message.SetData(msg, data); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := message.Data(msg); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+
+	t.Run("zstd frame content size", func(t *testing.T) {
+		_, msg, err := newMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.SetCompression(message.Message_Compression_zstd)
+		// a single segment frame header that declares a 1 TB content size
+		frame := []byte{0x28, 0xb5, 0x2f, 0xfd, 0xe0, 0, 0, 0, 0, 0, 1, 0, 0}
+		if err := msg.SetData(frame); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := message.Data(msg); !isMaxMessageSizeExceeded(err) {
+			t.Errorf("MaxMessageSizeExceededError was expected : %v", err)
+		}
+	})
 }
 
 func newMessage() (*capnp.Message, *message.Message, error) {
@@ -106,6 +268,71 @@ func newMessage() (*capnp.Message, *message.Message, error) {
 	return capnpMsg, &msg, nil
 }
 
+// newCompressibleData returns a message whose data is made up of size bytes of repetitive JSON
+func newCompressibleData(size int) (*capnp.Message, error) {
+	capnpMsg, msg, err := newMessage()
+	if err != nil {
+		return nil, err
+	}
+	buf := new(bytes.Buffer)
+	for i := 0; buf.Len() < size; i++ {
+		fmt.Fprintf(buf, `{"id":%d,"type":"0x%x","timestamp":%d,"status":"OK"}`, i, uid.NextUIDHash().UInt64(), time.Now().UnixNano())
+	}
+	if err := msg.SetData(buf.Bytes()[:size]); err != nil {
+		return nil, err
+	}
+	return capnpMsg, nil
+}
+
+// BenchmarkCompression compares the compression algorithms for compression ratio and speed, using 16 KB of JSON data.
+// The compressed data size is logged per compression. Run with -benchmem to compare allocations.
+func BenchmarkCompression(b *testing.B) {
+	app.Reset()
+
+	const DATA_SIZE = 16 * 1024
+	data, err := newCompressibleData(DATA_SIZE)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Logf("data size = %d", DATA_SIZE)
+
+	compressions := []message.Message_Compression{
+		message.Message_Compression_none,
+		message.Message_Compression_zlib,
+		message.Message_Compression_lz4,
+		message.Message_Compression_zstd,
+	}
+	for _, compression := range compressions {
+		_, msg, err := newMessage()
+		if err != nil {
+			b.Fatal(err)
+		}
+		msg.SetCompression(compression)
+		msg.SetPacked(true)
+		if err := message.SetData(msg, data); err != nil {
+			b.Fatal(err)
+		}
+		dataBytes, _ := msg.Data()
+		b.Logf("compression = %v, packed = true : %d (%.2f%%)", compression, len(dataBytes), float64(len(dataBytes))*100/DATA_SIZE)
+
+		b.Run(fmt.Sprintf("SetData - compression = %v", compression), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if err := message.SetData(msg, data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+
+		b.Run(fmt.Sprintf("Data - compression = %v", compression), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := message.Data(msg); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
 func BenchmarkData(b *testing.B) {
 	app.Reset()
 
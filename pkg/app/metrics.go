@@ -23,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"zombiezen.com/go/capnproto2"
 )
 
@@ -51,6 +52,11 @@ var (
 
 type AppMetricRegistry struct{}
 
+// Gather gathers the app metrics, i.e., the same metrics that are exposed to Prometheus by the metrics HTTP service
+func (a AppMetricRegistry) Gather() ([]*dto.MetricFamily, error) {
+	return metricsRegistry.Gather()
+}
+
 // NewRegistry creates a new registry.
 // If collectProcessMetrics = true, then the prometheus GoCollector and ProcessCollectors are registered.
 func newMetricsRegistry(collectProcessMetrics bool) *prometheus.Registry {
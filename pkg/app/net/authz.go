@@ -0,0 +1,284 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
+	"zombiezen.com/go/capnproto2"
+)
+
+type ctx_authorizer command.ContextKey
+
+// Operation is what the peer is requesting to do, i.e., send a message type, or call an RPC method.
+// For RPC methods, the InterfaceID is set.
+type Operation struct {
+	MessageType
+
+	InterfaceID uint64
+	MethodID    uint16
+}
+
+// MessageOperation returns the Operation for sending the message type
+func MessageOperation(messageType MessageType) Operation {
+	return Operation{MessageType: messageType}
+}
+
+// MethodOperation returns the Operation for calling the RPC method
+func MethodOperation(interfaceID uint64, methodID uint16) Operation {
+	return Operation{InterfaceID: interfaceID, MethodID: methodID}
+}
+
+// IsMethod returns true if the operation is an RPC method call
+func (a Operation) IsMethod() bool {
+	return a.InterfaceID != 0
+}
+
+func (a Operation) String() string {
+	if a.IsMethod() {
+		return fmt.Sprintf("method(%x.%d)", a.InterfaceID, a.MethodID)
+	}
+	return fmt.Sprintf("message(%x)", a.MessageType)
+}
+
+// Authorizer returns true if the peer is allowed to perform the operation.
+// The identity is nil if the peer did not present a verified cert.
+type Authorizer func(identity *PeerIdentity, op Operation) bool
+
+// WithAuthorizer adds the Authorizer to the Context. The Server adds its Authorizer to the ConnHandler Context.
+func WithAuthorizer(ctx context.Context, authorizer Authorizer) context.Context {
+	return context.WithValue(ctx, ctx_authorizer{}, authorizer)
+}
+
+// AuthorizerFromContext returns the Context Authorizer, or nil if the Context has no Authorizer
+func AuthorizerFromContext(ctx context.Context) Authorizer {
+	authorizer, _ := ctx.Value(ctx_authorizer{}).(Authorizer)
+	return authorizer
+}
+
+// Authorize checks if the Context peer is allowed to perform the operation - see PeerIdentityFromContext().
+// If the Context has no Authorizer, then the operation is allowed.
+//
+// errors:
+//	- ErrSpec_Unauthorized
+func Authorize(ctx context.Context, serviceID app.ServiceID, op Operation) *app.Error {
+	authorizer := AuthorizerFromContext(ctx)
+	if authorizer == nil {
+		return nil
+	}
+	identity := PeerIdentityFromContext(ctx)
+	if authorizer(identity, op) {
+		return nil
+	}
+	event := UNAUTHORIZED.Log(app.Logger().Warn()).Uint64("service", serviceID.UInt64()).Str("op", op.String())
+	if identity != nil {
+		event = event.Str("cn", identity.CN)
+	}
+	event.Msg("unauthorized")
+	return UnauthorizedError(serviceID, op)
+}
+
+// authorizeRequest authorizes the request message type. The built-in protocol message types are always allowed.
+func authorizeRequest(ctx context.Context, serviceID app.ServiceID, request message.Message) *app.Error {
+	switch messageType := MessageType(request.Type()); messageType {
+	case MessageType_PING, MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST:
+		return nil
+	default:
+		return Authorize(ctx, serviceID, MessageOperation(messageType))
+	}
+}
+
+// unauthorizedResponse returns the error response for an unauthorized request. If the request opened a stream, then the
+// stream is finished with the error, and nil is returned.
+func unauthorizedResponse(ctx context.Context, service *app.Service, streams *connStreams, stream *Stream, request message.Message, err *app.Error) *capnp.Message {
+	if stream != nil {
+		go func() {
+			defer streams.remove(stream.ID())
+			if e := stream.finish(ctx, err); e != nil && ctx.Err() == nil && !app.IsError(e, ErrSpec_StreamClosed.ErrorID) {
+				MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(e).Msg("failed to finish stream")
+			}
+		}()
+		return nil
+	}
+	response, e := NewErrorResponse(request, err)
+	if e != nil {
+		MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(e).Msg("failed to create error response message")
+		return nil
+	}
+	return response
+}
+
+// PeerMatcher matches peers by their cert identity. Fields that are not set match any value.
+type PeerMatcher struct {
+	CN           string
+	Organization string
+
+	app.DomainID
+	app.AppID
+	app.ServiceID
+}
+
+// Matches returns true if the peer identity matches. A nil identity never matches.
+func (a PeerMatcher) Matches(identity *PeerIdentity) bool {
+	if identity == nil {
+		return false
+	}
+	if a.CN != "" && a.CN != identity.CN {
+		return false
+	}
+	if a.Organization != "" && !containsString(identity.Organization, a.Organization) {
+		return false
+	}
+	if a.DomainID != app.DomainID(0) && a.DomainID != identity.DomainID {
+		return false
+	}
+	if a.AppID != app.AppID(0) && a.AppID != identity.AppID {
+		return false
+	}
+	if a.ServiceID != app.ServiceID(0) && a.ServiceID != identity.ServiceID {
+		return false
+	}
+	return true
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthzRule allows the matching peers to perform the operation
+type AuthzRule struct {
+	Operation
+	Allow []PeerMatcher
+}
+
+// AuthzPolicy is a rule based Authorizer. An operation is allowed if any of the matchers for the operation's rules match
+// the peer. If there are no rules for the operation, then DefaultAllow applies.
+type AuthzPolicy struct {
+	DefaultAllow bool
+	Rules        []AuthzRule
+}
+
+// NewAuthzPolicy converts the config.AuthzPolicy
+//
+// errors:
+//	- ErrSpec_IllegalArgument if a rule specifies both a message type and an RPC method, or neither
+func NewAuthzPolicy(spec config.AuthzPolicy) (*AuthzPolicy, error) {
+	policy := &AuthzPolicy{DefaultAllow: spec.DefaultAllow()}
+	rules, err := spec.Rules()
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < rules.Len(); i++ {
+		ruleSpec := rules.At(i)
+		rule := AuthzRule{
+			Operation: Operation{
+				MessageType: MessageType(ruleSpec.MessageType()),
+				InterfaceID: ruleSpec.InterfaceId(),
+				MethodID:    ruleSpec.MethodId(),
+			},
+		}
+		if (rule.MessageType == 0) == (rule.InterfaceID == 0) {
+			return nil, app.IllegalArgumentError(fmt.Sprintf("AuthzRule must specify either a message type or an RPC method : %v", rule.Operation))
+		}
+		matchers, err := ruleSpec.Allow()
+		if err != nil {
+			return nil, err
+		}
+		for j := 0; j < matchers.Len(); j++ {
+			matcherSpec := matchers.At(j)
+			matcher := PeerMatcher{
+				DomainID:  app.DomainID(matcherSpec.DomainID()),
+				AppID:     app.AppID(matcherSpec.AppId()),
+				ServiceID: app.ServiceID(matcherSpec.ServiceId()),
+			}
+			if matcher.CN, err = matcherSpec.Cn(); err != nil {
+				return nil, err
+			}
+			if matcher.Organization, err = matcherSpec.Organization(); err != nil {
+				return nil, err
+			}
+			rule.Allow = append(rule.Allow, matcher)
+		}
+		policy.Rules = append(policy.Rules, rule)
+	}
+	return policy, nil
+}
+
+// ToCapnp converts the policy to its config representation
+func (a *AuthzPolicy) ToCapnp(s *capnp.Segment) (config.AuthzPolicy, error) {
+	spec, err := config.NewAuthzPolicy(s)
+	if err != nil {
+		return spec, err
+	}
+	spec.SetDefaultAllow(a.DefaultAllow)
+	rules, err := spec.NewRules(int32(len(a.Rules)))
+	if err != nil {
+		return spec, err
+	}
+	for i, rule := range a.Rules {
+		ruleSpec := rules.At(i)
+		ruleSpec.SetMessageType(rule.MessageType.UInt64())
+		ruleSpec.SetInterfaceId(rule.InterfaceID)
+		ruleSpec.SetMethodId(rule.MethodID)
+		matchers, err := ruleSpec.NewAllow(int32(len(rule.Allow)))
+		if err != nil {
+			return spec, err
+		}
+		for j, matcher := range rule.Allow {
+			matcherSpec := matchers.At(j)
+			if err := matcherSpec.SetCn(matcher.CN); err != nil {
+				return spec, err
+			}
+			if err := matcherSpec.SetOrganization(matcher.Organization); err != nil {
+				return spec, err
+			}
+			matcherSpec.SetDomainID(matcher.DomainID.UInt64())
+			matcherSpec.SetAppId(matcher.AppID.UInt64())
+			matcherSpec.SetServiceId(matcher.ServiceID.UInt64())
+		}
+	}
+	return spec, nil
+}
+
+// Authorizer returns the Authorizer for the policy. The policy rules are indexed by operation.
+func (a *AuthzPolicy) Authorizer() Authorizer {
+	rules := make(map[Operation][]PeerMatcher, len(a.Rules))
+	for _, rule := range a.Rules {
+		rules[rule.Operation] = append(rules[rule.Operation], rule.Allow...)
+	}
+	defaultAllow := a.DefaultAllow
+	return func(identity *PeerIdentity, op Operation) bool {
+		matchers, ok := rules[op]
+		if !ok {
+			return defaultAllow
+		}
+		for _, matcher := range matchers {
+			if matcher.Matches(identity) {
+				return true
+			}
+		}
+		return false
+	}
+}
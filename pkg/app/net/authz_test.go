@@ -0,0 +1,145 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestAuthzPolicy(t *testing.T) {
+	const (
+		ECHO      = opnet.MessageType(0xe3e9a3d4c4d40e9a)
+		INTERFACE = uint64(0xa4d2b3a6b1e2c3d4)
+	)
+
+	serviceID := app.ServiceID(0xd113a2e016e12f0f)
+	clientServiceID := app.ServiceID(0x9f20a2c0a0b4e5a1)
+
+	serviceIdentity := opnet.NewPeerIdentity(&x509.Certificate{
+		Subject: pkix.Name{CommonName: opnet.ServerCN(app.DomainID(1), app.AppID(2), clientServiceID)},
+	})
+	userIdentity := opnet.NewPeerIdentity(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "alice", Organization: []string{"ops"}},
+	})
+
+	t.Run("ParseServerCN", func(t *testing.T) {
+		if !serviceIdentity.IsService() {
+			t.Error("service identity was expected")
+		}
+		if serviceIdentity.DomainID != app.DomainID(1) || serviceIdentity.AppID != app.AppID(2) || serviceIdentity.ServiceID != clientServiceID {
+			t.Errorf("ids do not match : %v", serviceIdentity)
+		}
+		if userIdentity.IsService() {
+			t.Error("user identity is not a service")
+		}
+		if _, _, _, err := opnet.ParseServerCN("alice"); err == nil {
+			t.Error("invalid CN should have failed to parse")
+		}
+	})
+
+	policy := &opnet.AuthzPolicy{
+		DefaultAllow: true,
+		Rules: []opnet.AuthzRule{
+			{
+				Operation: opnet.MessageOperation(ECHO),
+				Allow:     []opnet.PeerMatcher{{ServiceID: clientServiceID}},
+			},
+			{
+				Operation: opnet.MethodOperation(INTERFACE, 1),
+				Allow:     []opnet.PeerMatcher{{Organization: "ops"}},
+			},
+		},
+	}
+
+	t.Run("Authorizer", func(t *testing.T) {
+		authorizer := policy.Authorizer()
+		checks := []struct {
+			identity *opnet.PeerIdentity
+			op       opnet.Operation
+			allowed  bool
+		}{
+			{serviceIdentity, opnet.MessageOperation(ECHO), true},
+			{userIdentity, opnet.MessageOperation(ECHO), false},
+			{nil, opnet.MessageOperation(ECHO), false},
+			{userIdentity, opnet.MethodOperation(INTERFACE, 1), true},
+			{serviceIdentity, opnet.MethodOperation(INTERFACE, 1), false},
+			// no rules for the operation, i.e., DefaultAllow applies
+			{userIdentity, opnet.MethodOperation(INTERFACE, 2), true},
+		}
+		for _, check := range checks {
+			if authorizer(check.identity, check.op) != check.allowed {
+				t.Errorf("%v : %v : expected allowed = %v", check.identity, check.op, check.allowed)
+			}
+		}
+	})
+
+	t.Run("ToCapnp", func(t *testing.T) {
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec, err := policy.ToCapnp(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		policy2, err := opnet.NewAuthzPolicy(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if policy2.DefaultAllow != policy.DefaultAllow || len(policy2.Rules) != len(policy.Rules) {
+			t.Fatalf("policy does not match : %v", policy2)
+		}
+		for i, rule := range policy2.Rules {
+			if rule.Operation != policy.Rules[i].Operation {
+				t.Errorf("operation does not match : %v != %v", rule.Operation, policy.Rules[i].Operation)
+			}
+			if len(rule.Allow) != 1 || rule.Allow[0] != policy.Rules[i].Allow[0] {
+				t.Errorf("matchers do not match : %v", rule.Allow)
+			}
+		}
+
+		invalidPolicy := &opnet.AuthzPolicy{Rules: []opnet.AuthzRule{{Operation: opnet.Operation{MessageType: ECHO, InterfaceID: INTERFACE}}}}
+		spec, err = invalidPolicy.ToCapnp(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := opnet.NewAuthzPolicy(spec); err == nil {
+			t.Error("a rule that specifies both a message type and a method should be invalid")
+		}
+	})
+
+	t.Run("Authorize", func(t *testing.T) {
+		// when there is no authorizer, then all operations are authorized
+		if err := opnet.Authorize(context.Background(), serviceID, opnet.MessageOperation(ECHO)); err != nil {
+			t.Errorf("should be authorized : %v", err)
+		}
+
+		ctx := opnet.WithAuthorizer(context.Background(), policy.Authorizer())
+		if err := opnet.Authorize(opnet.WithPeerIdentity(ctx, serviceIdentity), serviceID, opnet.MessageOperation(ECHO)); err != nil {
+			t.Errorf("should be authorized : %v", err)
+		}
+		err := opnet.Authorize(opnet.WithPeerIdentity(ctx, userIdentity), serviceID, opnet.MessageOperation(ECHO))
+		if err == nil || err.ErrorID != opnet.ErrSpec_Unauthorized.ErrorID {
+			t.Errorf("ErrSpec_Unauthorized was expected : %v", err)
+		}
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"net"
+	"strings"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opsync "github.com/oysterpack/oysterpack.go/pkg/app/sync"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
+)
+
+// SERVER_BUSY_REPLY_TIMEOUT is the deadline for the ServerBusy reply, i.e., clients that do not complete the TLS handshake
+// and read the reply in time are simply disconnected
+const SERVER_BUSY_REPLY_TIMEOUT = time.Second
+
+// MAX_BUSY_CONN_REJECTIONS is the max number of ServerBusy replies that a server sends concurrently - see BusyConnRejecter
+const MAX_BUSY_CONN_REJECTIONS = 64
+
+// the server busy reason format is a protocol contract, i.e., clients parse the retry hint from it - see ServerBusyRetryAfter()
+const serverBusyReasonPrefix = "server busy, retry after "
+
+// ServerBusyReason returns the reason that is sent to clients that are rejected because the server is at max conns.
+// It is used by protocols that can only carry a text reason, e.g., capnp RPC aborts.
+func ServerBusyReason(retryAfter time.Duration) string {
+	return serverBusyReasonPrefix + retryAfter.String()
+}
+
+// ServerBusyRetryAfter parses the retry hint from the error, i.e., the error message must contain the ServerBusyReason().
+// It returns false if the error is not a server busy error.
+func ServerBusyRetryAfter(err error) (time.Duration, bool) {
+	if err == nil {
+		return 0, false
+	}
+	msg := err.Error()
+	i := strings.Index(msg, serverBusyReasonPrefix)
+	if i < 0 {
+		return 0, false
+	}
+	fields := strings.Fields(msg[i+len(serverBusyReasonPrefix):])
+	if len(fields) == 0 {
+		return 0, false
+	}
+	retryAfter, err := time.ParseDuration(strings.TrimRight(fields[0], ".,;:)"))
+	if err != nil || retryAfter < 0 {
+		return 0, false
+	}
+	return retryAfter, true
+}
+
+// NewServerBusyMessage returns a ServerBusy message, which tells the client that the server is at max conns, and to retry
+// after the specified duration. The server closes the connection after sending the message.
+func NewServerBusyMessage(retryAfter time.Duration) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	serverBusy, err := message.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
+	serverBusy.SetId(uid.NextUIDHash().UInt64())
+	serverBusy.SetType(MessageType_SERVER_BUSY.UInt64())
+	serverBusy.SetTimestamp(time.Now().UnixNano())
+
+	data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	serverBusyData, err := message.NewRootServerBusy(seg)
+	if err != nil {
+		return nil, err
+	}
+	serverBusyData.SetRetryAfterMSec(uint32(retryAfter / time.Millisecond))
+	if err := message.SetData(&serverBusy, data); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// serverBusyRetryAfter returns the retry hint carried by the ServerBusy message
+func serverBusyRetryAfter(msg *message.Message) (time.Duration, error) {
+	data, err := message.Data(msg)
+	if err != nil {
+		return 0, err
+	}
+	serverBusy, err := message.ReadRootServerBusy(data)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(serverBusy.RetryAfterMSec()) * time.Millisecond, nil
+}
+
+// RejectBusyConn sends the busy reply to the client, and then closes the conn. The reply must be sent within the
+// SERVER_BUSY_REPLY_TIMEOUT. For TLS conns, the reply write drives the TLS handshake, i.e., the deadline applies to the
+// handshake reads as well as the reply write.
+func RejectBusyConn(conn net.Conn, reply func(conn net.Conn) error) error {
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(SERVER_BUSY_REPLY_TIMEOUT)); err != nil {
+		return err
+	}
+	return reply(conn)
+}
+
+// BusyConnRejecter rejects busy conns asynchronously, bounding the number of busy replies that are in flight. Once the
+// max is reached, conns are closed immediately, i.e., without a reply.
+type BusyConnRejecter struct {
+	tokens *opsync.CountingSemaphore
+}
+
+// NewBusyConnRejecter returns a new BusyConnRejecter - see MAX_BUSY_CONN_REJECTIONS
+func NewBusyConnRejecter(maxInFlight uint) *BusyConnRejecter {
+	return &BusyConnRejecter{opsync.NewCountingSemaphore(maxInFlight)}
+}
+
+// Reject sends the busy reply in a new goroutine - see RejectBusyConn(). done is invoked with the result once the conn
+// is closed. If the max number of busy replies are in flight, then the conn is closed, and false is returned.
+func (a *BusyConnRejecter) Reject(conn net.Conn, reply func(conn net.Conn) error, done func(err error)) bool {
+	select {
+	case <-a.tokens.C:
+	default:
+		conn.Close()
+		return false
+	}
+	go func() {
+		err := RejectBusyConn(conn, reply)
+		a.tokens.ReturnToken()
+		done(err)
+	}()
+	return true
+}
+
+// replyServerBusy sends the ServerBusy message using the message framing protocol, i.e., packed message.Message frames
+func replyServerBusy(retryAfter time.Duration) func(conn net.Conn) error {
+	return func(conn net.Conn) error {
+		msg, err := NewServerBusyMessage(retryAfter)
+		if err != nil {
+			return err
+		}
+		return capnp.NewPackedEncoder(conn).Encode(msg)
+	}
+}
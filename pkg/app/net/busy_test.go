@@ -0,0 +1,135 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+)
+
+func TestServerBusyRetryAfter(t *testing.T) {
+	retryAfter := 1500 * time.Millisecond
+
+	errs := []error{
+		errors.New(opnet.ServerBusyReason(retryAfter)),
+		opnet.ServerBusyError(app.ServiceID(0xe49214fa20b35ba8), retryAfter),
+		// e.g., a capnp RPC abort, where the reason is wrapped
+		errors.New("rpc: aborted by remote: " + opnet.ServerBusyReason(retryAfter)),
+	}
+	for _, err := range errs {
+		if d, ok := opnet.ServerBusyRetryAfter(err); !ok || d != retryAfter {
+			t.Errorf("retry hint was not parsed : %v : %v, %v", err, d, ok)
+		}
+	}
+
+	for _, err := range []error{nil, errors.New("connection refused"), errors.New("server busy, retry after soon")} {
+		if _, ok := opnet.ServerBusyRetryAfter(err); ok {
+			t.Errorf("should not be a server busy error : %v", err)
+		}
+	}
+}
+
+func TestRejectBusyConn(t *testing.T) {
+	server, client := net.Pipe()
+	go opnet.RejectBusyConn(server, func(conn net.Conn) error {
+		_, err := conn.Write([]byte("busy"))
+		return err
+	})
+
+	// the client receives the reply, and then the conn is closed
+	data, err := ioutil.ReadAll(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "busy" {
+		t.Errorf("the busy reply was not received : %q", data)
+	}
+}
+
+func TestRejectBusyConn_Deadline(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	errs := make(chan error, 1)
+	// e.g., a TLS handshake that waits for a ClientHello that never comes
+	go func() {
+		errs <- opnet.RejectBusyConn(server, func(conn net.Conn) error {
+			_, err := conn.Read(make([]byte, 1))
+			return err
+		})
+	}()
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("the reply should have timed out")
+		}
+	case <-time.After(opnet.SERVER_BUSY_REPLY_TIMEOUT * 2):
+		t.Fatal("the reply reads should be bounded by the deadline")
+	}
+}
+
+func TestBusyConnRejecter(t *testing.T) {
+	rejecter := opnet.NewBusyConnRejecter(1)
+	release := make(chan struct{})
+	done := make(chan error, 1)
+
+	server1, client1 := net.Pipe()
+	defer client1.Close()
+	if !rejecter.Reject(server1, func(conn net.Conn) error {
+		<-release
+		_, err := conn.Write([]byte("busy"))
+		return err
+	}, func(err error) { done <- err }) {
+		t.Fatal("the first conn should have been replied to")
+	}
+
+	// the max number of replies are in flight, i.e., the conn is closed without a reply
+	server2, client2 := net.Pipe()
+	if rejecter.Reject(server2, func(conn net.Conn) error {
+		t.Error("the second conn should not have been replied to")
+		return nil
+	}, func(err error) {}) {
+		t.Error("the second conn should have been closed without a reply")
+	}
+	if data, err := ioutil.ReadAll(client2); err != nil || len(data) != 0 {
+		t.Errorf("the second conn should have been closed : %q : %v", data, err)
+	}
+
+	close(release)
+	if data, err := ioutil.ReadAll(client1); err != nil || string(data) != "busy" {
+		t.Errorf("the busy reply was not received : %q : %v", data, err)
+	}
+	if err := <-done; err != nil {
+		t.Error(err)
+	}
+
+	// once the reply is done, conns are replied to again
+	server3, client3 := net.Pipe()
+	if !rejecter.Reject(server3, func(conn net.Conn) error {
+		_, err := conn.Write([]byte("busy"))
+		return err
+	}, func(err error) {}) {
+		t.Error("the third conn should have been replied to")
+	}
+	if data, err := ioutil.ReadAll(client3); err != nil || string(data) != "busy" {
+		t.Errorf("the busy reply was not received : %q : %v", data, err)
+	}
+}
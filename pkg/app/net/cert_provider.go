@@ -0,0 +1,436 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// how often the CertProvider checks for new certs, and refreshes the cert expiry gauges
+	CERT_RELOAD_INTERVAL = 30 * time.Second
+
+	// The revocation files are stored in the config dir alongside the service config file, e.g.,
+	//
+	//	/run/secrets/0xe49214fa20b35ba8.crl
+	//	/run/secrets/0xe49214fa20b35ba8.denied
+	CRL_FILE_EXT            = ".crl"
+	DENIED_SERIALS_FILE_EXT = ".denied"
+)
+
+// CertSource is the server key pair, the CA bundle that is used to verify client certs, and the revocation lists.
+type CertSource struct {
+	// PEM file format
+	Cert []byte
+	// PEM file format
+	Key []byte
+	// PEM file format - may contain multiple CA certs
+	CACert []byte
+
+	// optional CRL in PEM or DER format - it must be signed by one of the CA certs
+	CRL []byte
+	// optional deny-list of cert serial numbers in hex, one per line - blank lines and lines starting with '#' are ignored
+	DeniedSerials []byte
+}
+
+// CertLoader loads the current CertSource
+type CertLoader func() (*CertSource, error)
+
+// LoadRevocationFiles reads the service CRL and serial deny-list files from the config dir into the CertSource.
+// Both files are optional - see CRL_FILE_EXT and DENIED_SERIALS_FILE_EXT.
+func LoadRevocationFiles(serviceID app.ServiceID, source *CertSource) error {
+	path := app.Configs.ServiceConfigPath(serviceID)
+	crl, err := readOptionalFile(path + CRL_FILE_EXT)
+	if err != nil {
+		return err
+	}
+	deniedSerials, err := readOptionalFile(path + DENIED_SERIALS_FILE_EXT)
+	if err != nil {
+		return err
+	}
+	source.CRL, source.DeniedSerials = crl, deniedSerials
+	return nil
+}
+
+func readOptionalFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	return data, err
+}
+
+// CertProvider provides the server TLS config via GetConfigForClient, i.e., each TLS handshake uses the certs that are
+// currently loaded. When new certs are loaded, existing connections are not affected.
+//
+// The CertProvider checks for new certs every CERT_RELOAD_INTERVAL - Reload() can be used to reload the certs on demand.
+// If the certs fail to load, then the previously loaded certs remain in use.
+//
+// Client certs are checked against the CRL and the serial deny-list. Revoked client certs fail the TLS handshake.
+//
+// The number of seconds until each loaded cert expires is reported via the SERVER_CERT_EXPIRY_METRIC_ID gauge vector, if
+// the gauge vector is registered.
+type CertProvider struct {
+	service *app.Service
+	load    CertLoader
+
+	certExpiry *prometheus.GaugeVec
+
+	mutex       sync.RWMutex
+	sourceHash  [sha256.Size]byte
+	cert        *tls.Certificate
+	leaf        *x509.Certificate
+	caCerts     []*x509.Certificate
+	tlsConfig   *tls.Config
+	revocations *revocations
+}
+
+// NewCertProvider loads the certs, and starts watching for new certs. The watcher is bound to the service lifecycle.
+//
+// errors:
+//	- app.ConfigError if the SERVER_CERT_EXPIRY_METRIC_ID gauge vector is registered with labels other than CERT_EXPIRY_METRIC_LABELS
+//	- TLSConfigError if the certs fail to load
+func NewCertProvider(service *app.Service, load CertLoader) (*CertProvider, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if load == nil {
+		return nil, app.IllegalArgumentError("CertLoader cannot be nil")
+	}
+	// the cert expiry metric is optional
+	certExpiry := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: SERVER_CERT_EXPIRY_METRIC_ID.PrometheusName(service.ID())}, CERT_EXPIRY_METRIC_LABELS)
+	if metric := app.MetricRegistry.GaugeVector(service.ID(), SERVER_CERT_EXPIRY_METRIC_ID); metric != nil {
+		if !sameLabels(metric.DynamicLabels, CERT_EXPIRY_METRIC_LABELS) {
+			err := fmt.Errorf("Server cert expiry gauge vector labels must be %v : %v", CERT_EXPIRY_METRIC_LABELS, metric.DynamicLabels)
+			return nil, app.ConfigError(service.ID(), err, "")
+		}
+		certExpiry = metric.GaugeVec
+	}
+
+	provider := &CertProvider{
+		service:    service,
+		load:       load,
+		certExpiry: certExpiry,
+	}
+	if err := provider.Reload(); err != nil {
+		return nil, err
+	}
+	service.Go(provider.watch)
+	return provider, nil
+}
+
+func sameLabels(labels, expected []string) bool {
+	if len(labels) != len(expected) {
+		return false
+	}
+	for i := range labels {
+		if labels[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Reload loads the certs. If the certs have not changed, then this is a no-op.
+//
+// errors:
+//	- TLSConfigError - the previously loaded certs remain in use
+func (a *CertProvider) Reload() error {
+	source, err := a.load()
+	if err == nil && source == nil {
+		err = errors.New("CertLoader returned nil")
+	}
+	if err != nil {
+		return a.reloadFailed(err)
+	}
+
+	hash := source.hash()
+	a.mutex.RLock()
+	unchanged := a.cert != nil && hash == a.sourceHash
+	a.mutex.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	cert, err := tls.X509KeyPair(source.Cert, source.Key)
+	if err != nil {
+		return a.reloadFailed(err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return a.reloadFailed(err)
+	}
+	caCerts, err := parsePEMCerts(source.CACert)
+	if err != nil {
+		return a.reloadFailed(err)
+	}
+	clientCAs := x509.NewCertPool()
+	for _, caCert := range caCerts {
+		clientCAs.AddCert(caCert)
+	}
+	revocations, err := newRevocations(source, caCerts)
+	if err != nil {
+		return a.reloadFailed(err)
+	}
+
+	tlsConfig := newServerTLSConfig(clientCAs, cert)
+	tlsConfig.VerifyPeerCertificate = a.verifyPeerCertificate
+
+	a.mutex.Lock()
+	a.sourceHash = hash
+	a.cert = &cert
+	a.leaf = leaf
+	a.caCerts = caCerts
+	a.tlsConfig = tlsConfig
+	a.revocations = revocations
+	a.mutex.Unlock()
+
+	a.updateCertExpiry()
+	CERT_RELOADED.Log(a.service.Logger().Info()).
+		Str("cn", leaf.Subject.CommonName).
+		Str("serial", SerialHex(leaf.SerialNumber)).
+		Time("not_after", leaf.NotAfter).
+		Int("ca_certs", len(caCerts)).
+		Int("revoked", revocations.count()).
+		Msg("certs loaded")
+	return nil
+}
+
+func (a *CertProvider) reloadFailed(err error) error {
+	CERT_RELOAD_FAILED.Log(a.service.Logger().Error()).Err(err).Msg("failed to load certs")
+	return TLSConfigError(a.service.ID(), err)
+}
+
+func (a *CertProvider) watch() error {
+	ticker := time.NewTicker(CERT_RELOAD_INTERVAL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.service.Dying():
+			return nil
+		case <-ticker.C:
+			// errors are logged - the current certs remain in use
+			a.Reload()
+			a.updateCertExpiry()
+		}
+	}
+}
+
+// updateCertExpiry reports the time until each loaded cert expires. Certs that are no longer loaded are removed.
+func (a *CertProvider) updateCertExpiry() {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	a.certExpiry.Reset()
+	now := time.Now()
+	setExpiry := func(certType string, cert *x509.Certificate) {
+		a.certExpiry.With(prometheus.Labels{
+			CERT_EXPIRY_LABEL_TYPE:   certType,
+			CERT_EXPIRY_LABEL_CN:     cert.Subject.CommonName,
+			CERT_EXPIRY_LABEL_SERIAL: SerialHex(cert.SerialNumber),
+		}).Set(cert.NotAfter.Sub(now).Seconds())
+	}
+	setExpiry("server", a.leaf)
+	for _, caCert := range a.caCerts {
+		setExpiry("ca", caCert)
+	}
+}
+
+// GetCertificate returns the server cert that is currently loaded - see tls.Config.GetCertificate
+func (a *CertProvider) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.cert, nil
+}
+
+// GetConfigForClient returns the TLS config for the certs that are currently loaded - see tls.Config.GetConfigForClient
+func (a *CertProvider) GetConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.tlsConfig, nil
+}
+
+// TLSConfig returns the TLS config that is used to create the TLS listener. The config is resolved per client handshake
+// via GetConfigForClient().
+func (a *CertProvider) TLSConfig() (*tls.Config, error) {
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ClientAuth:         tls.RequireAndVerifyClientCert,
+		GetCertificate:     a.GetCertificate,
+		GetConfigForClient: a.GetConfigForClient,
+	}, nil
+}
+
+// Cert returns the server cert that is currently loaded
+func (a *CertProvider) Cert() *x509.Certificate {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.leaf
+}
+
+// Revoked returns true if the cert is listed in the CRL or in the serial deny-list
+func (a *CertProvider) Revoked(cert *x509.Certificate) bool {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.revocations.revoked(cert)
+}
+
+// verifyPeerCertificate fails the TLS handshake if any cert in the verified chains is revoked
+func (a *CertProvider) verifyPeerCertificate(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+	for _, chain := range verifiedChains {
+		for _, cert := range chain {
+			if a.Revoked(cert) {
+				CERT_REVOKED.Log(a.service.Logger().Warn()).
+					Str("cn", cert.Subject.CommonName).
+					Str("serial", SerialHex(cert.SerialNumber)).
+					Msg("revoked cert was rejected")
+				return CertRevokedError(a.service.ID(), SerialHex(cert.SerialNumber))
+			}
+		}
+	}
+	return nil
+}
+
+func (a *CertSource) hash() [sha256.Size]byte {
+	hash := sha256.New()
+	for _, data := range [][]byte{a.Cert, a.Key, a.CACert, a.CRL, a.DeniedSerials} {
+		// the length prefix ensures that the hash is unambiguous
+		fmt.Fprintf(hash, "%d:", len(data))
+		hash.Write(data)
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], hash.Sum(nil))
+	return sum
+}
+
+// parsePEMCerts parses all of the certs in the PEM data. At least 1 cert is required.
+func parsePEMCerts(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, errors.New("Failed to parse PEM encoded cert(s)")
+	}
+	return certs, nil
+}
+
+// SerialHex is the cert serial number format that is used by the serial deny-list, e.g., 7f3a0c
+func SerialHex(serial *big.Int) string {
+	return fmt.Sprintf("%x", serial)
+}
+
+// revocations indexes the revoked cert serial numbers
+type revocations struct {
+	// CRL revocations apply to certs that are issued by the CRL issuer
+	crlIssuer []byte
+	crl       map[string]struct{}
+	// deny-list serials apply to certs from any issuer
+	denied map[string]struct{}
+}
+
+// newRevocations parses the CRL and deny-list. The CRL signature must be verified by one of the CA certs.
+// If the CRL has expired, then a warning is logged, and its revocations still apply.
+func newRevocations(source *CertSource, caCerts []*x509.Certificate) (*revocations, error) {
+	revocations := &revocations{
+		crl:    make(map[string]struct{}),
+		denied: make(map[string]struct{}),
+	}
+
+	if len(source.CRL) > 0 {
+		crl, err := x509.ParseCRL(source.CRL)
+		if err != nil {
+			return nil, err
+		}
+		var issuer *x509.Certificate
+		for _, caCert := range caCerts {
+			if caCert.CheckCRLSignature(crl) == nil {
+				issuer = caCert
+				break
+			}
+		}
+		if issuer == nil {
+			return nil, errors.New("CRL is not signed by any of the CA certs")
+		}
+		if crl.HasExpired(time.Now()) {
+			CRL_EXPIRED.Log(app.Logger().Warn()).Time("next_update", crl.TBSCertList.NextUpdate).Msg("CRL has expired")
+		}
+		revocations.crlIssuer = issuer.RawSubject
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			revocations.crl[SerialHex(revoked.SerialNumber)] = struct{}{}
+		}
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(source.DeniedSerials))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		// serials may be formatted with colons, e.g., 0a:1b:2c
+		serial, ok := new(big.Int).SetString(strings.Replace(strings.TrimPrefix(strings.ToLower(line), "0x"), ":", "", -1), 16)
+		if !ok {
+			return nil, fmt.Errorf("Invalid cert serial in deny-list : %q", line)
+		}
+		revocations.denied[SerialHex(serial)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return revocations, nil
+}
+
+func (a *revocations) revoked(cert *x509.Certificate) bool {
+	serial := SerialHex(cert.SerialNumber)
+	if _, ok := a.denied[serial]; ok {
+		return true
+	}
+	if _, ok := a.crl[serial]; ok && bytes.Equal(cert.RawIssuer, a.crlIssuer) {
+		return true
+	}
+	return false
+}
+
+func (a *revocations) count() int {
+	return len(a.crl) + len(a.denied)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+func (a *testCert) tlsCert(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(a.certPEM, a.keyPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// newTestCert creates a cert that is signed by the parent. If the parent is nil, then a self-signed CA cert is created.
+func newTestCert(t *testing.T, serial int64, cn string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn, Organization: []string{"oysterpack"}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{cn},
+	}
+	issuer, issuerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+		template.ExtKeyUsage = nil
+	} else {
+		issuer, issuerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func TestCertProvider(t *testing.T) {
+	const (
+		DOMAIN_ID  = app.DomainID(0xed5cf026e8734361)
+		APP_ID     = app.AppID(0xd113a2e016e12f0f)
+		SERVICE_ID = app.ServiceID(0xcc4d0a9a3a8f4b1d)
+	)
+
+	configDir := "./testdata/cert_provider_test/TestCertProvider"
+	initConfigDir(configDir)
+	initServerMetricsConfig(SERVICE_ID)
+	app.ResetWithConfigDir(configDir)
+	defer app.Reset()
+
+	service := app.NewService(SERVICE_ID)
+	defer service.Kill(nil)
+
+	serverCN := opnet.ServerCN(DOMAIN_ID, APP_ID, SERVICE_ID)
+	ca := newTestCert(t, 1, "ca.dev.oysterpack.com", nil)
+	serverCert := newTestCert(t, 2, serverCN, ca)
+	client1 := newTestCert(t, 3, "client1.dev.oysterpack.com", ca)
+	client2 := newTestCert(t, 4, "client2.dev.oysterpack.com", ca)
+
+	var sourceMutex sync.Mutex
+	source := opnet.CertSource{Cert: serverCert.certPEM, Key: serverCert.keyPEM, CACert: ca.certPEM}
+	setSource := func(update func(source *opnet.CertSource)) {
+		sourceMutex.Lock()
+		defer sourceMutex.Unlock()
+		update(&source)
+	}
+	loader := func() (*opnet.CertSource, error) {
+		sourceMutex.Lock()
+		defer sourceMutex.Unlock()
+		loaded := source
+		return &loaded, nil
+	}
+
+	certProvider, err := opnet.NewCertProvider(service, loader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.cert)
+	// returns the server cert that was presented to the client, and the server handshake error
+	handshake := func(t *testing.T, client *testCert) (*x509.Certificate, error) {
+		tlsConfig, err := certProvider.TLSConfig()
+		if err != nil {
+			t.Fatal(err)
+		}
+		serverConn, clientConn := net.Pipe()
+		defer serverConn.Close()
+		defer clientConn.Close()
+		deadline := time.Now().Add(5 * time.Second)
+		serverConn.SetDeadline(deadline)
+		clientConn.SetDeadline(deadline)
+
+		serverErr := make(chan error, 1)
+		go func() {
+			serverErr <- tls.Server(serverConn, tlsConfig).Handshake()
+		}()
+		tlsClientConn := tls.Client(clientConn, &tls.Config{
+			RootCAs:      rootCAs,
+			ServerName:   serverCN,
+			Certificates: []tls.Certificate{client.tlsCert(t)},
+			// with TLS 1.2, the client cert is verified before the client handshake completes
+			MaxVersion: tls.VersionTLS12,
+		})
+		clientErr := tlsClientConn.Handshake()
+		err = <-serverErr
+		if err == nil && clientErr != nil {
+			t.Fatal(clientErr)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return tlsClientConn.ConnectionState().PeerCertificates[0], nil
+	}
+
+	t.Run("handshake", func(t *testing.T) {
+		cert, err := handshake(t, client1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cert.SerialNumber.Cmp(serverCert.cert.SerialNumber) != 0 {
+			t.Errorf("Wrong server cert : %v", cert.SerialNumber)
+		}
+	})
+
+	t.Run("deny-list", func(t *testing.T) {
+		setSource(func(source *opnet.CertSource) {
+			source.DeniedSerials = []byte(fmt.Sprintf("# revoked client certs\n\n%x\n", client1.cert.SerialNumber))
+		})
+		defer setSource(func(source *opnet.CertSource) { source.DeniedSerials = nil })
+		if err := certProvider.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if !certProvider.Revoked(client1.cert) {
+			t.Error("client1 cert should be revoked")
+		}
+		if _, err := handshake(t, client1); err == nil {
+			t.Error("client1 handshake should have failed")
+		}
+		if _, err := handshake(t, client2); err != nil {
+			t.Errorf("client2 handshake should have succeeded : %v", err)
+		}
+	})
+
+	t.Run("crl", func(t *testing.T) {
+		now := time.Now()
+		crl, err := ca.cert.CreateCRL(rand.Reader, ca.key, []pkix.RevokedCertificate{
+			{SerialNumber: client2.cert.SerialNumber, RevocationTime: now},
+		}, now, now.Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		setSource(func(source *opnet.CertSource) { source.CRL = crl })
+		defer setSource(func(source *opnet.CertSource) { source.CRL = nil })
+		if err := certProvider.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := handshake(t, client2); err == nil {
+			t.Error("client2 handshake should have failed")
+		}
+		if _, err := handshake(t, client1); err != nil {
+			t.Errorf("client1 handshake should have succeeded : %v", err)
+		}
+
+		// a CRL that is signed by an unknown CA is rejected
+		otherCA := newTestCert(t, 1, "other-ca.dev.oysterpack.com", nil)
+		crl, err = otherCA.cert.CreateCRL(rand.Reader, otherCA.key, nil, now, now.Add(time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		setSource(func(source *opnet.CertSource) { source.CRL = crl })
+		if err := certProvider.Reload(); err == nil {
+			t.Error("the CRL should have been rejected")
+		}
+	})
+
+	t.Run("reload server cert", func(t *testing.T) {
+		setSource(func(source *opnet.CertSource) { source.CRL = nil })
+		if err := certProvider.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		newServerCert := newTestCert(t, 5, serverCN, ca)
+		setSource(func(source *opnet.CertSource) {
+			source.Cert, source.Key = newServerCert.certPEM, newServerCert.keyPEM
+		})
+		if err := certProvider.Reload(); err != nil {
+			t.Fatal(err)
+		}
+		if certProvider.Cert().SerialNumber.Cmp(newServerCert.cert.SerialNumber) != 0 {
+			t.Errorf("The new server cert should have been loaded : %v", certProvider.Cert().SerialNumber)
+		}
+		cert, err := handshake(t, client1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cert.SerialNumber.Cmp(newServerCert.cert.SerialNumber) != 0 {
+			t.Errorf("The new server cert should have been presented : %v", cert.SerialNumber)
+		}
+
+		// if the certs fail to load, then the current certs remain in use
+		setSource(func(source *opnet.CertSource) { source.Key = []byte("INVALID") })
+		if err := certProvider.Reload(); err == nil {
+			t.Error("Reload should have failed")
+		}
+		if certProvider.Cert().SerialNumber.Cmp(newServerCert.cert.SerialNumber) != 0 {
+			t.Errorf("The current server cert should still be in use : %v", certProvider.Cert().SerialNumber)
+		}
+		if _, err := handshake(t, client1); err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,626 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
+)
+
+const (
+	DEFAULT_CLIENT_INITIAL_RECONNECT_BACKOFF = 100 * time.Millisecond
+	DEFAULT_CLIENT_MAX_RECONNECT_BACKOFF     = 30 * time.Second
+)
+
+// errClientConnGoingAway is returned by the clientConn when the server has told the client to go away, i.e., the request
+// is retried on a new connection
+var errClientConnGoingAway = errors.New("server sent goaway")
+
+// Dialer is used to connect to the server, e.g., ClientSpec.Conn
+type Dialer func() (net.Conn, error)
+
+// ClientSettings are used to create a new Client
+type ClientSettings struct {
+	// the server service - used for logging and errors
+	ServiceID app.ServiceID
+	Dial      Dialer
+
+	// applied to the request message data - see message.SetData()
+	Compression message.Message_Compression
+	Packed      bool
+	// if set, then the policy decides how the request message data is compressed and packed, i.e., Compression and Packed
+	// are ignored
+	CompressionPolicy *message.CompressionPolicy
+
+	// how long to wait before reconnecting after the first failed connection attempt.
+	// If not set, then DEFAULT_CLIENT_INITIAL_RECONNECT_BACKOFF is used.
+	InitialReconnectBackoff time.Duration
+	// the reconnect backoff is doubled after each failed attempt, up to MaxReconnectBackoff.
+	// If not set, then DEFAULT_CLIENT_MAX_RECONNECT_BACKOFF is used.
+	MaxReconnectBackoff time.Duration
+
+	// the number of messages the server may send on a stream before the client grants more credits - see Stream.
+	// If not set, then DEFAULT_STREAM_WINDOW is used.
+	StreamWindow uint32
+}
+
+// ClientSettingsForSpec returns ClientSettings that will connect to the server using the ClientSpec
+func ClientSettingsForSpec(spec *ClientSpec) ClientSettings {
+	return ClientSettings{
+		ServiceID: spec.ServiceID(),
+		Dial:      spec.Conn,
+		Packed:    true,
+	}
+}
+
+func (a *ClientSettings) initialReconnectBackoff() time.Duration {
+	if a.InitialReconnectBackoff <= 0 {
+		return DEFAULT_CLIENT_INITIAL_RECONNECT_BACKOFF
+	}
+	return a.InitialReconnectBackoff
+}
+
+func (a *ClientSettings) maxReconnectBackoff() time.Duration {
+	if a.MaxReconnectBackoff <= 0 {
+		return DEFAULT_CLIENT_MAX_RECONNECT_BACKOFF
+	}
+	return a.MaxReconnectBackoff
+}
+
+// Client sends request messages to the server, using the message framing protocol, i.e., packed message.Message frames.
+//
+// Concurrent requests are multiplexed over a single connection. Each request is assigned a unique message id, which is also
+// used as the request correlation id. Responses are matched to requests via the response correlation id.
+// The request Context deadline is sent to the server as the request's deadline.expiresOn.
+//
+// If the connection fails, then the pending requests fail, and the client reconnects on the next request. Failed connection
+// attempts are backed off exponentially, i.e., requests fail fast until the backoff has elapsed.
+//
+// When the server sends a GoAway message, new requests are sent on a new connection. The old connection is closed once
+// its pending requests and streams are done.
+//
+// When the server sends a ServerBusy message, the connection is closed, and requests fail fast with a ServerBusyError
+// until the server's retry hint has elapsed.
+type Client struct {
+	settings ClientSettings
+
+	// used to serialize connection attempts
+	dialMutex sync.Mutex
+	// the reconnect backoff state is protected by the dialMutex
+	backoff   time.Duration
+	retryTime time.Time
+	dialErr   error
+
+	mutex  sync.Mutex
+	conn   *clientConn
+	closed bool
+}
+
+// NewClient returns a new Client. The client connects lazily, i.e., on the first request.
+func NewClient(settings ClientSettings) (*Client, error) {
+	if settings.Dial == nil {
+		return nil, app.IllegalArgumentError("Dial is required")
+	}
+	return &Client{settings: settings}, nil
+}
+
+// Request sends the request message, and waits for the response. If the server replies with an error response, then the
+// response is returned along with an *ErrorResponse error.
+//
+// errors:
+//	- ErrSpec_ClientClosed
+//	- ErrSpec_ClientConnFailed
+//	- ErrSpec_ServerBusy - if the server rejected the connection because it is at max conns
+//	- *ErrorResponse
+//	- Context errors
+func (a *Client) Request(ctx context.Context, messageType MessageType, data *capnp.Message) (*message.Message, error) {
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.request(ctx, messageType, data)
+	if err == errClientConnGoingAway {
+		// the server told the client to go away before the request was sent - retry on a new connection
+		return a.Request(ctx, messageType, data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if MessageType(response.Type()) == MessageType_ERROR {
+		return response, errorResponse(response)
+	}
+	return response, nil
+}
+
+// Stream opens a new stream by sending the first message on the stream. The server's messages are received via
+// Stream.Recv(). If final is true, then the opening message is the only message that the client sends on the stream,
+// i.e., the server streams back the responses. Otherwise, the client sends additional messages via Stream.Send().
+//
+// The Context applies to the opening message, i.e., the request deadline is sent to the server.
+//
+// errors:
+//	- ErrSpec_ClientClosed
+//	- ErrSpec_ClientConnFailed
+//	- Context errors
+func (a *Client) Stream(ctx context.Context, messageType MessageType, data *capnp.Message, final bool) (*Stream, error) {
+	conn, err := a.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stream, err := conn.openStream(ctx, messageType, data, final)
+	if err == errClientConnGoingAway {
+		// the server told the client to go away before the stream was opened - retry on a new connection
+		return a.Stream(ctx, messageType, data, final)
+	}
+	return stream, err
+}
+
+// Ping sends a Ping request, and waits for the Pong response
+func (a *Client) Ping(ctx context.Context) error {
+	data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return err
+	}
+	if _, err := message.NewRootPing(seg); err != nil {
+		return err
+	}
+	_, err = a.Request(ctx, MessageType_PING, data)
+	return err
+}
+
+// Connected returns true if the client is currently connected
+func (a *Client) Connected() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.conn != nil && a.conn.usable()
+}
+
+// Close closes the connection, failing any pending requests. Once closed, the client can no longer be used.
+func (a *Client) Close() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.closed = true
+	if a.conn != nil {
+		return a.conn.close(ClientClosedError(a.settings.ServiceID))
+	}
+	return nil
+}
+
+// busyRetryTime returns when the client may reconnect, if the server rejected the last connection because it was busy
+func (a *Client) busyRetryTime() time.Time {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.conn == nil {
+		return time.Time{}
+	}
+	return a.conn.busyRetryTime()
+}
+
+func (a *Client) currentConn() (*clientConn, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.closed {
+		return nil, ClientClosedError(a.settings.ServiceID)
+	}
+	if a.conn != nil && a.conn.usable() {
+		return a.conn, nil
+	}
+	return nil, nil
+}
+
+// connect returns the current connection, or reconnects if the connection is down.
+// While backing off, requests fail fast with the last connection error.
+func (a *Client) connect(ctx context.Context) (*clientConn, error) {
+	if conn, err := a.currentConn(); conn != nil || err != nil {
+		return conn, err
+	}
+
+	a.dialMutex.Lock()
+	defer a.dialMutex.Unlock()
+	// another request may have reconnected while we were waiting on the dial mutex
+	if conn, err := a.currentConn(); conn != nil || err != nil {
+		return conn, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if time.Now().Before(a.retryTime) {
+		return nil, ClientConnFailedError(a.settings.ServiceID, a.dialErr)
+	}
+	if retryTime := a.busyRetryTime(); time.Now().Before(retryTime) {
+		// the server rejected the last connection because it is busy - honor the server's retry hint
+		return nil, ServerBusyError(a.settings.ServiceID, time.Until(retryTime))
+	}
+
+	conn, err := a.settings.Dial()
+	if err != nil {
+		if a.backoff == 0 {
+			a.backoff = a.settings.initialReconnectBackoff()
+		} else if a.backoff *= 2; a.backoff > a.settings.maxReconnectBackoff() {
+			a.backoff = a.settings.maxReconnectBackoff()
+		}
+		a.retryTime = time.Now().Add(a.backoff)
+		a.dialErr = err
+		CLIENT_CONN_FAILED.Log(app.Logger().Warn()).
+			Uint64("service", a.settings.ServiceID.UInt64()).
+			Dur("backoff", a.backoff).
+			Err(err).
+			Msg("client connection failed")
+		return nil, ClientConnFailedError(a.settings.ServiceID, err)
+	}
+	a.backoff = 0
+	a.retryTime = time.Time{}
+	a.dialErr = nil
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.closed {
+		conn.Close()
+		return nil, ClientClosedError(a.settings.ServiceID)
+	}
+	a.conn = newClientConn(a.settings, conn)
+	CLIENT_CONNECTED.Log(app.Logger().Debug()).Uint64("service", a.settings.ServiceID.UInt64()).Msg("client connected")
+	return a.conn, nil
+}
+
+// clientConn multiplexes requests over the connection
+type clientConn struct {
+	settings ClientSettings
+	conn     net.Conn
+
+	writeMutex sync.Mutex
+	encoder    *capnp.Encoder
+
+	mutex   sync.Mutex
+	pending map[uint64]chan *message.Message
+	streams map[uint64]*Stream
+	err     error
+	// set when the server sends a GoAway message
+	goingAway bool
+	// set when the server sends a ServerBusy message, i.e., the client should not reconnect before then
+	retryTime time.Time
+}
+
+func newClientConn(settings ClientSettings, conn net.Conn) *clientConn {
+	clientConn := &clientConn{
+		settings: settings,
+		conn:     conn,
+		encoder:  capnp.NewPackedEncoder(conn),
+		pending:  make(map[uint64]chan *message.Message),
+		streams:  make(map[uint64]*Stream),
+	}
+	go clientConn.readResponses()
+	return clientConn
+}
+
+// usable returns true if new requests can be sent on the connection
+func (a *clientConn) usable() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.err == nil && !a.goingAway
+}
+
+// goAway is invoked when the server sends a GoAway message. No new requests are sent on the connection.
+func (a *clientConn) goAway() {
+	a.mutex.Lock()
+	a.goingAway = true
+	a.mutex.Unlock()
+	CLIENT_CONN_GOAWAY.Log(app.Logger().Debug()).Uint64("service", a.settings.ServiceID.UInt64()).Msg("server sent goaway")
+	a.closeIfDrained()
+}
+
+// serverBusy is invoked when the server rejects the connection because it is at max conns. The pending requests fail
+// with a ServerBusyError.
+func (a *clientConn) serverBusy(msg *message.Message) {
+	retryAfter, err := serverBusyRetryAfter(msg)
+	if err != nil {
+		MESSAGE_READ_FAILED.Log(app.Logger().Error()).Err(err).Msg("failed to read ServerBusy message")
+	}
+	a.mutex.Lock()
+	a.retryTime = time.Now().Add(retryAfter)
+	a.mutex.Unlock()
+	CLIENT_SERVER_BUSY.Log(app.Logger().Warn()).Uint64("service", a.settings.ServiceID.UInt64()).Dur("retry_after", retryAfter).Msg("server busy")
+	a.close(ServerBusyError(a.settings.ServiceID, retryAfter))
+}
+
+// busyRetryTime returns when the client may reconnect, if the server rejected the connection because it was busy
+func (a *clientConn) busyRetryTime() time.Time {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.retryTime
+}
+
+// closeIfDrained closes the connection once the server has told the client to go away, and there are no pending
+// requests or open streams
+func (a *clientConn) closeIfDrained() {
+	a.mutex.Lock()
+	drained := a.goingAway && a.err == nil && len(a.pending) == 0 && len(a.streams) == 0
+	a.mutex.Unlock()
+	if drained {
+		a.close(ClientConnFailedError(a.settings.ServiceID, errClientConnGoingAway))
+	}
+}
+
+// close closes the connection, and fails the pending requests with the specified error
+func (a *clientConn) close(err error) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.err != nil {
+		return nil
+	}
+	a.err = err
+	for correlationID, c := range a.pending {
+		close(c)
+		delete(a.pending, correlationID)
+	}
+	for id, stream := range a.streams {
+		stream.fail(err)
+		delete(a.streams, id)
+	}
+	return a.conn.Close()
+}
+
+func (a *clientConn) readResponses() {
+	decoder := capnp.NewPackedDecoder(a.conn)
+	for {
+		msg, err := decoder.Decode()
+		if err != nil {
+			a.close(ClientConnFailedError(a.settings.ServiceID, err))
+			return
+		}
+		response, err := message.ReadRootMessage(msg)
+		if err != nil {
+			MESSAGE_READ_FAILED.Log(app.Logger().Error()).Err(err).Msg("failed to read response message")
+			continue
+		}
+		if MessageType(response.Type()) == MessageType_GOAWAY {
+			a.goAway()
+			continue
+		}
+		if MessageType(response.Type()) == MessageType_SERVER_BUSY {
+			a.serverBusy(&response)
+			return
+		}
+		a.mutex.Lock()
+		if stream, ok := a.streams[response.CorrelationID()]; ok {
+			a.mutex.Unlock()
+			a.receiveStreamMessage(stream, &response)
+			continue
+		}
+		c, ok := a.pending[response.CorrelationID()]
+		if ok {
+			delete(a.pending, response.CorrelationID())
+		}
+		a.mutex.Unlock()
+		if ok {
+			c <- &response
+			a.closeIfDrained()
+		}
+	}
+}
+
+func (a *clientConn) request(ctx context.Context, messageType MessageType, data *capnp.Message) (*message.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	request, err := message.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
+	id := uid.NextUIDHash().UInt64()
+	request.SetId(id)
+	// the correlation id is set explicitly, i.e., it must not default to the trace id
+	request.SetCorrelationID(id)
+	request.SetType(messageType.UInt64())
+	request.SetTimestamp(time.Now().UnixNano())
+	if deadline, ok := ctx.Deadline(); ok {
+		request.Deadline().SetExpiresOn(deadline.UnixNano())
+	}
+	request.SetCompression(a.settings.Compression)
+	request.SetPacked(a.settings.Packed)
+	if data != nil {
+		if a.settings.CompressionPolicy != nil {
+			err = a.settings.CompressionPolicy.SetData(&request, data)
+		} else {
+			err = message.SetData(&request, data)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := trace.InjectMessage(ctx, &request); err != nil {
+		return nil, err
+	}
+
+	responseChan := make(chan *message.Message, 1)
+	a.mutex.Lock()
+	if a.err != nil {
+		a.mutex.Unlock()
+		return nil, a.err
+	}
+	if a.goingAway {
+		a.mutex.Unlock()
+		return nil, errClientConnGoingAway
+	}
+	a.pending[id] = responseChan
+	a.mutex.Unlock()
+
+	a.writeMutex.Lock()
+	err = a.encoder.Encode(msg)
+	a.writeMutex.Unlock()
+	if err != nil {
+		a.close(ClientConnFailedError(a.settings.ServiceID, err))
+		return nil, ClientConnFailedError(a.settings.ServiceID, err)
+	}
+
+	select {
+	case <-ctx.Done():
+		a.mutex.Lock()
+		delete(a.pending, id)
+		a.mutex.Unlock()
+		a.closeIfDrained()
+		return nil, ctx.Err()
+	case response, ok := <-responseChan:
+		if !ok {
+			a.mutex.Lock()
+			defer a.mutex.Unlock()
+			return nil, a.err
+		}
+		return response, nil
+	}
+}
+
+func (a *clientConn) receiveStreamMessage(stream *Stream, msg *message.Message) {
+	if err := stream.receive(msg); err != nil {
+		STREAM_PROTOCOL_ERROR.Log(app.Logger().Warn()).Uint64("stream", stream.ID()).Err(err).Msg("stream protocol violation")
+		stream.fail(err)
+		a.removeStream(stream.ID())
+		return
+	}
+	if stream.receivedFinal() {
+		a.removeStream(stream.ID())
+	}
+}
+
+func (a *clientConn) removeStream(id uint64) {
+	a.mutex.Lock()
+	delete(a.streams, id)
+	a.mutex.Unlock()
+	a.closeIfDrained()
+}
+
+func (a *clientConn) openStream(ctx context.Context, messageType MessageType, data *capnp.Message, final bool) (*Stream, error) {
+	write := func(msg *capnp.Message) error {
+		a.writeMutex.Lock()
+		err := a.encoder.Encode(msg)
+		a.writeMutex.Unlock()
+		if err != nil {
+			a.close(ClientConnFailedError(a.settings.ServiceID, err))
+			return ClientConnFailedError(a.settings.ServiceID, err)
+		}
+		return nil
+	}
+	stream := newStream(uid.NextUIDHash().UInt64(), a.settings.ServiceID, a.settings.StreamWindow, write)
+	stream.compression = a.settings.Compression
+	stream.packed = a.settings.Packed
+	stream.compressionPolicy = a.settings.CompressionPolicy
+	// the opening message does not consume a credit, and grants the server its initial credits
+	stream.sendCredits = 1
+	stream.grant = stream.window
+
+	a.mutex.Lock()
+	if a.err != nil {
+		a.mutex.Unlock()
+		return nil, a.err
+	}
+	if a.goingAway {
+		a.mutex.Unlock()
+		return nil, errClientConnGoingAway
+	}
+	a.streams[stream.ID()] = stream
+	a.mutex.Unlock()
+
+	if err := stream.Send(ctx, messageType, data, final); err != nil {
+		a.removeStream(stream.ID())
+		stream.fail(err)
+		return nil, err
+	}
+	return stream, nil
+}
+
+// ErrorResponse is returned by the Client when the server replies with an error response - see NewErrorResponse()
+type ErrorResponse struct {
+	app.ErrorID
+	Message string
+}
+
+func (a *ErrorResponse) Error() string {
+	return fmt.Sprintf("%x : %s", a.ErrorID, a.Message)
+}
+
+// errorResponse converts the error response message into an *ErrorResponse
+func errorResponse(response *message.Message) *ErrorResponse {
+	data, err := message.Data(response)
+	if err != nil {
+		return &ErrorResponse{Message: err.Error()}
+	}
+	errorMsg, err := message.ReadRootError(data)
+	if err != nil {
+		return &ErrorResponse{Message: err.Error()}
+	}
+	text, _ := errorMsg.Message()
+	return &ErrorResponse{app.ErrorID(errorMsg.ErrorID()), text}
+}
+
+// ClientPool spreads requests across a fixed number of clients, i.e., connections, in round robin order.
+type ClientPool struct {
+	clients []*Client
+	next    uint32
+}
+
+// NewClientPool returns a new ClientPool with the specified number of clients.
+func NewClientPool(settings ClientSettings, size uint8) (*ClientPool, error) {
+	if size == 0 {
+		return nil, app.IllegalArgumentError("ClientPool size must be > 0")
+	}
+	pool := &ClientPool{clients: make([]*Client, size)}
+	for i := range pool.clients {
+		client, err := NewClient(settings)
+		if err != nil {
+			return nil, err
+		}
+		pool.clients[i] = client
+	}
+	return pool, nil
+}
+
+// Client returns the next client
+func (a *ClientPool) Client() *Client {
+	i := atomic.AddUint32(&a.next, 1)
+	return a.clients[int(i)%len(a.clients)]
+}
+
+// Size returns the number of clients in the pool
+func (a *ClientPool) Size() int {
+	return len(a.clients)
+}
+
+// Request sends the request using the next client - see Client.Request()
+func (a *ClientPool) Request(ctx context.Context, messageType MessageType, data *capnp.Message) (*message.Message, error) {
+	return a.Client().Request(ctx, messageType, data)
+}
+
+// Close closes all of the clients
+func (a *ClientPool) Close() error {
+	var err error
+	for _, client := range a.clients {
+		if e := client.Close(); e != nil && err == nil {
+			err = e
+		}
+	}
+	return err
+}
@@ -17,7 +17,6 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
-	"fmt"
 	"net"
 
 	"errors"
@@ -129,13 +128,16 @@ func (a *ClientSpec) Conn() (net.Conn, error) {
 		Uint64("service", uint64(a.serviceID)).
 		Str("NetworkAddr", networkAddr).
 		Msg("ClientSpec")
-	addr := fmt.Sprintf("%s:%d", networkAddr, a.serverPort)
-	return tls.Dial("tcp", addr, a.TLSConfig())
+	return a.ConnForAddr(networkAddr)
 }
 
 // ConnForAddr returns a network conn using the specified network address
 // This mainly intended for testing purposes to connect locally
+//
+// For unix and pipe networks, the network address is the socket path or the pipe name.
 func (a *ClientSpec) ConnForAddr(networkAddr string) (net.Conn, error) {
-	addr := fmt.Sprintf("%s:%d", networkAddr, a.serverPort)
-	return tls.Dial("tcp", addr, a.TLSConfig())
+	if !a.network.TLS() {
+		return a.network.Dial(networkAddr, uint16(a.serverPort), nil)
+	}
+	return a.network.Dial(networkAddr, uint16(a.serverPort), a.TLSConfig())
 }
@@ -0,0 +1,294 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestClient(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	const (
+		SERVICE_ID = app.ServiceID(0xb895f55503171dd3)
+
+		ECHO    = opnet.MessageType(0xfb41b177bbf5c534)
+		FAILURE = opnet.MessageType(0xf6bd09fd8fe861ea)
+	)
+	failureErrSpec := app.ErrSpec{ErrorID: app.ErrorID(0xf626c63b847bd79d), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	service := app.NewService(SERVICE_ID)
+	defer service.Kill(nil)
+
+	// echoes back the request data - the request deadline is required
+	echo := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		if _, ok := ctx.Deadline(); !ok {
+			return nil, app.NewError(errors.New("request deadline is required"), "", failureErrSpec, SERVICE_ID, nil)
+		}
+		data, err := message.Data(request)
+		if err != nil {
+			return nil, app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		response, err := opnet.NewResponse(*request, ECHO, data)
+		if err != nil {
+			return nil, app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		return response, nil
+	}
+	failure := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		return nil, app.NewError(errors.New("BOOM"), "", failureErrSpec, SERVICE_ID, nil)
+	}
+	router, err := opnet.NewMessageRouter(service, opnet.MessageRoute{ECHO, echo}, opnet.MessageRoute{FAILURE, failure})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var dialCount int32
+	var dialFailure atomic.Value
+	dialFailure.Store(false)
+	dial := func() (net.Conn, error) {
+		atomic.AddInt32(&dialCount, 1)
+		if dialFailure.Load().(bool) {
+			return nil, errors.New("server is down")
+		}
+		serverConn, clientConn := net.Pipe()
+		go router.ConnHandler()(context.Background(), serverConn)
+		return clientConn, nil
+	}
+
+	newData := func(t *testing.T) *capnp.Message {
+		data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := message.NewRootPing(seg); err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	t.Run("requests", func(t *testing.T) {
+		compressions := []message.Message_Compression{
+			message.Message_Compression_none,
+			message.Message_Compression_zlib,
+			message.Message_Compression_lz4,
+			message.Message_Compression_zstd,
+		}
+		for _, compression := range compressions {
+			client, err := opnet.NewClient(opnet.ClientSettings{
+				ServiceID:         SERVICE_ID,
+				Dial:              dial,
+				Compression:       compression,
+				Packed:            true,
+				CompressionPolicy: &message.CompressionPolicy{Default: message.DataEncoding{Compression: compression, Packed: true}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := client.Ping(context.Background()); err != nil {
+				t.Error(err)
+			}
+			if !client.Connected() {
+				t.Error("client should be connected")
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			response, err := client.Request(ctx, ECHO, newData(t))
+			cancel()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if opnet.MessageType(response.Type()) != ECHO {
+				t.Errorf("Expected an echo response : %x", response.Type())
+			}
+
+			// without a deadline, the echo handler fails
+			_, err = client.Request(context.Background(), ECHO, newData(t))
+			if errorResponse, ok := err.(*opnet.ErrorResponse); !ok || errorResponse.ErrorID != failureErrSpec.ErrorID {
+				t.Errorf("Expected an *ErrorResponse : %v", err)
+			}
+
+			client.Close()
+			if err := client.Ping(context.Background()); err == nil {
+				t.Error("closed client should fail requests")
+			}
+		}
+	})
+
+	t.Run("concurrent requests", func(t *testing.T) {
+		client, err := opnet.NewClient(opnet.ClientSettings{ServiceID: SERVICE_ID, Dial: dial})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+
+		wait := sync.WaitGroup{}
+		for i := 0; i < 50; i++ {
+			wait.Add(1)
+			messageType := ECHO
+			if i%2 == 0 {
+				messageType = FAILURE
+			}
+			go func() {
+				defer wait.Done()
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				response, err := client.Request(ctx, messageType, newData(t))
+				switch messageType {
+				case ECHO:
+					if err != nil || opnet.MessageType(response.Type()) != ECHO {
+						t.Errorf("Expected an echo response : %v", err)
+					}
+				default:
+					if _, ok := err.(*opnet.ErrorResponse); !ok {
+						t.Errorf("Expected an *ErrorResponse : %v", err)
+					}
+				}
+			}()
+		}
+		wait.Wait()
+	})
+
+	t.Run("reconnect with backoff", func(t *testing.T) {
+		client, err := opnet.NewClient(opnet.ClientSettings{ServiceID: SERVICE_ID, Dial: dial, InitialReconnectBackoff: 100 * time.Millisecond})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+
+		dialFailure.Store(true)
+		atomic.StoreInt32(&dialCount, 0)
+		if err := client.Ping(context.Background()); err == nil {
+			t.Fatal("ping should have failed because the server is down")
+		}
+		dialFailure.Store(false)
+		// requests fail fast while backing off
+		if err := client.Ping(context.Background()); err == nil {
+			t.Error("ping should have failed while backing off")
+		}
+		if atomic.LoadInt32(&dialCount) != 1 {
+			t.Errorf("the client should not have redialed while backing off : %d", atomic.LoadInt32(&dialCount))
+		}
+		time.Sleep(150 * time.Millisecond)
+		if err := client.Ping(context.Background()); err != nil {
+			t.Errorf("the client should have reconnected : %v", err)
+		}
+	})
+
+	t.Run("pool", func(t *testing.T) {
+		atomic.StoreInt32(&dialCount, 0)
+		pool, err := opnet.NewClientPool(opnet.ClientSettings{ServiceID: SERVICE_ID, Dial: dial}, 3)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer pool.Close()
+		for i := 0; i < 2*pool.Size(); i++ {
+			if _, err := pool.Request(context.Background(), opnet.MessageType_PING, newData(t)); err != nil {
+				t.Error(err)
+			}
+		}
+		if atomic.LoadInt32(&dialCount) != 3 {
+			t.Errorf("each pooled client should have connected once : %d", atomic.LoadInt32(&dialCount))
+		}
+	})
+
+	t.Run("goaway", func(t *testing.T) {
+		// the server replies with a GoAway message before sending the response, and then waits for the client to close the conn
+		var goAwayDialCount int32
+		connClosed := make(chan struct{}, 1)
+		goAwayDial := func() (net.Conn, error) {
+			if atomic.AddInt32(&goAwayDialCount, 1) > 1 {
+				return dial()
+			}
+			serverConn, clientConn := net.Pipe()
+			go func() {
+				defer func() { connClosed <- struct{}{} }()
+				defer serverConn.Close()
+				decoder := capnp.NewPackedDecoder(serverConn)
+				encoder := capnp.NewPackedEncoder(serverConn)
+				msg, err := decoder.Decode()
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				request, err := message.ReadRootMessage(msg)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				goAway, err := opnet.NewGoAwayMessage(time.Now().Add(time.Second))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if err := encoder.Encode(goAway); err != nil {
+					t.Error(err)
+					return
+				}
+				response, err := opnet.NewResponse(request, opnet.MessageType_PING, nil)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if err := encoder.Encode(response); err != nil {
+					t.Error(err)
+					return
+				}
+				// blocks until the client closes the conn
+				decoder.Decode()
+			}()
+			return clientConn, nil
+		}
+
+		client, err := opnet.NewClient(opnet.ClientSettings{ServiceID: SERVICE_ID, Dial: goAwayDial})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer client.Close()
+
+		// the pending request completes on the conn that is going away
+		if err := client.Ping(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		select {
+		case <-connClosed:
+		case <-time.After(5 * time.Second):
+			t.Fatal("the client should have closed the conn once it was drained")
+		}
+		if client.Connected() {
+			t.Error("the client should not use a conn that is going away")
+		}
+		// new requests are sent on a new conn
+		if err := client.Ping(context.Background()); err != nil {
+			t.Error(err)
+		}
+		if atomic.LoadInt32(&goAwayDialCount) != 2 {
+			t.Errorf("the client should have reconnected : %d", atomic.LoadInt32(&goAwayDialCount))
+		}
+	})
+}
@@ -14,12 +14,12 @@ type ServerSpec struct{ capnp.Struct }
 const ServerSpec_TypeID = 0xe57b76fedcda1734
 
 func NewServerSpec(s *capnp.Segment) (ServerSpec, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 5})
 	return ServerSpec{st}, err
 }
 
 func NewRootServerSpec(s *capnp.Segment) (ServerSpec, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 40, PointerCount: 5})
 	return ServerSpec{st}, err
 }
 
@@ -145,12 +145,86 @@ func (s ServerSpec) SetWriteBufferSize(v uint32) {
 	s.Struct.SetUint32(20, v)
 }
 
+func (s ServerSpec) RateLimits() (RateLimitSpec, error) {
+	p, err := s.Struct.Ptr(3)
+	return RateLimitSpec{Struct: p.Struct()}, err
+}
+
+func (s ServerSpec) HasRateLimits() bool {
+	p, err := s.Struct.Ptr(3)
+	return p.IsValid() || err != nil
+}
+
+func (s ServerSpec) SetRateLimits(v RateLimitSpec) error {
+	return s.Struct.SetPtr(3, v.Struct.ToPtr())
+}
+
+// NewRateLimits sets the rateLimits field to a newly
+// allocated RateLimitSpec struct, preferring placement in s's segment.
+func (s ServerSpec) NewRateLimits() (RateLimitSpec, error) {
+	ss, err := NewRateLimitSpec(s.Struct.Segment())
+	if err != nil {
+		return RateLimitSpec{}, err
+	}
+	err = s.Struct.SetPtr(3, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s ServerSpec) IdleTimeoutSecs() uint32 {
+	return s.Struct.Uint32(24)
+}
+
+func (s ServerSpec) SetIdleTimeoutSecs(v uint32) {
+	s.Struct.SetUint32(24, v)
+}
+
+func (s ServerSpec) DrainTimeoutSecs() uint32 {
+	return s.Struct.Uint32(28) ^ 30
+}
+
+func (s ServerSpec) SetDrainTimeoutSecs(v uint32) {
+	s.Struct.SetUint32(28, v^30)
+}
+
+func (s ServerSpec) AuthzPolicy() (AuthzPolicy, error) {
+	p, err := s.Struct.Ptr(4)
+	return AuthzPolicy{Struct: p.Struct()}, err
+}
+
+func (s ServerSpec) HasAuthzPolicy() bool {
+	p, err := s.Struct.Ptr(4)
+	return p.IsValid() || err != nil
+}
+
+func (s ServerSpec) SetAuthzPolicy(v AuthzPolicy) error {
+	return s.Struct.SetPtr(4, v.Struct.ToPtr())
+}
+
+// NewAuthzPolicy sets the authzPolicy field to a newly
+// allocated AuthzPolicy struct, preferring placement in s's segment.
+func (s ServerSpec) NewAuthzPolicy() (AuthzPolicy, error) {
+	ss, err := NewAuthzPolicy(s.Struct.Segment())
+	if err != nil {
+		return AuthzPolicy{}, err
+	}
+	err = s.Struct.SetPtr(4, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s ServerSpec) BusyRetryAfterMSec() uint32 {
+	return s.Struct.Uint32(32)
+}
+
+func (s ServerSpec) SetBusyRetryAfterMSec(v uint32) {
+	s.Struct.SetUint32(32, v)
+}
+
 // ServerSpec_List is a list of ServerSpec.
 type ServerSpec_List struct{ capnp.List }
 
 // NewServerSpec creates a new list of ServerSpec.
 func NewServerSpec_List(s *capnp.Segment, sz int32) (ServerSpec_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 3}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 40, PointerCount: 5}, sz)
 	return ServerSpec_List{l}, err
 }
 
@@ -179,6 +253,566 @@ func (p ServerSpec_Promise) ServerCert() X509KeyPair_Promise {
 	return X509KeyPair_Promise{Pipeline: p.Pipeline.GetPipeline(1)}
 }
 
+func (p ServerSpec_Promise) RateLimits() RateLimitSpec_Promise {
+	return RateLimitSpec_Promise{Pipeline: p.Pipeline.GetPipeline(3)}
+}
+
+func (p ServerSpec_Promise) AuthzPolicy() AuthzPolicy_Promise {
+	return AuthzPolicy_Promise{Pipeline: p.Pipeline.GetPipeline(4)}
+}
+
+type RateLimitSpec struct{ capnp.Struct }
+
+// RateLimitSpec_TypeID is the unique identifier for the type RateLimitSpec.
+const RateLimitSpec_TypeID = 0x8c714296d13b5dc1
+
+func NewRateLimitSpec(s *capnp.Segment) (RateLimitSpec, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return RateLimitSpec{st}, err
+}
+
+func NewRootRateLimitSpec(s *capnp.Segment) (RateLimitSpec, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2})
+	return RateLimitSpec{st}, err
+}
+
+func ReadRootRateLimitSpec(msg *capnp.Message) (RateLimitSpec, error) {
+	root, err := msg.RootPtr()
+	return RateLimitSpec{root.Struct()}, err
+}
+
+func (s RateLimitSpec) String() string {
+	str, _ := text.Marshal(0x8c714296d13b5dc1, s.Struct)
+	return str
+}
+
+func (s RateLimitSpec) PerConn() (RateLimit, error) {
+	p, err := s.Struct.Ptr(0)
+	return RateLimit{Struct: p.Struct()}, err
+}
+
+func (s RateLimitSpec) HasPerConn() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s RateLimitSpec) SetPerConn(v RateLimit) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewPerConn sets the perConn field to a newly
+// allocated RateLimit struct, preferring placement in s's segment.
+func (s RateLimitSpec) NewPerConn() (RateLimit, error) {
+	ss, err := NewRateLimit(s.Struct.Segment())
+	if err != nil {
+		return RateLimit{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s RateLimitSpec) PerClient() (RateLimit, error) {
+	p, err := s.Struct.Ptr(1)
+	return RateLimit{Struct: p.Struct()}, err
+}
+
+func (s RateLimitSpec) HasPerClient() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s RateLimitSpec) SetPerClient(v RateLimit) error {
+	return s.Struct.SetPtr(1, v.Struct.ToPtr())
+}
+
+// NewPerClient sets the perClient field to a newly
+// allocated RateLimit struct, preferring placement in s's segment.
+func (s RateLimitSpec) NewPerClient() (RateLimit, error) {
+	ss, err := NewRateLimit(s.Struct.Segment())
+	if err != nil {
+		return RateLimit{}, err
+	}
+	err = s.Struct.SetPtr(1, ss.Struct.ToPtr())
+	return ss, err
+}
+
+func (s RateLimitSpec) Mode() RateLimitSpec_Mode {
+	return RateLimitSpec_Mode(s.Struct.Uint16(0))
+}
+
+func (s RateLimitSpec) SetMode(v RateLimitSpec_Mode) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+// RateLimitSpec_List is a list of RateLimitSpec.
+type RateLimitSpec_List struct{ capnp.List }
+
+// NewRateLimitSpec creates a new list of RateLimitSpec.
+func NewRateLimitSpec_List(s *capnp.Segment, sz int32) (RateLimitSpec_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 2}, sz)
+	return RateLimitSpec_List{l}, err
+}
+
+func (s RateLimitSpec_List) At(i int) RateLimitSpec { return RateLimitSpec{s.List.Struct(i)} }
+
+func (s RateLimitSpec_List) Set(i int, v RateLimitSpec) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s RateLimitSpec_List) String() string {
+	str, _ := text.MarshalList(0x8c714296d13b5dc1, s.List)
+	return str
+}
+
+// RateLimitSpec_Promise is a wrapper for a RateLimitSpec promised by a client call.
+type RateLimitSpec_Promise struct{ *capnp.Pipeline }
+
+func (p RateLimitSpec_Promise) Struct() (RateLimitSpec, error) {
+	s, err := p.Pipeline.Struct()
+	return RateLimitSpec{s}, err
+}
+
+func (p RateLimitSpec_Promise) PerConn() RateLimit_Promise {
+	return RateLimit_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+func (p RateLimitSpec_Promise) PerClient() RateLimit_Promise {
+	return RateLimit_Promise{Pipeline: p.Pipeline.GetPipeline(1)}
+}
+
+type RateLimitSpec_Mode uint16
+
+// RateLimitSpec_Mode_TypeID is the unique identifier for the type RateLimitSpec_Mode.
+const RateLimitSpec_Mode_TypeID = 0xa6a17062fec2b6d3
+
+// Values of RateLimitSpec_Mode.
+const (
+	RateLimitSpec_Mode_delay  RateLimitSpec_Mode = 0
+	RateLimitSpec_Mode_reject RateLimitSpec_Mode = 1
+)
+
+// String returns the enum's constant name.
+func (c RateLimitSpec_Mode) String() string {
+	switch c {
+	case RateLimitSpec_Mode_delay:
+		return "delay"
+	case RateLimitSpec_Mode_reject:
+		return "reject"
+
+	default:
+		return ""
+	}
+}
+
+// RateLimitSpec_ModeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func RateLimitSpec_ModeFromString(c string) RateLimitSpec_Mode {
+	switch c {
+	case "delay":
+		return RateLimitSpec_Mode_delay
+	case "reject":
+		return RateLimitSpec_Mode_reject
+
+	default:
+		return 0
+	}
+}
+
+type RateLimitSpec_Mode_List struct{ capnp.List }
+
+func NewRateLimitSpec_Mode_List(s *capnp.Segment, sz int32) (RateLimitSpec_Mode_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return RateLimitSpec_Mode_List{l.List}, err
+}
+
+func (l RateLimitSpec_Mode_List) At(i int) RateLimitSpec_Mode {
+	ul := capnp.UInt16List{List: l.List}
+	return RateLimitSpec_Mode(ul.At(i))
+}
+
+func (l RateLimitSpec_Mode_List) Set(i int, v RateLimitSpec_Mode) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
+type RateLimit struct{ capnp.Struct }
+
+// RateLimit_TypeID is the unique identifier for the type RateLimit.
+const RateLimit_TypeID = 0xb0f9b9d179394348
+
+func NewRateLimit(s *capnp.Segment) (RateLimit, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return RateLimit{st}, err
+}
+
+func NewRootRateLimit(s *capnp.Segment) (RateLimit, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0})
+	return RateLimit{st}, err
+}
+
+func ReadRootRateLimit(msg *capnp.Message) (RateLimit, error) {
+	root, err := msg.RootPtr()
+	return RateLimit{root.Struct()}, err
+}
+
+func (s RateLimit) String() string {
+	str, _ := text.Marshal(0xb0f9b9d179394348, s.Struct)
+	return str
+}
+
+func (s RateLimit) MessagesPerSec() uint32 {
+	return s.Struct.Uint32(0)
+}
+
+func (s RateLimit) SetMessagesPerSec(v uint32) {
+	s.Struct.SetUint32(0, v)
+}
+
+func (s RateLimit) MessageBurst() uint32 {
+	return s.Struct.Uint32(4)
+}
+
+func (s RateLimit) SetMessageBurst(v uint32) {
+	s.Struct.SetUint32(4, v)
+}
+
+func (s RateLimit) BytesPerSec() uint32 {
+	return s.Struct.Uint32(8)
+}
+
+func (s RateLimit) SetBytesPerSec(v uint32) {
+	s.Struct.SetUint32(8, v)
+}
+
+func (s RateLimit) ByteBurst() uint32 {
+	return s.Struct.Uint32(12)
+}
+
+func (s RateLimit) SetByteBurst(v uint32) {
+	s.Struct.SetUint32(12, v)
+}
+
+// RateLimit_List is a list of RateLimit.
+type RateLimit_List struct{ capnp.List }
+
+// NewRateLimit creates a new list of RateLimit.
+func NewRateLimit_List(s *capnp.Segment, sz int32) (RateLimit_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 16, PointerCount: 0}, sz)
+	return RateLimit_List{l}, err
+}
+
+func (s RateLimit_List) At(i int) RateLimit { return RateLimit{s.List.Struct(i)} }
+
+func (s RateLimit_List) Set(i int, v RateLimit) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s RateLimit_List) String() string {
+	str, _ := text.MarshalList(0xb0f9b9d179394348, s.List)
+	return str
+}
+
+// RateLimit_Promise is a wrapper for a RateLimit promised by a client call.
+type RateLimit_Promise struct{ *capnp.Pipeline }
+
+func (p RateLimit_Promise) Struct() (RateLimit, error) {
+	s, err := p.Pipeline.Struct()
+	return RateLimit{s}, err
+}
+
+type AuthzPolicy struct{ capnp.Struct }
+
+// AuthzPolicy_TypeID is the unique identifier for the type AuthzPolicy.
+const AuthzPolicy_TypeID = 0xbc4c442609a17221
+
+func NewAuthzPolicy(s *capnp.Segment) (AuthzPolicy, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return AuthzPolicy{st}, err
+}
+
+func NewRootAuthzPolicy(s *capnp.Segment) (AuthzPolicy, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return AuthzPolicy{st}, err
+}
+
+func ReadRootAuthzPolicy(msg *capnp.Message) (AuthzPolicy, error) {
+	root, err := msg.RootPtr()
+	return AuthzPolicy{root.Struct()}, err
+}
+
+func (s AuthzPolicy) String() string {
+	str, _ := text.Marshal(0xbc4c442609a17221, s.Struct)
+	return str
+}
+
+func (s AuthzPolicy) DefaultAllow() bool {
+	return s.Struct.Bit(0)
+}
+
+func (s AuthzPolicy) SetDefaultAllow(v bool) {
+	s.Struct.SetBit(0, v)
+}
+
+func (s AuthzPolicy) Rules() (AuthzRule_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return AuthzRule_List{List: p.List()}, err
+}
+
+func (s AuthzPolicy) HasRules() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s AuthzPolicy) SetRules(v AuthzRule_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewRules sets the rules field to a newly
+// allocated AuthzRule_List, preferring placement in s's segment.
+func (s AuthzPolicy) NewRules(n int32) (AuthzRule_List, error) {
+	l, err := NewAuthzRule_List(s.Struct.Segment(), n)
+	if err != nil {
+		return AuthzRule_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// AuthzPolicy_List is a list of AuthzPolicy.
+type AuthzPolicy_List struct{ capnp.List }
+
+// NewAuthzPolicy creates a new list of AuthzPolicy.
+func NewAuthzPolicy_List(s *capnp.Segment, sz int32) (AuthzPolicy_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return AuthzPolicy_List{l}, err
+}
+
+func (s AuthzPolicy_List) At(i int) AuthzPolicy { return AuthzPolicy{s.List.Struct(i)} }
+
+func (s AuthzPolicy_List) Set(i int, v AuthzPolicy) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s AuthzPolicy_List) String() string {
+	str, _ := text.MarshalList(0xbc4c442609a17221, s.List)
+	return str
+}
+
+// AuthzPolicy_Promise is a wrapper for a AuthzPolicy promised by a client call.
+type AuthzPolicy_Promise struct{ *capnp.Pipeline }
+
+func (p AuthzPolicy_Promise) Struct() (AuthzPolicy, error) {
+	s, err := p.Pipeline.Struct()
+	return AuthzPolicy{s}, err
+}
+
+type AuthzRule struct{ capnp.Struct }
+
+// AuthzRule_TypeID is the unique identifier for the type AuthzRule.
+const AuthzRule_TypeID = 0xff8bb471a6ba010f
+
+func NewAuthzRule(s *capnp.Segment) (AuthzRule, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return AuthzRule{st}, err
+}
+
+func NewRootAuthzRule(s *capnp.Segment) (AuthzRule, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1})
+	return AuthzRule{st}, err
+}
+
+func ReadRootAuthzRule(msg *capnp.Message) (AuthzRule, error) {
+	root, err := msg.RootPtr()
+	return AuthzRule{root.Struct()}, err
+}
+
+func (s AuthzRule) String() string {
+	str, _ := text.Marshal(0xff8bb471a6ba010f, s.Struct)
+	return str
+}
+
+func (s AuthzRule) MessageType() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s AuthzRule) SetMessageType(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s AuthzRule) InterfaceId() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s AuthzRule) SetInterfaceId(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s AuthzRule) MethodId() uint16 {
+	return s.Struct.Uint16(16)
+}
+
+func (s AuthzRule) SetMethodId(v uint16) {
+	s.Struct.SetUint16(16, v)
+}
+
+func (s AuthzRule) Allow() (PeerMatcher_List, error) {
+	p, err := s.Struct.Ptr(0)
+	return PeerMatcher_List{List: p.List()}, err
+}
+
+func (s AuthzRule) HasAllow() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s AuthzRule) SetAllow(v PeerMatcher_List) error {
+	return s.Struct.SetPtr(0, v.List.ToPtr())
+}
+
+// NewAllow sets the allow field to a newly
+// allocated PeerMatcher_List, preferring placement in s's segment.
+func (s AuthzRule) NewAllow(n int32) (PeerMatcher_List, error) {
+	l, err := NewPeerMatcher_List(s.Struct.Segment(), n)
+	if err != nil {
+		return PeerMatcher_List{}, err
+	}
+	err = s.Struct.SetPtr(0, l.List.ToPtr())
+	return l, err
+}
+
+// AuthzRule_List is a list of AuthzRule.
+type AuthzRule_List struct{ capnp.List }
+
+// NewAuthzRule creates a new list of AuthzRule.
+func NewAuthzRule_List(s *capnp.Segment, sz int32) (AuthzRule_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 1}, sz)
+	return AuthzRule_List{l}, err
+}
+
+func (s AuthzRule_List) At(i int) AuthzRule { return AuthzRule{s.List.Struct(i)} }
+
+func (s AuthzRule_List) Set(i int, v AuthzRule) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s AuthzRule_List) String() string {
+	str, _ := text.MarshalList(0xff8bb471a6ba010f, s.List)
+	return str
+}
+
+// AuthzRule_Promise is a wrapper for a AuthzRule promised by a client call.
+type AuthzRule_Promise struct{ *capnp.Pipeline }
+
+func (p AuthzRule_Promise) Struct() (AuthzRule, error) {
+	s, err := p.Pipeline.Struct()
+	return AuthzRule{s}, err
+}
+
+type PeerMatcher struct{ capnp.Struct }
+
+// PeerMatcher_TypeID is the unique identifier for the type PeerMatcher.
+const PeerMatcher_TypeID = 0xd6939e8127414da9
+
+func NewPeerMatcher(s *capnp.Segment) (PeerMatcher, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return PeerMatcher{st}, err
+}
+
+func NewRootPeerMatcher(s *capnp.Segment) (PeerMatcher, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2})
+	return PeerMatcher{st}, err
+}
+
+func ReadRootPeerMatcher(msg *capnp.Message) (PeerMatcher, error) {
+	root, err := msg.RootPtr()
+	return PeerMatcher{root.Struct()}, err
+}
+
+func (s PeerMatcher) String() string {
+	str, _ := text.Marshal(0xd6939e8127414da9, s.Struct)
+	return str
+}
+
+func (s PeerMatcher) Cn() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s PeerMatcher) HasCn() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s PeerMatcher) CnBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s PeerMatcher) SetCn(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s PeerMatcher) Organization() (string, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.Text(), err
+}
+
+func (s PeerMatcher) HasOrganization() bool {
+	p, err := s.Struct.Ptr(1)
+	return p.IsValid() || err != nil
+}
+
+func (s PeerMatcher) OrganizationBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(1)
+	return p.TextBytes(), err
+}
+
+func (s PeerMatcher) SetOrganization(v string) error {
+	return s.Struct.SetText(1, v)
+}
+
+func (s PeerMatcher) DomainID() uint64 {
+	return s.Struct.Uint64(0)
+}
+
+func (s PeerMatcher) SetDomainID(v uint64) {
+	s.Struct.SetUint64(0, v)
+}
+
+func (s PeerMatcher) AppId() uint64 {
+	return s.Struct.Uint64(8)
+}
+
+func (s PeerMatcher) SetAppId(v uint64) {
+	s.Struct.SetUint64(8, v)
+}
+
+func (s PeerMatcher) ServiceId() uint64 {
+	return s.Struct.Uint64(16)
+}
+
+func (s PeerMatcher) SetServiceId(v uint64) {
+	s.Struct.SetUint64(16, v)
+}
+
+// PeerMatcher_List is a list of PeerMatcher.
+type PeerMatcher_List struct{ capnp.List }
+
+// NewPeerMatcher creates a new list of PeerMatcher.
+func NewPeerMatcher_List(s *capnp.Segment, sz int32) (PeerMatcher_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 24, PointerCount: 2}, sz)
+	return PeerMatcher_List{l}, err
+}
+
+func (s PeerMatcher_List) At(i int) PeerMatcher { return PeerMatcher{s.List.Struct(i)} }
+
+func (s PeerMatcher_List) Set(i int, v PeerMatcher) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s PeerMatcher_List) String() string {
+	str, _ := text.MarshalList(0xd6939e8127414da9, s.List)
+	return str
+}
+
+// PeerMatcher_Promise is a wrapper for a PeerMatcher promised by a client call.
+type PeerMatcher_Promise struct{ *capnp.Pipeline }
+
+func (p PeerMatcher_Promise) Struct() (PeerMatcher, error) {
+	s, err := p.Pipeline.Struct()
+	return PeerMatcher{s}, err
+}
+
 type ClientSpec struct{ capnp.Struct }
 
 // ClientSpec_TypeID is the unique identifier for the type ClientSpec.
@@ -308,12 +942,12 @@ type ServiceSpec struct{ capnp.Struct }
 const ServiceSpec_TypeID = 0x8e98877ce02ee396
 
 func NewServiceSpec(s *capnp.Segment) (ServiceSpec, error) {
-	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
 	return ServiceSpec{st}, err
 }
 
 func NewRootServiceSpec(s *capnp.Segment) (ServiceSpec, error) {
-	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0})
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1})
 	return ServiceSpec{st}, err
 }
 
@@ -359,12 +993,37 @@ func (s ServiceSpec) SetPort(v uint16) {
 	s.Struct.SetUint16(24, v)
 }
 
+func (s ServiceSpec) Network() (NetworkSpec, error) {
+	p, err := s.Struct.Ptr(0)
+	return NetworkSpec{Struct: p.Struct()}, err
+}
+
+func (s ServiceSpec) HasNetwork() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s ServiceSpec) SetNetwork(v NetworkSpec) error {
+	return s.Struct.SetPtr(0, v.Struct.ToPtr())
+}
+
+// NewNetwork sets the network field to a newly
+// allocated NetworkSpec struct, preferring placement in s's segment.
+func (s ServiceSpec) NewNetwork() (NetworkSpec, error) {
+	ss, err := NewNetworkSpec(s.Struct.Segment())
+	if err != nil {
+		return NetworkSpec{}, err
+	}
+	err = s.Struct.SetPtr(0, ss.Struct.ToPtr())
+	return ss, err
+}
+
 // ServiceSpec_List is a list of ServiceSpec.
 type ServiceSpec_List struct{ capnp.List }
 
 // NewServiceSpec creates a new list of ServiceSpec.
 func NewServiceSpec_List(s *capnp.Segment, sz int32) (ServiceSpec_List, error) {
-	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 0}, sz)
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 32, PointerCount: 1}, sz)
 	return ServiceSpec_List{l}, err
 }
 
@@ -385,6 +1044,156 @@ func (p ServiceSpec_Promise) Struct() (ServiceSpec, error) {
 	return ServiceSpec{s}, err
 }
 
+func (p ServiceSpec_Promise) Network() NetworkSpec_Promise {
+	return NetworkSpec_Promise{Pipeline: p.Pipeline.GetPipeline(0)}
+}
+
+type NetworkSpec struct{ capnp.Struct }
+
+// NetworkSpec_TypeID is the unique identifier for the type NetworkSpec.
+const NetworkSpec_TypeID = 0xcb88fc1b4a294b26
+
+func NewNetworkSpec(s *capnp.Segment) (NetworkSpec, error) {
+	st, err := capnp.NewStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return NetworkSpec{st}, err
+}
+
+func NewRootNetworkSpec(s *capnp.Segment) (NetworkSpec, error) {
+	st, err := capnp.NewRootStruct(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1})
+	return NetworkSpec{st}, err
+}
+
+func ReadRootNetworkSpec(msg *capnp.Message) (NetworkSpec, error) {
+	root, err := msg.RootPtr()
+	return NetworkSpec{root.Struct()}, err
+}
+
+func (s NetworkSpec) String() string {
+	str, _ := text.Marshal(0xcb88fc1b4a294b26, s.Struct)
+	return str
+}
+
+func (s NetworkSpec) Type() NetworkSpec_Type {
+	return NetworkSpec_Type(s.Struct.Uint16(0))
+}
+
+func (s NetworkSpec) SetType(v NetworkSpec_Type) {
+	s.Struct.SetUint16(0, uint16(v))
+}
+
+func (s NetworkSpec) Address() (string, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.Text(), err
+}
+
+func (s NetworkSpec) HasAddress() bool {
+	p, err := s.Struct.Ptr(0)
+	return p.IsValid() || err != nil
+}
+
+func (s NetworkSpec) AddressBytes() ([]byte, error) {
+	p, err := s.Struct.Ptr(0)
+	return p.TextBytes(), err
+}
+
+func (s NetworkSpec) SetAddress(v string) error {
+	return s.Struct.SetText(0, v)
+}
+
+func (s NetworkSpec) FileMode() uint32 {
+	return s.Struct.Uint32(4) ^ 384
+}
+
+func (s NetworkSpec) SetFileMode(v uint32) {
+	s.Struct.SetUint32(4, v^384)
+}
+
+// NetworkSpec_List is a list of NetworkSpec.
+type NetworkSpec_List struct{ capnp.List }
+
+// NewNetworkSpec creates a new list of NetworkSpec.
+func NewNetworkSpec_List(s *capnp.Segment, sz int32) (NetworkSpec_List, error) {
+	l, err := capnp.NewCompositeList(s, capnp.ObjectSize{DataSize: 8, PointerCount: 1}, sz)
+	return NetworkSpec_List{l}, err
+}
+
+func (s NetworkSpec_List) At(i int) NetworkSpec { return NetworkSpec{s.List.Struct(i)} }
+
+func (s NetworkSpec_List) Set(i int, v NetworkSpec) error { return s.List.SetStruct(i, v.Struct) }
+
+func (s NetworkSpec_List) String() string {
+	str, _ := text.MarshalList(0xcb88fc1b4a294b26, s.List)
+	return str
+}
+
+// NetworkSpec_Promise is a wrapper for a NetworkSpec promised by a client call.
+type NetworkSpec_Promise struct{ *capnp.Pipeline }
+
+func (p NetworkSpec_Promise) Struct() (NetworkSpec, error) {
+	s, err := p.Pipeline.Struct()
+	return NetworkSpec{s}, err
+}
+
+type NetworkSpec_Type uint16
+
+// NetworkSpec_Type_TypeID is the unique identifier for the type NetworkSpec_Type.
+const NetworkSpec_Type_TypeID = 0xb87e4f5223187046
+
+// Values of NetworkSpec_Type.
+const (
+	NetworkSpec_Type_tcp  NetworkSpec_Type = 0
+	NetworkSpec_Type_unix NetworkSpec_Type = 1
+	NetworkSpec_Type_pipe NetworkSpec_Type = 2
+)
+
+// String returns the enum's constant name.
+func (c NetworkSpec_Type) String() string {
+	switch c {
+	case NetworkSpec_Type_tcp:
+		return "tcp"
+	case NetworkSpec_Type_unix:
+		return "unix"
+	case NetworkSpec_Type_pipe:
+		return "pipe"
+
+	default:
+		return ""
+	}
+}
+
+// NetworkSpec_TypeFromString returns the enum value with a name,
+// or the zero value if there's no such value.
+func NetworkSpec_TypeFromString(c string) NetworkSpec_Type {
+	switch c {
+	case "tcp":
+		return NetworkSpec_Type_tcp
+	case "unix":
+		return NetworkSpec_Type_unix
+	case "pipe":
+		return NetworkSpec_Type_pipe
+
+	default:
+		return 0
+	}
+}
+
+type NetworkSpec_Type_List struct{ capnp.List }
+
+func NewNetworkSpec_Type_List(s *capnp.Segment, sz int32) (NetworkSpec_Type_List, error) {
+	l, err := capnp.NewUInt16List(s, sz)
+	return NetworkSpec_Type_List{l.List}, err
+}
+
+func (l NetworkSpec_Type_List) At(i int) NetworkSpec_Type {
+	ul := capnp.UInt16List{List: l.List}
+	return NetworkSpec_Type(ul.At(i))
+}
+
+func (l NetworkSpec_Type_List) Set(i int, v NetworkSpec_Type) {
+	ul := capnp.UInt16List{List: l.List}
+	ul.Set(i, uint16(v))
+}
+
 type X509KeyPair struct{ capnp.Struct }
 
 // X509KeyPair_TypeID is the unique identifier for the type X509KeyPair.
@@ -464,57 +1273,162 @@ func (p X509KeyPair_Promise) Struct() (X509KeyPair, error) {
 	return X509KeyPair{s}, err
 }
 
-const schema_cee75c59b9f2a30b = "x\xda\xa4SO\x88\x1bU\x1c\xfe\xbe\xf7f\x92,&" +
-	"l\x86\x19\xb1xI-\xf1\xb0\xa5-\xab\xb6Ps\xe9" +
-	"n\x93\x1eR\x0d\xe4\xed\x10\xa8\xa2\xe28y)C\xf3" +
-	"g\x98$\xdb\xddZ/E\x11z\xe8\xa1\x82x\x13\x14" +
-	"\xbcz\x13\x15\xf4P\xd0C\x0f\x1e=\x88\x07Ad/" +
-	"z\x11W\xf6\xa2#/\xbb;\xc9.\x82\xa2\xb7\xdf|" +
-	"\xbf?\xf3}\xef\xfb\xfdV?\xe5\x9ax\xca\xaeH@" +
-	"U\xed\\\xba\xfb\xfb\xe3\x1f}y\xaa\xf6\x16\x9c\x12\xd3" +
-	"G>\xfc\xf5\xb3\x17^\xda\xf9\x06\xb6\xcc\x03\xee\x94\x0f" +
-	"\xdc7h\xa2m~\x0c\xa6\xef\xfex\xee\x87\xdbo\xbf" +
-	"w\x0f\xaaDk^l\x99\x8au\xf1\xd0m\x09\x135" +
-	"\xc5\x0e\x98\x9e\x7f\xec\xbb\xef\xff\xdc|\xfd'S+\x8f" +
-	"\x0f~R>p\xcf\x9a\xe8\x99\x15\x99\x12L\xef_\xff" +
-	"\xe4\xf3{_\x9f\xd9;\xc6b6\xafc?t\x03\xdb" +
-	"D/\xdb7q1\x0dG\xc3^t\xfd\\(\x82x" +
-	"\x18\xd7\xea\xfdH\x0f'~\xacC\xb4IU\x94\x16`" +
-	"\x11p\xae\xbc\x06\xa8\x86\xa4j\x0b:\xa4G\x03\xb6^" +
-	"\x04\xd4\xf3\x92\xea\x9a\xa0#\x84G\x018\x9d\x9a\xd3\xa9" +
-	"\xa8-I\xf5\xa6`:\xd6\xc9f\x14j\x1f\xf9X\x87" +
-	",\xcfE\x83,\x83i8\xfba]C&\x13\x96\xe7" +
-	"\xcc\xf7\xd3\x97\xc2\xa0\xae\x93\x89\xb2(\xd2W\xdey_" +
-	"}\xf1\xed\xdd\xaf\xa0,\xc1u\x8f,\x02\x0e\xef\xa4\xed" +
-	"+\xad\x93\xbd\xa8\xafy\xb27J\x06\xc1\x04\x00K\x10" +
-	",\x99\xe9G\xc4\xf9\x07\\\x8c\xba\x99\xbcr&/\xb8" +
-	"\x0a\xa8W%U\x7fA^\xf44\xa0\xba\x92*^\x90" +
-	"7\xd8\x00T_Rm\x09:\xb2\xe8Q\x02\xce\xf44" +
-	"\xa0bIu[0\xed\x8e\x06A4l6\x0c\x91%" +
-	"\x08.\x81\x95 \x8e\x9b\xdd\xc3\xaf\xc3Gi\x82\x19\xb6" +
-	"\x1c\x8f\x92\x09\xf3\x10\xcc\xff-o\x9dd\xa6T3\xd6" +
-	"\xbf\x18S~\x96T{\x0b\xacw\x8d)\xbfInp" +
-	"\x81\xf5\x1f5@\xedI\xfa\x16\x05)=J\xd2%\xaf" +
-	"\x02\x1b\x94\xf4\x8b\xa6\xd6\xb2<Z\xa4\xbb\xc4\x0f\x00\xbf" +
-	"h\xf0\x13\x06\xb7\x85G\x1bp\x1f\xe5]\xc0?a\xf0" +
-	"\xaa\xc1s\xd2c\x0ep\x9f\xe0}\xc0\xaf\x1a|\xd5\xe0" +
-	"y\xcb\x9bm\xf9Y\xde\x02\xfc3\x06\xbfh\xf0\x82\xed" +
-	"\xb1\x00\xb8\x17x\x07\xf0\xcf\x1b|\x8d\xffbE\xc63" +
-	"\xf9\xff\xb0\"\x99\xe5\x83`\xab>\x1a\x0e\xc7\xe6\xf5\x0b" +
-	"\x10Na\x0dLoh\x1d\xaf\xf7\xa3M\xea\xb6N\xa2" +
-	"Q\xd7\xcf\xebp\xcc\x1c\x84\x9d[\x06\xd3D\x07\xdd\x86" +
-	"\x0e\xba\xecGC\xdd\xf2\xcdz\x98^\x16\xc0\xf4f\x12" +
-	"MtC\x07\xec\x1e$\x19f9\xd3wy\xda\xeb\xe1" +
-	"\x92N\xfc\xe8\x96>\xdaty\xdaco?1\x1fw" +
-	"\xd4\xd9k\x17V\x9f}No\xb7\x83(\xd9\xdf\xc8B" +
-	"\xe6\xed\xca)g\xa5\x92\x1d\xd7\xa1\xb9\x9d\xd3\x0b\xc7\x95" +
-	"\xbf\xa1\xb7\xff\xebi,\x87\xff\xe3\xae\xfe\x0a\x00\x00\xff" +
-	"\xff\xf1\xea'\xce"
+const schema_cee75c59b9f2a30b = "x\xda\xbcXol\x1cG\x15\x7fo\xe6v\xe7\x9c\xda" +
+	"=\x8f\xe7J\xa9K\xb9\xd6HE\xb1JD\xadV\xb4" +
+	"\xe6\x83\xeb\x8b\x8b\x88\x1b\xd3[\xaf#\xb5VBX\xdf" +
+	"\x8d\xe3s\xcew\x97\xbd\xbd$6\x09\x09V#R\x13" +
+	"\xaa&M\xabR\xa5@\x03\x89\xd2H\xa8\x14E\x15\x8d" +
+	"\x1a\x04\x11E\xc8\x08\xd4\x14\x11AD\xd54\x08\x95D" +
+	"\xad\xd4Z\x14\x91(\xcd\xa0\xd9\xbd\xdb[_\x9c\x16\xf8" +
+	"\xc0\x87S\xe2\xf7~o\xfe\xbc\xf7~\xbf\x99Yn\xee" +
+	"\xec\xc0\x9d\xb7\xed\xc4\x0e\xfc\xc7}1q\xce@ V" +
+	"\x92\xc6\x00b\x08\xc0\xb7\x0f\x02X\xdb(Z\xbb\x09r" +
+	"\xc4$j\xe3\xae\x1e\x00k'Ek\x0fANH\x12" +
+	"\x09\x00\x7ft\x18\xc0\xdaM\xd1\xdaO\x90\xd3\xd6$R" +
+	"\x00\xbe\xb7\x1b\xc0\xdaC\xd1z\x9a \xc6\x92\x18\x03\xe0" +
+	"O\xa6\xf9\x93\xcc\xdaO\xd1\xfa\x11A\x95+M9\xf9" +
+	"\xe2\xaa\x01\x00\xc0\x16 \xd8\x02\x98r\xca\xe5U\xb9\xfa" +
+	"_\xaa\"\xdd\xcd\xf9\xac\\\x05\x18\xda\x12\xe5\x92\xeb!" +
+	"\x03\x82\x0cpGQz[J\xee\xc6\x0c\x12lW\xb7" +
+	"?\xb0|\xf0\xe6\xcb\xbb\x7f\x0b\x00\xf7!\x00\xb6\x03\xf6" +
+	"\x9d\xb8\xa2T\x0aU\xb6T\x1c\xcfoX\x91%N\xb9" +
+	"X\xee\xb5\xa5\xbbY\xbavYf!\xf5\xbeR\xead" +
+	"\x061uQ)\xc5\xd4F)\xcb\xfd\x85\xfcf\x94\x19" +
+	"\xe9\xe6K9\x9b\xc9l\xc5\x07\x1dD\x13\xc8\xfaw\x94" +
+	"R\x14\x0d3\x01\xb8\xfe=\xa5\x14A\xe5J'7 " +
+	"\x9d\x1c\x16\xf2E9d\xcb,\x04\xa3\xcea<\x0c\xc0" +
+	"x\x03\xbf\xc5\xcd{r@:\x98\xab\x05`\xd6\xc7\xef" +
+	"\x8b\xe03H\xac\x01\xc3T\x1f\xfc\xb3\xf3\xf0\xcf\xbbz" +
+	"w\x01oCu\xdd\x0f\x17^~x\xed\xdb\xbf\x07\x83" +
+	"2\x00\xf1grR\xbcE\xf4\xff\xde /\x00\xaa\xa7" +
+	"\xfe\xba\xe2\xec\xb6o=\xfd\x18Xm\x18\x8b\x80QC" +
+	"\x0e\xd1y\xf1\xa2\x0e\xa3G\x09\xa0\xba\xeb\xc63\x7f\xb9" +
+	"\xb2\xf9\xeb\x7f\xd3X#\x8254b\x9e\xd03\x04@" +
+	"t\xd2\x18\xa0\xda\xb7\xe1\xa5\xe3\x8f\xfd\xfa\x8e\x7f5-" +
+	"\xc1\x9f\xf8\x0c\xa1\xe2\x02\xd1\xd5\xfe\x84\x01\xf7\xa8_\xae" +
+	"\xfb\xe2\xa9\xa7\xd2\x9b\xf6\xe8Q\x9b\xb1<m\xf0I\x83" +
+	"\xcf\x18\xa2\x9b\x18\x80\xea\x0f/\x9d\xbc2V~\xee0" +
+	"\xf0\x9bI#\x10P\x0c\x13C\x8c\x11C\xb8\xc4\x10\xaf" +
+	"\xfb\xd0/\xaf\xbcw\xfa\xd4\xcb\x17\x7f\xa2\x87%\x8da" +
+	"cz\x05\x0b\xc4\x10\x84\x1a\xa2\x83\x1a\"mh\xf4m" +
+	"\xees-\xb7\x0f\xac~\xa5y\x11~\x1a&\x0dC\xcc" +
+	"\x18\x86\x983\x0c1ojx\x02\x8f\x1f\xdet\xec\xdb" +
+	"J\xc3i3\xfc\x82i\x88K\xa6!\x961C\x9cF" +
+	"\x13P=?\xd4\xff\xd9o~\xef\x89\xd3\xcdp?\x1d" +
+	"\x84\x98\xa2\x83\x98\xa2\x8b\x98b>\xa6\xe1aK.\xb5" +
+	"\x98\x85\x98):\x0dSt\x1b\xa6\x9875\xfcK\xe5" +
+	"O~f\xf8\xc1o\xfc\x0c\xf8M\xa4\x11\x0b(\x16L" +
+	"S\x10f\x8a\x0ef\x8ag\x98\x09\xd8\xd7\xf6\xa1Ro" +
+	"\x1aM\x8d\xbd\xb2\x90\x97E\xcfo\xec\x0c\xa2\xd5\x1ar" +
+	"\xf9\xfe1\x00k\x80\xa2\x95\x89pyh\x14\xc0ZM" +
+	"\xd1z(\xc2\xe55\xbd|M\xca\xdaJ\xd1z\x84\x84" +
+	"\x04\xb4\x81\x95e\x16\xdb\x1b=\x06\xa8\xe9\xa5\xb2\xfe\x84" +
+	"+%P\xd7\xc3\xf6F\xaf\x04\xee\xbe\xac\xb3R\xba\x9e" +
+	"\x15C\xa2\xbe\xfa\xc4\xf7\xad\x13\xa7\xe7^\x05+F\xb0" +
+	"?\x89\xd8\x0a\xc0qVe\xee\x1f\xbau<_\x90x" +
+	"\xebx\xc9\x9dr<\x00\xc06 \xd8\x06K\xb1V\xaf" +
+	"E\xef\xce\xdf\xde]\xf5\xed\x89u8\x06`\xafE\x8a" +
+	"\xf6\x046v($\x8e\x02\xd89m/cc\x93b" +
+	"\x0a{\x01\xec\x09m\xf7\x90 \xd2$RD\xb1\x09\x07" +
+	"\x01\xec\xb26o\xd3\xf0\x98\x96-Dz\x89\x00\xd0." +
+	"J\xd3\x94\x1b$\x89\x06\x00\x1d\xa3\x00t\x8e\xd2\xa3\x94" +
+	"\x9b4\x89\xa6\xa6\x8d6-P\xdeI9\x8b%u\x85" +
+	"y\x8f\xa6\xc6(\xe53\x94\xc7\x8d$\xc6\x01\xf8>m" +
+	":N\xf9\x19\xca[h\x12[\x00\xf8\x02\xe5\xe7\x99\xe8" +
+	"B*\xd2H\xf923\x89\xcb\x00\xc4(R1\x8eT" +
+	"\xecC*~\x8a\x94_\xc7\x92x\x1d\xa2x\x1d\xa98" +
+	"\x87T,#Tt\x13\xca[cI\x9dK\x91&T" +
+	"\x0c\x13*f\x09\x15\x07\x09\xfd\xd8\xd2U|\x11\xfc\x98" +
+	"\xd2\x85\xa5\x98r\xb6\xae,\x15\x8b\x15\x00\xd02\xc5\xe3" +
+	"\xf7\x01f\x90`|\xb1\x0e\xa6\xab\xe3\xe3\xd0'];" +
+	"?#S\x1f(\xa5f>J\x04\xd3\xd5q\x1c\x0f\xc0" +
+	"\xe0\xa3g\xaf\x81v\x1dO\xae\xceO\xe5\x81z\x15\x1f" +
+	"8\x8a\xed\x11\xd1\xf0\xe5~\xfd\xdf\x83\xb0\xf6FX>" +
+	"W\x90#\xf9)\x89\xa5\xaag\xcbl\xa56\x09\xbdD" +
+	"9\xb2kL\x95s\x9d|q$?\x85\xb2\x1eTS" +
+	"\xf3\x0c\x92\xe0\x8c\x88\x04\xf2\xf8\xa7\x1b\x91N\xd5\x9b\x98" +
+	"\xc9\x94\x0a\xc0\xf2\xd9i\x7f\xa6\xb1FL{C\x97\xae" +
+	"\xb5\\N\xc8\x9d\xf8&i\xea\xfa\x87\xee\xfe\xfc\xbd\x0f" +
+	"\xc8\xe9\x8c\x93w\x01R\xef*\xa5\xe6\xc3\xd3\xca\x8a\x87" +
+	"\x04_\xde\xc5\x97\xa7B2\xd7\x19\xbe\xa6[\x1cg1" +
+	"q\x8e\xc5\xb8i\xb0\x8dr\xfa\x7f\xa5b\"\xaby|" +
+	"OH\xb8\xed>\xe1\xb6i\xa6\xec\x8e\x12n\x97O\xb8" +
+	"G\xb4\xfd\xf1(\xe1\xbe\xe3\x13n\xb7\xb6\xef\x8f\x10n" +
+	"\xafO\xb8\xc7\xb5\xf9@\x84p\xe2\xbbx\x10\xc0>\xa0" +
+	"\xedG\xb4\xbd\xc6:q\x08\xe7\x00\xec#\xda~L\xdb" +
+	"k\xd4\x13/\xe2>\x00\xfb\x98\xb6\xffB\xdbk\xfc\x13" +
+	"'p\x06\xc0~E\xdb\x7f\xa3\xed5\x12\x8aWq\x16" +
+	"\xc0\xfe\x95\xb6\xbf\xa6\xed5&\x8a\xdf\xe1\xa88\x85\xcc" +
+	"~M{\xcejO\x9d\x8do\xe0\xacx\x0b\x99}V" +
+	"{\xde\xd1\x9e:\x1f\xcf\xe3\x9cx\x1f\x99\xfd\x9e\xf6\\" +
+	"\xd6\x9e:#/\xe2\x98\xf8\x10\x99}Y{Z\x09A" +
+	"\xde\x16Ob\x1b\x80h!\xcf\x08N\x98\xddN(\xda" +
+	"\x9f\"\x04\xfb6]Q\xea\xc6\xff\x07i\x97\xbe\xe1\xa0" +
+	"\x09\xc4\xbf\xd4,u\x9b\xd1\xb1\x9a\"K\xdd\\B_" +
+	"3\xfb\x17\x07E\x99\xde\x08\x89\xb0:\xb8\xc15Q\xda" +
+	"\xdf\xf2U$\x0ed'\x18b\x09\xb6\xd6\xdc>5\x17" +
+	"s2\x98\xa3\x89\x87\xfe\x1cc\xd5\xca\xf4\xb0\xf4\\\x9c" +
+	"\xee\x1f\xf7\xa4;dS\x99\x8dL\xf3\xdf\xb2&\xf5G" +
+	"\xa5T\x0a\xdbB\x9d\xd0\xd5\x10\x17X,CP,\x10" +
+	"\xcaqK\x13\xcb\x87k\xa9\xf0\xec2\x95Y\x9f\xe6g" +
+	"\xac\x18F\xafI\xd8\x9d\x18*\xe5\xa4\xef\xbb1\x14\x96" +
+	"\xe8\x19\x9f\xe6\xf73\xff\x90\x1f\x89H\x805\xcc\xd70" +
+	"k\x84\xa2\x95#\x88\xb5C\xde\xe9\xe6\x0e\xb3\xbeF\xd1" +
+	"*\x13\xdcQ\x96\xaen\x93 =\xe1e+\x92\x1e\x0d" +
+	"\xd0\x07=\xa0wMPb\xaa\x94\x93\xda\x9bh\xac\xb9" +
+	"\xe6M\x00\xa6\x96+\xa5^\x087M\x9b7-\xb3+" +
+	"\xf4\xe6\xa0\xef]_\x0aC\x85k\xec3\xee/\x9c\xf7" +
+	"p\xce\x10y[/oc\xa9\x9c,8\xba\xaa}\xae" +
+	"\x9c\x94Y\xbd\xb6\xd4)\xa5\xd4\x17\xc2y\xb0>O_" +
+	"0\x91\xaf\xe4\xc7\x97\x18\xbd=\xcc\xa23\xc3%\xb3r" +
+	"Aj\xc2,NM\xf2M\xcc*S\xb4\xb6E\xeeJ" +
+	"\xd3c|;\x0b\x9fH\x94\x06\x0f\x9f]\xc3\xfcQV" +
+	"\x7f\x0e\xa9)Y\xa98\x1bd\x05\xfa2\xd2\xb5\x17\xb5" +
+	"U\xdd\x97\x86D\xd5\xadxQ\xcf\xd8\xb4'+\x19\xe9" +
+	"\x02k\x0a\xd1\x8et\xd5\xad\xd4JQ3\xa7\xce_Q" +
+	"\xea\xed\xa6\x9e\xea\xaf\xf5~>;}\xd5\xc9\x11\xc9k" +
+	"\xe3\x08\x99\xe4\x9fc\xd6\x1d\x14\xad{\x08\xd67~w" +
+	"\x0f\xbf\x9bYw\x05M\xa5rr\xdc\xa9\x16\xbc~H" +
+	"\x14\x0a\xa5-z\x01\x08\xfa\x87)\xb7Z\x90>\x89\xaf" +
+	"\x07\xcc\xe8c\xadq\xb1\xaeu\xc1\xf5\xfau\xa7\x94\xda" +
+	"\xd2T\x9d\xfe\xaa\xd7713\\-\xc8\xff\xa0:\x1d" +
+	"&_\xc7x\xaf\xc9G\xcd\xb06\xae\xc9gM~\xd4" +
+	"\xe4\xf3&'\xf1\xa02\xe7L\xbe`\x8aN4E/" +
+	"\x9a\x18\x14F\x0c\xa2)F\xd1\x14G\xd1\x14\xf3hf" +
+	"\x90\xd4+0\x02l\xba\x1c\\X\xc6\xb0\xa5A\xdb\x96" +
+	"\xc8]\xa2\xe8Iw\xdc\xc9\x02\x93\xabr\xcd\xa7\xfb\xd2" +
+	"!S\xd2\x9b(\xe5V\xe5\x00\x82\x8b\xc7`#\x805" +
+	"\x02X\x18\x90rtV}DO\x03\x1a&4|z" +
+	"\xd4.\x10\x7f\x0a\xe2\xaf\x0f\xe3E\x07386\xb7A" +
+	"FJw\xc8\xf1\xb2\x13\xd2\xfd\x886\x88<\xfb;\xa3" +
+	"M\x1d\xbe\xfb'#M]\x97\x91\xbd\x83\xf5\xe7\xfc\x0f" +
+	"t\xffc\xd0\xff\xcf\xf6\xf0g\x99u\x80\xa2uD\x1f" +
+	"\xe9$x\xfa\x1f\x1a\xe6\xcf3\xeb\x08E\xeb\x18A\x9a" +
+	"\xf5\xd5\xa6\x15\xf4\x0fU\xc9\xdd\xe0\x14\xf33\x0e$\xbc" +
+	"|i\x91'\xf2\x89@\x9b\x17}%\x88\x18\xa2\x1f\x0a" +
+	"\"\xe6\xbe\x1f/\xf5\xfa\xffJ\xf0\xcd xG\x04\x09" +
+	"\xf1\xa5\xb6\xf1\xf8\xc2\xee\xc4\x88\xee\x87kKmwD" +
+	"jC\xa5Ms\x8bY\x19\x8a\xd6Z\x82\x9c`\x12\x09" +
+	"\"\x7fx\x90\xafc\xd6Z\x8a\xd6\x04\xc1\x847]\xae" +
+	"\x89d8[C$w8\xb9\x9c++\x95h\x02\xf4" +
+	"\xc9\xe2+cx\xb0\xdd\x19\xdf\x89\x80\xa9;\x17\x09\xea" +
+	"U;K\xad\x08w\xb0\xb0D\xb9[\xfd\xfa\xdd\xd2\xc5" +
+	"o\xd1jzS7\xbf\x89!\xe17t\xf3\x1b\x18\xf3" +
+	"\xb2\xe5\x0c\x92D\xb5\x98\xdf\xaa\xff-\xe7\xfd%\xa7N" +
+	"(\xa5\x1e\xfc\xf7\x00lk\x17\xcd"
 
 func init() {
 	schemas.Register(schema_cee75c59b9f2a30b,
 		0x853a22bea61af6f5,
+		0x8c714296d13b5dc1,
 		0x8e98877ce02ee396,
+		0xa6a17062fec2b6d3,
+		0xb0f9b9d179394348,
+		0xb87e4f5223187046,
+		0xbc4c442609a17221,
+		0xcb88fc1b4a294b26,
+		0xd6939e8127414da9,
 		0xe57b76fedcda1734,
-		0xf82cc68ebab66792)
+		0xf82cc68ebab66792,
+		0xff8bb471a6ba010f)
 }
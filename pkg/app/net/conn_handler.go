@@ -16,10 +16,18 @@ package net
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"net"
+	"sort"
+	"time"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
 )
 
 type ConnHandler func(ctx context.Context, conn net.Conn)
@@ -47,20 +55,500 @@ func (a ConnHandlerContext) ServerSpec(ctx context.Context) *ServerSpec {
 	return ctx.Value(CTX_SERVER_SPEC).(*ServerSpec)
 }
 
-type MessageHandler func(ctx context.Context, conn net.Conn, msg message.Message)
+// MessageHandler processes a request message, and returns the response message, which must have a message.Message root.
+// If nil is returned, then no response is sent. If an error is returned, then an error response is sent - see NewErrorResponse().
+// Handlers are run concurrently.
+type MessageHandler func(ctx context.Context, request *message.Message) (response *capnp.Message, err *app.Error)
 
+// MessageType is the capnp type id of the message data, i.e., Message.type
 type MessageType uint64
 
-type MessageContext struct {
-	ctx  context.Context
-	conn net.Conn
-	msg  message.Message
+func (a MessageType) UInt64() uint64 {
+	return uint64(a)
 }
 
+func (a MessageType) Hex() string {
+	return fmt.Sprintf("%x", a)
+}
+
+// built-in message types that are handled by the MessageRouter
+const (
+	MessageType_PING                             = MessageType(message.Ping_TypeID)
+	MessageType_PONG                             = MessageType(message.Pong_TypeID)
+	MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST  = MessageType(message.SupportedMessageTypes_Request_TypeID)
+	MessageType_SUPPORTED_MESSAGE_TYPES_RESPONSE = MessageType(message.SupportedMessageTypes_Response_TypeID)
+	MessageType_ERROR                            = MessageType(message.Error_TypeID)
+	// stream control messages carry no data, i.e., only the stream header - see Stream
+	MessageType_STREAM_CONTROL = MessageType(message.Message_Stream_TypeID)
+	// sent by the server when it is draining - see ConnGoAway()
+	MessageType_GOAWAY = MessageType(message.GoAway_TypeID)
+	// sent by the server when it is at max conns, before closing the conn - see ServerSpec.BusyRetryAfter()
+	MessageType_SERVER_BUSY = MessageType(message.ServerBusy_TypeID)
+)
+
+// MessageRoute maps a message type to the handler
 type MessageRoute struct {
-	c chan *MessageContext
+	MessageType
+	Handler MessageHandler
+}
+
+// PipelineMessageRoute routes messages to a command pipeline. If the pipeline is not registered, then it is started from
+// its config. The pipeline's last stage sets the response message on the Context - see WithResponseMessage().
+func PipelineMessageRoute(messageType MessageType, pipelineID command.PipelineID) MessageRoute {
+	return MessageRoute{messageType, PipelineMessageHandler(messagePipeline(pipelineID))}
 }
 
+// PipelineMessageHandler submits the request to the pipeline, and waits for the workflow result. The workflow result is
+// returned via the Context output channel, i.e., the pipeline output channel is not used.
+// If the workflow fails on any stage, then the workflow error is returned, i.e., the router replies with an error response.
+func PipelineMessageHandler(pipeline *command.Pipeline) MessageHandler {
+	in := pipeline.InputChan()
+	return func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		results := make(chan context.Context, 1)
+		ctx = command.WithOutputChannel(WithRequestMessage(ctx, request), results)
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case in <- ctx:
+		}
+		select {
+		case <-ctx.Done():
+			return nil, nil
+		case result := <-results:
+			if err := command.Error(result); err != nil {
+				return nil, err
+			}
+			response := ResponseMessage(result)
+			if response == nil {
+				return nil, nil
+			}
+			if err := stampResponse(*request, response); err != nil {
+				return nil, InvalidMessageError(pipeline.Service.ID(), err)
+			}
+			return response, nil
+		}
+	}
+}
+
+// MessageRouter dispatches request messages to MessageHandler(s) by message type. The following message types are handled
+// by the router :
+//	- Ping is replied to with a Pong
+//	- SupportedMessageTypes.Request is replied to with the registered message types
+//
+// Requests for unknown message types are replied to with an error response - see ErrSpec_UnknownMessageType.
+//
+// Messages that carry a stream header are dispatched to StreamHandler(s) - see Stream.
 type MessageRouter struct {
-	//messageHandlers map[MessageType]
+	service        *app.Service
+	handlers       map[MessageType]MessageHandler
+	streamHandlers map[MessageType]StreamHandler
+}
+
+// NewMessageRouter returns a new MessageRouter.
+//
+// errors
+//	- ErrSpec_IllegalArgument : if a route handler is nil, a message type is routed more than once, or a built-in message
+//	  type is routed
+func NewMessageRouter(service *app.Service, routes ...MessageRoute) (*MessageRouter, error) {
+	return NewStreamingMessageRouter(service, routes, nil)
+}
+
+// NewStreamingMessageRouter returns a new MessageRouter, which routes messages to MessageHandler(s), and streams to StreamHandler(s).
+//
+// errors
+//	- ErrSpec_IllegalArgument : if a route handler is nil, a message type is routed more than once, or a built-in message
+//	  type is routed
+func NewStreamingMessageRouter(service *app.Service, routes []MessageRoute, streamRoutes []StreamRoute) (*MessageRouter, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service is required")
+	}
+	handlers := make(map[MessageType]MessageHandler, len(routes))
+	streamHandlers := make(map[MessageType]StreamHandler, len(streamRoutes))
+	checkMessageType := func(messageType MessageType) error {
+		switch messageType {
+		case MessageType_PING, MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST, MessageType_STREAM_CONTROL, MessageType_GOAWAY:
+			return app.IllegalArgumentError(fmt.Sprintf("Built-in message type cannot be routed : %x", messageType))
+		}
+		_, routed := handlers[messageType]
+		_, streamRouted := streamHandlers[messageType]
+		if routed || streamRouted {
+			return app.IllegalArgumentError(fmt.Sprintf("Message type is routed more than once : %x", messageType))
+		}
+		return nil
+	}
+	for _, route := range routes {
+		if route.Handler == nil {
+			return nil, app.IllegalArgumentError(fmt.Sprintf("MessageHandler is nil for message type : %x", route.MessageType))
+		}
+		if err := checkMessageType(route.MessageType); err != nil {
+			return nil, err
+		}
+		handlers[route.MessageType] = route.Handler
+	}
+	for _, route := range streamRoutes {
+		if route.Handler == nil {
+			return nil, app.IllegalArgumentError(fmt.Sprintf("StreamHandler is nil for message type : %x", route.MessageType))
+		}
+		if err := checkMessageType(route.MessageType); err != nil {
+			return nil, err
+		}
+		streamHandlers[route.MessageType] = route.Handler
+	}
+	return &MessageRouter{service, handlers, streamHandlers}, nil
+}
+
+// SupportedMessageTypes returns the request message types that are supported, including the built-in message types
+func (a *MessageRouter) SupportedMessageTypes() []MessageType {
+	messageTypes := []MessageType{MessageType_PING, MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST}
+	for messageType := range a.handlers {
+		messageTypes = append(messageTypes, messageType)
+	}
+	for messageType := range a.streamHandlers {
+		messageTypes = append(messageTypes, messageType)
+	}
+	sort.Slice(messageTypes, func(i, j int) bool { return messageTypes[i] < messageTypes[j] })
+	return messageTypes
+}
+
+// ConnHandler reads packed message.Message frames from the conn, and dispatches them to the message handlers.
+// Requests are processed concurrently, i.e., responses may be sent in a different order than the requests were received.
+// Responses are correlated to requests via the correlation id - see NewResponse().
+//
+// Messages that open a stream are dispatched to the stream handler, which runs in its own goroutine. Subsequent messages
+// on the stream are delivered to the open Stream. Stream protocol violations are replied to with an error response
+// - see ErrSpec_StreamProtocol. When the conn is closed, the open streams are failed.
+//
+// If the conn is rate limited, then the rate limits are applied to each message before it is dispatched - see RateLimiter.
+//
+// If the Context has an Authorizer, then requests and streams are only dispatched if the peer is authorized to send the
+// message type - see Authorize(). Unauthorized requests are replied to with an error response - see ErrSpec_Unauthorized.
+//
+// When the server is draining, the client is sent a GoAway message, and the conn is closed once the requests that are
+// in flight are done - see Server.Drain().
+//
+// The conn is closed if a frame cannot be decoded, or if a response fails to be sent.
+func (a *MessageRouter) ConnHandler() ConnHandler {
+	service := a.service
+	return func(ctx context.Context, conn net.Conn) {
+		defer conn.Close()
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// sends messages back to the client on the conn
+		responses := make(chan *capnp.Message)
+		goAway, closing := ConnGoAway(ctx), connClosing(ctx)
+		service.Go(func() error {
+			encoder := capnp.NewPackedEncoder(conn)
+			for {
+				select {
+				case <-service.Dying():
+					return nil
+				case <-ctx.Done():
+					return nil
+				case <-closing:
+					// the conn is drained
+					cancel()
+					conn.Close()
+					return nil
+				case <-goAway:
+					goAway = nil
+					if err := sendGoAway(ctx, encoder); err != nil {
+						MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("failed to send goaway message")
+						cancel()
+						conn.Close()
+						return nil
+					}
+				case response := <-responses:
+					if err := encoder.Encode(response); err != nil {
+						if service.Alive() {
+							MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("message encoding failed")
+						}
+						cancel()
+						conn.Close()
+						return nil
+					}
+				}
+			}
+		})
+
+		send := func(response *capnp.Message) {
+			select {
+			case <-ctx.Done():
+			case responses <- response:
+			}
+		}
+		streams := newConnStreams(service, func(msg *capnp.Message) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case responses <- msg:
+				return nil
+			}
+		})
+		defer streams.failAll()
+
+		// NOTE: the decoder buffer is not reused because requests are processed concurrently
+		decoder := capnp.NewPackedDecoder(conn)
+		for {
+			msg, err := decoder.Decode()
+			if err != nil {
+				if err != io.EOF && ctx.Err() == nil && (service.Alive() || app.Alive()) {
+					MESSAGE_DECODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("message decoding failed")
+				}
+				return
+			}
+			request, err := message.ReadRootMessage(msg)
+			if err != nil {
+				MESSAGE_READ_FAILED.Log(service.Logger().Error().Err(err)).Msg("failed to read message")
+				if response, err := NewErrorResponse(message.Message{}, InvalidMessageError(service.ID(), err)); err == nil {
+					send(response)
+				}
+				return
+			}
+
+			if response := rateLimit(ctx, msg, request); response != nil {
+				send(response)
+				continue
+			}
+
+			if response := unsupportedCompressionResponse(service, request); response != nil {
+				send(response)
+				continue
+			}
+
+			var stream *Stream
+			if request.HasStream() {
+				var appErr *app.Error
+				if stream, appErr = streams.dispatch(&request); appErr != nil {
+					if response := streamProtocolErrorResponse(service, request, appErr); response != nil {
+						send(response)
+					}
+					continue
+				}
+				if stream == nil {
+					// the message was delivered to an open stream
+					continue
+				}
+			}
+
+			if err := authorizeRequest(ctx, service.ID(), request); err != nil {
+				if response := unauthorizedResponse(ctx, service, streams, stream, request, err); response != nil {
+					send(response)
+				}
+				continue
+			}
+
+			requestCtx := WithRequestMessage(trace.ExtractMessage(ctx, &request), &request)
+			requestCtx, cancelDeadline, ok := withRequestDeadline(service.Context(requestCtx), request)
+			if !ok {
+				MESSAGE_DEADLINE_UNKNOWN.Log(service.Logger().Error()).Int("deadline_type", int(request.Deadline().Which())).Msgf("deadline type is not supported")
+			}
+
+			done := requestStarted(ctx)
+			if stream != nil {
+				go func() {
+					defer done()
+					defer cancelDeadline()
+					a.handleStream(withStream(requestCtx, stream), streams, stream, MessageType(request.Type()))
+				}()
+				continue
+			}
+
+			go func() {
+				defer done()
+				defer cancelDeadline()
+				response := a.handle(requestCtx, &request)
+				if response == nil || requestCtx.Err() != nil {
+					// the response is not sent for expired requests
+					return
+				}
+				if err := trace.InjectMessage(requestCtx, response.root); err != nil {
+					MESSAGE_TRACE_INJECT_FAILED.Log(service.Logger().Warn()).Err(err).Msg("failed to inject trace context")
+				}
+				send(response.msg)
+			}()
+		}
+	}
+}
+
+// handleStream runs the stream handler, and then finishes the stream. If no stream handler is routed for the message type,
+// then the stream is finished with an error.
+func (a *MessageRouter) handleStream(ctx context.Context, streams *connStreams, stream *Stream, messageType MessageType) {
+	defer streams.remove(stream.ID())
+	var err *app.Error
+	if handler, ok := a.streamHandlers[messageType]; ok {
+		if e := stream.open(ctx); e != nil {
+			stream.fail(e)
+			return
+		}
+		err = handler(ctx, stream)
+	} else if _, ok := a.handlers[messageType]; ok {
+		err = StreamProtocolError(a.service.ID(), stream.ID(), fmt.Errorf("Message type does not support streaming : %x", messageType))
+	} else {
+		MESSAGE_TYPE_UNKNOWN.Log(a.service.Logger().Warn()).Uint64("type", messageType.UInt64()).Msg("unknown message type")
+		err = UnknownMessageTypeError(a.service.ID(), messageType)
+	}
+	if e := stream.finish(ctx, err); e != nil && ctx.Err() == nil && !app.IsError(e, ErrSpec_StreamClosed.ErrorID) {
+		MESSAGE_ENCODE_FAILED.Log(a.service.Logger().Error()).Err(e).Msg("failed to finish stream")
+	}
+}
+
+// response is a capnp message with a message.Message root
+type response struct {
+	msg  *capnp.Message
+	root *message.Message
+}
+
+func (a *MessageRouter) handle(ctx context.Context, request *message.Message) *response {
+	var msg *capnp.Message
+	var err error
+	switch messageType := MessageType(request.Type()); messageType {
+	case MessageType_PING:
+		msg, err = NewPongResponse(*request)
+	case MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST:
+		msg, err = NewSupportedMessageTypesResponse(*request, a.SupportedMessageTypes())
+	default:
+		handler, ok := a.handlers[messageType]
+		if !ok {
+			if _, ok := a.streamHandlers[messageType]; ok {
+				msg, err = NewErrorResponse(*request, StreamProtocolError(a.service.ID(), responseCorrelationID(*request), fmt.Errorf("Message type requires a stream : %x", messageType)))
+				break
+			}
+			MESSAGE_TYPE_UNKNOWN.Log(a.service.Logger().Warn()).Uint64("type", messageType.UInt64()).Msg("unknown message type")
+			msg, err = NewErrorResponse(*request, UnknownMessageTypeError(a.service.ID(), messageType))
+			break
+		}
+		var appErr *app.Error
+		if msg, appErr = handler(ctx, request); appErr != nil {
+			msg, err = NewErrorResponse(*request, appErr)
+		}
+	}
+	if err != nil {
+		MESSAGE_ENCODE_FAILED.Log(a.service.Logger().Error()).Err(err).Msg("failed to create response message")
+		return nil
+	}
+	if msg == nil {
+		return nil
+	}
+	root, err := message.ReadRootMessage(msg)
+	if err != nil {
+		MESSAGE_READ_FAILED.Log(a.service.Logger().Error()).Err(err).Msg("response message root is not a message.Message")
+		return nil
+	}
+	return &response{msg, &root}
+}
+
+// NewResponse returns a new response message for the request. The response is assigned a new id, and the request's
+// correlation id. If the request has no correlation id, then the request id is used as the correlation id.
+// The response data is set via message.SetData(), using the request's compression and packing settings, unless a
+// message.CompressionPolicy is registered.
+func NewResponse(request message.Message, messageType MessageType, data *capnp.Message) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	response, err := message.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
+	response.SetId(uid.NextUIDHash().UInt64())
+	response.SetType(messageType.UInt64())
+	response.SetCorrelationID(responseCorrelationID(request))
+	response.SetTimestamp(time.Now().UnixNano())
+	if request.IsValid() && message.SupportedCompression(request.Compression()) {
+		response.SetCompression(request.Compression())
+		response.SetPacked(request.Packed())
+	}
+	if data != nil {
+		if err := message.SetData(&response, data); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+// unsupportedCompressionResponse returns an error response if the request data compression is not supported, i.e., the
+// request data cannot be read. Otherwise, nil is returned.
+func unsupportedCompressionResponse(service *app.Service, request message.Message) *capnp.Message {
+	if message.SupportedCompression(request.Compression()) {
+		return nil
+	}
+	MESSAGE_COMPRESSION_UNSUPPORTED.Log(service.Logger().Warn()).Uint64("type", request.Type()).Uint16("compression", uint16(request.Compression())).Msg("message compression is not supported")
+	response, err := NewErrorResponse(request, InvalidMessageError(service.ID(), message.UnsupportedCompressionError{Message_Compression: request.Compression()}))
+	if err != nil {
+		MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("failed to create error response message")
+		return nil
+	}
+	return response
+}
+
+func responseCorrelationID(request message.Message) uint64 {
+	if correlationID := request.CorrelationID(); correlationID != 0 {
+		return correlationID
+	}
+	return request.Id()
+}
+
+// stampResponse assigns the response a new id, and correlates it to the request - see NewResponse().
+// The response timestamp is set, if not already set.
+func stampResponse(request message.Message, response *capnp.Message) error {
+	root, err := message.ReadRootMessage(response)
+	if err != nil {
+		return err
+	}
+	root.SetId(uid.NextUIDHash().UInt64())
+	root.SetCorrelationID(responseCorrelationID(request))
+	if root.Timestamp() == 0 {
+		root.SetTimestamp(time.Now().UnixNano())
+	}
+	return nil
+}
+
+// NewPongResponse returns a Pong reply for the Ping request
+func NewPongResponse(request message.Message) (*capnp.Message, error) {
+	data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	if _, err := message.NewRootPong(seg); err != nil {
+		return nil, err
+	}
+	return NewResponse(request, MessageType_PONG, data)
+}
+
+// NewSupportedMessageTypesResponse returns a SupportedMessageTypes.Response reply
+func NewSupportedMessageTypesResponse(request message.Message, messageTypes []MessageType) (*capnp.Message, error) {
+	data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	supportedMessageTypes, err := message.NewRootSupportedMessageTypes_Response(seg)
+	if err != nil {
+		return nil, err
+	}
+	types, err := supportedMessageTypes.NewTypes(int32(len(messageTypes)))
+	if err != nil {
+		return nil, err
+	}
+	for i, messageType := range messageTypes {
+		types.Set(i, messageType.UInt64())
+	}
+	return NewResponse(request, MessageType_SUPPORTED_MESSAGE_TYPES_RESPONSE, data)
+}
+
+// NewErrorResponse returns an error reply, which carries the error id and message
+func NewErrorResponse(request message.Message, err *app.Error) (*capnp.Message, error) {
+	data, seg, e := capnp.NewMessage(capnp.SingleSegment(nil))
+	if e != nil {
+		return nil, e
+	}
+	errorMsg, e := message.NewRootError(seg)
+	if e != nil {
+		return nil, e
+	}
+	errorMsg.SetErrorID(err.ErrorID.UInt64())
+	if e := errorMsg.SetMessage(err.Error()); e != nil {
+		return nil, e
+	}
+	return NewResponse(request, MessageType_ERROR, data)
 }
@@ -16,6 +16,7 @@ package net
 
 import (
 	"context"
+	"errors"
 	"net"
 
 	"io"
@@ -25,11 +26,13 @@ import (
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 	"zombiezen.com/go/capnproto2"
 )
 
 type ctx_request_message command.ContextKey
 type ctx_response_message command.ContextKey
+type ctx_request_done command.ContextKey
 
 func RequestMessage(ctx context.Context) *message.Message {
 	msg, ok := ctx.Value(ctx_request_message{}).(*message.Message)
@@ -39,6 +42,23 @@ func RequestMessage(ctx context.Context) *message.Message {
 	return nil
 }
 
+// RequestData returns the request message data, i.e., the data is decompressed and unpacked according to the request
+// message's compression and packed settings - see message.Data()
+//
+// errors:
+//	- ErrSpec_InvalidMessage if the Context does not carry a request message, or if the data fails to be read
+func RequestData(ctx context.Context, serviceID app.ServiceID) (*capnp.Message, *app.Error) {
+	request := RequestMessage(ctx)
+	if request == nil {
+		return nil, InvalidMessageError(serviceID, errors.New("Context has no request message"))
+	}
+	data, err := message.Data(request)
+	if err != nil {
+		return nil, InvalidMessageError(serviceID, err)
+	}
+	return data, nil
+}
+
 func WithRequestMessage(ctx context.Context, msg *message.Message) context.Context {
 	return context.WithValue(ctx, ctx_request_message{}, msg)
 }
@@ -55,7 +75,76 @@ func WithResponseMessage(ctx context.Context, msg *capnp.Message) context.Contex
 	return context.WithValue(ctx, ctx_response_message{}, msg)
 }
 
-func NewMessagePipelineConnHandler(pipelineID command.PipelineID) ConnHandler {
+// withRequestDone adds the func that is called when the request is done, i.e., when its response has been sent.
+// The func must be safe to call more than once.
+func withRequestDone(ctx context.Context, done func()) context.Context {
+	return context.WithValue(ctx, ctx_request_done{}, done)
+}
+
+// requestDone marks the request as done. It is a no-op if the Context does not track the request - see withRequestDone().
+func requestDone(ctx context.Context) {
+	if done, ok := ctx.Value(ctx_request_done{}).(func()); ok {
+		done()
+	}
+}
+
+// withRequestDeadline applies the request message deadline to the Context. The returned cancel func must be called once
+// the request is done. false is returned if the deadline type is not supported.
+func withRequestDeadline(ctx context.Context, request message.Message) (context.Context, context.CancelFunc, bool) {
+	cancel := func() {}
+	switch request.Deadline().Which() {
+	case message.Message_deadline_Which_timeoutMSec:
+		timeoutMSec := request.Deadline().TimeoutMSec()
+		if timeoutMSec > 0 {
+			ctx, cancel = context.WithTimeout(ctx, time.Millisecond*time.Duration(timeoutMSec))
+		}
+	case message.Message_deadline_Which_expiresOn:
+		expiresOn := request.Deadline().ExpiresOn()
+		if expiresOn > 0 {
+			ctx, cancel = context.WithDeadline(ctx, time.Unix(0, expiresOn))
+		}
+	default:
+		return ctx, cancel, false
+	}
+	return ctx, cancel, true
+}
+
+// RequestMessageCodec is a command.ContextCodec for Context(s) that carry a request message - see WithRequestMessage().
+// It is used to back message pipelines with a command.DurableQueue - see command.StartDurablePipeline().
+// When the request message is decoded, the request deadline is re-applied, i.e., relative timeouts are restarted.
+// The deadline resources are released when the decoded Context is done, i.e., when the deadline expires or when the
+// Context that the request was decoded into is cancelled - see command.DurableQueue.
+type RequestMessageCodec struct{}
+
+// Encode returns the request message in packed format. If the Context does not carry a request message, then nil is returned.
+func (a RequestMessageCodec) Encode(ctx context.Context) ([]byte, error) {
+	request := RequestMessage(ctx)
+	if request == nil {
+		return nil, nil
+	}
+	return request.Segment().Message().MarshalPacked()
+}
+
+// Decode unmarshals the packed request message and adds it to the Context
+func (a RequestMessageCodec) Decode(ctx context.Context, data []byte) (context.Context, error) {
+	msg, err := capnp.UnmarshalPacked(data)
+	if err != nil {
+		return nil, err
+	}
+	request, err := message.ReadRootMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel, _ := withRequestDeadline(WithRequestMessage(trace.ExtractMessage(ctx, &request), &request), request)
+	go func() {
+		<-ctx.Done()
+		cancel()
+	}()
+	return ctx, nil
+}
+
+// messagePipeline returns the registered pipeline. If the pipeline is not registered, then the pipeline is started from its config.
+func messagePipeline(pipelineID command.PipelineID) *command.Pipeline {
 	pipeline := command.GetPipeline(pipelineID)
 	if pipeline == nil {
 		service := app.Services.Service(pipelineID.ServiceID())
@@ -65,52 +154,124 @@ func NewMessagePipelineConnHandler(pipelineID command.PipelineID) ConnHandler {
 		}
 		pipeline = command.StartPipelineFromConfig(service)
 	}
+	return pipeline
+}
+
+// NewMessagePipelineConnHandler returns a ConnHandler that submits the request messages read from the conn to the pipeline.
+// The pipeline's last stage sets the response message on the Context - see WithResponseMessage().
+//
+// Each conn has its own output channel, i.e., workflow results are routed back to the conn that the request was received on.
+// Requests are processed concurrently, i.e., responses may be sent in a different order than the requests were received.
+// Responses are assigned a new message id, and are correlated to the request via the correlation id - see NewResponse().
+// If the workflow fails, then an error response is sent - see NewErrorResponse().
+//
+// Messages that open a stream are submitted to the pipeline with the Stream on the Context - see StreamFromContext().
+// The pipeline commands use the Stream to consume the client stream, and to send any number of responses. When the
+// workflow completes, the response message, if set, is sent as the final message on the stream - see Stream.
+//
+// If the conn is rate limited, then the rate limits are applied to each message before it is submitted - see RateLimiter.
+//
+// If the Context has an Authorizer, then only requests that the peer is authorized to send are submitted - see Authorize().
+//
+// When the server is draining, the client is sent a GoAway message, and the conn is closed once the requests that are
+// in flight have been responded to - see Server.Drain(). Requests whose workflow results are dropped by the pipeline
+// remain in flight until their Context is done.
+//
+// When the conn is closed, the Context(s) for the requests that are still in flight are cancelled.
+func NewMessagePipelineConnHandler(pipelineID command.PipelineID) ConnHandler {
+	pipeline := messagePipeline(pipelineID)
 
 	service := pipeline.Service
 	in := pipeline.InputChan()
-	out := pipeline.OutputChan()
 
 	return func(ctx context.Context, conn net.Conn) {
 		defer conn.Close()
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
 
-		decoder := capnp.NewPackedDecoder(conn)
-		decoder.ReuseBuffer()
+		results := make(chan context.Context)
+		responses := make(chan *capnp.Message)
+		streams := newConnStreams(service, func(msg *capnp.Message) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case responses <- msg:
+				return nil
+			}
+		})
+		defer streams.failAll()
 
 		// sends messages back to the client on the conn
+		goAway, closing := ConnGoAway(ctx), connClosing(ctx)
 		service.Go(func() error {
 			encoder := capnp.NewPackedEncoder(conn)
 			for {
+				var responseMsg *capnp.Message
+				var responseCtx context.Context
 				select {
 				case <-service.Dying():
 					return nil
-				case responseCtx := <-out:
-					select {
-					case <-responseCtx.Done():
+				case <-ctx.Done():
+					return nil
+				case <-closing:
+					// the conn is drained
+					cancel()
+					conn.Close()
+					return nil
+				case <-goAway:
+					goAway = nil
+					if err := sendGoAway(ctx, encoder); err != nil {
+						MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("failed to send goaway message")
+						cancel()
+						conn.Close()
+						return nil
+					}
+					continue
+				case responseMsg = <-responses:
+				case responseCtx = <-results:
+					if stream := StreamFromContext(responseCtx); stream != nil {
+						// the stream is finished async because sending on the stream may block on flow control
+						go func(ctx context.Context) {
+							defer requestDone(ctx)
+							finishPipelineStream(service, streams, ctx, stream)
+						}(responseCtx)
+						continue
+					}
+					if responseCtx.Err() != nil {
 						// context is expired - response will not be sent
 						// NOTE: metrics and log events are recorded by the pipeline
-					default:
-						responseMsg := ResponseMessage(responseCtx)
-						if responseMsg != nil {
-							if err := encoder.Encode(responseMsg); err != nil {
-								if service.Alive() {
-									MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("message encoding failed")
-								}
-								return err
-							}
-						}
+						requestDone(responseCtx)
+						continue
 					}
-
+					responseMsg = pipelineResponse(service, responseCtx)
+				}
+				if responseMsg == nil {
+					if responseCtx != nil {
+						requestDone(responseCtx)
+					}
+					continue
+				}
+				err := encoder.Encode(responseMsg)
+				if responseCtx != nil {
+					requestDone(responseCtx)
+				}
+				if err != nil {
+					if service.Alive() {
+						MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("message encoding failed")
+					}
+					cancel()
+					conn.Close()
+					return nil
 				}
 			}
 		})
 
+		// NOTE: the decoder buffer is not reused because the request messages are processed concurrently
+		decoder := capnp.NewPackedDecoder(conn)
 		for {
 			msg, err := decoder.Decode()
 			if err != nil {
-				if err == io.EOF {
-					return
-				}
-				if service.Alive() || app.Alive() {
+				if err != io.EOF && ctx.Err() == nil && (service.Alive() || app.Alive()) {
 					MESSAGE_DECODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("message decoding failed")
 				}
 				return
@@ -118,26 +279,91 @@ func NewMessagePipelineConnHandler(pipelineID command.PipelineID) ConnHandler {
 			request, err := message.ReadRootMessage(msg)
 			if err != nil {
 				MESSAGE_READ_FAILED.Log(service.Logger().Error().Err(err)).Msg("failed to read message")
-				// TODO: return error response message, and then close the connection
+				// reply with an error response, and then close the connection
+				if response, err := NewErrorResponse(message.Message{}, InvalidMessageError(service.ID(), err)); err == nil {
+					select {
+					case <-ctx.Done():
+					case responses <- response:
+					}
+				}
 				return
 			}
 
-			requestCtx := service.Context(WithRequestMessage(ctx, &request))
+			if response := rateLimit(ctx, msg, request); response != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case responses <- response:
+				}
+				continue
+			}
 
-			switch request.Deadline().Which() {
-			case message.Message_deadline_Which_timeoutMSec:
-				timeoutMSec := request.Deadline().TimeoutMSec()
-				if timeoutMSec > 0 {
-					requestCtx, _ = context.WithTimeout(requestCtx, time.Millisecond*time.Duration(timeoutMSec))
+			if response := unsupportedCompressionResponse(service, request); response != nil {
+				select {
+				case <-ctx.Done():
+					return
+				case responses <- response:
 				}
-			case message.Message_deadline_Which_expiresOn:
-				expiresOn := request.Deadline().ExpiresOn()
-				if expiresOn > 0 {
-					requestCtx, _ = context.WithDeadline(requestCtx, time.Unix(0, expiresOn))
+				continue
+			}
+
+			var stream *Stream
+			if request.HasStream() {
+				var appErr *app.Error
+				if stream, appErr = streams.dispatch(&request); appErr != nil {
+					if response := streamProtocolErrorResponse(service, request, appErr); response != nil {
+						select {
+						case <-ctx.Done():
+							return
+						case responses <- response:
+						}
+					}
+					continue
+				}
+				if stream == nil {
+					// the message was delivered to an open stream
+					continue
+				}
+			}
+
+			if err := authorizeRequest(ctx, service.ID(), request); err != nil {
+				if response := unauthorizedResponse(ctx, service, streams, stream, request, err); response != nil {
+					select {
+					case <-ctx.Done():
+						return
+					case responses <- response:
+					}
 				}
-			default:
+				continue
+			}
+
+			// the request message trace context takes precedence over the conn span
+			requestCtx := WithRequestMessage(trace.ExtractMessage(ctx, &request), &request)
+			requestCtx, cancelDeadline, ok := withRequestDeadline(service.Context(requestCtx), request)
+			if !ok {
 				MESSAGE_DEADLINE_UNKNOWN.Log(service.Logger().Error()).Int("deadline_type", int(request.Deadline().Which())).Msgf("deadline type is not supported")
 			}
+			requestCtx = command.WithOutputChannel(requestCtx, results)
+			// the request is in flight until its response is sent, or until its Context is done
+			requestCtx, cancelRequest := context.WithCancel(requestCtx)
+			done := requestStarted(ctx)
+			requestCtx = withRequestDone(requestCtx, func() {
+				done()
+				cancelRequest()
+				cancelDeadline()
+			})
+			go func(ctx context.Context) {
+				<-ctx.Done()
+				done()
+			}(requestCtx)
+			if stream != nil {
+				requestCtx = withStream(requestCtx, stream)
+				go func(ctx context.Context) {
+					if err := stream.open(ctx); err != nil {
+						stream.fail(err)
+					}
+				}(requestCtx)
+			}
 
 			select {
 			case <-requestCtx.Done():
@@ -146,3 +372,63 @@ func NewMessagePipelineConnHandler(pipelineID command.PipelineID) ConnHandler {
 		}
 	}
 }
+
+// pipelineResponse returns the response message for the pipeline workflow result. If the workflow failed, then an error
+// response is returned. nil is returned if the workflow produced no response.
+func pipelineResponse(service *app.Service, ctx context.Context) *capnp.Message {
+	request := RequestMessage(ctx)
+	if request == nil {
+		// should never happen
+		return nil
+	}
+	var response *capnp.Message
+	var err error
+	if workflowErr := command.Error(ctx); workflowErr != nil {
+		response, err = NewErrorResponse(*request, workflowErr)
+	} else {
+		if response = ResponseMessage(ctx); response == nil {
+			return nil
+		}
+		err = stampResponse(*request, response)
+	}
+	if err != nil {
+		MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(err).Msg("failed to create response message")
+		return nil
+	}
+	if root, err := message.ReadRootMessage(response); err == nil {
+		if err := trace.InjectMessage(ctx, &root); err != nil {
+			MESSAGE_TRACE_INJECT_FAILED.Log(service.Logger().Warn()).Err(err).Msg("failed to inject trace context")
+		}
+	}
+	return response
+}
+
+// finishPipelineStream sends the workflow response message as the final message on the stream. If the workflow failed,
+// then an error response is sent as the final message.
+func finishPipelineStream(service *app.Service, streams *connStreams, ctx context.Context, stream *Stream) {
+	defer streams.remove(stream.ID())
+	if ctx.Err() != nil {
+		// context is expired - the stream is abandoned
+		stream.fail(ctx.Err())
+		return
+	}
+	err := command.Error(ctx)
+	if response := ResponseMessage(ctx); response != nil && err == nil {
+		root, e := message.ReadRootMessage(response)
+		if e == nil {
+			var data *capnp.Message
+			if root.HasData() {
+				data, e = message.Data(&root)
+			}
+			if e == nil {
+				e = stream.Send(ctx, MessageType(root.Type()), data, true)
+			}
+		}
+		if e != nil && !app.IsError(e, ErrSpec_StreamClosed.ErrorID) {
+			MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(e).Msg("failed to send the stream response message")
+		}
+	}
+	if e := stream.finish(ctx, err); e != nil && ctx.Err() == nil && !app.IsError(e, ErrSpec_StreamClosed.ErrorID) {
+		MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(e).Msg("failed to finish stream")
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"github.com/oysterpack/oysterpack.go/pkg/data/keyvalue"
+	"zombiezen.com/go/capnproto2"
+)
+
+// when an intermediate stage fails, the error response must be sent back to the client on the conn that the request
+// was received on
+func TestNewMessagePipelineConnHandler_FailedStage(t *testing.T) {
+	const (
+		SERVICE_ID = app.ServiceID(0xf3e9a4c6d1b27085)
+		REQUEST    = opnet.MessageType(0xa0c83bd5e4f61972)
+	)
+
+	configDir := "./testdata/conn_handler_pipeline_test/TestNewMessagePipelineConnHandler_FailedStage"
+	initConfigDir(configDir)
+	if err := initPipelineMetricsConfig(SERVICE_ID); err != nil {
+		t.Fatal(err)
+	}
+	app.ResetWithConfigDir(configDir)
+	defer app.Reset()
+
+	failureErrSpec := app.ErrSpec{ErrorID: app.ErrorID(0xe2b8a5f01c4d7396), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	service := app.NewService(SERVICE_ID)
+	command.StartPipeline(service,
+		command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+			return command.WithError(ctx, command.CommandID(1), app.NewError(errors.New("BOOM"), "", failureErrSpec, SERVICE_ID, nil))
+		}), 1),
+		command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+			t.Error("The workflow should have been aborted by the first stage")
+			return ctx
+		}), 1),
+	)
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	go opnet.NewMessagePipelineConnHandler(command.PipelineID(SERVICE_ID))(context.Background(), serverConn)
+
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, err := message.NewRootMessage(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requestID := uid.NextUIDHash().UInt64()
+	request.SetId(requestID)
+	request.SetType(REQUEST.UInt64())
+	if err := capnp.NewPackedEncoder(clientConn).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	clientConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msg, err = capnp.NewPackedDecoder(clientConn).Decode()
+	if err != nil {
+		t.Fatalf("The error response was not received : %v", err)
+	}
+	response, err := message.ReadRootMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.CorrelationID() != requestID {
+		t.Errorf("response is not correlated to the request : %x != %x", response.CorrelationID(), requestID)
+	}
+	if opnet.MessageType(response.Type()) != opnet.MessageType_ERROR {
+		t.Fatalf("Expected an error response : %x", response.Type())
+	}
+	data, err := message.Data(&response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errorMsg, err := message.ReadRootError(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.ErrorID(errorMsg.ErrorID()) != failureErrSpec.ErrorID {
+		t.Errorf("error id does not match : %x", errorMsg.ErrorID())
+	}
+}
+
+// when a durable pipeline is restarted, the pending requests are replayed without a conn to reply to - the replayed
+// workflows must not tie up the pipeline, i.e., requests received on the conn must still be responded to
+func TestNewMessagePipelineConnHandler_DurableReplay(t *testing.T) {
+	const (
+		SERVICE_ID = app.ServiceID(0xd5a1c7e93f08b264)
+		REQUEST    = opnet.MessageType(0xb93e05d2a7c4f618)
+		// more than the number of last stage workers
+		PENDING_COUNT = 3
+	)
+
+	configDir := "./testdata/conn_handler_pipeline_test/TestNewMessagePipelineConnHandler_DurableReplay"
+	initConfigDir(configDir)
+	if err := initPipelineMetricsConfig(SERVICE_ID); err != nil {
+		t.Fatal(err)
+	}
+	app.ResetWithConfigDir(configDir)
+	defer app.Reset()
+
+	db, err := keyvalue.CreateDatabase(filepath.Join(configDir, "durable_pipeline.db"), "durable_pipeline", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	queueBucket, err := db.CreateBucketIfNotExists("queue")
+	if err != nil {
+		t.Fatal(err)
+	}
+	deadLetterBucket, err := db.CreateBucketIfNotExists("dead_letter")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyCount := func() int {
+		count := 0
+		for range queueBucket.Keys("", nil) {
+			count++
+		}
+		return count
+	}
+
+	newRequest := func() (*capnp.Message, message.Message) {
+		msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		request, err := message.NewRootMessage(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		request.SetId(uid.NextUIDHash().UInt64())
+		request.SetType(REQUEST.UInt64())
+		return msg, request
+	}
+
+	// the pending entries are what the pipeline persisted before the process died : [deliveries][packed request message]
+	for i := 0; i < PENDING_COUNT; i++ {
+		_, request := newRequest()
+		data, err := opnet.RequestMessageCodec{}.Encode(opnet.WithRequestMessage(context.Background(), &request))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := queueBucket.Put(fmt.Sprintf("%016x%08x", time.Now().UnixNano(), i), append([]byte{1}, data...)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	command.StartDurablePipeline(app.NewService(SERVICE_ID),
+		command.DurableQueue{
+			Bucket:           queueBucket,
+			DeadLetterBucket: deadLetterBucket,
+			Codec:            opnet.RequestMessageCodec{},
+			MaxDeliveries:    3,
+		},
+		command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+			msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+			if err != nil {
+				t.Error(err)
+				return ctx
+			}
+			response, err := message.NewRootMessage(seg)
+			if err != nil {
+				t.Error(err)
+				return ctx
+			}
+			response.SetType(REQUEST.UInt64())
+			return opnet.WithResponseMessage(ctx, msg)
+		}), 1),
+	)
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	go opnet.NewMessagePipelineConnHandler(command.PipelineID(SERVICE_ID))(context.Background(), serverConn)
+
+	msg, request := newRequest()
+	if err := capnp.NewPackedEncoder(clientConn).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+	clientConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msg, err = capnp.NewPackedDecoder(clientConn).Decode()
+	if err != nil {
+		t.Fatalf("The response was not received : %v", err)
+	}
+	response, err := message.ReadRootMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.CorrelationID() != request.Id() {
+		t.Errorf("response is not correlated to the request : %x != %x", response.CorrelationID(), request.Id())
+	}
+
+	// the replayed workflows are acked once they are done
+	for i := 0; i < 100 && keyCount() > 0; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if count := keyCount(); count != 0 {
+		t.Errorf("The replayed requests should have been acked : %d", count)
+	}
+}
@@ -0,0 +1,298 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestMessageRouter(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	const (
+		SERVICE_ID = app.ServiceID(0xce2140d527a038d0)
+
+		ECHO    = opnet.MessageType(0xaf8422ec9a85bc45)
+		FAILURE = opnet.MessageType(0xd506934ca959351d)
+		UNKNOWN = opnet.MessageType(0x82c6dc857fdfd917)
+	)
+
+	failureErrSpec := app.ErrSpec{ErrorID: app.ErrorID(0x991cec1e2f7484f4), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	service := app.NewService(SERVICE_ID)
+	defer service.Kill(nil)
+
+	echo := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		data, err := message.Data(request)
+		if err != nil {
+			return nil, app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		response, err := opnet.NewResponse(*request, ECHO, data)
+		if err != nil {
+			return nil, app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		return response, nil
+	}
+	failure := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		return nil, app.NewError(errors.New("BOOM"), "", failureErrSpec, SERVICE_ID, nil)
+	}
+
+	if _, err := opnet.NewMessageRouter(service, opnet.MessageRoute{opnet.MessageType_PING, echo}); err == nil {
+		t.Error("Built-in message types should not be routable")
+	}
+	if _, err := opnet.NewMessageRouter(service, opnet.MessageRoute{ECHO, echo}, opnet.MessageRoute{ECHO, echo}); err == nil {
+		t.Error("Message types should not be routable more than once")
+	}
+	if _, err := opnet.NewMessageRouter(service, opnet.MessageRoute{ECHO, nil}); err == nil {
+		t.Error("MessageHandler is required")
+	}
+
+	router, err := opnet.NewMessageRouter(service, opnet.MessageRoute{ECHO, echo}, opnet.MessageRoute{FAILURE, failure})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	go router.ConnHandler()(context.Background(), serverConn)
+
+	encoder := capnp.NewPackedEncoder(clientConn)
+	decoder := capnp.NewPackedDecoder(clientConn)
+
+	// sends the request, and returns the response message
+	requestWithCompression := func(messageType opnet.MessageType, compression message.Message_Compression) (requestID uint64, response message.Message) {
+		msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		requestMsg, err := message.NewRootMessage(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		requestID = uid.NextUIDHash().UInt64()
+		requestMsg.SetId(requestID)
+		requestMsg.SetType(messageType.UInt64())
+		if messageType == ECHO {
+			data, dataSeg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+			message.NewRootPing(dataSeg)
+			if message.SupportedCompression(compression) {
+				requestMsg.SetCompression(compression)
+			}
+			if err := message.SetData(&requestMsg, data); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// unsupported compressions are set after the data is set, i.e., to simulate a client sending data that cannot be read
+		requestMsg.SetCompression(compression)
+		if err := encoder.Encode(msg); err != nil {
+			t.Fatal(err)
+		}
+		msg, err = decoder.Decode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		response, err = message.ReadRootMessage(msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if response.CorrelationID() != requestID {
+			t.Errorf("response is not correlated to the request : %x != %x", response.CorrelationID(), requestID)
+		}
+		return requestID, response
+	}
+	request := func(messageType opnet.MessageType) (requestID uint64, response message.Message) {
+		return requestWithCompression(messageType, message.Message_Compression_none)
+	}
+
+	responseError := func(response message.Message) message.Error {
+		if opnet.MessageType(response.Type()) != opnet.MessageType_ERROR {
+			t.Fatalf("Expected an error response : %x", response.Type())
+		}
+		data, err := message.Data(&response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		errorMsg, err := message.ReadRootError(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return errorMsg
+	}
+
+	t.Run("Ping", func(t *testing.T) {
+		if _, response := request(opnet.MessageType_PING); opnet.MessageType(response.Type()) != opnet.MessageType_PONG {
+			t.Errorf("Expected a pong response : %x", response.Type())
+		}
+	})
+
+	t.Run("SupportedMessageTypes", func(t *testing.T) {
+		_, response := request(opnet.MessageType_SUPPORTED_MESSAGE_TYPES_REQUEST)
+		if opnet.MessageType(response.Type()) != opnet.MessageType_SUPPORTED_MESSAGE_TYPES_RESPONSE {
+			t.Fatalf("Expected a supported message types response : %x", response.Type())
+		}
+		data, err := message.Data(&response)
+		if err != nil {
+			t.Fatal(err)
+		}
+		supportedMessageTypes, err := message.ReadRootSupportedMessageTypes_Response(data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		types, err := supportedMessageTypes.Types()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if types.Len() != len(router.SupportedMessageTypes()) || types.Len() != 4 {
+			t.Errorf("supported message types do not match : %v", router.SupportedMessageTypes())
+		}
+	})
+
+	t.Run("routed message", func(t *testing.T) {
+		if _, response := request(ECHO); opnet.MessageType(response.Type()) != ECHO {
+			t.Errorf("Expected an echo response : %x", response.Type())
+		}
+	})
+
+	t.Run("handler error", func(t *testing.T) {
+		_, response := request(FAILURE)
+		if errorMsg := responseError(response); app.ErrorID(errorMsg.ErrorID()) != failureErrSpec.ErrorID {
+			t.Errorf("error id does not match : %x", errorMsg.ErrorID())
+		}
+	})
+
+	t.Run("compressed message", func(t *testing.T) {
+		_, response := requestWithCompression(ECHO, message.Message_Compression_zstd)
+		if opnet.MessageType(response.Type()) != ECHO || response.Compression() != message.Message_Compression_zstd {
+			t.Errorf("Expected a zstd compressed echo response : %x, %v", response.Type(), response.Compression())
+		}
+		if _, err := message.Data(&response); err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("unsupported compression", func(t *testing.T) {
+		_, response := requestWithCompression(ECHO, message.Message_Compression(99))
+		if errorMsg := responseError(response); app.ErrorID(errorMsg.ErrorID()) != opnet.ErrSpec_InvalidMessage.ErrorID {
+			t.Errorf("error id does not match : %x", errorMsg.ErrorID())
+		}
+		// the conn should still be usable
+		if _, response := request(opnet.MessageType_PING); opnet.MessageType(response.Type()) != opnet.MessageType_PONG {
+			t.Errorf("Expected a pong response : %x", response.Type())
+		}
+	})
+
+	t.Run("unknown message type", func(t *testing.T) {
+		_, response := request(UNKNOWN)
+		if errorMsg := responseError(response); app.ErrorID(errorMsg.ErrorID()) != opnet.ErrSpec_UnknownMessageType.ErrorID {
+			t.Errorf("error id does not match : %x", errorMsg.ErrorID())
+		}
+		// the conn should still be usable
+		if _, response := request(opnet.MessageType_PING); opnet.MessageType(response.Type()) != opnet.MessageType_PONG {
+			t.Errorf("Expected a pong response : %x", response.Type())
+		}
+	})
+}
+
+// the router must reply to every request, i.e., when an intermediate pipeline stage fails, an error response is sent
+func TestPipelineMessageHandler_FailedStage(t *testing.T) {
+	const (
+		SERVICE_ID = app.ServiceID(0xc7d05e3a91f4b268)
+		REQUEST    = opnet.MessageType(0x9b3e61f0d8a7c524)
+	)
+
+	configDir := "./testdata/conn_handler_test/TestPipelineMessageHandler_FailedStage"
+	initConfigDir(configDir)
+	if err := initPipelineMetricsConfig(SERVICE_ID); err != nil {
+		t.Fatal(err)
+	}
+	app.ResetWithConfigDir(configDir)
+	defer app.Reset()
+
+	failureErrSpec := app.ErrSpec{ErrorID: app.ErrorID(0xd84f2c17a6e09b35), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	service := app.NewService(SERVICE_ID)
+	pipeline := command.StartPipeline(service,
+		command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(1), func(ctx context.Context) context.Context {
+			return command.WithError(ctx, command.CommandID(1), app.NewError(errors.New("BOOM"), "", failureErrSpec, SERVICE_ID, nil))
+		}), 1),
+		command.NewStage(SERVICE_ID, command.NewCommand(command.CommandID(2), func(ctx context.Context) context.Context {
+			t.Error("The workflow should have been aborted by the first stage")
+			return ctx
+		}), 1),
+	)
+
+	router, err := opnet.NewMessageRouter(service, opnet.MessageRoute{REQUEST, opnet.PipelineMessageHandler(pipeline)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serverConn, clientConn := net.Pipe()
+	defer clientConn.Close()
+	go router.ConnHandler()(context.Background(), serverConn)
+
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	request, err := message.NewRootMessage(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requestID := uid.NextUIDHash().UInt64()
+	request.SetId(requestID)
+	request.SetType(REQUEST.UInt64())
+	if err := capnp.NewPackedEncoder(clientConn).Encode(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	clientConn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	msg, err = capnp.NewPackedDecoder(clientConn).Decode()
+	if err != nil {
+		t.Fatalf("The error response was not received : %v", err)
+	}
+	response, err := message.ReadRootMessage(msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.CorrelationID() != requestID {
+		t.Errorf("response is not correlated to the request : %x != %x", response.CorrelationID(), requestID)
+	}
+	if opnet.MessageType(response.Type()) != opnet.MessageType_ERROR {
+		t.Fatalf("Expected an error response : %x", response.Type())
+	}
+	data, err := message.Data(&response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	errorMsg, err := message.ReadRootError(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if app.ErrorID(errorMsg.ErrorID()) != failureErrSpec.ErrorID {
+		t.Errorf("error id does not match : %x", errorMsg.ErrorID())
+	}
+}
@@ -16,6 +16,8 @@ package net
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 )
@@ -29,6 +31,21 @@ var (
 	ErrSpec_ServerSpecError       = app.ErrSpec{ErrorID: app.ErrorID(0x9394e42b4cf30b1b), ErrorType: app.ErrorType_Config, ErrorSeverity: app.ErrorSeverity_FATAL}
 	ErrSpec_ClientSpecError       = app.ErrSpec{ErrorID: app.ErrorID(0xebcb20d1b8ffd569), ErrorType: app.ErrorType_Config, ErrorSeverity: app.ErrorSeverity_FATAL}
 
+	ErrSpec_UnknownMessageType = app.ErrSpec{ErrorID: app.ErrorID(0x850a487365fa43c2), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_InvalidMessage     = app.ErrSpec{ErrorID: app.ErrorID(0xfeae33291fa10946), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+
+	ErrSpec_ClientClosed     = app.ErrSpec{ErrorID: app.ErrorID(0xce2f1020ce194c33), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_ClientConnFailed = app.ErrSpec{ErrorID: app.ErrorID(0xc7d9f7130dc6ccac), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_HIGH}
+
+	ErrSpec_StreamClosed   = app.ErrSpec{ErrorID: app.ErrorID(0xb76c531babec2776), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_StreamProtocol = app.ErrSpec{ErrorID: app.ErrorID(0x91f572e2dbe881ff), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+
+	ErrSpec_RateLimitExceeded = app.ErrSpec{ErrorID: app.ErrorID(0xb7788aa51e7bc77e), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_ServerBusy        = app.ErrSpec{ErrorID: app.ErrorID(0xca975b526c90a418), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	ErrSpec_CertRevoked  = app.ErrSpec{ErrorID: app.ErrorID(0xf9cd284161e63445), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+	ErrSpec_Unauthorized = app.ErrSpec{ErrorID: app.ErrorID(0xdc4e3de0d90418b3), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+
 	//ErrServerNameBlank               = &app.Err{ErrorID: app.ErrorID(0x82ba8744c43fe673), Err: errors.New("Server name is blank")}
 	//ErrServerMaxConnsZero            = &app.Err{ErrorID: app.ErrorID(0x999e5626a881b99b), Err: errors.New("Server max conns must be > 0")}
 	//ErrServerConnKeepAlivePeriodZero = &app.Err{ErrorID: app.ErrorID(0xb25783843b427f53), Err: errors.New("Server conn keep alive period must be > 0")}
@@ -102,3 +119,118 @@ func ClientSpecError(serviceID app.ServiceID, err error) *app.Error {
 		nil,
 	)
 }
+
+// UnknownMessageTypeError is returned to the client when the request message type is not supported
+func UnknownMessageTypeError(serviceID app.ServiceID, messageType MessageType) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Unknown message type : %x", messageType),
+		"",
+		ErrSpec_UnknownMessageType,
+		serviceID,
+		nil,
+	)
+}
+
+// InvalidMessageError is returned to the client when the request frame does not contain a valid message.Message
+func InvalidMessageError(serviceID app.ServiceID, err error) *app.Error {
+	return app.NewError(
+		err,
+		"Invalid message",
+		ErrSpec_InvalidMessage,
+		serviceID,
+		nil,
+	)
+}
+
+// ClientClosedError is returned when a request is made on a closed Client
+func ClientClosedError(serviceID app.ServiceID) *app.Error {
+	return app.NewError(
+		errors.New("Client is closed"),
+		"",
+		ErrSpec_ClientClosed,
+		serviceID,
+		nil,
+	)
+}
+
+// ClientConnFailedError is returned when the client is not able to connect to the server, or the connection failed
+func ClientConnFailedError(serviceID app.ServiceID, err error) *app.Error {
+	return app.NewError(
+		err,
+		"Client connection failed",
+		ErrSpec_ClientConnFailed,
+		serviceID,
+		nil,
+	)
+}
+
+// StreamClosedError is returned when a message is sent on a stream that is closed for sending, i.e., the final message
+// was already sent, or the stream failed
+func StreamClosedError(serviceID app.ServiceID, streamID uint64) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Stream is closed : %x", streamID),
+		"",
+		ErrSpec_StreamClosed,
+		serviceID,
+		nil,
+	)
+}
+
+// StreamProtocolError is returned when a stream message violates the stream protocol, e.g., the message is out of sequence,
+// or the sender has run out of flow control credits
+func StreamProtocolError(serviceID app.ServiceID, streamID uint64, err error) *app.Error {
+	return app.NewError(
+		err,
+		fmt.Sprintf("Stream protocol violation : %x", streamID),
+		ErrSpec_StreamProtocol,
+		serviceID,
+		nil,
+	)
+}
+
+// RateLimitExceededError is returned to the client when a request message is rejected because it exceeds the server
+// rate limits. The limit names the token bucket that ran out of tokens, e.g., "conn messages".
+func RateLimitExceededError(serviceID app.ServiceID, limit string) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Rate limit exceeded : %s", limit),
+		"",
+		ErrSpec_RateLimitExceeded,
+		serviceID,
+		nil,
+	)
+}
+
+// ServerBusyError is returned when the server rejected the connection because it is at max conns. The client should
+// retry after the specified duration - see ServerBusyRetryAfter()
+func ServerBusyError(serviceID app.ServiceID, retryAfter time.Duration) *app.Error {
+	return app.NewError(
+		errors.New(ServerBusyReason(retryAfter)),
+		"",
+		ErrSpec_ServerBusy,
+		serviceID,
+		nil,
+	)
+}
+
+// CertRevokedError is returned when a peer presents a certificate that is revoked, i.e., it is listed in the CRL or in
+// the serial deny-list
+func CertRevokedError(serviceID app.ServiceID, serial string) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Certificate is revoked : %s", serial),
+		"",
+		ErrSpec_CertRevoked,
+		serviceID,
+		nil,
+	)
+}
+
+// UnauthorizedError is returned to the client when the client is not authorized to perform the operation - see Authorizer
+func UnauthorizedError(serviceID app.ServiceID, op Operation) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Unauthorized : %v", op),
+		"",
+		ErrSpec_Unauthorized,
+		serviceID,
+		nil,
+	)
+}
@@ -0,0 +1,94 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+)
+
+type ctx_peer_identity command.ContextKey
+
+// PeerIdentity is the identity of the peer, which is derived from the peer's verified cert
+type PeerIdentity struct {
+	CN           string
+	Organization []string
+
+	// If the CN follows the service naming convention, then the ids encoded in the CN are set - see ServerCN()
+	app.DomainID
+	app.AppID
+	app.ServiceID
+
+	Cert *x509.Certificate
+}
+
+// NewPeerIdentity returns the identity for the cert
+func NewPeerIdentity(cert *x509.Certificate) *PeerIdentity {
+	identity := &PeerIdentity{
+		CN:           cert.Subject.CommonName,
+		Organization: cert.Subject.Organization,
+		Cert:         cert,
+	}
+	if domainID, appID, serviceID, err := ParseServerCN(identity.CN); err == nil {
+		identity.DomainID, identity.AppID, identity.ServiceID = domainID, appID, serviceID
+	}
+	return identity
+}
+
+// IsService returns true if the peer cert was issued to a service - see ServerCN()
+func (a *PeerIdentity) IsService() bool {
+	return a.ServiceID != app.ServiceID(0)
+}
+
+// WithPeerIdentity adds the peer identity to the Context
+func WithPeerIdentity(ctx context.Context, identity *PeerIdentity) context.Context {
+	return context.WithValue(ctx, ctx_peer_identity{}, identity)
+}
+
+// PeerIdentityFromContext returns the peer identity, or nil if the Context carries no peer identity.
+// The Server adds the peer identity to the ConnHandler Context.
+func PeerIdentityFromContext(ctx context.Context) *PeerIdentity {
+	identity, _ := ctx.Value(ctx_peer_identity{}).(*PeerIdentity)
+	return identity
+}
+
+// ConnPeerIdentity completes the TLS handshake, if it has not yet been completed, and returns the identity for the verified
+// peer cert. nil is returned if the conn is not a TLS conn, or if the peer did not present a verified cert.
+//
+// errors:
+//	- TLS handshake errors, e.g., the client cert is revoked - see CertProvider
+func ConnPeerIdentity(conn net.Conn) (*PeerIdentity, error) {
+	// the conn is a *tls.Conn, or is wrapped by the Server
+	tlsConn, ok := conn.(interface {
+		Handshake() error
+		ConnectionState() tls.ConnectionState
+	})
+	if !ok {
+		return nil, nil
+	}
+	if err := tlsConn.Handshake(); err != nil {
+		return nil, err
+	}
+	chains := tlsConn.ConnectionState().VerifiedChains
+	if len(chains) == 0 || len(chains[0]) == 0 {
+		return nil, nil
+	}
+	return NewPeerIdentity(chains[0][0]), nil
+}
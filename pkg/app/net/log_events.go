@@ -14,7 +14,10 @@
 
 package net
 
-import "github.com/oysterpack/oysterpack.go/pkg/app"
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
 
 const (
 	SERVER_LISTENER_STARTED = app.LogEventID(0x982731754b2ce950)
@@ -25,10 +28,124 @@ const (
 	SERVER_CONN_CLOSED       = app.LogEventID(0xf5610a189674584b)
 	SERVER_ALL_CONNS_CLOSED  = app.LogEventID(0xe03265bc9473f120)
 	SERVER_MAX_CONNS_REACHED = app.LogEventID(0xa982a966f9be952b)
+	SERVER_CONN_IDLE_CLOSED  = app.LogEventID(0xa6c9bdf772615915)
+	SERVER_DRAINING          = app.LogEventID(0xa232b381d14cbf06)
+	SERVER_DRAINED           = app.LogEventID(0xea9cbdbbdd9e270e)
+	SERVER_BUSY_REJECTED     = app.LogEventID(0xb023b8a89a7c5835)
 
 	MESSAGE_ENCODE_FAILED = app.LogEventID(0xb8ff314f7f4093d5)
 	MESSAGE_DECODE_FAILED = app.LogEventID(0xdbfda98904675e63)
 	MESSAGE_READ_FAILED   = app.LogEventID(0xd9362d5c9143c894)
 
 	MESSAGE_DEADLINE_UNKNOWN = app.LogEventID(0xdc08642730dfa530)
+
+	MESSAGE_TRACE_INJECT_FAILED = app.LogEventID(0xd4f17f8332aba6c2)
+	MESSAGE_TYPE_UNKNOWN        = app.LogEventID(0xfdbd04369a759c29)
+
+	MESSAGE_COMPRESSION_UNSUPPORTED = app.LogEventID(0xea49611277cad737)
+
+	STREAM_PROTOCOL_ERROR = app.LogEventID(0xb796274d9f4db6c1)
+
+	RATE_LIMIT_EXCEEDED = app.LogEventID(0xe223c1b5271beff0)
+
+	CERT_RELOADED      = app.LogEventID(0xc630f4c09ca25ef9)
+	CERT_RELOAD_FAILED = app.LogEventID(0xdaacb0d4fc70697c)
+	CERT_REVOKED       = app.LogEventID(0xdc3d52029304a712)
+	CRL_EXPIRED        = app.LogEventID(0x9fbf17aed8c4fcbe)
+
+	SERVER_TLS_HANDSHAKE_FAILED = app.LogEventID(0x8da24c855a9da8a3)
+	UNAUTHORIZED                = app.LogEventID(0xedd64e57f63a2108)
+
+	CLIENT_CONNECTED   = app.LogEventID(0xba20a00a4727a973)
+	CLIENT_CONN_FAILED = app.LogEventID(0xd82ab09e673a5481)
+	CLIENT_CONN_GOAWAY = app.LogEventID(0x878c9903e792bb96)
+	CLIENT_SERVER_BUSY = app.LogEventID(0xe755214414edf83d)
 )
+
+func init() {
+	connsField := app.LogEventField{Name: "conns", Type: app.LogFieldType_INT, Description: "number of active connections"}
+	remoteAddrField := app.LogEventField{Name: "remote_addr", Type: app.LogFieldType_STRING, Description: "client address"}
+	retryAfterField := app.LogEventField{Name: "retry_after", Type: app.LogFieldType_DURATION, Description: "how long the client should wait before retrying"}
+	messageTypeField := app.LogEventField{Name: "type", Type: app.LogFieldType_ID, Description: "MessageType"}
+	cnField := app.LogEventField{Name: "cn", Type: app.LogFieldType_STRING, Description: "cert subject common name"}
+	serialField := app.LogEventField{Name: "serial", Type: app.LogFieldType_STRING, Description: "cert serial number in hex"}
+	serviceField := app.LogEventField{Name: "service", Type: app.LogFieldType_ID, Description: "ServiceID of the server"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: SERVER_LISTENER_STARTED, Name: "SERVER_LISTENER_STARTED", Level: zerolog.InfoLevel, Description: "the server listener has started",
+			Fields: []app.LogEventField{
+				{Name: "addr", Type: app.LogFieldType_STRING, Description: "listener address"},
+				{Name: "max-conns", Type: app.LogFieldType_INT, Description: "max number of concurrent connections"},
+				{Name: "keep-alive-period-secs", Type: app.LogFieldType_INT, Description: "TCP keep alive period"},
+			}},
+		app.LogEventSpec{ID: SERVER_LISTENER_RESTART, Name: "SERVER_LISTENER_RESTART", Level: zerolog.WarnLevel, Description: "the server listener is being restarted"},
+		app.LogEventSpec{ID: SERVER_LISTENER_CLOSED, Name: "SERVER_LISTENER_CLOSED", Level: zerolog.InfoLevel, Description: "the server listener was closed"},
+		app.LogEventSpec{ID: SERVER_NEW_CONN, Name: "SERVER_NEW_CONN", Level: zerolog.DebugLevel, Description: "a new connection was accepted",
+			Fields: []app.LogEventField{connsField}},
+		app.LogEventSpec{ID: SERVER_CONN_CLOSED, Name: "SERVER_CONN_CLOSED", Level: zerolog.DebugLevel, Description: "a connection was closed"},
+		app.LogEventSpec{ID: SERVER_ALL_CONNS_CLOSED, Name: "SERVER_ALL_CONNS_CLOSED", Level: zerolog.InfoLevel, Description: "all connections were closed"},
+		app.LogEventSpec{ID: SERVER_MAX_CONNS_REACHED, Name: "SERVER_MAX_CONNS_REACHED", Level: zerolog.WarnLevel, Description: "the listener was closed until connections free up"},
+		app.LogEventSpec{ID: SERVER_CONN_IDLE_CLOSED, Name: "SERVER_CONN_IDLE_CLOSED", Level: zerolog.DebugLevel, Description: "an idle connection was reaped",
+			Fields: []app.LogEventField{{Name: "idle", Type: app.LogFieldType_DURATION, Description: "how long the connection was idle"}}},
+		app.LogEventSpec{ID: SERVER_DRAINING, Name: "SERVER_DRAINING", Level: zerolog.InfoLevel, Description: "the server is draining connections",
+			Fields: []app.LogEventField{connsField, {Name: "timeout", Type: app.LogFieldType_DURATION, Description: "drain timeout"}}},
+		app.LogEventSpec{ID: SERVER_DRAINED, Name: "SERVER_DRAINED", Level: zerolog.InfoLevel, Description: "all connections are drained - logged at WARN level if the drain timed out",
+			Fields: []app.LogEventField{connsField}},
+		app.LogEventSpec{ID: SERVER_BUSY_REJECTED, Name: "SERVER_BUSY_REJECTED", Level: zerolog.DebugLevel, Description: "a connection was rejected because the server is at max conns",
+			Fields: []app.LogEventField{remoteAddrField, retryAfterField, app.ErrLogEventField}},
+
+		app.LogEventSpec{ID: MESSAGE_ENCODE_FAILED, Name: "MESSAGE_ENCODE_FAILED", Level: zerolog.ErrorLevel, Description: "a response message failed to be encoded or sent",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: MESSAGE_DECODE_FAILED, Name: "MESSAGE_DECODE_FAILED", Level: zerolog.ErrorLevel, Description: "a message failed to be decoded",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: MESSAGE_READ_FAILED, Name: "MESSAGE_READ_FAILED", Level: zerolog.ErrorLevel, Description: "a message failed to be read from the connection",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: MESSAGE_DEADLINE_UNKNOWN, Name: "MESSAGE_DEADLINE_UNKNOWN", Level: zerolog.ErrorLevel, Description: "the message deadline type is not supported",
+			Fields: []app.LogEventField{{Name: "deadline_type", Type: app.LogFieldType_INT, Description: "message deadline type"}}},
+		app.LogEventSpec{ID: MESSAGE_TRACE_INJECT_FAILED, Name: "MESSAGE_TRACE_INJECT_FAILED", Level: zerolog.WarnLevel, Description: "the trace context failed to be injected into the message",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: MESSAGE_TYPE_UNKNOWN, Name: "MESSAGE_TYPE_UNKNOWN", Level: zerolog.WarnLevel, Description: "no handler is registered for the message type",
+			Fields: []app.LogEventField{messageTypeField}},
+		app.LogEventSpec{ID: MESSAGE_COMPRESSION_UNSUPPORTED, Name: "MESSAGE_COMPRESSION_UNSUPPORTED", Level: zerolog.WarnLevel, Description: "the message compression is not supported",
+			Fields: []app.LogEventField{messageTypeField, {Name: "compression", Type: app.LogFieldType_INT, Description: "message compression"}}},
+
+		app.LogEventSpec{ID: STREAM_PROTOCOL_ERROR, Name: "STREAM_PROTOCOL_ERROR", Level: zerolog.WarnLevel, Description: "a stream message violated the stream protocol",
+			Fields: []app.LogEventField{{Name: "stream", Type: app.LogFieldType_ID, Description: "stream id"}, app.ErrLogEventField}},
+
+		app.LogEventSpec{ID: RATE_LIMIT_EXCEEDED, Name: "RATE_LIMIT_EXCEEDED", Level: zerolog.DebugLevel, Description: "a message was rejected because the rate limit was exceeded",
+			Fields: []app.LogEventField{
+				{Name: "limit", Type: app.LogFieldType_STRING, Description: "the rate limit that was exceeded"},
+				{Name: "client", Type: app.LogFieldType_STRING, Description: "client cert common name"},
+				messageTypeField,
+			}},
+
+		app.LogEventSpec{ID: CERT_RELOADED, Name: "CERT_RELOADED", Level: zerolog.InfoLevel, Description: "the server certs were loaded",
+			Fields: []app.LogEventField{
+				cnField,
+				serialField,
+				{Name: "not_after", Type: app.LogFieldType_TIME, Description: "when the cert expires"},
+				{Name: "ca_certs", Type: app.LogFieldType_INT, Description: "number of CA certs"},
+				{Name: "revoked", Type: app.LogFieldType_INT, Description: "number of revoked certs"},
+			}},
+		app.LogEventSpec{ID: CERT_RELOAD_FAILED, Name: "CERT_RELOAD_FAILED", Level: zerolog.ErrorLevel, Description: "the server certs failed to reload - the previous certs are kept",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: CERT_REVOKED, Name: "CERT_REVOKED", Level: zerolog.WarnLevel, Description: "a revoked peer cert was rejected",
+			Fields: []app.LogEventField{cnField, serialField}},
+		app.LogEventSpec{ID: CRL_EXPIRED, Name: "CRL_EXPIRED", Level: zerolog.WarnLevel, Description: "the CRL has expired",
+			Fields: []app.LogEventField{{Name: "next_update", Type: app.LogFieldType_TIME, Description: "when the CRL was due to be updated"}}},
+
+		app.LogEventSpec{ID: SERVER_TLS_HANDSHAKE_FAILED, Name: "SERVER_TLS_HANDSHAKE_FAILED", Level: zerolog.WarnLevel, Description: "the TLS handshake failed",
+			Fields: []app.LogEventField{app.ErrLogEventField, remoteAddrField}},
+		app.LogEventSpec{ID: UNAUTHORIZED, Name: "UNAUTHORIZED", Level: zerolog.WarnLevel, Description: "the request was not authorized",
+			Fields: []app.LogEventField{serviceField, {Name: "op", Type: app.LogFieldType_STRING, Description: "operation"}, cnField}},
+
+		app.LogEventSpec{ID: CLIENT_CONNECTED, Name: "CLIENT_CONNECTED", Level: zerolog.DebugLevel, Description: "the client connected to the server",
+			Fields: []app.LogEventField{serviceField}},
+		app.LogEventSpec{ID: CLIENT_CONN_FAILED, Name: "CLIENT_CONN_FAILED", Level: zerolog.WarnLevel, Description: "the client failed to connect to the server",
+			Fields: []app.LogEventField{serviceField, {Name: "backoff", Type: app.LogFieldType_DURATION, Description: "how long the client will wait before reconnecting"}, app.ErrLogEventField}},
+		app.LogEventSpec{ID: CLIENT_CONN_GOAWAY, Name: "CLIENT_CONN_GOAWAY", Level: zerolog.DebugLevel, Description: "the server sent a goaway - the client will reconnect",
+			Fields: []app.LogEventField{serviceField}},
+		app.LogEventSpec{ID: CLIENT_SERVER_BUSY, Name: "CLIENT_SERVER_BUSY", Level: zerolog.WarnLevel, Description: "the server rejected the connection because it is busy",
+			Fields: []app.LogEventField{serviceField, retryAfterField}},
+	)
+}
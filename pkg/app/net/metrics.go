@@ -14,14 +14,24 @@
 
 package net
 
-import "github.com/oysterpack/oysterpack.go/pkg/app"
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 // server metrics
+//
+// The optional metrics are not required to be registered. If not registered, then an unregistered metric is used, i.e.,
+// the metric is not exported.
 const (
 	// gauges
 
 	// the current number of connections
 	SERVER_CONN_COUNT_METRIC_ID = app.MetricID(0xaa8b66726c359b98)
+	// the age of the oldest connection in seconds (optional)
+	SERVER_CONN_OLDEST_AGE_METRIC_ID = app.MetricID(0xa36e547603a8fe7a)
+	// the longest time in seconds that any connection has been idle (optional)
+	SERVER_CONN_MAX_IDLE_TIME_METRIC_ID = app.MetricID(0xd95a3f86420b1159)
 
 	// counters
 
@@ -29,4 +39,59 @@ const (
 	SERVER_CONN_TOTAL_CREATED_METRIC_ID   = app.MetricID(0xc33ede6c39ca8a07)
 	SERVER_REQUEST_COUNT_METRIC_ID        = app.MetricID(0xc33ede6c39ca8a07)
 	SERVER_REQUEST_FAILED_COUNT_METRIC_ID = app.MetricID(0xc33ede6c39ca8a07)
+
+	// the total number of messages that were delayed by the rate limiter
+	SERVER_RATE_LIMIT_DELAYED_COUNT_METRIC_ID = app.MetricID(0xbe4bd7fc570ed899)
+	// the total number of messages that were rejected by the rate limiter
+	SERVER_RATE_LIMIT_REJECTED_COUNT_METRIC_ID = app.MetricID(0xe6e89dec8996843f)
+	// the total number of connections that were closed because they were idle (optional)
+	SERVER_CONN_IDLE_CLOSED_COUNT_METRIC_ID = app.MetricID(0x9acbf8903c96345d)
+	// the total number of connections that were rejected with a ServerBusy reply - see ServerSpec.BusyRetryAfter()
+	SERVER_BUSY_REJECTED_COUNT_METRIC_ID = app.MetricID(0xf958f4e3a174eb5a)
+
+	// histograms
+
+	// the connection age in seconds when the connection is closed (optional)
+	SERVER_CONN_AGE_METRIC_ID = app.MetricID(0xd0be54e23aae5bca)
+
+	// gauge vectors
+
+	// the number of seconds until each loaded cert expires - see CERT_EXPIRY_METRIC_LABELS (optional)
+	SERVER_CERT_EXPIRY_METRIC_ID = app.MetricID(0xab232ff517f5eb19)
 )
+
+// SERVER_CERT_EXPIRY_METRIC_ID labels
+const (
+	// the cert type, i.e., "server" or "ca"
+	CERT_EXPIRY_LABEL_TYPE = "type"
+	CERT_EXPIRY_LABEL_CN   = "cn"
+	// the cert serial number in hex
+	CERT_EXPIRY_LABEL_SERIAL = "serial"
+)
+
+// CERT_EXPIRY_METRIC_LABELS are the SERVER_CERT_EXPIRY_METRIC_ID gauge vector labels
+var CERT_EXPIRY_METRIC_LABELS = []string{CERT_EXPIRY_LABEL_TYPE, CERT_EXPIRY_LABEL_CN, CERT_EXPIRY_LABEL_SERIAL}
+
+// gauge returns an unregistered gauge if the metric is not registered
+func gauge(serviceID app.ServiceID, metricID app.MetricID) prometheus.Gauge {
+	if metric := app.MetricRegistry.Gauge(serviceID, metricID); metric != nil {
+		return metric.Gauge
+	}
+	return prometheus.NewGauge(prometheus.GaugeOpts{Name: metricID.PrometheusName(serviceID)})
+}
+
+// counter returns an unregistered counter if the metric is not registered
+func counter(serviceID app.ServiceID, metricID app.MetricID) prometheus.Counter {
+	if metric := app.MetricRegistry.Counter(serviceID, metricID); metric != nil {
+		return metric.Counter
+	}
+	return prometheus.NewCounter(prometheus.CounterOpts{Name: metricID.PrometheusName(serviceID)})
+}
+
+// histogram returns an unregistered histogram if the metric is not registered
+func histogram(serviceID app.ServiceID, metricID app.MetricID) prometheus.Histogram {
+	if metric := app.MetricRegistry.Histogram(serviceID, metricID); metric != nil {
+		return metric.Histogram
+	}
+	return prometheus.NewHistogram(prometheus.HistogramOpts{Name: metricID.PrometheusName(serviceID)})
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
 	appconfig "github.com/oysterpack/oysterpack.go/pkg/app/config"
 	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
 	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
@@ -159,7 +160,7 @@ func initServerMetricsConfig(serviceID app.ServiceID) error {
 	metricsServiceSpec.SetMetricSpecs(metricsSpecs)
 
 	// gauges
-	gauges, err := metricsSpecs.NewGaugeSpecs(1)
+	gauges, err := metricsSpecs.NewGaugeSpecs(3)
 	if err != nil {
 		return err
 	}
@@ -174,8 +175,57 @@ func initServerMetricsConfig(serviceID app.ServiceID) error {
 	}
 	gauges.Set(0, connCountGauge)
 
+	oldestConnAgeGauge, err := appconfig.NewGaugeMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	oldestConnAgeGauge.SetServiceId(serviceID.UInt64())
+	oldestConnAgeGauge.SetMetricId(opnet.SERVER_CONN_OLDEST_AGE_METRIC_ID.UInt64())
+	if err := oldestConnAgeGauge.SetHelp("Age of the oldest connection in seconds"); err != nil {
+		return err
+	}
+	gauges.Set(1, oldestConnAgeGauge)
+
+	maxConnIdleTimeGauge, err := appconfig.NewGaugeMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	maxConnIdleTimeGauge.SetServiceId(serviceID.UInt64())
+	maxConnIdleTimeGauge.SetMetricId(opnet.SERVER_CONN_MAX_IDLE_TIME_METRIC_ID.UInt64())
+	if err := maxConnIdleTimeGauge.SetHelp("Longest connection idle time in seconds"); err != nil {
+		return err
+	}
+	gauges.Set(2, maxConnIdleTimeGauge)
+
+	// gauge vectors
+	gaugeVectors, err := metricsSpecs.NewGaugeVectorSpecs(1)
+	if err != nil {
+		return err
+	}
+	certExpiryGaugeVector, err := appconfig.NewGaugeVectorMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	certExpiryGauge, err := certExpiryGaugeVector.NewMetricSpec()
+	if err != nil {
+		return err
+	}
+	certExpiryGauge.SetServiceId(serviceID.UInt64())
+	certExpiryGauge.SetMetricId(opnet.SERVER_CERT_EXPIRY_METRIC_ID.UInt64())
+	if err := certExpiryGauge.SetHelp("Number of seconds until the cert expires"); err != nil {
+		return err
+	}
+	labelNames, err := certExpiryGaugeVector.NewLabelNames(int32(len(opnet.CERT_EXPIRY_METRIC_LABELS)))
+	if err != nil {
+		return err
+	}
+	for i, label := range opnet.CERT_EXPIRY_METRIC_LABELS {
+		labelNames.Set(i, label)
+	}
+	gaugeVectors.Set(0, certExpiryGaugeVector)
+
 	// counters
-	counters, err := metricsSpecs.NewCounterSpecs(3)
+	counters, err := metricsSpecs.NewCounterSpecs(6)
 	if err != nil {
 		return err
 	}
@@ -212,6 +262,179 @@ func initServerMetricsConfig(serviceID app.ServiceID) error {
 	}
 	counters.Set(2, requestFailedCounter)
 
+	rateLimitDelayedCounter, err := appconfig.NewCounterMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	rateLimitDelayedCounter.SetServiceId(serviceID.UInt64())
+	rateLimitDelayedCounter.SetMetricId(opnet.SERVER_RATE_LIMIT_DELAYED_COUNT_METRIC_ID.UInt64())
+	if err := rateLimitDelayedCounter.SetHelp("Total number of messages delayed by the rate limiter"); err != nil {
+		return err
+	}
+	counters.Set(3, rateLimitDelayedCounter)
+
+	rateLimitRejectedCounter, err := appconfig.NewCounterMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	rateLimitRejectedCounter.SetServiceId(serviceID.UInt64())
+	rateLimitRejectedCounter.SetMetricId(opnet.SERVER_RATE_LIMIT_REJECTED_COUNT_METRIC_ID.UInt64())
+	if err := rateLimitRejectedCounter.SetHelp("Total number of messages rejected by the rate limiter"); err != nil {
+		return err
+	}
+	counters.Set(4, rateLimitRejectedCounter)
+
+	idleConnClosedCounter, err := appconfig.NewCounterMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	idleConnClosedCounter.SetServiceId(serviceID.UInt64())
+	idleConnClosedCounter.SetMetricId(opnet.SERVER_CONN_IDLE_CLOSED_COUNT_METRIC_ID.UInt64())
+	if err := idleConnClosedCounter.SetHelp("Total number of idle connections that were closed"); err != nil {
+		return err
+	}
+	counters.Set(5, idleConnClosedCounter)
+
+	// histograms
+	histograms, err := metricsSpecs.NewHistogramSpecs(1)
+	if err != nil {
+		return err
+	}
+	connAgeHistogram, err := appconfig.NewHistogramMetricSpec(seg)
+	if err != nil {
+		return err
+	}
+	connAgeHistogram.SetServiceId(serviceID.UInt64())
+	connAgeHistogram.SetMetricId(opnet.SERVER_CONN_AGE_METRIC_ID.UInt64())
+	if err := connAgeHistogram.SetHelp("Connection age in seconds when the connection is closed"); err != nil {
+		return err
+	}
+	buckets, err := connAgeHistogram.NewBuckets(5)
+	if err != nil {
+		return err
+	}
+	for i, bucket := range []float64{1, 10, 60, 600, 3600} {
+		buckets.Set(i, bucket)
+	}
+	histograms.Set(0, connAgeHistogram)
+
+	// store the config
+	serviceConfigPath := app.Configs.ServiceConfigPath(app.METRICS_SERVICE_ID)
+	configFile, err := os.Create(serviceConfigPath)
+	if err != nil {
+		return err
+	}
+	app.MarshalCapnpMessage(msg, configFile)
+	configFile.Close()
+
+	return nil
+}
+
+// initPipelineMetricsConfig stores the metrics config for the metrics that are required to run a command pipeline
+func initPipelineMetricsConfig(serviceID app.ServiceID) error {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return err
+	}
+	metricsServiceSpec, err := appconfig.NewRootMetricsServiceSpec(seg)
+	if err != nil {
+		return err
+	}
+	metricsSpecs, err := appconfig.NewMetricSpecs(seg)
+	if err != nil {
+		return err
+	}
+	metricsServiceSpec.SetMetricSpecs(metricsSpecs)
+
+	// the durable queue metrics are included for durable pipelines
+	counterMetricIDs := append(append([]app.MetricID{}, command.COUNTER_METRIC_IDS...), command.DURABLE_COUNTER_METRIC_IDS...)
+	counters, err := metricsSpecs.NewCounterSpecs(int32(len(counterMetricIDs)))
+	if err != nil {
+		return err
+	}
+	for i, metricID := range counterMetricIDs {
+		counter, err := appconfig.NewCounterMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		counter.SetServiceId(serviceID.UInt64())
+		counter.SetMetricId(metricID.UInt64())
+		if err := counter.SetHelp(metricID.Hex()); err != nil {
+			return err
+		}
+		counters.Set(i, counter)
+	}
+
+	counterVectorMetricIDs := append(append([]app.MetricID{}, command.COUNTER_VECTOR_METRIC_IDS...), command.OPTIONAL_COUNTER_VECTOR_METRIC_IDS...)
+	counterVectors, err := metricsSpecs.NewCounterVectorSpecs(int32(len(counterVectorMetricIDs)))
+	if err != nil {
+		return err
+	}
+	for i, metricID := range counterVectorMetricIDs {
+		counterVector, err := appconfig.NewCounterVectorMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		counter, err := counterVector.NewMetricSpec()
+		if err != nil {
+			return err
+		}
+		counter.SetServiceId(serviceID.UInt64())
+		counter.SetMetricId(metricID.UInt64())
+		if err := counter.SetHelp(metricID.Hex()); err != nil {
+			return err
+		}
+		labelNames, err := counterVector.NewLabelNames(1)
+		if err != nil {
+			return err
+		}
+		labelNames.Set(0, command.LABEL_COMMAND)
+		counterVectors.Set(i, counterVector)
+	}
+
+	gauges, err := metricsSpecs.NewGaugeSpecs(int32(len(command.GAUGE_METRIC_IDS)))
+	if err != nil {
+		return err
+	}
+	for i, metricID := range command.GAUGE_METRIC_IDS {
+		gauge, err := appconfig.NewGaugeMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		gauge.SetServiceId(serviceID.UInt64())
+		gauge.SetMetricId(metricID.UInt64())
+		if err := gauge.SetHelp(metricID.Hex()); err != nil {
+			return err
+		}
+		gauges.Set(i, gauge)
+	}
+
+	gaugeVectors, err := metricsSpecs.NewGaugeVectorSpecs(int32(len(command.OPTIONAL_GAUGE_VECTOR_METRIC_IDS)))
+	if err != nil {
+		return err
+	}
+	for i, metricID := range command.OPTIONAL_GAUGE_VECTOR_METRIC_IDS {
+		gaugeVector, err := appconfig.NewGaugeVectorMetricSpec(seg)
+		if err != nil {
+			return err
+		}
+		gauge, err := gaugeVector.NewMetricSpec()
+		if err != nil {
+			return err
+		}
+		gauge.SetServiceId(serviceID.UInt64())
+		gauge.SetMetricId(metricID.UInt64())
+		if err := gauge.SetHelp(metricID.Hex()); err != nil {
+			return err
+		}
+		labelNames, err := gaugeVector.NewLabelNames(1)
+		if err != nil {
+			return err
+		}
+		labelNames.Set(0, command.LABEL_COMMAND)
+		gaugeVectors.Set(i, gaugeVector)
+	}
+
 	// store the config
 	serviceConfigPath := app.Configs.ServiceConfigPath(app.METRICS_SERVICE_ID)
 	configFile, err := os.Create(serviceConfigPath)
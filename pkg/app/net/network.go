@@ -0,0 +1,205 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
+	"zombiezen.com/go/capnproto2"
+)
+
+// NetworkType is the network that a server listens on, and that clients dial
+type NetworkType uint8
+
+const (
+	// TLS over TCP
+	NETWORK_TCP NetworkType = iota
+	// unix socket - access is controlled via the socket file permissions instead of TLS. Thus, clients have no cert
+	// identity, i.e., PeerIdentityFromContext() returns nil.
+	NETWORK_UNIX
+	// TLS over an in-memory pipe, which is only reachable within the process - see ListenPipe()
+	NETWORK_PIPE
+)
+
+func (a NetworkType) String() string {
+	switch a {
+	case NETWORK_TCP:
+		return "tcp"
+	case NETWORK_UNIX:
+		return "unix"
+	case NETWORK_PIPE:
+		return "pipe"
+	default:
+		return fmt.Sprintf("NetworkType(%d)", a)
+	}
+}
+
+// ParseNetworkType parses the NetworkType.String() value
+func ParseNetworkType(s string) (NetworkType, error) {
+	for _, networkType := range []NetworkType{NETWORK_TCP, NETWORK_UNIX, NETWORK_PIPE} {
+		if networkType.String() == s {
+			return networkType, nil
+		}
+	}
+	return NETWORK_TCP, app.IllegalArgumentError(fmt.Sprintf("Unsupported network type : %q", s))
+}
+
+const DEFAULT_UNIX_SOCKET_FILE_MODE os.FileMode = 0600
+
+// Network specifies the listener and dialer network. The zero value is TCP.
+type Network struct {
+	Type NetworkType
+	// unix socket path, or the pipe name - not used for TCP
+	Address string
+	// unix socket file permissions
+	FileMode os.FileMode
+}
+
+// NewNetwork converts the config.NetworkSpec
+func NewNetwork(spec config.NetworkSpec) (Network, error) {
+	network := Network{FileMode: os.FileMode(spec.FileMode())}
+	switch spec.Type() {
+	case config.NetworkSpec_Type_tcp:
+		network.Type = NETWORK_TCP
+	case config.NetworkSpec_Type_unix:
+		network.Type = NETWORK_UNIX
+	case config.NetworkSpec_Type_pipe:
+		network.Type = NETWORK_PIPE
+	default:
+		return network, app.IllegalArgumentError(fmt.Sprintf("Unsupported network type : %v", spec.Type()))
+	}
+	address, err := spec.Address()
+	if err != nil {
+		return network, err
+	}
+	network.Address = address
+	return network, network.Validate()
+}
+
+func (a Network) ToCapnp(s *capnp.Segment) (config.NetworkSpec, error) {
+	spec, err := config.NewNetworkSpec(s)
+	if err != nil {
+		return spec, err
+	}
+	switch a.Type {
+	case NETWORK_UNIX:
+		spec.SetType(config.NetworkSpec_Type_unix)
+	case NETWORK_PIPE:
+		spec.SetType(config.NetworkSpec_Type_pipe)
+	default:
+		spec.SetType(config.NetworkSpec_Type_tcp)
+	}
+	spec.SetFileMode(uint32(a.fileMode()))
+	return spec, spec.SetAddress(a.Address)
+}
+
+// Validate checks that an address is specified for unix and pipe networks
+func (a Network) Validate() error {
+	switch a.Type {
+	case NETWORK_TCP:
+		return nil
+	case NETWORK_UNIX, NETWORK_PIPE:
+		if a.Address == "" {
+			return app.IllegalArgumentError(fmt.Sprintf("%v network address is required", a.Type))
+		}
+		return nil
+	default:
+		return app.IllegalArgumentError(fmt.Sprintf("Unsupported network type : %v", a.Type))
+	}
+}
+
+// TLS returns false for unix sockets
+func (a Network) TLS() bool {
+	return a.Type != NETWORK_UNIX
+}
+
+func (a Network) fileMode() os.FileMode {
+	if a.FileMode == 0 {
+		return DEFAULT_UNIX_SOCKET_FILE_MODE
+	}
+	return a.FileMode
+}
+
+// Listen opens the listener. The port only applies to TCP.
+//
+// For unix sockets, a stale socket file, e.g., left behind by a process that died, is removed, and the socket file
+// permissions are applied.
+func (a Network) Listen(port uint16) (net.Listener, error) {
+	switch a.Type {
+	case NETWORK_UNIX:
+		if info, err := os.Stat(a.Address); err == nil {
+			if info.Mode()&os.ModeSocket == 0 {
+				return nil, fmt.Errorf("Unix socket path exists, but is not a socket : %s", a.Address)
+			}
+			if err := os.Remove(a.Address); err != nil {
+				return nil, err
+			}
+		}
+		l, err := net.Listen("unix", a.Address)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.Chmod(a.Address, a.fileMode()); err != nil {
+			l.Close()
+			return nil, err
+		}
+		return l, nil
+	case NETWORK_PIPE:
+		return ListenPipe(a.Address)
+	default:
+		return net.Listen("tcp", fmt.Sprintf(":%d", port))
+	}
+}
+
+// Dial connects to the server. The host and port only apply to TCP. For unix and pipe networks, the host overrides the
+// network address if it is not blank.
+//
+// TLS is used for TCP and pipe networks. The TLS handshake is completed before the conn is returned.
+func (a Network) Dial(host string, port uint16, tlsConfig *tls.Config) (net.Conn, error) {
+	switch a.Type {
+	case NETWORK_UNIX:
+		return net.Dial("unix", a.address(host))
+	case NETWORK_PIPE:
+		conn, err := DialPipe(a.address(host))
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig == nil {
+			conn.Close()
+			return nil, errors.New("TLS config is required")
+		}
+		tlsConn := tls.Client(conn, tlsConfig)
+		if err := tlsConn.Handshake(); err != nil {
+			tlsConn.Close()
+			return nil, err
+		}
+		return tlsConn, nil
+	default:
+		return tls.Dial("tcp", fmt.Sprintf("%s:%d", host, port), tlsConfig)
+	}
+}
+
+func (a Network) address(host string) string {
+	if host != "" {
+		return host
+	}
+	return a.Address
+}
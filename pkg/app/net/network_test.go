@@ -0,0 +1,176 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestNetwork(t *testing.T) {
+	t.Run("NewNetwork", func(t *testing.T) {
+		_, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		network := opnet.Network{Type: opnet.NETWORK_UNIX, Address: "/tmp/op.sock", FileMode: 0660}
+		spec, err := network.ToCapnp(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		network2, err := opnet.NewNetwork(spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if network2 != network {
+			t.Errorf("networks do not match : %v != %v", network2, network)
+		}
+
+		spec, _ = config.NewNetworkSpec(seg)
+		if spec.FileMode() != uint32(opnet.DEFAULT_UNIX_SOCKET_FILE_MODE) {
+			t.Errorf("default file mode does not match : %o", spec.FileMode())
+		}
+		spec.SetType(config.NetworkSpec_Type_pipe)
+		if _, err := opnet.NewNetwork(spec); err == nil {
+			t.Error("pipe network address is required")
+		}
+	})
+
+	t.Run("pipe", func(t *testing.T) {
+		serverConfig, clientConfig := selfSignedTLSConfigs(t)
+		network := opnet.Network{Type: opnet.NETWORK_PIPE, Address: "TestNetwork"}
+		l, err := network.Listen(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer l.Close()
+		if _, err := opnet.ListenPipe(network.Address); err == nil {
+			t.Error("pipe listener names must be unique")
+		}
+		go echo(tls.NewListener(l, serverConfig))
+
+		conn, err := network.Dial("", 0, clientConfig)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkEcho(t, conn)
+
+		l.Close()
+		if _, err := opnet.DialPipe(network.Address); err == nil {
+			t.Error("pipe listener should have been unregistered")
+		}
+	})
+
+	t.Run("unix", func(t *testing.T) {
+		dir, err := ioutil.TempDir("", "TestNetwork")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		network := opnet.Network{Type: opnet.NETWORK_UNIX, Address: filepath.Join(dir, "op.sock")}
+		if network.TLS() {
+			t.Error("unix sockets do not use TLS")
+		}
+		l, err := network.Listen(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		info, err := os.Stat(network.Address)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != opnet.DEFAULT_UNIX_SOCKET_FILE_MODE {
+			t.Errorf("socket file mode does not match : %v", info.Mode())
+		}
+		go echo(l)
+
+		conn, err := network.Dial("", 0, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		checkEcho(t, conn)
+		l.Close()
+	})
+}
+
+func echo(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			defer conn.Close()
+			io.Copy(conn, conn)
+		}()
+	}
+}
+
+func checkEcho(t *testing.T, conn net.Conn) {
+	t.Helper()
+	defer conn.Close()
+	msg := []byte("ping")
+	if _, err := conn.Write(msg); err != nil {
+		t.Fatal(err)
+	}
+	reply := make([]byte, len(msg))
+	if _, err := io.ReadFull(conn, reply); err != nil {
+		t.Fatal(err)
+	}
+	if string(reply) != string(msg) {
+		t.Errorf("reply does not match : %q", reply)
+	}
+}
+
+func selfSignedTLSConfigs(t *testing.T) (serverConfig, clientConfig *tls.Config) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Minute),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(cert)
+	serverConfig = &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+	clientConfig = &tls.Config{RootCAs: rootCAs, ServerName: "localhost"}
+	return
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+)
+
+var (
+	pipeListenersMutex sync.Mutex
+	pipeListeners      = make(map[string]*PipeListener)
+
+	errPipeListenerClosed = errors.New("Pipe listener is closed")
+)
+
+// PipeAddr is the pipe listener name
+type PipeAddr string
+
+func (a PipeAddr) Network() string { return NETWORK_PIPE.String() }
+
+func (a PipeAddr) String() string { return string(a) }
+
+// PipeListener is an in-memory net.Listener, which is registered by name within the process. It enables many servers
+// to run in the same process, e.g., for integration tests, without allocating ports.
+//
+// Conns are created via net.Pipe(), i.e., they are synchronous and unbuffered.
+type PipeListener struct {
+	name  PipeAddr
+	conns chan net.Conn
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// ListenPipe registers a new pipe listener. The name must be unique within the process.
+func ListenPipe(name string) (*PipeListener, error) {
+	pipeListenersMutex.Lock()
+	defer pipeListenersMutex.Unlock()
+	if _, exists := pipeListeners[name]; exists {
+		return nil, fmt.Errorf("Pipe listener already exists : %s", name)
+	}
+	l := &PipeListener{
+		name:   PipeAddr(name),
+		conns:  make(chan net.Conn),
+		closed: make(chan struct{}),
+	}
+	pipeListeners[name] = l
+	return l, nil
+}
+
+// DialPipe connects to the named pipe listener. It blocks until the listener accepts the conn, or is closed.
+func DialPipe(name string) (net.Conn, error) {
+	pipeListenersMutex.Lock()
+	l := pipeListeners[name]
+	pipeListenersMutex.Unlock()
+	if l == nil {
+		return nil, fmt.Errorf("Pipe listener not found : %s", name)
+	}
+
+	server, client := net.Pipe()
+	select {
+	case l.conns <- server:
+		return client, nil
+	case <-l.closed:
+		server.Close()
+		client.Close()
+		return nil, errPipeListenerClosed
+	}
+}
+
+func (a *PipeListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-a.conns:
+		return conn, nil
+	case <-a.closed:
+		return nil, errPipeListenerClosed
+	}
+}
+
+// Close unregisters the listener. Conns that were already accepted are not closed.
+func (a *PipeListener) Close() error {
+	a.closeOnce.Do(func() {
+		close(a.closed)
+		pipeListenersMutex.Lock()
+		defer pipeListenersMutex.Unlock()
+		if pipeListeners[string(a.name)] == a {
+			delete(pipeListeners, string(a.name))
+		}
+	})
+	return nil
+}
+
+func (a *PipeListener) Addr() net.Addr {
+	return a.name
+}
@@ -0,0 +1,498 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
+	"github.com/prometheus/client_golang/prometheus"
+	"zombiezen.com/go/capnproto2"
+)
+
+// RateLimitMode determines how inbound messages that exceed the rate limits are handled
+type RateLimitMode uint8
+
+// RateLimitMode enum values
+const (
+	// messages are not read off the connection until the tokens are available, i.e., back pressure is applied to the client
+	RateLimitMode_DELAY = RateLimitMode(config.RateLimitSpec_Mode_delay)
+	// messages are rejected with a RateLimitExceededError response
+	RateLimitMode_REJECT = RateLimitMode(config.RateLimitSpec_Mode_reject)
+)
+
+func (a RateLimitMode) String() string {
+	return config.RateLimitSpec_Mode(a).String()
+}
+
+// RateLimit specifies token bucket limits for messages and bytes. A zero limit means no limit.
+// If the burst is zero, then it defaults to the per second limit.
+type RateLimit struct {
+	MessagesPerSec uint32
+	MessageBurst   uint32
+
+	BytesPerSec uint32
+	ByteBurst   uint32
+}
+
+// Unlimited returns true if no limits are specified
+func (a RateLimit) Unlimited() bool {
+	return a.MessagesPerSec == 0 && a.BytesPerSec == 0
+}
+
+func (a RateLimit) messageBucket() *tokenBucket {
+	return newTokenBucket(a.MessagesPerSec, a.MessageBurst)
+}
+
+func (a RateLimit) byteBucket() *tokenBucket {
+	return newTokenBucket(a.BytesPerSec, a.ByteBurst)
+}
+
+// RateLimitSpec specifies the rate limits that are applied to inbound messages.
+//
+//	- PerConn limits are applied to each connection
+//	- PerClient limits are shared by all connections that present a client cert with the same CN
+type RateLimitSpec struct {
+	PerConn   RateLimit
+	PerClient RateLimit
+	Mode      RateLimitMode
+}
+
+// Enabled returns true if any limits are specified
+func (a RateLimitSpec) Enabled() bool {
+	return !a.PerConn.Unlimited() || !a.PerClient.Unlimited()
+}
+
+// NewRateLimitSpec converts the capnp RateLimitSpec
+//
+// errors:
+//	- app.ErrSpec_IllegalArgument if the mode is unknown
+func NewRateLimitSpec(spec config.RateLimitSpec) (RateLimitSpec, error) {
+	rateLimitSpec := RateLimitSpec{Mode: RateLimitMode(spec.Mode())}
+	switch rateLimitSpec.Mode {
+	case RateLimitMode_DELAY, RateLimitMode_REJECT:
+	default:
+		return rateLimitSpec, app.IllegalArgumentError(fmt.Sprintf("Unknown rate limit mode : %d", spec.Mode()))
+	}
+
+	if spec.HasPerConn() {
+		perConn, err := spec.PerConn()
+		if err != nil {
+			return rateLimitSpec, err
+		}
+		rateLimitSpec.PerConn = newRateLimit(perConn)
+	}
+	if spec.HasPerClient() {
+		perClient, err := spec.PerClient()
+		if err != nil {
+			return rateLimitSpec, err
+		}
+		rateLimitSpec.PerClient = newRateLimit(perClient)
+	}
+	return rateLimitSpec, nil
+}
+
+func newRateLimit(spec config.RateLimit) RateLimit {
+	return RateLimit{
+		MessagesPerSec: spec.MessagesPerSec(),
+		MessageBurst:   spec.MessageBurst(),
+		BytesPerSec:    spec.BytesPerSec(),
+		ByteBurst:      spec.ByteBurst(),
+	}
+}
+
+func (a RateLimitSpec) ToCapnp(s *capnp.Segment) (config.RateLimitSpec, error) {
+	spec, err := config.NewRateLimitSpec(s)
+	if err != nil {
+		return spec, err
+	}
+	spec.SetMode(config.RateLimitSpec_Mode(a.Mode))
+
+	perConn, err := spec.NewPerConn()
+	if err != nil {
+		return spec, err
+	}
+	a.PerConn.setCapnp(perConn)
+
+	perClient, err := spec.NewPerClient()
+	if err != nil {
+		return spec, err
+	}
+	a.PerClient.setCapnp(perClient)
+
+	return spec, nil
+}
+
+func (a RateLimit) setCapnp(spec config.RateLimit) {
+	spec.SetMessagesPerSec(a.MessagesPerSec)
+	spec.SetMessageBurst(a.MessageBurst)
+	spec.SetBytesPerSec(a.BytesPerSec)
+	spec.SetByteBurst(a.ByteBurst)
+}
+
+// NewRateLimiter creates a new RateLimiter for the service.
+//
+// The following service metrics are required:
+//	- SERVER_RATE_LIMIT_DELAYED_COUNT_METRIC_ID
+//	- SERVER_RATE_LIMIT_REJECTED_COUNT_METRIC_ID
+//
+// errors:
+//	- app.ErrSpec_IllegalArgument if the service is nil
+//	- app.ErrSpec_ConfigFailure if the metrics are not registered
+func NewRateLimiter(service *app.Service, spec RateLimitSpec) (*RateLimiter, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	delayedCount := app.MetricRegistry.Counter(service.ID(), SERVER_RATE_LIMIT_DELAYED_COUNT_METRIC_ID)
+	if delayedCount == nil {
+		err := fmt.Errorf("Server rate limit delayed counter metric missing : ServiceID(0x%x) : MetricID(0x%x)", service.ID(), SERVER_RATE_LIMIT_DELAYED_COUNT_METRIC_ID)
+		return nil, app.ConfigError(service.ID(), err, "")
+	}
+	rejectedCount := app.MetricRegistry.Counter(service.ID(), SERVER_RATE_LIMIT_REJECTED_COUNT_METRIC_ID)
+	if rejectedCount == nil {
+		err := fmt.Errorf("Server rate limit rejected counter metric missing : ServiceID(0x%x) : MetricID(0x%x)", service.ID(), SERVER_RATE_LIMIT_REJECTED_COUNT_METRIC_ID)
+		return nil, app.ConfigError(service.ID(), err, "")
+	}
+
+	return &RateLimiter{
+		service:       service,
+		spec:          spec,
+		clients:       make(map[string]*clientTokenBuckets),
+		delayedCount:  delayedCount,
+		rejectedCount: rejectedCount,
+	}, nil
+}
+
+// RateLimiter applies the RateLimitSpec to inbound messages. It is installed as ConnHandler middleware, i.e., the conn
+// rate limiter is made available to the message read loop via the ConnHandler context.
+//
+// The per client token buckets are shared by all of the client's connections, and are released when the client's last
+// connection is closed.
+type RateLimiter struct {
+	service *app.Service
+	spec    RateLimitSpec
+
+	mutex   sync.Mutex
+	clients map[string]*clientTokenBuckets
+
+	delayedCount  prometheus.Counter
+	rejectedCount prometheus.Counter
+}
+
+// Spec returns the RateLimitSpec
+func (a *RateLimiter) Spec() RateLimitSpec {
+	return a.spec
+}
+
+// ConnHandler wraps the ConnHandler, applying the rate limits to the conn.
+func (a *RateLimiter) ConnHandler(handler ConnHandler) ConnHandler {
+	return func(ctx context.Context, conn net.Conn) {
+		limiter := &connRateLimiter{
+			RateLimiter: a,
+			conn:        conn,
+			messages:    a.spec.PerConn.messageBucket(),
+			bytes:       a.spec.PerConn.byteBucket(),
+		}
+		defer limiter.close()
+		handler(context.WithValue(ctx, ctx_conn_rate_limiter{}, limiter), conn)
+	}
+}
+
+func (a *RateLimiter) acquireClientBuckets(cn string) *clientTokenBuckets {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	client := a.clients[cn]
+	if client == nil {
+		client = &clientTokenBuckets{
+			messages: a.spec.PerClient.messageBucket(),
+			bytes:    a.spec.PerClient.byteBucket(),
+		}
+		a.clients[cn] = client
+	}
+	client.conns++
+	return client
+}
+
+func (a *RateLimiter) releaseClientBuckets(cn string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if client := a.clients[cn]; client != nil {
+		if client.conns--; client.conns <= 0 {
+			delete(a.clients, cn)
+		}
+	}
+}
+
+type clientTokenBuckets struct {
+	messages *tokenBucket
+	bytes    *tokenBucket
+	conns    int
+}
+
+type ctx_conn_rate_limiter command.ContextKey
+
+// connRateLimiter is only used by the conn read loop, i.e., it is not safe for concurrent use.
+type connRateLimiter struct {
+	*RateLimiter
+	conn net.Conn
+
+	messages *tokenBucket
+	bytes    *tokenBucket
+
+	// the client is resolved when the first message is read, i.e., after the TLS handshake is complete
+	clientResolved bool
+	clientCN       string
+	client         *clientTokenBuckets
+}
+
+func (a *connRateLimiter) close() {
+	if a.client != nil {
+		a.releaseClientBuckets(a.clientCN)
+		a.client = nil
+	}
+}
+
+func (a *connRateLimiter) resolveClient(ctx context.Context) {
+	if a.clientResolved {
+		return
+	}
+	a.clientResolved = true
+	if a.spec.PerClient.Unlimited() {
+		return
+	}
+	if identity := PeerIdentityFromContext(ctx); identity != nil {
+		a.clientCN = identity.CN
+		a.client = a.acquireClientBuckets(a.clientCN)
+		return
+	}
+	// the conn is a *tls.Conn, or is wrapped by the Server
+	tlsConn, ok := a.conn.(interface {
+		ConnectionState() tls.ConnectionState
+	})
+	if !ok {
+		return
+	}
+	peerCerts := tlsConn.ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return
+	}
+	a.clientCN = peerCerts[0].Subject.CommonName
+	a.client = a.acquireClientBuckets(a.clientCN)
+}
+
+// buckets returns the token buckets in the order that they are checked, which matches rateLimitNames.
+// The buckets for limits that are not specified are nil.
+func (a *connRateLimiter) buckets() []*tokenBucket {
+	buckets := []*tokenBucket{a.messages, a.bytes, nil, nil}
+	if a.client != nil {
+		buckets[2], buckets[3] = a.client.messages, a.client.bytes
+	}
+	return buckets
+}
+
+var rateLimitNames = []string{"conn messages", "conn bytes", "client messages", "client bytes"}
+
+// allow takes the tokens for the message only if all of the token buckets have the tokens available. If the message is
+// not allowed, then the name of the limit that was exceeded is returned.
+func (a *connRateLimiter) allow(now time.Time, size int) (string, bool) {
+	buckets := a.buckets()
+	for i, bucket := range buckets {
+		if bucket.tryTake(now, tokens(i, size)) {
+			continue
+		}
+		// give back the tokens that were taken from the previous buckets
+		for j := 0; j < i; j++ {
+			buckets[j].giveBack(tokens(j, size))
+		}
+		return rateLimitNames[i], false
+	}
+	return "", true
+}
+
+// reserve takes the tokens for the message from all of the token buckets, and returns how long the message must be
+// delayed until the tokens are available.
+func (a *connRateLimiter) reserve(now time.Time, size int) time.Duration {
+	var delay time.Duration
+	for i, bucket := range a.buckets() {
+		if wait := bucket.take(now, tokens(i, size)); wait > delay {
+			delay = wait
+		}
+	}
+	return delay
+}
+
+// the even buckets are message buckets, and the odd buckets are byte buckets
+func tokens(bucket int, size int) float64 {
+	if bucket%2 == 0 {
+		return 1
+	}
+	return float64(size)
+}
+
+// rateLimit applies the conn rate limits to the request message. It is meant to be called from the conn read loop
+// for each message that is read.
+//
+//	- in delay mode, the read loop is blocked until the tokens are available, or the context is done
+//	- in reject mode, if the message exceeds the rate limits, then an error response is returned, which should be sent
+//	  back to the client in place of handling the request
+//
+// Stream messages are always delayed because they are already subject to flow control, and rejecting a stream message
+// would break the stream sequence.
+//
+// If the conn is not rate limited, then nil is returned.
+func rateLimit(ctx context.Context, msg *capnp.Message, request message.Message) *capnp.Message {
+	limiter, ok := ctx.Value(ctx_conn_rate_limiter{}).(*connRateLimiter)
+	if !ok {
+		return nil
+	}
+	limiter.resolveClient(ctx)
+	now := time.Now()
+	size := messageSize(msg)
+
+	if limiter.spec.Mode == RateLimitMode_REJECT && !request.HasStream() {
+		limit, ok := limiter.allow(now, size)
+		if ok {
+			return nil
+		}
+		limiter.rejectedCount.Inc()
+		RATE_LIMIT_EXCEEDED.Log(limiter.service.Logger().Debug()).
+			Str("limit", limit).
+			Str("client", limiter.clientCN).
+			Uint64("type", request.Type()).
+			Msg("message rejected")
+		response, err := NewErrorResponse(request, RateLimitExceededError(limiter.service.ID(), limit))
+		if err != nil {
+			MESSAGE_ENCODE_FAILED.Log(limiter.service.Logger().Error()).Err(err).Msg("failed to create error response message")
+			return nil
+		}
+		return response
+	}
+
+	delay := limiter.reserve(now, size)
+	if delay <= 0 {
+		return nil
+	}
+	limiter.delayedCount.Inc()
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+	return nil
+}
+
+// messageSize returns the number of bytes used by the message segments
+func messageSize(msg *capnp.Message) int {
+	size := 0
+	for i := int64(0); i < msg.NumSegments(); i++ {
+		seg, err := msg.Segment(capnp.SegmentID(i))
+		if err != nil {
+			break
+		}
+		size += len(seg.Data())
+	}
+	return size
+}
+
+// tokenBucket methods are safe to call on a nil bucket, which represents no limit.
+type tokenBucket struct {
+	mutex sync.Mutex
+
+	// tokens per second
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns nil if the rate is zero, i.e., no limit. The bucket starts out full.
+func newTokenBucket(rate, burst uint32) *tokenBucket {
+	if rate == 0 {
+		return nil
+	}
+	if burst == 0 {
+		burst = rate
+	}
+	return &tokenBucket{
+		rate:   float64(rate),
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (a *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(a.last); elapsed > 0 {
+		a.tokens = math.Min(a.burst, a.tokens+elapsed.Seconds()*a.rate)
+		a.last = now
+	}
+}
+
+// a request for more than the burst is capped at the burst, otherwise it could never be satisfied
+func (a *tokenBucket) cost(n float64) float64 {
+	return math.Min(n, a.burst)
+}
+
+// take takes the tokens, and returns how long the caller must wait until the tokens are available.
+// The bucket goes into debt, which is paid off by the caller waiting.
+func (a *tokenBucket) take(now time.Time, n float64) time.Duration {
+	if a == nil {
+		return 0
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.refill(now)
+	a.tokens -= a.cost(n)
+	if a.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-a.tokens / a.rate * float64(time.Second))
+}
+
+// tryTake takes the tokens only if they are available now
+func (a *tokenBucket) tryTake(now time.Time, n float64) bool {
+	if a == nil {
+		return true
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.refill(now)
+	n = a.cost(n)
+	if a.tokens < n {
+		return false
+	}
+	a.tokens -= n
+	return true
+}
+
+// giveBack returns tokens that were taken by tryTake
+func (a *tokenBucket) giveBack(n float64) {
+	if a == nil {
+		return
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.tokens = math.Min(a.burst, a.tokens+a.cost(n))
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestRateLimiter(t *testing.T) {
+	const (
+		SERVICE_ID = app.ServiceID(0xa2b5b5a3e9ec2a8f)
+
+		ECHO = opnet.MessageType(0xd0e1b1ac5a29cbb4)
+	)
+
+	configDir := "./testdata/rate_limit_test/TestRateLimiter"
+	initConfigDir(configDir)
+	initServerMetricsConfig(SERVICE_ID)
+	app.ResetWithConfigDir(configDir)
+	defer app.Reset()
+
+	service := app.NewService(SERVICE_ID)
+	defer service.Kill(nil)
+
+	echo := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		response, err := opnet.NewResponse(*request, ECHO, nil)
+		if err != nil {
+			return nil, opnet.InvalidMessageError(SERVICE_ID, err)
+		}
+		return response, nil
+	}
+	router, err := opnet.NewMessageRouter(service, opnet.MessageRoute{ECHO, echo})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newClient := func(t *testing.T, spec opnet.RateLimitSpec) *opnet.Client {
+		rateLimiter, err := opnet.NewRateLimiter(service, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		connHandler := rateLimiter.ConnHandler(router.ConnHandler())
+		client, err := opnet.NewClient(opnet.ClientSettings{
+			ServiceID: SERVICE_ID,
+			Dial: func() (net.Conn, error) {
+				serverConn, clientConn := net.Pipe()
+				go connHandler(context.Background(), serverConn)
+				return clientConn, nil
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client
+	}
+
+	newData := func(t *testing.T) *capnp.Message {
+		data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := message.NewRootPing(seg); err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+
+	t.Run("spec", func(t *testing.T) {
+		spec := opnet.RateLimitSpec{
+			PerConn:   opnet.RateLimit{MessagesPerSec: 10, MessageBurst: 20, BytesPerSec: 1024, ByteBurst: 4096},
+			PerClient: opnet.RateLimit{MessagesPerSec: 100},
+			Mode:      opnet.RateLimitMode_REJECT,
+		}
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			t.Fatal(err)
+		}
+		capnpSpec, err := spec.ToCapnp(seg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		spec2, err := opnet.NewRateLimitSpec(capnpSpec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if spec2 != spec {
+			t.Errorf("Spec did not match after capnp round trip : %v != %v", spec2, spec)
+		}
+		if !spec.Enabled() {
+			t.Error("Spec should be enabled")
+		}
+		if (opnet.RateLimitSpec{}).Enabled() {
+			t.Error("Spec with no limits should not be enabled")
+		}
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		client := newClient(t, opnet.RateLimitSpec{
+			PerConn: opnet.RateLimit{MessagesPerSec: 1, MessageBurst: 2},
+			Mode:    opnet.RateLimitMode_REJECT,
+		})
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		for i := 0; i < 2; i++ {
+			if _, err := client.Request(ctx, ECHO, newData(t)); err != nil {
+				t.Fatalf("Request #%d should have been within the burst limit : %v", i+1, err)
+			}
+		}
+		_, err := client.Request(ctx, ECHO, newData(t))
+		if errorResponse, ok := err.(*opnet.ErrorResponse); !ok || errorResponse.ErrorID != opnet.ErrSpec_RateLimitExceeded.ErrorID {
+			t.Errorf("Expected a rate limit exceeded *ErrorResponse : %v", err)
+		}
+	})
+
+	t.Run("delay", func(t *testing.T) {
+		const MESSAGES_PER_SEC = 20
+		client := newClient(t, opnet.RateLimitSpec{
+			PerConn: opnet.RateLimit{MessagesPerSec: MESSAGES_PER_SEC, MessageBurst: 1},
+			Mode:    opnet.RateLimitMode_DELAY,
+		})
+		defer client.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		const REQUEST_COUNT = 11
+		start := time.Now()
+		for i := 0; i < REQUEST_COUNT; i++ {
+			if _, err := client.Request(ctx, ECHO, newData(t)); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// the first request uses up the burst, and each subsequent request must wait for a token
+		if elapsed, expected := time.Since(start), (REQUEST_COUNT-1)*time.Second/MESSAGES_PER_SEC; elapsed < expected*9/10 {
+			t.Errorf("Requests were not delayed : %v < %v", elapsed, expected)
+		}
+	})
+}
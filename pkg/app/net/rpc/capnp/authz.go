@@ -0,0 +1,85 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"context"
+
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+// authorizingClient wraps the main interface for a conn. It adds the client identity to the call Context, and authorizes
+// calls to the main interface methods.
+//
+// Pings, i.e., calls to RPC_PING_METHOD, are answered directly. They are not authorized, and they are not passed on
+// to the main interface interceptors - see BootstrapPing().
+//
+// NOTE: capabilities that are returned by the main interface methods are not wrapped, i.e., they must authorize their
+// own calls via opnet.Authorize()
+type authorizingClient struct {
+	capnp.Client
+
+	rpcService *RPCService
+	identity   *opnet.PeerIdentity
+}
+
+func (a *RPCService) authorizingClient(client capnp.Client, identity *opnet.PeerIdentity) capnp.Client {
+	return &authorizingClient{Client: client, rpcService: a, identity: identity}
+}
+
+func (a *authorizingClient) Call(call *capnp.Call) capnp.Answer {
+	if isPing(call.Method) {
+		return pong()
+	}
+	ctx := call.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if a.identity != nil {
+		ctx = opnet.WithPeerIdentity(ctx, a.identity)
+	}
+	if authorizer := a.rpcService.authorizer; authorizer != nil {
+		ctx = opnet.WithAuthorizer(ctx, authorizer)
+		if err := opnet.Authorize(ctx, a.rpcService.ID(), opnet.MethodOperation(call.Method.InterfaceID, call.Method.MethodID)); err != nil {
+			return capnp.ErrorAnswer(err)
+		}
+	}
+	authorizedCall := *call
+	authorizedCall.Ctx = ctx
+	return a.Client.Call(&authorizedCall)
+}
+
+// Close is a no-op because the main interface is shared by all conns, i.e., it is owned by the RPCService
+func (a *authorizingClient) Close() error {
+	return nil
+}
+
+func isPing(method capnp.Method) bool {
+	return method.InterfaceID == RPC_PING_METHOD.InterfaceID && method.MethodID == RPC_PING_METHOD.MethodID
+}
+
+// pong answers a ping with empty results
+func pong() capnp.Answer {
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return capnp.ErrorAnswer(err)
+	}
+	results, err := capnp.NewRootStruct(seg, capnp.ObjectSize{})
+	if err != nil {
+		return capnp.ErrorAnswer(err)
+	}
+	return capnp.ImmediateAnswer(results)
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"os"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/discovery"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2/rpc"
+)
+
+// ServiceInstance returns the discovery.ServiceInstance for the RPCService running in this app instance, which is used
+// to announce the service - see discovery.Announcer. If the host is blank, then the os hostname is used.
+func (a *RPCServiceSpec) ServiceInstance(host string) (discovery.ServiceInstance, error) {
+	if a.Network.Type == opnet.NETWORK_TCP && host == "" {
+		var err error
+		if host, err = os.Hostname(); err != nil {
+			return discovery.ServiceInstance{}, err
+		}
+	}
+	instance := discovery.ServiceInstance{
+		DomainID:   a.DomainID,
+		AppID:      a.AppID,
+		ServiceID:  a.ServiceID,
+		InstanceID: app.Instance(),
+		Kind:       discovery.KIND_RPC,
+		Network:    a.Network,
+		Host:       host,
+		Port:       uint16(a.RPCPort),
+	}
+	return instance, instance.Validate()
+}
+
+// ResolveConn returns an RPC conn to a healthy service instance, which is resolved via the registry - see
+// discovery.Registry.Dial()
+func (a *RPCClientSpec) ResolveConn(registry *discovery.Registry) (*rpc.Conn, discovery.ServiceInstance, error) {
+	clientConn, instance, err := registry.Dial(a.ServiceID, a.TLSConfig())
+	if err != nil {
+		return nil, instance, err
+	}
+	return rpc.NewConn(rpc.StreamTransport(clientConn)), instance, nil
+}
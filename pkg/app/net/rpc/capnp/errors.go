@@ -26,6 +26,8 @@ var (
 	ErrRPCServiceMaxConnsZero       = &app.Err{ErrorID: app.ErrorID(0xea3df278b2992429), Err: errors.New("The max number of connection must be > 0")}
 	ErrRPCServiceUnknownMessageType = &app.Err{ErrorID: app.ErrorID(0xdff7dbf6f092058e), Err: errors.New("Unknown message type")}
 	ErrRPCListenerNotStarted        = &app.Err{ErrorID: app.ErrorID(0xdb45f1d3176ecad3), Err: errors.New("RPC server listener is not started")}
+	ErrRPCClientPoolNotReady        = &app.Err{ErrorID: app.ErrorID(0x904a7c50ba36f172), Err: errors.New("No RPC client conn is ready")}
+	ErrRPCClientPoolSizeZero        = &app.Err{ErrorID: app.ErrorID(0xb6606fd1b32e37c1), Err: errors.New("RPC client pool size must be > 0")}
 )
 
 // NewRPCServerFactoryError wraps an error as an RPCServerFactoryError
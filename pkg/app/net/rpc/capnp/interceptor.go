@@ -0,0 +1,219 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/prometheus/client_golang/prometheus"
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
+)
+
+// RPCInterceptor intercepts calls to the RPCService main interface. The interceptor must either pass the call on to the
+// next client, or reject the call by returning an error answer, i.e., capnp.ErrorAnswer().
+//
+// The call context carries the client identity, i.e., interceptors can use opnet.PeerIdentityFromContext(), e.g., for
+// custom authorization, rate limiting, or tracing.
+//
+// NOTE: only the main interface methods are intercepted. Capabilities that are returned by the main interface methods
+// are not intercepted.
+type RPCInterceptor func(call *capnp.Call, next capnp.Client) capnp.Answer
+
+// Intercept wraps the client with the interceptors. The interceptors are applied in order, i.e., the first interceptor
+// is called first.
+func Intercept(client capnp.Client, interceptors ...RPCInterceptor) capnp.Client {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		client = interceptedClient{client, interceptors[i]}
+	}
+	return client
+}
+
+// InterceptMainInterface wraps the main interface with the interceptors - see Intercept()
+func InterceptMainInterface(mainInterface RPCMainInterface, interceptors ...RPCInterceptor) RPCMainInterface {
+	if len(interceptors) == 0 {
+		return mainInterface
+	}
+	return func() (capnp.Client, error) {
+		client, err := mainInterface()
+		if err != nil {
+			return nil, err
+		}
+		return Intercept(client, interceptors...), nil
+	}
+}
+
+type interceptedClient struct {
+	capnp.Client
+	interceptor RPCInterceptor
+}
+
+func (a interceptedClient) Call(call *capnp.Call) capnp.Answer {
+	return a.interceptor(call, a.Client)
+}
+
+// observeAnswer returns an answer that calls f with the call duration the first time the answer resolves, i.e., when
+// Struct() first returns. The RPC conn waits on the answer of each call that it delivers. Thus, the answer is observed
+// without blocking a goroutine per call - if the answer never resolves, then it is never observed.
+func observeAnswer(answer capnp.Answer, start time.Time, f func(time.Duration, error)) capnp.Answer {
+	return observedAnswer{Answer: answer, start: start, once: new(sync.Once), observe: f}
+}
+
+type observedAnswer struct {
+	capnp.Answer
+	start   time.Time
+	once    *sync.Once
+	observe func(time.Duration, error)
+}
+
+func (a observedAnswer) Struct() (capnp.Struct, error) {
+	s, err := a.Answer.Struct()
+	a.once.Do(func() {
+		a.observe(time.Since(a.start), err)
+	})
+	return s, err
+}
+
+// NewRPCMetricsInterceptor records the call count, error count, and call duration per interface method.
+// The metric vectors are registered under the service - see metrics.go.
+//
+// The interface and method IDs are sent by the client. Thus, to bound the number of metric series, only the specified
+// methods, i.e., the main interface *_Methods(), are labeled by interface and method. All other calls are labeled
+// RPC_CALL_LABEL_UNKNOWN.
+//
+// errors:
+//	- app.ConfigError if the metric vectors are not registered, or their labels are not RPC_CALL_METRIC_LABELS
+func NewRPCMetricsInterceptor(serviceID app.ServiceID, methods []server.Method) (RPCInterceptor, error) {
+	callCount := app.MetricRegistry.CounterVector(serviceID, RPC_SERVICE_CALL_COUNT_METRIC_ID)
+	if callCount == nil {
+		return nil, rpcMetricMissingError(serviceID, "call count counter vector", RPC_SERVICE_CALL_COUNT_METRIC_ID)
+	}
+	if !sameLabels(callCount.DynamicLabels, RPC_CALL_METRIC_LABELS) {
+		return nil, rpcMetricLabelsError(serviceID, "call count counter vector", callCount.DynamicLabels)
+	}
+	errorCount := app.MetricRegistry.CounterVector(serviceID, RPC_SERVICE_CALL_ERROR_COUNT_METRIC_ID)
+	if errorCount == nil {
+		return nil, rpcMetricMissingError(serviceID, "call error count counter vector", RPC_SERVICE_CALL_ERROR_COUNT_METRIC_ID)
+	}
+	if !sameLabels(errorCount.DynamicLabels, RPC_CALL_METRIC_LABELS) {
+		return nil, rpcMetricLabelsError(serviceID, "call error count counter vector", errorCount.DynamicLabels)
+	}
+	duration := app.MetricRegistry.HistogramVector(serviceID, RPC_SERVICE_CALL_DURATION_METRIC_ID)
+	if duration == nil {
+		return nil, rpcMetricMissingError(serviceID, "call duration histogram vector", RPC_SERVICE_CALL_DURATION_METRIC_ID)
+	}
+	if !sameLabels(duration.DynamicLabels, RPC_CALL_METRIC_LABELS) {
+		return nil, rpcMetricLabelsError(serviceID, "call duration histogram vector", duration.DynamicLabels)
+	}
+
+	knownMethods := make(map[rpcMethodKey]bool, len(methods))
+	for _, method := range methods {
+		knownMethods[rpcMethodKey{method.InterfaceID, method.MethodID}] = true
+	}
+
+	return func(call *capnp.Call, next capnp.Client) capnp.Answer {
+		labels := rpcCallLabels(call.Method, knownMethods)
+		callCount.With(labels).Inc()
+		return observeAnswer(next.Call(call), time.Now(), func(d time.Duration, err error) {
+			duration.With(labels).Observe(d.Seconds())
+			if err != nil {
+				errorCount.With(labels).Inc()
+			}
+		})
+	}, nil
+}
+
+// RPCMetricsRegistered returns true if the RPC call metric vectors are registered for the service
+func RPCMetricsRegistered(serviceID app.ServiceID) bool {
+	return app.MetricRegistry.CounterVector(serviceID, RPC_SERVICE_CALL_COUNT_METRIC_ID) != nil ||
+		app.MetricRegistry.CounterVector(serviceID, RPC_SERVICE_CALL_ERROR_COUNT_METRIC_ID) != nil ||
+		app.MetricRegistry.HistogramVector(serviceID, RPC_SERVICE_CALL_DURATION_METRIC_ID) != nil
+}
+
+type rpcMethodKey struct {
+	interfaceID uint64
+	methodID    uint16
+}
+
+func rpcCallLabels(method capnp.Method, knownMethods map[rpcMethodKey]bool) prometheus.Labels {
+	if !knownMethods[rpcMethodKey{method.InterfaceID, method.MethodID}] {
+		return prometheus.Labels{
+			RPC_CALL_LABEL_INTERFACE: RPC_CALL_LABEL_UNKNOWN,
+			RPC_CALL_LABEL_METHOD:    RPC_CALL_LABEL_UNKNOWN,
+		}
+	}
+	return prometheus.Labels{
+		RPC_CALL_LABEL_INTERFACE: fmt.Sprintf("%x", method.InterfaceID),
+		RPC_CALL_LABEL_METHOD:    strconv.Itoa(int(method.MethodID)),
+	}
+}
+
+func rpcMetricMissingError(serviceID app.ServiceID, name string, metricID app.MetricID) *app.Error {
+	err := fmt.Errorf("RPC %s metric missing : ServiceID(0x%x) : MetricID(0x%x)", name, serviceID, metricID)
+	return app.ConfigError(serviceID, err, "")
+}
+
+func rpcMetricLabelsError(serviceID app.ServiceID, name string, labels []string) *app.Error {
+	err := fmt.Errorf("RPC %s labels must be %v : %v", name, RPC_CALL_METRIC_LABELS, labels)
+	return app.ConfigError(serviceID, err, "")
+}
+
+func sameLabels(labels, expected []string) bool {
+	if len(labels) != len(expected) {
+		return false
+	}
+	for i := range labels {
+		if labels[i] != expected[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// NewRPCSlowCallInterceptor logs calls that take longer than the threshold to complete
+//
+// Log events:
+//	- RPC_SLOW_CALL
+func NewRPCSlowCallInterceptor(service *app.Service, threshold time.Duration) RPCInterceptor {
+	return func(call *capnp.Call, next capnp.Client) capnp.Answer {
+		return observeAnswer(next.Call(call), time.Now(), func(d time.Duration, err error) {
+			if d < threshold {
+				return
+			}
+			event := RPC_SLOW_CALL.Log(service.Logger().Warn()).
+				Str("interface", fmt.Sprintf("%x", call.Method.InterfaceID)).
+				Uint16("method", call.Method.MethodID).
+				Dur("duration", d).
+				Err(err)
+			if identity := opnet.PeerIdentityFromContext(callContext(call)); identity != nil {
+				event = event.Str("peer", identity.CN)
+			}
+			event.Msg("slow RPC call")
+		})
+	}
+}
+
+func callContext(call *capnp.Call) context.Context {
+	if call.Ctx == nil {
+		return context.Background()
+	}
+	return call.Ctx
+}
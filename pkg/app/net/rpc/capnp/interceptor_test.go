@@ -0,0 +1,115 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"zombiezen.com/go/capnproto2"
+)
+
+type interceptorTestClient struct {
+	calls int
+}
+
+func (a *interceptorTestClient) Call(call *capnp.Call) capnp.Answer {
+	a.calls++
+	return capnp.ErrorAnswer(errors.New("interceptorTestClient"))
+}
+
+func (a *interceptorTestClient) Close() error { return nil }
+
+func TestIntercept(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	order := []int{}
+	interceptor := func(i int) RPCInterceptor {
+		return func(call *capnp.Call, next capnp.Client) capnp.Answer {
+			order = append(order, i)
+			return next.Call(call)
+		}
+	}
+
+	client := &interceptorTestClient{}
+	mainInterface := InterceptMainInterface(func() (capnp.Client, error) { return client, nil }, interceptor(1), interceptor(2), interceptor(3))
+	interceptedClient, err := mainInterface()
+	if err != nil {
+		t.Fatal(err)
+	}
+	interceptedClient.Call(&capnp.Call{Method: capnp.Method{InterfaceID: 0xa0d7a5dd1f0f8c3d, MethodID: 1}})
+	if client.calls != 1 {
+		t.Errorf("client should have been called once : %d", client.calls)
+	}
+	if len(order) != 3 || order[0] != 1 || order[1] != 2 || order[2] != 3 {
+		t.Errorf("interceptors were not applied in order : %v", order)
+	}
+
+	t.Run("rejected call", func(t *testing.T) {
+		reject := func(call *capnp.Call, next capnp.Client) capnp.Answer {
+			return capnp.ErrorAnswer(errors.New("rejected"))
+		}
+		Intercept(client, reject).Call(&capnp.Call{})
+		if client.calls != 1 {
+			t.Errorf("client should not have been called : %d", client.calls)
+		}
+	})
+
+	t.Run("metrics not registered", func(t *testing.T) {
+		if RPCMetricsRegistered(app.ServiceID(0xa0d7a5dd1f0f8c3d)) {
+			t.Error("RPC metrics should not be registered")
+		}
+		if _, err := NewRPCMetricsInterceptor(app.ServiceID(0xa0d7a5dd1f0f8c3d), nil); err == nil {
+			t.Error("ConfigError should have been returned")
+		} else {
+			t.Log(err)
+		}
+	})
+
+	t.Run("call labels - unknown methods", func(t *testing.T) {
+		knownMethods := map[rpcMethodKey]bool{{0xa0d7a5dd1f0f8c3d, 1}: true}
+		labels := rpcCallLabels(capnp.Method{InterfaceID: 0xa0d7a5dd1f0f8c3d, MethodID: 1}, knownMethods)
+		if labels[RPC_CALL_LABEL_INTERFACE] != "a0d7a5dd1f0f8c3d" || labels[RPC_CALL_LABEL_METHOD] != "1" {
+			t.Errorf("known method should be labeled by interface and method : %v", labels)
+		}
+		for _, method := range []capnp.Method{{InterfaceID: 0xa0d7a5dd1f0f8c3d, MethodID: 2}, {InterfaceID: 0, MethodID: 1}} {
+			labels := rpcCallLabels(method, knownMethods)
+			if labels[RPC_CALL_LABEL_INTERFACE] != RPC_CALL_LABEL_UNKNOWN || labels[RPC_CALL_LABEL_METHOD] != RPC_CALL_LABEL_UNKNOWN {
+				t.Errorf("unknown method should be labeled unknown : %v", labels)
+			}
+		}
+	})
+
+	t.Run("observed answer", func(t *testing.T) {
+		observed := 0
+		answer := observeAnswer(capnp.ErrorAnswer(errors.New("observed")), time.Now(), func(d time.Duration, err error) {
+			observed++
+			if err == nil {
+				t.Error("the answer error should have been observed")
+			}
+		})
+		if observed != 0 {
+			t.Errorf("the answer should not be observed until it is waited on : %d", observed)
+		}
+		answer.Struct()
+		answer.Struct()
+		if observed != 1 {
+			t.Errorf("the answer should have been observed once : %d", observed)
+		}
+	})
+}
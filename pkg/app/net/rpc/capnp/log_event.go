@@ -14,13 +14,73 @@
 
 package capnp
 
-import "github.com/oysterpack/oysterpack.go/pkg/app"
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
 
 const (
 	RPC_SERVICE_LISTENER_STARTED = app.LogEventID(0xc1398919f7426edb)
-	RPC_SERVICE_LISTENER_RESTART = app.LogEventID(0xa611d10b1dfc880d)
+	RPC_SERVICE_LISTENER_RESTART = app.LogEventID(0xf51e66a578d532b3)
 	RPC_SERVICE_NEW_CONN         = app.LogEventID(0xeeb8cd1422232a22)
 	RPC_SERVICE_CONN_CLOSED      = app.LogEventID(0x8b5dd1b82559601b)
 	RPC_SERVICE_CONN_REMOVED     = app.LogEventID(0x9156bdee6b48f2b3)
+	RPC_SERVICE_BUSY_REJECTED    = app.LogEventID(0xc0c11cf39cda13a1)
 	RPC_CONN_CLOSE_ERR           = app.LogEventID(0xe4ce88e6d408a26c)
+
+	RPC_CLIENT_CONNECTED      = app.LogEventID(0x94c3c6ef6e529456)
+	RPC_CLIENT_CONNECT_FAILED = app.LogEventID(0x9baf10ef9e6672ff)
+	RPC_CLIENT_PING_FAILED    = app.LogEventID(0xd299d65d8969cd1d)
+	RPC_CLIENT_CONN_LOST      = app.LogEventID(0xc097543e4444999d)
+	RPC_CLIENT_SERVER_BUSY    = app.LogEventID(0xe4552b4c15ba05b6)
+
+	RPC_SLOW_CALL = app.LogEventID(0xa85389570841a377)
 )
+
+func init() {
+	connsField := app.LogEventField{Name: "conns", Type: app.LogFieldType_INT, Description: "number of active connections"}
+	remoteAddrField := app.LogEventField{Name: "remote_addr", Type: app.LogFieldType_STRING, Description: "client address"}
+	retryAfterField := app.LogEventField{Name: "retry_after", Type: app.LogFieldType_DURATION, Description: "how long the client should wait before retrying"}
+	clientConnField := app.LogEventField{Name: "conn", Type: app.LogFieldType_INT, Description: "RPCClientPool conn index"}
+	clientAddrField := app.LogEventField{Name: "addr", Type: app.LogFieldType_STRING, Description: "server address"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: RPC_SERVICE_LISTENER_STARTED, Name: "RPC_SERVICE_LISTENER_STARTED", Level: zerolog.InfoLevel, Description: "the RPCService listener has started",
+			Fields: []app.LogEventField{
+				{Name: "addr", Type: app.LogFieldType_STRING, Description: "listener address"},
+				{Name: "max-conns", Type: app.LogFieldType_INT, Description: "max number of concurrent connections"},
+				{Name: "tls", Type: app.LogFieldType_BOOL, Description: "true if the listener is using TLS"},
+			}},
+		app.LogEventSpec{ID: RPC_SERVICE_LISTENER_RESTART, Name: "RPC_SERVICE_LISTENER_RESTART", Level: zerolog.WarnLevel, Description: "the RPCService listener is being restarted",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+		app.LogEventSpec{ID: RPC_SERVICE_NEW_CONN, Name: "RPC_SERVICE_NEW_CONN", Level: zerolog.DebugLevel, Description: "a new RPC connection was registered",
+			Fields: []app.LogEventField{connsField}},
+		app.LogEventSpec{ID: RPC_SERVICE_CONN_CLOSED, Name: "RPC_SERVICE_CONN_CLOSED", Level: zerolog.DebugLevel, Description: "an RPC connection was closed"},
+		app.LogEventSpec{ID: RPC_SERVICE_CONN_REMOVED, Name: "RPC_SERVICE_CONN_REMOVED", Level: zerolog.DebugLevel, Description: "an RPC connection was unregistered",
+			Fields: []app.LogEventField{connsField}},
+		app.LogEventSpec{ID: RPC_SERVICE_BUSY_REJECTED, Name: "RPC_SERVICE_BUSY_REJECTED", Level: zerolog.DebugLevel, Description: "a connection was rejected because the RPCService is at max conns",
+			Fields: []app.LogEventField{remoteAddrField, retryAfterField, app.ErrLogEventField}},
+		app.LogEventSpec{ID: RPC_CONN_CLOSE_ERR, Name: "RPC_CONN_CLOSE_ERR", Level: zerolog.WarnLevel, Description: "an error occurred while closing an RPC connection",
+			Fields: []app.LogEventField{app.ErrLogEventField}},
+
+		app.LogEventSpec{ID: RPC_CLIENT_CONNECTED, Name: "RPC_CLIENT_CONNECTED", Level: zerolog.InfoLevel, Description: "a pooled RPC client connected to the server",
+			Fields: []app.LogEventField{clientConnField, clientAddrField}},
+		app.LogEventSpec{ID: RPC_CLIENT_CONNECT_FAILED, Name: "RPC_CLIENT_CONNECT_FAILED", Level: zerolog.WarnLevel, Description: "a pooled RPC client failed to connect - it will reconnect with backoff",
+			Fields: []app.LogEventField{clientConnField, clientAddrField, app.ErrLogEventField}},
+		app.LogEventSpec{ID: RPC_CLIENT_PING_FAILED, Name: "RPC_CLIENT_PING_FAILED", Level: zerolog.WarnLevel, Description: "a pooled RPC client failed its ping health check",
+			Fields: []app.LogEventField{clientConnField, clientAddrField, app.ErrLogEventField}},
+		app.LogEventSpec{ID: RPC_CLIENT_CONN_LOST, Name: "RPC_CLIENT_CONN_LOST", Level: zerolog.WarnLevel, Description: "a pooled RPC client lost its connection",
+			Fields: []app.LogEventField{clientConnField, clientAddrField, app.ErrLogEventField}},
+		app.LogEventSpec{ID: RPC_CLIENT_SERVER_BUSY, Name: "RPC_CLIENT_SERVER_BUSY", Level: zerolog.WarnLevel, Description: "the server rejected the pooled RPC client connection because it is busy",
+			Fields: []app.LogEventField{clientConnField, clientAddrField, retryAfterField}},
+
+		app.LogEventSpec{ID: RPC_SLOW_CALL, Name: "RPC_SLOW_CALL", Level: zerolog.WarnLevel, Description: "an RPC call took longer than the slow call threshold",
+			Fields: []app.LogEventField{
+				{Name: "interface", Type: app.LogFieldType_STRING, Description: "capnp interface id in hex"},
+				{Name: "method", Type: app.LogFieldType_INT, Description: "capnp method id"},
+				{Name: "duration", Type: app.LogFieldType_DURATION, Description: "call duration"},
+				app.ErrLogEventField,
+				{Name: "peer", Type: app.LogFieldType_STRING, Description: "peer cert common name"},
+			}},
+	)
+}
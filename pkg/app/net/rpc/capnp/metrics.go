@@ -0,0 +1,73 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import "github.com/oysterpack/oysterpack.go/pkg/app"
+
+// RPCService call metrics - see NewRPCMetricsInterceptor(). The metric vectors use RPC_CALL_METRIC_LABELS.
+const (
+	// counter vectors
+
+	// the total number of calls
+	RPC_SERVICE_CALL_COUNT_METRIC_ID = app.MetricID(0x89cd26b0f932b053)
+	// the total number of calls that failed
+	RPC_SERVICE_CALL_ERROR_COUNT_METRIC_ID = app.MetricID(0xe73371d30f37a8aa)
+
+	// histogram vectors
+
+	// the call duration in seconds
+	RPC_SERVICE_CALL_DURATION_METRIC_ID = app.MetricID(0xb898251cf8a951e9)
+)
+
+// RPC call metric labels
+const (
+	// the capnp interface ID in hex
+	RPC_CALL_LABEL_INTERFACE = "interface"
+	// the capnp method ID, i.e., the method ordinal
+	RPC_CALL_LABEL_METHOD = "method"
+
+	// the interface and method label value for calls to methods that are not known to the main interface - see
+	// NewRPCMetricsInterceptor()
+	RPC_CALL_LABEL_UNKNOWN = "unknown"
+)
+
+// RPC_CALL_METRIC_LABELS are the RPC call metric vector labels
+var RPC_CALL_METRIC_LABELS = []string{RPC_CALL_LABEL_INTERFACE, RPC_CALL_LABEL_METHOD}
+
+// RPCService metrics - the metrics are optional, i.e., they are only reported if they are registered
+const (
+	// counters
+
+	// the total number of conns that were rejected with a server busy reply - see RPCServiceSettings.BusyRetryAfter
+	RPC_SERVICE_BUSY_REJECTED_COUNT_METRIC_ID = app.MetricID(0xfcbe68b1609f41a6)
+)
+
+// RPCClientPool metrics - the metrics are registered under the pool's ServiceID. The metrics are optional, i.e., they
+// are only reported if they are registered.
+const (
+	// gauges
+
+	// the number of pooled conns that are ready
+	RPC_CLIENT_READY_CONN_COUNT_METRIC_ID = app.MetricID(0x97c66b7b3899c086)
+
+	// counters
+
+	// the total number of conns that were established, including reconnects
+	RPC_CLIENT_CONNECT_COUNT_METRIC_ID = app.MetricID(0x8091ee9c53d7d0fe)
+	// the total number of failed connection attempts
+	RPC_CLIENT_CONNECT_FAILED_COUNT_METRIC_ID = app.MetricID(0x917e6a689126749c)
+	// the total number of failed health check pings - the conn is closed and re-established
+	RPC_CLIENT_PING_FAILED_COUNT_METRIC_ID = app.MetricID(0xc870d8212c5bf03f)
+)
@@ -0,0 +1,579 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/discovery"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/prometheus/client_golang/prometheus"
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/rpc"
+)
+
+// RPCClientPool defaults
+const (
+	DEFAULT_RPC_CLIENT_MIN_BACKOFF          = 100 * time.Millisecond
+	DEFAULT_RPC_CLIENT_MAX_BACKOFF          = 30 * time.Second
+	DEFAULT_RPC_CLIENT_HEALTHCHECK_INTERVAL = 10 * time.Second
+	DEFAULT_RPC_CLIENT_HEALTHCHECK_TIMEOUT  = 5 * time.Second
+)
+
+// RPCClientConnState is the pooled conn state
+type RPCClientConnState uint8
+
+const (
+	// the conn is being established
+	RPC_CLIENT_CONN_CONNECTING RPCClientConnState = iota
+	// the conn is established and passed its last health check
+	RPC_CLIENT_CONN_READY
+	// the conn failed, and is waiting to reconnect
+	RPC_CLIENT_CONN_BACKOFF
+	// the pool is closed
+	RPC_CLIENT_CONN_CLOSED
+)
+
+func (a RPCClientConnState) String() string {
+	switch a {
+	case RPC_CLIENT_CONN_CONNECTING:
+		return "connecting"
+	case RPC_CLIENT_CONN_READY:
+		return "ready"
+	case RPC_CLIENT_CONN_BACKOFF:
+		return "backoff"
+	case RPC_CLIENT_CONN_CLOSED:
+		return "closed"
+	default:
+		return fmt.Sprintf("RPCClientConnState(%d)", a)
+	}
+}
+
+// RPCClientPing checks that the conn is alive. The bootstrap client must not be closed.
+type RPCClientPing func(ctx context.Context, bootstrap capnp.Client) error
+
+// RPCClientPoolSettings configures the RPCClientPool. Zero values are replaced with the defaults.
+type RPCClientPoolSettings struct {
+	// The number of conns - default is 1
+	Size int
+
+	// The network addresses to connect to - see RPCClientSpec.ConnForAddr(). The addresses are tried in order, i.e.,
+	// if the first address fails, then the pool fails over to the next address. Each conn starts at a different address
+	// in order to spread the conns across the addresses.
+	// If no addresses are specified, then the spec NetworkAddr() is used.
+	Addrs []string
+	// If specified, then the service instances are resolved via the registry instead of using Addrs - see RPCClientSpec.ResolveConn()
+	Registry *discovery.Registry
+
+	// reconnect exponential backoff range
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+
+	HealthCheckInterval time.Duration
+	HealthCheckTimeout  time.Duration
+	// default is BootstrapPing
+	Ping RPCClientPing
+}
+
+func (a *RPCClientPoolSettings) applyDefaults() {
+	if a.Size == 0 {
+		a.Size = 1
+	}
+	if a.MinBackoff == 0 {
+		a.MinBackoff = DEFAULT_RPC_CLIENT_MIN_BACKOFF
+	}
+	if a.MaxBackoff == 0 {
+		a.MaxBackoff = DEFAULT_RPC_CLIENT_MAX_BACKOFF
+	}
+	if a.MaxBackoff < a.MinBackoff {
+		a.MaxBackoff = a.MinBackoff
+	}
+	if a.HealthCheckInterval == 0 {
+		a.HealthCheckInterval = DEFAULT_RPC_CLIENT_HEALTHCHECK_INTERVAL
+	}
+	if a.HealthCheckTimeout == 0 {
+		a.HealthCheckTimeout = DEFAULT_RPC_CLIENT_HEALTHCHECK_TIMEOUT
+	}
+	if a.Ping == nil {
+		a.Ping = BootstrapPing
+	}
+}
+
+// RPC_PING_METHOD is the method that BootstrapPing() calls. capnp interface IDs always have the high bit set, i.e., the
+// ping method never collides with a main interface method. The RPCService answers pings for each conn, i.e., pings are
+// not authorized, and they are not passed on to the main interface interceptors.
+var RPC_PING_METHOD = capnp.Method{
+	InterfaceID:   0,
+	MethodID:      0,
+	InterfaceName: "ping",
+	MethodName:    "ping",
+}
+
+// BootstrapPing sends a RPC_PING_METHOD call to the bootstrap capability. Any reply from the server, including an error
+// reply, means the conn is alive, e.g., servers that do not answer pings reply with an unimplemented error. The ping
+// fails if the conn is closed, or the context is done before the server replies.
+func BootstrapPing(ctx context.Context, bootstrap capnp.Client) error {
+	_, err := bootstrap.Call(&capnp.Call{
+		Ctx:        ctx,
+		Method:     RPC_PING_METHOD,
+		ParamsFunc: func(capnp.Struct) error { return nil },
+	}).Struct()
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	if err == rpc.ErrConnClosed {
+		return err
+	}
+	// the server replied, e.g., with an unimplemented error
+	return nil
+}
+
+// RPCClientConnInfo is a pooled conn state snapshot
+type RPCClientConnInfo struct {
+	ID    int
+	State RPCClientConnState
+	// the address that the conn is connected to, or was last connected to
+	Addr string
+	// when the current conn was established
+	ConnectedAt time.Time
+	// the number of times the conn was re-established
+	Reconnects uint64
+	// the last connect or health check error
+	LastErr error
+}
+
+// RPCClientPool owns a pool of RPC conns to an RPCService. Each conn is managed by its own goroutine, which :
+//	- connects to the first address that is available, i.e., fails over between addresses
+//	- reconnects with exponential backoff when the conn is lost or fails its health check
+//	- health checks the conn by pinging the bootstrap capability - see RPCClientPing. A conn is marked ready only after
+//	  its first ping succeeds.
+//	- honors the server busy retry hint, i.e., when the server rejects the conn because it is at max conns, the conn fails
+//	  over to the next address, and the busy address is not dialed again until the retry hint has elapsed
+//
+// Client() returns a capnp.Client that routes each call to a conn that is ready, i.e., the client can be used to create
+// long lived capnp interface clients that transparently reconnect. Calls that are in flight when a conn fails are not
+// retried, because the pool does not know if the calls are idempotent.
+//
+// The pool is bound to the service lifecycle, i.e., when the service is killed, all conns are closed.
+//
+// Log events:
+//	- RPC_CLIENT_CONNECTED
+//	- RPC_CLIENT_CONNECT_FAILED
+//	- RPC_CLIENT_PING_FAILED
+//	- RPC_CLIENT_CONN_LOST
+//	- RPC_CLIENT_SERVER_BUSY
+//
+// Metrics - see metrics.go
+type RPCClientPool struct {
+	service  *app.Service
+	spec     *RPCClientSpec
+	settings RPCClientPoolSettings
+
+	conns []*pooledConn
+	next  uint32
+
+	readyConnCount     prometheus.Gauge
+	connectCount       prometheus.Counter
+	connectFailedCount prometheus.Counter
+	pingFailedCount    prometheus.Counter
+}
+
+// NewRPCClientPool starts connecting in the background, i.e., use Ready() to wait for the first conn
+//
+// errors:
+//	- ErrRPCClientPoolSizeZero
+func NewRPCClientPool(service *app.Service, spec *RPCClientSpec, settings RPCClientPoolSettings) (*RPCClientPool, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("Service cannot be nil")
+	}
+	if spec == nil {
+		return nil, app.IllegalArgumentError("RPCClientSpec cannot be nil")
+	}
+	if settings.Size < 0 {
+		return nil, ErrRPCClientPoolSizeZero
+	}
+	settings.applyDefaults()
+	if len(settings.Addrs) == 0 {
+		settings.Addrs = []string{spec.NetworkAddr()}
+	}
+
+	pool := &RPCClientPool{
+		service:            service,
+		spec:               spec,
+		settings:           settings,
+		conns:              make([]*pooledConn, settings.Size),
+		readyConnCount:     gauge(service.ID(), RPC_CLIENT_READY_CONN_COUNT_METRIC_ID),
+		connectCount:       counter(service.ID(), RPC_CLIENT_CONNECT_COUNT_METRIC_ID),
+		connectFailedCount: counter(service.ID(), RPC_CLIENT_CONNECT_FAILED_COUNT_METRIC_ID),
+		pingFailedCount:    counter(service.ID(), RPC_CLIENT_PING_FAILED_COUNT_METRIC_ID),
+	}
+	for i := range pool.conns {
+		conn := &pooledConn{pool: pool, id: i, ready: make(chan struct{})}
+		pool.conns[i] = conn
+		service.Go(conn.run)
+	}
+	return pool, nil
+}
+
+// gauge returns an unregistered gauge if the metric is not registered
+func gauge(serviceID app.ServiceID, metricID app.MetricID) prometheus.Gauge {
+	if metric := app.MetricRegistry.Gauge(serviceID, metricID); metric != nil {
+		return metric.Gauge
+	}
+	return prometheus.NewGauge(prometheus.GaugeOpts{Name: metricID.PrometheusName(serviceID)})
+}
+
+// counter returns an unregistered counter if the metric is not registered
+func counter(serviceID app.ServiceID, metricID app.MetricID) prometheus.Counter {
+	if metric := app.MetricRegistry.Counter(serviceID, metricID); metric != nil {
+		return metric.Counter
+	}
+	return prometheus.NewCounter(prometheus.CounterOpts{Name: metricID.PrometheusName(serviceID)})
+}
+
+// Client returns a client that routes each call to the bootstrap capability of a ready conn. The conns are selected
+// round robin. If no conn is ready, then the call fails with ErrRPCClientPoolNotReady.
+//
+// Closing the client is a no-op - the conns are owned by the pool.
+func (a *RPCClientPool) Client() capnp.Client {
+	return poolClient{a}
+}
+
+// Bootstrap returns the bootstrap capability for the next ready conn
+//
+// errors:
+//	- ErrRPCClientPoolNotReady
+func (a *RPCClientPool) Bootstrap() (capnp.Client, error) {
+	n := len(a.conns)
+	start := int(atomic.AddUint32(&a.next, 1))
+	for i := 0; i < n; i++ {
+		if client := a.conns[(start+i)%n].bootstrap(); client != nil {
+			return client, nil
+		}
+	}
+	return nil, ErrRPCClientPoolNotReady
+}
+
+// Ready returns when at least 1 conn is ready, or the context is done
+//
+// errors:
+//	- the context error
+//	- ErrRPCClientPoolNotReady if the pool is closed
+func (a *RPCClientPool) Ready(ctx context.Context) error {
+	done := make(chan struct{})
+	defer close(done)
+	ready := make(chan struct{}, len(a.conns))
+	for _, conn := range a.conns {
+		go func(connReady <-chan struct{}) {
+			select {
+			case <-connReady:
+				ready <- struct{}{}
+			case <-done:
+			}
+		}(conn.readyChan())
+	}
+	select {
+	case <-ready:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-a.service.Dying():
+		return ErrRPCClientPoolNotReady
+	}
+}
+
+// ConnInfo returns a state snapshot for each conn
+func (a *RPCClientPool) ConnInfo() []RPCClientConnInfo {
+	infos := make([]RPCClientConnInfo, len(a.conns))
+	for i, conn := range a.conns {
+		infos[i] = conn.info()
+	}
+	return infos
+}
+
+// ReadyConnCount returns the number of conns that are ready
+func (a *RPCClientPool) ReadyConnCount() int {
+	count := 0
+	for _, conn := range a.conns {
+		if conn.bootstrap() != nil {
+			count++
+		}
+	}
+	return count
+}
+
+// Close kills the pool service, which closes all conns
+func (a *RPCClientPool) Close() error {
+	a.service.Kill(nil)
+	return a.service.Wait()
+}
+
+func (a *RPCClientPool) dial(addr string) (*rpc.Conn, string, error) {
+	if a.settings.Registry != nil {
+		conn, instance, err := a.spec.ResolveConn(a.settings.Registry)
+		return conn, instance.Addr(), err
+	}
+	conn, err := a.spec.ConnForAddr(addr)
+	return conn, addr, err
+}
+
+func (a *RPCClientPool) backoff(attempt uint) time.Duration {
+	backoff := a.settings.MinBackoff
+	for i := uint(0); i < attempt && backoff < a.settings.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > a.settings.MaxBackoff {
+		backoff = a.settings.MaxBackoff
+	}
+	// +/- 20% jitter to avoid clients reconnecting in lock step after a server restart
+	jitter := time.Duration(rand.Int63n(int64(backoff)/5+1)) * 2
+	return backoff - backoff/5 + jitter
+}
+
+type poolClient struct {
+	pool *RPCClientPool
+}
+
+func (a poolClient) Call(call *capnp.Call) capnp.Answer {
+	client, err := a.pool.Bootstrap()
+	if err != nil {
+		return capnp.ErrorAnswer(err)
+	}
+	return client.Call(call)
+}
+
+func (a poolClient) Close() error {
+	return nil
+}
+
+type pooledConn struct {
+	pool *RPCClientPool
+	id   int
+
+	mutex       sync.RWMutex
+	state       RPCClientConnState
+	addr        string
+	conn        *rpc.Conn
+	client      capnp.Client
+	connectedAt time.Time
+	reconnects  uint64
+	lastErr     error
+	// closed when the conn becomes ready, and replaced when the conn is lost
+	ready chan struct{}
+}
+
+func (a *pooledConn) bootstrap() capnp.Client {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	if a.state != RPC_CLIENT_CONN_READY {
+		return nil
+	}
+	return a.client
+}
+
+func (a *pooledConn) readyChan() <-chan struct{} {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return a.ready
+}
+
+func (a *pooledConn) info() RPCClientConnInfo {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	return RPCClientConnInfo{
+		ID:          a.id,
+		State:       a.state,
+		Addr:        a.addr,
+		ConnectedAt: a.connectedAt,
+		Reconnects:  a.reconnects,
+		LastErr:     a.lastErr,
+	}
+}
+
+func (a *pooledConn) setState(state RPCClientConnState, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.state == RPC_CLIENT_CONN_READY && state != RPC_CLIENT_CONN_READY {
+		a.pool.readyConnCount.Dec()
+		a.ready = make(chan struct{})
+	}
+	a.state = state
+	if err != nil {
+		a.lastErr = err
+	}
+}
+
+func (a *pooledConn) run() error {
+	logger := a.pool.service.Logger()
+	addrs := a.pool.settings.Addrs
+	// each conn starts at a different address to spread the conns across the addresses
+	addrIndex := a.id % len(addrs)
+	var attempt uint
+	failures := 0
+	connected := false
+	// the server busy retry hints per address
+	busyUntil := make(map[string]time.Time)
+	for {
+		if retryTime, ok := busyUntil[addrs[addrIndex]]; ok {
+			delete(busyUntil, addrs[addrIndex])
+			if wait := time.Until(retryTime); wait > 0 {
+				select {
+				case <-a.pool.service.Dying():
+					a.setState(RPC_CLIENT_CONN_CLOSED, nil)
+					return nil
+				case <-time.After(wait):
+				}
+			}
+		}
+		a.setState(RPC_CLIENT_CONN_CONNECTING, nil)
+		conn, addr, err := a.pool.dial(addrs[addrIndex])
+		if err != nil {
+			a.pool.connectFailedCount.Inc()
+			RPC_CLIENT_CONNECT_FAILED.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(err).Msg("RPC client connect failed")
+			a.setState(RPC_CLIENT_CONN_BACKOFF, err)
+			// fail over to the next address - backoff once all addresses have been tried
+			addrIndex = (addrIndex + 1) % len(addrs)
+			failures++
+			if failures%len(addrs) == 0 {
+				select {
+				case <-a.pool.service.Dying():
+					a.setState(RPC_CLIENT_CONN_CLOSED, nil)
+					return nil
+				case <-time.After(a.pool.backoff(attempt)):
+				}
+				attempt++
+			}
+			continue
+		}
+		attempt, failures = 0, 0
+		if connected {
+			a.mutex.Lock()
+			a.reconnects++
+			a.mutex.Unlock()
+		}
+		connected = true
+
+		closed, err := a.serve(conn, addr)
+		conn.Close()
+		if !closed {
+			// avoid a tight reconnect loop if the server keeps on dropping the conn
+			if retryAfter, busy := opnet.ServerBusyRetryAfter(err); busy {
+				// the server is at max conns - fail over to the next address, and honor the retry hint for this address
+				RPC_CLIENT_SERVER_BUSY.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Dur("retry_after", retryAfter).Msg("RPC server busy")
+				busyUntil[addrs[addrIndex]] = time.Now().Add(retryAfter)
+				addrIndex = (addrIndex + 1) % len(addrs)
+			}
+			select {
+			case <-a.pool.service.Dying():
+				closed = true
+			case <-time.After(a.pool.backoff(0)):
+			}
+		}
+		if closed {
+			a.setState(RPC_CLIENT_CONN_CLOSED, nil)
+			return nil
+		}
+	}
+}
+
+// serve marks the conn as ready once the bootstrap capability replies to a ping, and then health checks the conn until it
+// fails. It returns true if the pool service is dying. Otherwise, the error that caused the conn to fail is returned.
+//
+// A conn that the server rejects, e.g., because the server is busy, is never marked ready because the server aborts the
+// conn before it replies to the ping.
+func (a *pooledConn) serve(conn *rpc.Conn, addr string) (bool, error) {
+	logger := a.pool.service.Logger()
+	client := conn.Bootstrap(context.Background())
+	defer client.Close()
+
+	connLost := make(chan error, 1)
+	go func() {
+		connLost <- conn.Wait()
+	}()
+
+	pinged := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), a.pool.settings.HealthCheckTimeout)
+		defer cancel()
+		pinged <- a.pool.settings.Ping(ctx, client)
+	}()
+	select {
+	case <-a.pool.service.Dying():
+		return true, nil
+	case err := <-connLost:
+		RPC_CLIENT_CONN_LOST.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(err).Msg("RPC client conn lost")
+		a.setState(RPC_CLIENT_CONN_BACKOFF, err)
+		return false, err
+	case err := <-pinged:
+		if err != nil {
+			// if the server aborted the conn, then the abort reason is returned, e.g., server busy
+			conn.Close()
+			if connErr := <-connLost; connErr != nil && connErr != rpc.ErrConnClosed {
+				RPC_CLIENT_CONN_LOST.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(connErr).Msg("RPC client conn lost")
+				a.setState(RPC_CLIENT_CONN_BACKOFF, connErr)
+				return false, connErr
+			}
+			a.pool.pingFailedCount.Inc()
+			RPC_CLIENT_PING_FAILED.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(err).Msg("RPC client health check failed")
+			a.setState(RPC_CLIENT_CONN_BACKOFF, err)
+			return false, err
+		}
+	}
+
+	a.mutex.Lock()
+	a.state = RPC_CLIENT_CONN_READY
+	a.addr = addr
+	a.conn = conn
+	a.client = client
+	a.connectedAt = time.Now()
+	a.lastErr = nil
+	close(a.ready)
+	a.mutex.Unlock()
+	a.pool.readyConnCount.Inc()
+	a.pool.connectCount.Inc()
+	RPC_CLIENT_CONNECTED.Log(logger.Info()).Int("conn", a.id).Str("addr", addr).Msg("RPC client connected")
+
+	ticker := time.NewTicker(a.pool.settings.HealthCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.pool.service.Dying():
+			return true, nil
+		case err := <-connLost:
+			RPC_CLIENT_CONN_LOST.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(err).Msg("RPC client conn lost")
+			a.setState(RPC_CLIENT_CONN_BACKOFF, err)
+			return false, err
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), a.pool.settings.HealthCheckTimeout)
+			err := a.pool.settings.Ping(ctx, client)
+			cancel()
+			if err != nil {
+				a.pool.pingFailedCount.Inc()
+				RPC_CLIENT_PING_FAILED.Log(logger.Warn()).Int("conn", a.id).Str("addr", addr).Err(err).Msg("RPC client health check failed")
+				a.setState(RPC_CLIENT_CONN_BACKOFF, err)
+				return false, err
+			}
+		}
+	}
+}
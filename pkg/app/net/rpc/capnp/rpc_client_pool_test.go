@@ -0,0 +1,115 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package capnp
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestRPCClientPool(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	// the pipe listeners do not exist, i.e., all connection attempts fail fast
+	spec := &RPCClientSpec{
+		RPCServiceSpec: &RPCServiceSpec{
+			DomainID:  app.DomainID(0xed5cf026e8734361),
+			AppID:     app.AppID(0xd113a2e016e12f0f),
+			ServiceID: app.ServiceID(0xe49214fa20b35ba8),
+			Network:   opnet.Network{Type: opnet.NETWORK_PIPE, Address: "TestRPCClientPool"},
+		},
+		RootCAs: x509.NewCertPool(),
+	}
+	settings := RPCClientPoolSettings{
+		Size:       2,
+		Addrs:      []string{"TestRPCClientPool-a", "TestRPCClientPool-b"},
+		MinBackoff: 10 * time.Millisecond,
+		MaxBackoff: 40 * time.Millisecond,
+	}
+	pool, err := NewRPCClientPool(app.NewService(app.ServiceID(0xa3a2bcb1c7f48ddd)), spec, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("backoff", func(t *testing.T) {
+		for attempt := uint(0); attempt < 10; attempt++ {
+			backoff := pool.backoff(attempt)
+			if backoff < settings.MinBackoff*4/5 || backoff > settings.MaxBackoff*6/5 {
+				t.Errorf("backoff is out of range : attempt = %d : %v", attempt, backoff)
+			}
+		}
+	})
+
+	t.Run("not ready", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		if err := pool.Ready(ctx); err != context.DeadlineExceeded {
+			t.Errorf("pool should not be ready : %v", err)
+		}
+		if _, err := pool.Bootstrap(); err != ErrRPCClientPoolNotReady {
+			t.Errorf("ErrRPCClientPoolNotReady was expected : %v", err)
+		}
+		if pool.ReadyConnCount() != 0 {
+			t.Errorf("no conns should be ready : %d", pool.ReadyConnCount())
+		}
+		for _, info := range pool.ConnInfo() {
+			if info.LastErr == nil || info.State == RPC_CLIENT_CONN_READY {
+				t.Errorf("conn should have failed to connect : %v", info)
+			}
+		}
+	})
+
+	if err := pool.Close(); err != nil {
+		t.Error(err)
+	}
+	for _, info := range pool.ConnInfo() {
+		if info.State != RPC_CLIENT_CONN_CLOSED {
+			t.Errorf("conn should be closed : %v", info)
+		}
+	}
+}
+
+func TestBootstrapPing(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	// pings are answered by the conn's authorizing client, i.e., they never reach the main interface
+	client := &interceptorTestClient{}
+	bootstrap := &authorizingClient{Client: client}
+	if err := BootstrapPing(context.Background(), bootstrap); err != nil {
+		t.Errorf("ping failed : %v", err)
+	}
+	if _, err := bootstrap.Call(&capnp.Call{Method: RPC_PING_METHOD}).Struct(); err != nil {
+		t.Errorf("ping should have been answered : %v", err)
+	}
+	if client.calls != 0 {
+		t.Errorf("ping should not have been passed on to the main interface : %d", client.calls)
+	}
+
+	// servers that do not answer pings reply with an error, which still means the conn is alive
+	if err := BootstrapPing(context.Background(), client); err != nil {
+		t.Errorf("an error reply means the conn is alive : %v", err)
+	}
+	if client.calls != 1 {
+		t.Errorf("ping should have been passed on to the client : %d", client.calls)
+	}
+}
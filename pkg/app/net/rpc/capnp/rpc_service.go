@@ -17,16 +17,20 @@ package capnp
 import (
 	"context"
 	"net"
+	"sync/atomic"
+	"time"
 
 	"fmt"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
 	opsync "github.com/oysterpack/oysterpack.go/pkg/app/sync"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"gopkg.in/tomb.v2"
 	"zombiezen.com/go/capnproto2"
 	"zombiezen.com/go/capnproto2/rpc"
+	rpccapnp "zombiezen.com/go/capnproto2/std/capnp/rpc"
 )
 
 // StartRPCService creates and starts a new RPCService asynchronously.
@@ -39,19 +43,61 @@ import (
 //		- ErrRPCMainInterfaceNil
 //		- ErrRPCServiceMaxConnsZero
 func StartRPCService(service *Service, listenerFactory opnet.ListenerFactory, tlsConfigProvider opnet.TLSConfigProvider, server RPCMainInterface, maxConns uint) (*RPCService, error) {
+	return StartAuthorizedRPCService(service, listenerFactory, tlsConfigProvider, server, maxConns, nil)
+}
+
+// StartAuthorizedRPCService creates and starts a new RPCService, which authorizes the calls to the main interface methods
+// using the client cert identity. If the authorizer is nil, then all clients are authorized.
+//
+// The client identity is added to the call Context - see opnet.PeerIdentityFromContext().
+func StartAuthorizedRPCService(service *Service, listenerFactory opnet.ListenerFactory, tlsConfigProvider opnet.TLSConfigProvider, server RPCMainInterface, maxConns uint, authorizer opnet.Authorizer) (*RPCService, error) {
+	return StartRPCServiceWithSettings(service, RPCServiceSettings{
+		ListenerFactory:   listenerFactory,
+		TLSConfigProvider: tlsConfigProvider,
+		MainInterface:     server,
+		MaxConns:          maxConns,
+		Authorizer:        authorizer,
+	})
+}
+
+// RPCServiceSettings are used to start an RPCService - see StartRPCServiceWithSettings()
+type RPCServiceSettings struct {
+	ListenerFactory opnet.ListenerFactory
+	// optional - if nil, then TLS is not used
+	TLSConfigProvider opnet.TLSConfigProvider
+	MainInterface     RPCMainInterface
+	MaxConns          uint
+
+	// optional - if nil, then all clients are authorized
+	Authorizer opnet.Authorizer
+
+	// optional - if > 0, then the listener is kept open when the service is at max conns. New conns are sent a capnp RPC
+	// abort message, whose reason is the opnet.ServerBusyReason(), and then closed. Clients should retry after the
+	// specified duration - see opnet.ServerBusyRetryAfter().
+	// If 0, then the listener is closed until connections free up, i.e., clients get connection refused.
+	BusyRetryAfter time.Duration
+}
+
+// StartRPCServiceWithSettings creates and starts a new RPCService.
+//
+// The RPC_SERVICE_BUSY_REJECTED_COUNT_METRIC_ID counter is optional, i.e., it is only reported if it is registered.
+//
+// errors:
+//	- see StartRPCService()
+func StartRPCServiceWithSettings(service *Service, settings RPCServiceSettings) (*RPCService, error) {
 	if service == nil {
 		return nil, ErrServiceNil
 	}
 	if !service.Alive() {
 		return nil, ErrServiceNotAlive
 	}
-	if listenerFactory == nil {
+	if settings.ListenerFactory == nil {
 		return nil, ErrListenerFactoryNil
 	}
-	if server == nil {
+	if settings.MainInterface == nil {
 		return nil, ErrRPCMainInterfaceNil
 	}
-	if maxConns == 0 {
+	if settings.MaxConns == 0 {
 		return nil, ErrRPCServiceMaxConnsZero
 	}
 
@@ -62,12 +108,16 @@ func StartRPCService(service *Service, listenerFactory opnet.ListenerFactory, tl
 
 	rpcService := &RPCService{
 		ServiceCommandChannel: serviceCommandChannel,
-		server:                server,
+		server:                settings.MainInterface,
 		startedChan:           make(chan struct{}),
-		connSemaphore:         opsync.NewCountingSemaphore(maxConns),
+		connSemaphore:         opsync.NewCountingSemaphore(settings.MaxConns),
 		conns:                 make(map[uint64]*rpc.Conn),
 		logger:                NewConnLogger(service.logger),
-		listener:              &listener{factory: listenerFactory, tlsConfigProvider: tlsConfigProvider},
+		listener:              &listener{factory: settings.ListenerFactory, tlsConfigProvider: settings.TLSConfigProvider},
+		authorizer:            settings.Authorizer,
+		busyRetryAfter:        settings.BusyRetryAfter,
+		busyRejectedCount:     counter(service.ID(), RPC_SERVICE_BUSY_REJECTED_COUNT_METRIC_ID),
+		busyConnRejecter:      opnet.NewBusyConnRejecter(opnet.MAX_BUSY_CONN_REJECTIONS),
 	}
 	rpcService.start()
 
@@ -101,6 +151,8 @@ func StartRPCService(service *Service, listenerFactory opnet.ListenerFactory, tl
 //   - once the max connection capacity limit has been reached, the listener will automatically close itself. Clients will
 //     fail fast with connection refused errors because the port will be down. Load balancers should automatically route
 //     clients to other servers with capacity. Once connection capacity is freed up, then the listener will automatically restart.
+//   - in busy mode, i.e., RPCServiceSettings.BusyRetryAfter > 0, the listener is kept open. Connections that exceed the
+//     max connection capacity are sent an RPC abort message with a "server busy, retry after" reason, and then closed.
 // - RPC conn handler goroutine
 //   - handles RPC requests
 //	 - registers itself
@@ -110,6 +162,7 @@ func StartRPCService(service *Service, listenerFactory opnet.ListenerFactory, tl
 // - WARN
 //	 - RPC_SERVICE_LISTENER_RESTART - will log the listener error
 //	 - RPC_CONN_CLOSE_ERR - if an error occurred when closing the RPC conn
+//	 - RPC_SERVICE_BUSY_REJECTED - when a conn is rejected because the service is at max conns, in busy mode
 // - INFO
 // 	 - SERVICE_STARTING - when the main service goroutine is launched
 // 	 - SERVICE_STARTED - once the listener goroutine is launched
@@ -138,6 +191,15 @@ type RPCService struct {
 
 	// wraps the service logger
 	logger rpc.Logger
+
+	// nil if all clients are authorized
+	authorizer opnet.Authorizer
+
+	// if > 0, then conns that exceed max conns are rejected with a server busy reply
+	busyRetryAfter    time.Duration
+	busyRejectedCount prometheus.Counter
+	busyRejected      uint64
+	busyConnRejecter  *opnet.BusyConnRejecter
 }
 
 // RPCMainInterface provides the RPC server main interface
@@ -146,8 +208,10 @@ type RPCMainInterface func() (capnp.Client, error)
 func (a *RPCService) start() {
 	a.Go(func() error {
 		app.SERVICE_STARTING.Log(a.Logger().Info()).Msg("starting")
+		app.Events.Publish(app.NewServiceEvent(app.SERVICE_STARTING, a.ID(), nil))
 		a.startListener()
 		app.SERVICE_STARTED.Log(a.Logger().Info()).Msg("started")
+		app.Events.Publish(app.NewServiceEvent(app.SERVICE_STARTED, a.ID(), nil))
 
 		a.registerRPCService()
 
@@ -214,47 +278,109 @@ func (a *RPCService) startListener() {
 			Bool("tls", a.listener.tlsConfig != nil).
 			Msg("rpc listener started")
 		for {
-			select {
-			case <-a.Dying():
-				return nil
-			case <-a.listener.Dying():
-				return nil
-			case <-a.connSemaphore:
-				if listener == nil {
-					listener, err = a.listener.start()
-					if err != nil {
-						return err
-					}
+			if a.busyRetryAfter == 0 {
+				select {
+				case <-a.Dying():
+					return nil
+				case <-a.listener.Dying():
+					return nil
+				case <-a.connSemaphore:
 				}
-				conn, err := listener.Accept()
+			}
+			if listener == nil {
+				listener, err = a.listener.start()
 				if err != nil {
 					return err
 				}
-				go func(conn net.Conn) {
-					rpcConn := rpc.NewConn(rpc.StreamTransport(conn), rpc.MainInterface(mainInterface), rpc.ConnLog(a.logger))
-					connKey := a.connSeq.Next()
-					a.Submit(a.registerConn(connKey, rpcConn))
-					defer func() {
-						a.connSemaphore.ReturnToken()
-						RPC_SERVICE_CONN_CLOSED.Log(a.Logger().Debug()).Msg("RPCService conn closed")
-						a.Submit(a.unregisterConn(connKey))
-					}()
-					err := rpcConn.Wait()
-					if err != nil {
-						a.Service.Logger().Info().Err(err).Msg("")
-					}
-				}(conn)
-
-				if a.RemainingConnectionCapacity() == 0 {
-					// no longer accept connections - we want clients to fail fast and not hang waiting to be served
-					listener.Close()
-					listener = nil
+			}
+			conn, err := listener.Accept()
+			if err != nil {
+				return err
+			}
+			if a.busyRetryAfter > 0 {
+				// the listener is kept open - conns that exceed max conns are rejected with a server busy reply
+				select {
+				case <-a.connSemaphore:
+				default:
+					a.rejectBusyConn(conn)
+					continue
 				}
 			}
+			go func(conn net.Conn) {
+				identity, err := opnet.ConnPeerIdentity(conn)
+				if err != nil {
+					opnet.SERVER_TLS_HANDSHAKE_FAILED.Log(a.Logger().Warn()).Err(err).Str("remote_addr", conn.RemoteAddr().String()).Msg("TLS handshake failed")
+					conn.Close()
+					a.connSemaphore.ReturnToken()
+					return
+				}
+				rpcConn := rpc.NewConn(rpc.StreamTransport(conn), rpc.MainInterface(a.authorizingClient(mainInterface, identity)), rpc.ConnLog(a.logger))
+				connKey := a.connSeq.Next()
+				a.Submit(a.registerConn(connKey, rpcConn))
+				defer func() {
+					a.connSemaphore.ReturnToken()
+					RPC_SERVICE_CONN_CLOSED.Log(a.Logger().Debug()).Msg("RPCService conn closed")
+					a.Submit(a.unregisterConn(connKey))
+				}()
+				err := rpcConn.Wait()
+				if err != nil {
+					a.Service.Logger().Info().Err(err).Msg("")
+				}
+			}(conn)
+
+			if a.busyRetryAfter == 0 && a.RemainingConnectionCapacity() == 0 {
+				// no longer accept connections - we want clients to fail fast and not hang waiting to be served
+				listener.Close()
+				listener = nil
+			}
 		}
 	})
 }
 
+// rejectBusyConn sends a capnp RPC abort message to the client asynchronously, whose reason carries the retry hint, and
+// closes the conn. If too many replies are in flight, then the conn is closed without a reply - see opnet.MAX_BUSY_CONN_REJECTIONS
+func (a *RPCService) rejectBusyConn(conn net.Conn) {
+	atomic.AddUint64(&a.busyRejected, 1)
+	a.busyRejectedCount.Inc()
+	remoteAddr := conn.RemoteAddr().String()
+	replying := a.busyConnRejecter.Reject(conn, replyRPCServerBusy(a.busyRetryAfter), func(err error) {
+		event := RPC_SERVICE_BUSY_REJECTED.Log(a.Logger().Debug()).Str("remote_addr", remoteAddr).Dur("retry_after", a.busyRetryAfter)
+		if err != nil {
+			event.Err(err)
+		}
+		event.Msg("RPCService busy - conn rejected")
+	})
+	if !replying {
+		RPC_SERVICE_BUSY_REJECTED.Log(a.Logger().Debug()).Str("remote_addr", remoteAddr).Msg("RPCService busy - conn closed without a reply")
+	}
+}
+
+// replyRPCServerBusy sends an RPC abort message, i.e., the client rpc.Conn is shutdown with an error that carries the
+// abort reason - see opnet.ServerBusyRetryAfter()
+func replyRPCServerBusy(retryAfter time.Duration) func(conn net.Conn) error {
+	return func(conn net.Conn) error {
+		_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+		if err != nil {
+			return err
+		}
+		msg, err := rpccapnp.NewRootMessage(seg)
+		if err != nil {
+			return err
+		}
+		abort, err := msg.NewAbort()
+		if err != nil {
+			return err
+		}
+		abort.SetType(rpccapnp.Exception_Type_overloaded)
+		if err := abort.SetReason(opnet.ServerBusyReason(retryAfter)); err != nil {
+			return err
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), opnet.SERVER_BUSY_REPLY_TIMEOUT)
+		defer cancel()
+		return rpc.StreamTransport(conn).SendMessage(ctx, msg)
+	}
+}
+
 func (a *RPCService) restartListener() {
 	a.logListenerRestart()
 	a.listener.Tomb = tomb.Tomb{}
@@ -328,14 +454,24 @@ func (a *RPCService) ActiveConns() int {
 			return count
 		}
 	}
-	if count > 0 {
+	if count > 0 && a.busyRetryAfter == 0 {
 		// the listener will acquire the next token immediately, and wait for a connection
 		// thus, we need to account for it
 		return count - 1
 	}
+	if count > 0 {
+		// in busy mode, the listener acquires the token after accepting the connection
+		return count
+	}
 	return 0
 }
 
+// BusyRejectedConns returns the total number of conns that were rejected because the service was at max conns.
+// Conns are only rejected in busy mode - see RPCServiceSettings.BusyRetryAfter
+func (a *RPCService) BusyRejectedConns() uint64 {
+	return atomic.LoadUint64(&a.busyRejected)
+}
+
 func (a *RPCService) RemainingConnectionCapacity() int {
 	return len(a.connSemaphore)
 }
@@ -20,6 +20,8 @@ import (
 	"fmt"
 
 	"net"
+	"os"
+	"time"
 
 	"errors"
 
@@ -27,6 +29,7 @@ import (
 	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
 	"zombiezen.com/go/capnproto2"
 	"zombiezen.com/go/capnproto2/rpc"
+	"zombiezen.com/go/capnproto2/server"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 )
@@ -38,6 +41,16 @@ func NewRPCServiceSpec(spec config.RPCServiceSpec) (*RPCServiceSpec, error) {
 		app.AppID(spec.AppId()),
 		app.ServiceID(spec.ServiceId()),
 		RPCPort(spec.Port()),
+		opnet.Network{},
+	}
+	if spec.HasNetwork() {
+		networkSpec, err := spec.Network()
+		if err != nil {
+			return nil, err
+		}
+		if serviceSpec.Network, err = newNetwork(networkSpec); err != nil {
+			return nil, err
+		}
 	}
 	if err := serviceSpec.Validate(); err != nil {
 		return nil, err
@@ -52,6 +65,47 @@ type RPCServiceSpec struct {
 	app.ServiceID
 
 	RPCPort
+	// defaults to TCP
+	Network opnet.Network
+}
+
+func newNetwork(spec config.NetworkSpec) (opnet.Network, error) {
+	network := opnet.Network{FileMode: os.FileMode(spec.FileMode())}
+	switch spec.Type() {
+	case config.NetworkSpec_Type_tcp:
+		network.Type = opnet.NETWORK_TCP
+	case config.NetworkSpec_Type_unix:
+		network.Type = opnet.NETWORK_UNIX
+	case config.NetworkSpec_Type_pipe:
+		network.Type = opnet.NETWORK_PIPE
+	default:
+		return network, app.IllegalArgumentError(fmt.Sprintf("Unsupported network type : %v", spec.Type()))
+	}
+	address, err := spec.Address()
+	if err != nil {
+		return network, err
+	}
+	network.Address = address
+	return network, network.Validate()
+}
+
+func networkToCapnp(network opnet.Network, s *capnp.Segment) (config.NetworkSpec, error) {
+	spec, err := config.NewNetworkSpec(s)
+	if err != nil {
+		return spec, err
+	}
+	switch network.Type {
+	case opnet.NETWORK_UNIX:
+		spec.SetType(config.NetworkSpec_Type_unix)
+	case opnet.NETWORK_PIPE:
+		spec.SetType(config.NetworkSpec_Type_pipe)
+	default:
+		spec.SetType(config.NetworkSpec_Type_tcp)
+	}
+	if network.FileMode != 0 {
+		spec.SetFileMode(uint32(network.FileMode))
+	}
+	return spec, spec.SetAddress(network.Address)
 }
 
 // CN returns the x509 CN - this used by client TLS to set the x509.Config.ServerName
@@ -65,7 +119,12 @@ func (a *RPCServiceSpec) CN() string {
 //		fmt.Sprintf("%x_%x", a.DomainID, a.AppID)
 //
 //		e.g. ed5cf026e8734361-d113a2e016e12f0f
+//
+// For unix and pipe networks, the network address is the socket path or the pipe name.
 func (a *RPCServiceSpec) NetworkAddr() string {
+	if a.Network.Type != opnet.NETWORK_TCP {
+		return a.Network.Address
+	}
 	return fmt.Sprintf("%x_%x", a.DomainID, a.AppID)
 }
 
@@ -78,6 +137,15 @@ func (a *RPCServiceSpec) ToCapnp(s *capnp.Segment) (config.RPCServiceSpec, error
 	spec.SetAppId(uint64(a.AppID))
 	spec.SetServiceId(uint64(a.ServiceID))
 	spec.SetPort(uint16(a.RPCPort))
+	if a.Network.Type != opnet.NETWORK_TCP {
+		network, err := networkToCapnp(a.Network, s)
+		if err != nil {
+			return spec, err
+		}
+		if err := spec.SetNetwork(network); err != nil {
+			return spec, err
+		}
+	}
 	return spec, nil
 }
 
@@ -91,10 +159,10 @@ func (a *RPCServiceSpec) Validate() error {
 	if a.ServiceID == app.ServiceID(0) {
 		return app.ErrServiceIDZero
 	}
-	if a.RPCPort == RPCPort(0) {
+	if a.Network.Type == opnet.NETWORK_TCP && a.RPCPort == RPCPort(0) {
 		return ErrRPCPortZero
 	}
-	return nil
+	return a.Network.Validate()
 }
 
 // ServerPort represents an RPC port
@@ -139,6 +207,7 @@ func NewRPCServerSpec(spec config.RPCServerSpec) (*RPCServerSpec, error) {
 		RPCServiceSpec: rpcServiceSpec,
 		ClientCAs:      x509.NewCertPool(),
 		MaxConns:       spec.MaxConns(),
+		BusyRetryAfter: time.Duration(spec.BusyRetryAfterMSec()) * time.Millisecond,
 	}
 
 	serverCert, err := spec.ServerCert()
@@ -165,6 +234,7 @@ func NewRPCServerSpec(spec config.RPCServerSpec) (*RPCServerSpec, error) {
 	if !serverSpec.ClientCAs.AppendCertsFromPEM(caCert) {
 		return nil, opnet.ErrPEMParsing
 	}
+	serverSpec.certSource = opnet.CertSource{Cert: cert, Key: key, CACert: caCert}
 
 	return serverSpec, nil
 }
@@ -175,6 +245,23 @@ type RPCServerSpec struct {
 	ClientCAs *x509.CertPool
 	Cert      tls.Certificate
 	MaxConns  uint32
+	// optional - if > 0, then when the service is at max conns, new conns are sent a server busy reply before being closed,
+	// i.e., the listener is kept open - see RPCServiceSettings.BusyRetryAfter
+	BusyRetryAfter time.Duration
+
+	// optional - if nil, then all clients are authorized
+	Authorizer opnet.Authorizer
+
+	// optional - calls that take longer than the threshold to complete are logged. If zero, then slow calls are not logged.
+	SlowCallThreshold time.Duration
+	// optional - custom interceptors are applied after the metrics and slow call interceptors, in order
+	Interceptors []RPCInterceptor
+	// optional - the main interface methods, e.g., App_Methods(nil, nil). The RPC call metrics are labeled by interface
+	// and method only for these methods - see NewRPCMetricsInterceptor()
+	Methods []server.Method
+
+	// the PEM encoded certs that the spec was created with
+	certSource opnet.CertSource
 }
 
 func (a *RPCServerSpec) ToCapnp(s *capnp.Segment) (config.RPCServerSpec, error) {
@@ -219,14 +306,109 @@ func (a *RPCServerSpec) TLSConfigProvider() func() (*tls.Config, error) {
 	}
 }
 
+// CertLoader returns a CertLoader that reads the server key pair and CA bundle from the service's RPCServerSpec config
+// file, i.e., new certs are picked up when the config file is replaced. If the service has no config file, then the
+// spec certs are used. The revocation lists are read from the config dir - see opnet.LoadRevocationFiles().
+func (a *RPCServerSpec) CertLoader() opnet.CertLoader {
+	return func() (*opnet.CertSource, error) {
+		source := a.certSource
+		msg, err := app.Configs.Config(a.ServiceID)
+		if err != nil {
+			return nil, err
+		}
+		if msg != nil {
+			spec, err := config.ReadRootRPCServerSpec(msg)
+			if err != nil {
+				return nil, err
+			}
+			if err := CheckRPCServerSpec(spec); err != nil {
+				return nil, err
+			}
+			serverCert, err := spec.ServerCert()
+			if err != nil {
+				return nil, err
+			}
+			if source.Cert, err = serverCert.Cert(); err != nil {
+				return nil, err
+			}
+			if source.Key, err = serverCert.Key(); err != nil {
+				return nil, err
+			}
+			if source.CACert, err = spec.CaCert(); err != nil {
+				return nil, err
+			}
+		}
+		if err := opnet.LoadRevocationFiles(a.ServiceID, &source); err != nil {
+			return nil, err
+		}
+		return &source, nil
+	}
+}
+
+// StartReloadableRPCService starts the RPCService using a CertProvider, i.e., new certs are picked up from the config dir
+// without restarting the service, and revoked client certs are rejected - see opnet.CertProvider
+func (a *RPCServerSpec) StartReloadableRPCService(service *app.Service, mainInterface RPCMainInterface) (*RPCService, error) {
+	certProvider, err := opnet.NewCertProvider(service, a.CertLoader())
+	if err != nil {
+		return nil, err
+	}
+	interceptors, err := a.interceptors(service)
+	if err != nil {
+		return nil, err
+	}
+	return StartRPCServiceWithSettings(service, a.settings(a.tlsConfigProvider(certProvider.TLSConfig), InterceptMainInterface(mainInterface, interceptors...)))
+}
+
 func (a *RPCServerSpec) ListenerFactory() func() (net.Listener, error) {
 	return func() (net.Listener, error) {
-		return net.Listen("tcp", fmt.Sprintf(":%d", a.RPCPort))
+		return a.Network.Listen(uint16(a.RPCPort))
 	}
 }
 
+// tlsConfigProvider returns nil for unix sockets, i.e., access is controlled via the socket file permissions
+func (a *RPCServerSpec) tlsConfigProvider(provider opnet.TLSConfigProvider) opnet.TLSConfigProvider {
+	if !a.Network.TLS() {
+		return nil
+	}
+	return provider
+}
+
 func (a *RPCServerSpec) StartRPCService(service *app.Service, mainInterface RPCMainInterface) (*RPCService, error) {
-	return StartRPCService(service, a.ListenerFactory(), a.TLSConfigProvider(), mainInterface, uint(a.MaxConns))
+	interceptors, err := a.interceptors(service)
+	if err != nil {
+		return nil, err
+	}
+	return StartRPCServiceWithSettings(service, a.settings(a.tlsConfigProvider(a.TLSConfigProvider()), InterceptMainInterface(mainInterface, interceptors...)))
+}
+
+func (a *RPCServerSpec) settings(tlsConfigProvider opnet.TLSConfigProvider, mainInterface RPCMainInterface) RPCServiceSettings {
+	return RPCServiceSettings{
+		ListenerFactory:   a.ListenerFactory(),
+		TLSConfigProvider: tlsConfigProvider,
+		MainInterface:     mainInterface,
+		MaxConns:          uint(a.MaxConns),
+		Authorizer:        a.Authorizer,
+		BusyRetryAfter:    a.BusyRetryAfter,
+	}
+}
+
+// interceptors returns the main interface interceptors in the following order:
+//	1. RPC call metrics - if the RPC call metric vectors are registered for the service
+//	2. slow call logging - if SlowCallThreshold > 0
+//	3. custom Interceptors
+func (a *RPCServerSpec) interceptors(service *app.Service) ([]RPCInterceptor, error) {
+	interceptors := []RPCInterceptor{}
+	if RPCMetricsRegistered(service.ID()) {
+		interceptor, err := NewRPCMetricsInterceptor(service.ID(), a.Methods)
+		if err != nil {
+			return nil, err
+		}
+		interceptors = append(interceptors, interceptor)
+	}
+	if a.SlowCallThreshold > 0 {
+		interceptors = append(interceptors, NewRPCSlowCallInterceptor(service, a.SlowCallThreshold))
+	}
+	return append(interceptors, a.Interceptors...), nil
 }
 
 func CheckRPCClientSpec(spec config.RPCClientSpec) error {
@@ -324,19 +506,19 @@ func (a *RPCClientSpec) Conn() (*rpc.Conn, error) {
 		Uint64("service", uint64(a.ServiceID)).
 		Str("NetworkAddr", networkAddr).
 		Msg("RPCClientSpec")
-	addr := fmt.Sprintf("%s:%d", networkAddr, a.RPCPort)
-	clientConn, err := tls.Dial("tcp", addr, a.TLSConfig())
-	if err != nil {
-		return nil, err
-	}
-	return rpc.NewConn(rpc.StreamTransport(clientConn)), nil
+	return a.ConnForAddr(networkAddr)
 }
 
 // ConnForAddr returns an RPC conn using the specified network address
 // This mainly intended for testing purposes to connect locally
+//
+// For unix and pipe networks, the network address is the socket path or the pipe name.
 func (a *RPCClientSpec) ConnForAddr(networkAddr string) (*rpc.Conn, error) {
-	addr := fmt.Sprintf("%s:%d", networkAddr, a.RPCPort)
-	clientConn, err := tls.Dial("tcp", addr, a.TLSConfig())
+	var tlsConfig *tls.Config
+	if a.Network.TLS() {
+		tlsConfig = a.TLSConfig()
+	}
+	clientConn, err := a.Network.Dial(networkAddr, uint16(a.RPCPort), tlsConfig)
 	if err != nil {
 		return nil, err
 	}
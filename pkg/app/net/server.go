@@ -29,17 +29,29 @@ import (
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 	"github.com/oysterpack/oysterpack.go/pkg/app/net/config"
 	opsync "github.com/oysterpack/oysterpack.go/pkg/app/sync"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
 // TODO: Server registry
 
+const (
+	// how often the server checks its connections, e.g., for idle connections - see ServerSpec.IdleTimeout()
+	SERVER_CONN_MONITOR_INTERVAL = 10 * time.Second
+	// how often the server checks if its connections are drained - see Server.Drain()
+	SERVER_DRAIN_POLL_INTERVAL = 50 * time.Millisecond
+)
+
 // NewTLSServer requires a tls.Config provider.
 //
 // errors :
 // 	- ServerSettings validation errors
 //	- ListenerProviderError
 //	- TLSConfigError
+//	- NewRateLimiter errors, if rate limits are enabled
+//	- NewCertProvider errors
+//	- app.ErrSpec_ConfigFailure if the SERVER_BUSY_REJECTED_COUNT_METRIC_ID counter is not registered, and the server busy
+//	  reply is enabled - see ServerSpec.BusyRetryAfter()
 func StartServer(settings ServerSettings) (*Server, error) {
 	if err := settings.Validate(); err != nil {
 		return nil, err
@@ -55,8 +67,40 @@ func StartServer(settings ServerSettings) (*Server, error) {
 		err := fmt.Errorf("Server total conn created counter metric missing : ServiceID(0x%x) : MetricID(0x%x)", settings.Service.ID(), SERVER_CONN_TOTAL_CREATED_METRIC_ID)
 		return nil, app.ConfigError(settings.ServiceID(), err, "")
 	}
+	// the conn age and idle metrics are optional
+	connAge := histogram(settings.Service.ID(), SERVER_CONN_AGE_METRIC_ID)
+	oldestConnAgeGauge := gauge(settings.Service.ID(), SERVER_CONN_OLDEST_AGE_METRIC_ID)
+	maxConnIdleTimeGauge := gauge(settings.Service.ID(), SERVER_CONN_MAX_IDLE_TIME_METRIC_ID)
+	idleConnClosedCount := counter(settings.Service.ID(), SERVER_CONN_IDLE_CLOSED_COUNT_METRIC_ID)
+	var busyRejectedCount prometheus.Counter
+	if settings.BusyRetryAfter() > 0 {
+		busyRejectedCount = app.MetricRegistry.Counter(settings.Service.ID(), SERVER_BUSY_REJECTED_COUNT_METRIC_ID)
+		if busyRejectedCount == nil {
+			err := fmt.Errorf("Server busy rejected counter metric missing : ServiceID(0x%x) : MetricID(0x%x)", settings.Service.ID(), SERVER_BUSY_REJECTED_COUNT_METRIC_ID)
+			return nil, app.ConfigError(settings.ServiceID(), err, "")
+		}
+	}
+
+	authorizer := settings.Authorizer
+	if authorizer == nil && settings.AuthzPolicy() != nil {
+		authorizer = settings.AuthzPolicy().Authorizer()
+	}
+
+	connHandler := settings.ConnHandler
+	if settings.RateLimits().Enabled() {
+		rateLimiter, err := NewRateLimiter(settings.Service, settings.RateLimits())
+		if err != nil {
+			return nil, err
+		}
+		connHandler = rateLimiter.ConnHandler(connHandler)
+	}
+
+	certProvider, err := NewCertProvider(settings.Service, settings.CertLoader())
+	if err != nil {
+		return nil, err
+	}
 
-	l, err := settings.newListener()
+	l, err := settings.newListener(certProvider)
 	if err != nil {
 		return nil, err
 	}
@@ -66,12 +110,23 @@ func StartServer(settings ServerSettings) (*Server, error) {
 		settings:              settings,
 		connSemaphore:         opsync.NewCountingSemaphore(uint(settings.maxConns)),
 		listener:              l,
+		certProvider:          certProvider,
+		authorizer:            authorizer,
+		connHandler:           connHandler,
+		conns:                 &connMap{conns: make(map[uint64]*serverConn)},
 		running:               make(chan struct{}),
 		connCount:             connCountGauge,
 		totalConnCreatedCount: totalConnCreatedCount,
+		connAge:               connAge,
+		oldestConnAge:         oldestConnAgeGauge,
+		maxConnIdleTime:       maxConnIdleTimeGauge,
+		idleConnClosedCount:   idleConnClosedCount,
+		busyRejectedCount:     busyRejectedCount,
+		busyConnRejecter:      NewBusyConnRejecter(MAX_BUSY_CONN_REJECTIONS),
 	}
 
 	server.Service.Go(server.run)
+	server.Service.Go(server.monitorConns)
 	server.Service.Go(func() error {
 		select {
 		case <-server.Service.Dying():
@@ -108,7 +163,7 @@ func NewServerSettings(service *app.Service, spec config.ServerSpec, handler Con
 	if err != nil {
 		return ServerSettings{}, err
 	}
-	return ServerSettings{service, serverSpec, handler}, nil
+	return ServerSettings{Service: service, ServerSpec: serverSpec, ConnHandler: handler}, nil
 }
 
 // ServerSettings is used to create a new Server
@@ -118,6 +173,9 @@ type ServerSettings struct {
 	*ServerSpec
 
 	ConnHandler ConnHandler
+
+	// optional - if nil, then the ServerSpec AuthzPolicy is used, if configured
+	Authorizer Authorizer
 }
 
 // Validate validates the settings
@@ -145,14 +203,18 @@ func (a *ServerSettings) Validate() error {
 	return nil
 }
 
-func (a *ServerSettings) newListener() (net.Listener, error) {
+func (a *ServerSettings) newListener(certProvider *CertProvider) (net.Listener, error) {
 	// starting for the first time
 	l, err := a.ServerSpec.ListenerProvider()()
 	if err != nil {
 		return nil, ListenerProviderError(a.ServiceID(), err)
 	}
+	if !a.Network().TLS() {
+		// unix socket access is controlled via file permissions
+		return l, nil
+	}
 
-	tlsConfig, err := a.TLSConfigProvider()()
+	tlsConfig, err := certProvider.TLSConfig()
 	if err != nil {
 		return nil, TLSConfigError(a.ServiceID(), err)
 	}
@@ -163,7 +225,11 @@ func (a *ServerSettings) newListener() (net.Listener, error) {
 //
 // Design:
 //	- every server maps to a Service, i.e., the server lifecycle aligns with the service lifecycle
-//	-
+//	- connections that are idle for longer than the spec IdleTimeout are closed
+//	- the server can be stopped gracefully - see Drain() and Shutdown()
+//	- the server certs are reloaded without restarting the server - see CertProvider
+//	- the client identity is added to the ConnHandler Context, along with the Authorizer - see PeerIdentityFromContext()
+//	  and Authorize()
 type Server struct {
 	settings ServerSettings
 
@@ -173,8 +239,17 @@ type Server struct {
 	listenerMutex sync.Mutex
 	listener      net.Listener
 	tlsConfig     *tls.Config
+	certProvider  *CertProvider
+	// once the server is draining, the listener is no longer restarted
+	draining bool
 
 	connSeq opsync.Sequence
+	conns   *connMap
+
+	// the settings ConnHandler, which is wrapped by the RateLimiter if rate limits are enabled
+	connHandler ConnHandler
+	// nil if all clients are authorized
+	authorizer Authorizer
 
 	// signal
 	running chan struct{}
@@ -182,6 +257,13 @@ type Server struct {
 	// metrics
 	connCount             prometheus.Gauge
 	totalConnCreatedCount prometheus.Counter
+	connAge               prometheus.Histogram
+	oldestConnAge         prometheus.Gauge
+	maxConnIdleTime       prometheus.Gauge
+	idleConnClosedCount   prometheus.Counter
+	// only used when the server busy reply is enabled - see ServerSpec.BusyRetryAfter()
+	busyRejectedCount prometheus.Counter
+	busyConnRejecter  *BusyConnRejecter
 }
 
 // Running is used to signal when the server is running.
@@ -203,8 +285,11 @@ func (a *Server) closeListener() {
 func (a *Server) getListener() (l net.Listener, err error) {
 	a.listenerMutex.Lock()
 	defer a.listenerMutex.Unlock()
+	if a.draining {
+		return nil, ListenerDownError(a.Service.ID())
+	}
 	if a.listener == nil {
-		a.listener, err = a.settings.newListener()
+		a.listener, err = a.settings.newListener(a.certProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -214,7 +299,7 @@ func (a *Server) getListener() (l net.Listener, err error) {
 }
 
 func (a *Server) run() (err error) {
-	conns := connMap{conns: make(map[uint64]net.Conn)}
+	conns := a.conns
 
 	defer func() {
 		a.closeListener()
@@ -235,64 +320,234 @@ func (a *Server) run() (err error) {
 		Msg("listener started")
 
 	close(a.running)
+	busyRetryAfter := a.settings.BusyRetryAfter()
 	for {
-		select {
-		case <-a.Service.Dying():
-			return nil
-		case <-a.connSemaphore.C:
-			l, err := a.getListener()
-			if err != nil {
-				if !a.Service.Alive() || !app.Alive() {
-					// the error can be ignored because it means the server is being killed
-					return nil
-				}
-				return err
+		if busyRetryAfter == 0 {
+			select {
+			case <-a.Service.Dying():
+				return nil
+			case <-a.connSemaphore.C:
 			}
-
-			conn, err := l.Accept()
-			if err != nil {
-				if !a.Service.Alive() || !app.Alive() {
-					// the error can be ignored because it means the server is being killed
-					return nil
-				}
-				return err
+		}
+		l, err := a.getListener()
+		if err != nil {
+			if !a.Service.Alive() || !app.Alive() {
+				// the error can be ignored because it means the server is being killed
+				return nil
 			}
-			a.connCount.Inc()
-			a.totalConnCreatedCount.Inc()
-			if err := a.settings.ConfigureConnBuffers(conn); err != nil {
-				// should never happen
-				a.Logger().Warn().Err(err).Msg("Failed to configure conn buffers")
+			if a.isDraining() {
+				a.awaitDeath()
+				return nil
 			}
+			return err
+		}
 
-			if tcpConn, ok := conn.(*net.TCPConn); ok {
-				tcpConn.SetKeepAlive(true)
-				tcpConn.SetKeepAlivePeriod(time.Second * time.Duration(a.settings.keepAlivePeriodSecs))
+		conn, err := l.Accept()
+		if err != nil {
+			if !a.Service.Alive() || !app.Alive() {
+				// the error can be ignored because it means the server is being killed
+				return nil
+			}
+			if a.isDraining() {
+				a.awaitDeath()
+				return nil
 			}
+			return err
+		}
+		if busyRetryAfter > 0 {
+			// the listener is kept open - conns that exceed max conns are rejected with a ServerBusy reply
+			select {
+			case <-a.connSemaphore.C:
+			default:
+				a.rejectBusyConn(conn, busyRetryAfter)
+				continue
+			}
+		}
+		a.connCount.Inc()
+		a.totalConnCreatedCount.Inc()
+		if err := a.settings.ConfigureConnBuffers(conn); err != nil {
+			// should never happen
+			a.Logger().Warn().Err(err).Msg("Failed to configure conn buffers")
+		}
+
+		if tcpConn, ok := conn.(*net.TCPConn); ok {
+			tcpConn.SetKeepAlive(true)
+			tcpConn.SetKeepAlivePeriod(time.Second * time.Duration(a.settings.keepAlivePeriodSecs))
+		}
 
-			go func() {
-				connKey := a.connSeq.Next()
-				conns.put(connKey, conn)
-				SERVER_NEW_CONN.Log(a.Logger().Debug()).Int("conns", a.ConnectionCount()).Msg("new conn")
-				defer func() {
-					a.connSemaphore.ReturnToken()
-					conns.close(connKey)
-					a.connCount.Dec()
-					SERVER_CONN_CLOSED.Log(a.Logger().Debug()).Msg("conn closed")
-				}()
-				ctx := context.WithValue(context.Background(), CTX_SERVER_SPEC, a.settings.ServerSpec)
-				ctx = context.WithValue(ctx, CTX_SERVICE, a.Service)
-				a.settings.ConnHandler(ctx, conn)
+		go func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			conn := newServerConn(conn, cancel)
+			connKey := a.connSeq.Next()
+			conns.put(connKey, conn)
+			SERVER_NEW_CONN.Log(a.Logger().Debug()).Int("conns", a.ConnectionCount()).Msg("new conn")
+			defer func() {
+				a.connSemaphore.ReturnToken()
+				conns.close(connKey)
+				a.connCount.Dec()
+				a.connAge.Observe(conn.age(time.Now()).Seconds())
+				SERVER_CONN_CLOSED.Log(a.Logger().Debug()).Msg("conn closed")
 			}()
+			ctx = context.WithValue(ctx, CTX_SERVER_SPEC, a.settings.ServerSpec)
+			ctx = context.WithValue(ctx, CTX_SERVICE, a.Service)
+			ctx = context.WithValue(ctx, ctx_server_conn{}, conn)
+			identity, err := ConnPeerIdentity(conn)
+			if err != nil {
+				SERVER_TLS_HANDSHAKE_FAILED.Log(a.Logger().Warn()).Err(err).Str("remote_addr", conn.RemoteAddr().String()).Msg("TLS handshake failed")
+				conn.Close()
+				return
+			}
+			if identity != nil {
+				ctx = WithPeerIdentity(ctx, identity)
+			}
+			if a.authorizer != nil {
+				ctx = WithAuthorizer(ctx, a.authorizer)
+			}
+			ctx, span := trace.StartSpan(ctx, "conn", trace.SpanKind_SERVER)
+			span.SetAttribute("remote_addr", conn.RemoteAddr().String())
+			if identity != nil {
+				span.SetAttribute("peer_cn", identity.CN)
+			}
+			defer span.Finish(nil)
+			a.connHandler(ctx, conn)
+		}()
+
+		if busyRetryAfter == 0 && a.connSemaphore.AvailableTokens() == 0 && !a.isDraining() {
+			// no longer accept connections - we want clients to fail fast and not hang waiting to be served
+			a.closeListener()
+			SERVER_MAX_CONNS_REACHED.Log(a.Service.Logger().Warn()).Msg("Listener has been closed until connections free up.")
+		}
+	}
+}
+
+// rejectBusyConn sends the ServerBusy reply to the client asynchronously, and closes the conn. If too many replies are
+// in flight, then the conn is closed without a reply - see MAX_BUSY_CONN_REJECTIONS
+func (a *Server) rejectBusyConn(conn net.Conn, retryAfter time.Duration) {
+	a.busyRejectedCount.Inc()
+	remoteAddr := conn.RemoteAddr().String()
+	replying := a.busyConnRejecter.Reject(conn, replyServerBusy(retryAfter), func(err error) {
+		event := SERVER_BUSY_REJECTED.Log(a.Logger().Debug()).Str("remote_addr", remoteAddr).Dur("retry_after", retryAfter)
+		if err != nil {
+			event.Err(err)
+		}
+		event.Msg("server busy - conn rejected")
+	})
+	if !replying {
+		SERVER_BUSY_REJECTED.Log(a.Logger().Debug()).Str("remote_addr", remoteAddr).Msg("server busy - conn closed without a reply")
+	}
+}
+
+func (a *Server) isDraining() bool {
+	a.listenerMutex.Lock()
+	defer a.listenerMutex.Unlock()
+	return a.draining
+}
+
+// awaitDeath blocks until the server service or the app is killed
+func (a *Server) awaitDeath() {
+	select {
+	case <-a.Service.Dying():
+	case <-app.Dying():
+	}
+}
+
+// monitorConns periodically closes idle connections, and records the connection age and idle time metrics.
+func (a *Server) monitorConns() error {
+	ticker := time.NewTicker(a.connMonitorInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-a.Service.Dying():
+			return nil
+		case <-app.Dying():
+			return nil
+		case now := <-ticker.C:
+			a.checkConns(now)
+		}
+	}
+}
+
+// the monitor runs often enough to close idle connections within 1.5x the idle timeout
+func (a *Server) connMonitorInterval() time.Duration {
+	if idleTimeout := a.settings.IdleTimeout(); idleTimeout > 0 && idleTimeout/2 < SERVER_CONN_MONITOR_INTERVAL {
+		return idleTimeout / 2
+	}
+	return SERVER_CONN_MONITOR_INTERVAL
+}
+
+func (a *Server) checkConns(now time.Time) {
+	idleTimeout := a.settings.IdleTimeout()
+	var oldestAge, maxIdleTime time.Duration
+	for key, conn := range a.conns.snapshot() {
+		idleTime := conn.idleTime(now)
+		if idleTimeout > 0 && idleTime >= idleTimeout {
+			a.conns.close(key)
+			a.idleConnClosedCount.Inc()
+			SERVER_CONN_IDLE_CLOSED.Log(a.Logger().Debug()).Dur("idle", idleTime).Msg("idle conn closed")
+			continue
+		}
+		if age := conn.age(now); age > oldestAge {
+			oldestAge = age
+		}
+		if idleTime > maxIdleTime {
+			maxIdleTime = idleTime
+		}
+	}
+	a.oldestConnAge.Set(oldestAge.Seconds())
+	a.maxConnIdleTime.Set(maxIdleTime.Seconds())
+}
 
-			if a.connSemaphore.AvailableTokens() == 0 {
-				// no longer accept connections - we want clients to fail fast and not hang waiting to be served
-				a.closeListener()
-				SERVER_MAX_CONNS_REACHED.Log(a.Service.Logger().Warn()).Msg("Listener has been closed until connections free up.")
+// Drain gracefully drains the server connections:
+//	1. the listener is closed, i.e., new connections are no longer accepted
+//	2. each client is sent a GoAway message, which tells the client to stop sending requests on the connection
+//	3. each connection is closed once its requests that are in flight are done
+//	4. connections that are still open when the timeout expires are closed
+//
+// Drain blocks until all connections are closed. Once drained, the server no longer accepts connections, i.e., the
+// server service should be killed - see Shutdown().
+func (a *Server) Drain(timeout time.Duration) {
+	a.listenerMutex.Lock()
+	a.draining = true
+	a.listenerMutex.Unlock()
+	a.closeListener()
+
+	deadline := time.Now().Add(timeout)
+	SERVER_DRAINING.Log(a.Logger().Info()).Int("conns", a.conns.size()).Dur("timeout", timeout).Msg("draining connections")
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	ticker := time.NewTicker(SERVER_DRAIN_POLL_INTERVAL)
+	defer ticker.Stop()
+	for {
+		// conns that were being accepted while the listener was closed are picked up on the next iteration
+		for _, conn := range a.conns.snapshot() {
+			conn.signalGoAway(deadline)
+			if conn.drained() {
+				conn.signalClosing()
 			}
 		}
+		if a.conns.size() == 0 {
+			SERVER_DRAINED.Log(a.Logger().Info()).Msg("all connections are drained")
+			return
+		}
+		select {
+		case <-a.Service.Dying():
+			return
+		case <-timer.C:
+			SERVER_DRAINED.Log(a.Logger().Warn()).Int("conns", a.conns.size()).Msg("drain timed out - closing connections")
+			a.conns.closeAll()
+			return
+		case <-ticker.C:
+		}
 	}
 }
 
+// Shutdown drains the server using the spec DrainTimeout, and then kills the server service.
+func (a *Server) Shutdown() {
+	a.Drain(a.settings.DrainTimeout())
+	a.Service.Kill(nil)
+}
+
 func (a *Server) newContext() context.Context {
 	ctx := context.WithValue(context.Background(), CTX_SERVER_SPEC, a.settings.ServerSpec)
 	ctx = context.WithValue(ctx, CTX_SERVICE, a.Service)
@@ -316,24 +571,29 @@ func (a *Server) ConnectionCount() int {
 	return a.connSemaphore.TotalTokens() - a.connSemaphore.AvailableTokens()
 }
 
+// CertProvider provides the server certs - it can be used to reload the certs on demand
+func (a *Server) CertProvider() *CertProvider {
+	return a.certProvider
+}
+
 type connMap struct {
 	sync.Mutex
-	conns map[uint64]net.Conn
+	conns map[uint64]*serverConn
 }
 
-func (a connMap) put(key uint64, conn net.Conn) {
+func (a *connMap) put(key uint64, conn *serverConn) {
 	a.Lock()
 	defer a.Unlock()
 	a.conns[key] = conn
 }
 
-func (a connMap) delete(key uint64) {
+func (a *connMap) delete(key uint64) {
 	a.Lock()
 	defer a.Unlock()
 	delete(a.conns, key)
 }
 
-func (a connMap) close(key uint64) {
+func (a *connMap) close(key uint64) {
 	a.Lock()
 	defer a.Unlock()
 	conn := a.conns[key]
@@ -343,11 +603,27 @@ func (a connMap) close(key uint64) {
 	}
 }
 
-func (a connMap) closeAll() {
+func (a *connMap) closeAll() {
 	a.Lock()
 	defer a.Unlock()
 	for _, conn := range a.conns {
 		conn.Close()
 	}
-	a.conns = make(map[uint64]net.Conn)
+	a.conns = make(map[uint64]*serverConn)
+}
+
+func (a *connMap) size() int {
+	a.Lock()
+	defer a.Unlock()
+	return len(a.conns)
+}
+
+func (a *connMap) snapshot() map[uint64]*serverConn {
+	a.Lock()
+	defer a.Unlock()
+	conns := make(map[uint64]*serverConn, len(a.conns))
+	for key, conn := range a.conns {
+		conns[key] = conn
+	}
+	return conns
 }
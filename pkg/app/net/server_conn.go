@@ -0,0 +1,230 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
+)
+
+type ctx_server_conn command.ContextKey
+
+// serverConn wraps the server connection to track its activity, which is used to reap idle connections and to drain
+// connections gracefully. The serverConn is made available to the ConnHandler via the Context.
+//
+// Connection handlers that support draining:
+//	- send a GoAway message to the client when ConnGoAway() is signalled - see sendGoAway()
+//	- track requests that are in flight via requestStarted()
+//	- close the conn from the goroutine that writes to the conn when connClosing() is signalled, i.e., once the conn is
+//	  drained. This ensures that responses that were handed off to the writer are sent before the conn is closed.
+//
+// Connections whose handlers do not support draining are closed when the drain timeout expires.
+type serverConn struct {
+	net.Conn
+	// cancels the ConnHandler Context
+	cancel context.CancelFunc
+
+	created time.Time
+	// Unix time in nanoseconds when data was last read or written
+	lastActive int64
+	// the number of requests that are in flight
+	inFlight int64
+
+	goAwayOnce     sync.Once
+	goAway         chan struct{}
+	goAwayDeadline time.Time
+	// set to 1 once the GoAway message has been sent to the client
+	goAwaySent int32
+
+	closingOnce sync.Once
+	closing     chan struct{}
+}
+
+func newServerConn(conn net.Conn, cancel context.CancelFunc) *serverConn {
+	now := time.Now()
+	return &serverConn{
+		Conn:       conn,
+		cancel:     cancel,
+		created:    now,
+		lastActive: now.UnixNano(),
+		goAway:     make(chan struct{}),
+		closing:    make(chan struct{}),
+	}
+}
+
+func (a *serverConn) Read(b []byte) (int, error) {
+	n, err := a.Conn.Read(b)
+	if n > 0 {
+		atomic.StoreInt64(&a.lastActive, time.Now().UnixNano())
+	}
+	return n, err
+}
+
+func (a *serverConn) Write(b []byte) (int, error) {
+	n, err := a.Conn.Write(b)
+	if n > 0 {
+		atomic.StoreInt64(&a.lastActive, time.Now().UnixNano())
+	}
+	return n, err
+}
+
+// Close cancels the ConnHandler Context before closing the conn, i.e., the ConnHandler is able to tell that the conn was
+// closed on purpose.
+func (a *serverConn) Close() error {
+	a.cancel()
+	return a.Conn.Close()
+}
+
+// ConnectionState returns the TLS connection state, if the underlying connection is a TLS connection.
+func (a *serverConn) ConnectionState() tls.ConnectionState {
+	if tlsConn, ok := a.Conn.(*tls.Conn); ok {
+		return tlsConn.ConnectionState()
+	}
+	return tls.ConnectionState{}
+}
+
+// Handshake runs the TLS handshake, if the underlying connection is a TLS connection.
+func (a *serverConn) Handshake() error {
+	if tlsConn, ok := a.Conn.(*tls.Conn); ok {
+		return tlsConn.Handshake()
+	}
+	return nil
+}
+
+func (a *serverConn) age(now time.Time) time.Duration {
+	return now.Sub(a.created)
+}
+
+// idleTime returns how long the connection has been idle. The connection is not idle while requests are in flight.
+func (a *serverConn) idleTime(now time.Time) time.Duration {
+	if atomic.LoadInt64(&a.inFlight) > 0 {
+		return 0
+	}
+	return now.Sub(time.Unix(0, atomic.LoadInt64(&a.lastActive)))
+}
+
+// drained returns true once the client has been told to go away, and there are no requests in flight
+func (a *serverConn) drained() bool {
+	return atomic.LoadInt32(&a.goAwaySent) == 1 && atomic.LoadInt64(&a.inFlight) == 0
+}
+
+// signalGoAway signals the ConnHandler to send the GoAway message to the client
+func (a *serverConn) signalGoAway(deadline time.Time) {
+	a.goAwayOnce.Do(func() {
+		a.goAwayDeadline = deadline
+		close(a.goAway)
+	})
+}
+
+// signalClosing signals the ConnHandler to close the conn
+func (a *serverConn) signalClosing() {
+	a.closingOnce.Do(func() {
+		close(a.closing)
+	})
+}
+
+func serverConnFromContext(ctx context.Context) *serverConn {
+	conn, _ := ctx.Value(ctx_server_conn{}).(*serverConn)
+	return conn
+}
+
+// ConnGoAway returns a channel that is closed when the server is draining, i.e., when the ConnHandler should send a
+// GoAway message to the client - see NewGoAwayMessage().
+// If the conn is not managed by a Server, then a nil channel is returned, i.e., it is never signalled.
+func ConnGoAway(ctx context.Context) <-chan struct{} {
+	if conn := serverConnFromContext(ctx); conn != nil {
+		return conn.goAway
+	}
+	return nil
+}
+
+// NewGoAwayMessage returns a GoAway message, which tells the client to stop sending requests on the connection.
+// The server closes the connection by the deadline.
+func NewGoAwayMessage(deadline time.Time) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	goAway, err := message.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
+	goAway.SetId(uid.NextUIDHash().UInt64())
+	goAway.SetType(MessageType_GOAWAY.UInt64())
+	goAway.SetTimestamp(time.Now().UnixNano())
+
+	data, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	goAwayData, err := message.NewRootGoAway(seg)
+	if err != nil {
+		return nil, err
+	}
+	goAwayData.SetDeadline(deadline.UnixNano())
+	if err := message.SetData(&goAway, data); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// connClosing returns a channel that is closed when the conn has been drained, i.e., when the ConnHandler should close
+// the conn. If the conn is not managed by a Server, then a nil channel is returned.
+func connClosing(ctx context.Context) <-chan struct{} {
+	if conn := serverConnFromContext(ctx); conn != nil {
+		return conn.closing
+	}
+	return nil
+}
+
+// sendGoAway sends the GoAway message to the client, and records that the client was told to go away.
+// It must only be called from the goroutine that writes to the conn.
+func sendGoAway(ctx context.Context, encoder *capnp.Encoder) error {
+	conn := serverConnFromContext(ctx)
+	msg, err := NewGoAwayMessage(conn.goAwayDeadline)
+	if err != nil {
+		return err
+	}
+	if err := encoder.Encode(msg); err != nil {
+		return err
+	}
+	atomic.StoreInt32(&conn.goAwaySent, 1)
+	return nil
+}
+
+// requestStarted is used by the ConnHandler to track requests that are in flight. The returned func must be called when
+// the request is done - it is safe to call more than once.
+func requestStarted(ctx context.Context) func() {
+	conn := serverConnFromContext(ctx)
+	if conn == nil {
+		return func() {}
+	}
+	atomic.AddInt64(&conn.inFlight, 1)
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			atomic.AddInt64(&conn.inFlight, -1)
+		})
+	}
+}
@@ -29,6 +29,16 @@ func NewServerServiceSpec(spec config.ServiceSpec) (*ServerServiceSpec, error) {
 		app.AppID(spec.AppId()),
 		app.ServiceID(spec.ServiceId()),
 		ServerPort(spec.Port()),
+		Network{},
+	}
+	if spec.HasNetwork() {
+		networkSpec, err := spec.Network()
+		if err != nil {
+			return nil, err
+		}
+		if serviceSpec.network, err = NewNetwork(networkSpec); err != nil {
+			return nil, err
+		}
 	}
 	if err := serviceSpec.Validate(); err != nil {
 		return nil, err
@@ -43,6 +53,7 @@ type ServerServiceSpec struct {
 	serviceID app.ServiceID
 
 	serverPort ServerPort
+	network    Network
 }
 
 func (a *ServerServiceSpec) DomainID() app.DomainID {
@@ -61,6 +72,11 @@ func (a *ServerServiceSpec) ServerPort() ServerPort {
 	return a.serverPort
 }
 
+// Network defaults to TCP
+func (a *ServerServiceSpec) Network() Network {
+	return a.network
+}
+
 // CN returns the x509 CN - this used by client TLS to set the x509.Config.ServerName
 func (a *ServerServiceSpec) CN() string {
 	return ServerCN(a.domainID, a.appID, a.serviceID)
@@ -72,7 +88,12 @@ func (a *ServerServiceSpec) CN() string {
 //		fmt.Sprintf("%x_%x", a.DomainID, a.AppID)
 //
 //		e.g. ed5cf026e8734361-d113a2e016e12f0f
+//
+// For unix and pipe networks, the network address is the socket path or the pipe name.
 func (a *ServerServiceSpec) NetworkAddr() string {
+	if a.network.Type != NETWORK_TCP {
+		return a.network.Address
+	}
 	return fmt.Sprintf("%x_%x", a.domainID, a.appID)
 }
 
@@ -85,6 +106,15 @@ func (a *ServerServiceSpec) ToCapnp(s *capnp.Segment) (config.ServiceSpec, error
 	spec.SetAppId(uint64(a.appID))
 	spec.SetServiceId(uint64(a.serviceID))
 	spec.SetPort(uint16(a.serverPort))
+	if a.network.Type != NETWORK_TCP {
+		network, err := a.network.ToCapnp(s)
+		if err != nil {
+			return spec, err
+		}
+		if err := spec.SetNetwork(network); err != nil {
+			return spec, err
+		}
+	}
 	return spec, nil
 }
 
@@ -98,10 +128,10 @@ func (a *ServerServiceSpec) Validate() error {
 	if a.serviceID == app.ServiceID(0) {
 		return app.IllegalArgumentError("ServiceID cannot be 0")
 	}
-	if a.serverPort == ServerPort(0) {
+	if a.network.Type == NETWORK_TCP && a.serverPort == ServerPort(0) {
 		return app.IllegalArgumentError("Server port cannot be 0")
 	}
-	return nil
+	return a.network.Validate()
 }
 
 // ServerPort represents a server network port
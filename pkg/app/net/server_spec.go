@@ -17,7 +17,6 @@ package net
 import (
 	"crypto/tls"
 	"crypto/x509"
-	"fmt"
 
 	"net"
 
@@ -49,6 +48,29 @@ func NewServerSpec(spec config.ServerSpec) (*ServerSpec, error) {
 		clientCAs:           x509.NewCertPool(),
 		maxConns:            spec.MaxConns(),
 		keepAlivePeriodSecs: spec.KeepAlivePeriodSecs(),
+		idleTimeout:         time.Duration(spec.IdleTimeoutSecs()) * time.Second,
+		drainTimeout:        time.Duration(spec.DrainTimeoutSecs()) * time.Second,
+		busyRetryAfter:      time.Duration(spec.BusyRetryAfterMSec()) * time.Millisecond,
+	}
+
+	if spec.HasRateLimits() {
+		rateLimits, err := spec.RateLimits()
+		if err != nil {
+			return nil, err
+		}
+		if serverSpec.rateLimits, err = NewRateLimitSpec(rateLimits); err != nil {
+			return nil, err
+		}
+	}
+
+	if spec.HasAuthzPolicy() {
+		authzPolicy, err := spec.AuthzPolicy()
+		if err != nil {
+			return nil, err
+		}
+		if serverSpec.authzPolicy, err = NewAuthzPolicy(authzPolicy); err != nil {
+			return nil, err
+		}
 	}
 
 	serverCert, err := spec.ServerCert()
@@ -75,6 +97,7 @@ func NewServerSpec(spec config.ServerSpec) (*ServerSpec, error) {
 	if !serverSpec.clientCAs.AppendCertsFromPEM(caCert) {
 		return nil, app.ConfigError(serverSpec.ServiceID(), errors.New("Failed to parse PEM encoded cert(s)"), "")
 	}
+	serverSpec.certSource = CertSource{Cert: cert, Key: key, CACert: caCert}
 
 	return serverSpec, nil
 }
@@ -117,6 +140,9 @@ type ServerSpec struct {
 	maxConns            uint32
 	keepAlivePeriodSecs uint8
 
+	// the PEM encoded certs that the spec was created with
+	certSource CertSource
+
 	// If > 0, sets the size of the operating system's receive buffer associated with the connection.
 	readBufferSize uint
 	// If > 0, sets the size of the operating system's transmit buffer associated with the connection.
@@ -135,6 +161,20 @@ type ServerSpec struct {
 	//
 	// if > 0, then used to set the connection write deadline
 	writeTimeout time.Duration
+
+	// inbound message rate limits
+	rateLimits RateLimitSpec
+
+	// if > 0, then connections that are idle for longer are closed
+	idleTimeout time.Duration
+	// how long to wait for in flight requests to complete when the server is drained
+	drainTimeout time.Duration
+	// if > 0, then the listener is kept open when the server is at max conns, and new conns are rejected with a
+	// ServerBusy reply
+	busyRetryAfter time.Duration
+
+	// if nil, then all clients are authorized
+	authzPolicy *AuthzPolicy
 }
 
 func (a *ServerSpec) ClientCAs() *x509.CertPool {
@@ -169,6 +209,29 @@ func (a *ServerSpec) WriteTimeout() time.Duration {
 	return a.writeTimeout
 }
 
+func (a *ServerSpec) RateLimits() RateLimitSpec {
+	return a.rateLimits
+}
+
+func (a *ServerSpec) IdleTimeout() time.Duration {
+	return a.idleTimeout
+}
+
+func (a *ServerSpec) DrainTimeout() time.Duration {
+	return a.drainTimeout
+}
+
+// BusyRetryAfter is the retry hint that is sent to clients that are rejected because the server is at max conns.
+// If 0, then the listener is closed until connections free up, i.e., clients get connection refused.
+func (a *ServerSpec) BusyRetryAfter() time.Duration {
+	return a.busyRetryAfter
+}
+
+// AuthzPolicy returns nil if no policy is configured
+func (a *ServerSpec) AuthzPolicy() *AuthzPolicy {
+	return a.authzPolicy
+}
+
 // ConfigureConnBuffers configures the conn read and write buffer sizes.
 func (a *ServerSpec) ConfigureConnBuffers(conn net.Conn) error {
 	if a.readBufferSize == 0 && a.writeBufferSize == 0 {
@@ -221,12 +284,34 @@ func (a *ServerSpec) ToCapnp(s *capnp.Segment) (config.ServerSpec, error) {
 	serverSpec.SetServiceSpec(serviceSpec)
 
 	serverSpec.SetMaxConns(a.maxConns)
+	serverSpec.SetIdleTimeoutSecs(uint32(a.idleTimeout / time.Second))
+	serverSpec.SetDrainTimeoutSecs(uint32(a.drainTimeout / time.Second))
+	serverSpec.SetBusyRetryAfterMSec(uint32(a.busyRetryAfter / time.Millisecond))
 	// TODO: set server Cert and client CA Cert
 
+	rateLimits, err := a.rateLimits.ToCapnp(s)
+	if err != nil {
+		return serverSpec, err
+	}
+	serverSpec.SetRateLimits(rateLimits)
+
+	if a.authzPolicy != nil {
+		authzPolicy, err := a.authzPolicy.ToCapnp(s)
+		if err != nil {
+			return serverSpec, err
+		}
+		serverSpec.SetAuthzPolicy(authzPolicy)
+	}
+
 	return serverSpec, nil
 }
 
+// TLSConfig returns a static TLS config for the spec certs - see CertProvider for a TLS config that picks up new certs
 func (a *ServerSpec) TLSConfig() *tls.Config {
+	return newServerTLSConfig(a.clientCAs, a.cert)
+}
+
+func newServerTLSConfig(clientCAs *x509.CertPool, cert tls.Certificate) *tls.Config {
 	// Caveat, all these recommended settings apply only to the amd64 architecture, for which fast, constant time
 	// implementations of the crypto primitives (AES-GCM, ChaCha20-Poly1305, P256) are available.
 
@@ -237,9 +322,9 @@ func (a *ServerSpec) TLSConfig() *tls.Config {
 		ClientAuth: tls.RequireAndVerifyClientCert,
 
 		// Ensure that we only use our "CA" to validate certificates
-		ClientCAs: a.clientCAs,
+		ClientCAs: clientCAs,
 		// Server cert
-		Certificates: []tls.Certificate{a.cert},
+		Certificates: []tls.Certificate{cert},
 
 		PreferServerCipherSuites: true,
 
@@ -275,8 +360,47 @@ func (a *ServerSpec) TLSConfigProvider() func() (*tls.Config, error) {
 	}
 }
 
+// CertLoader returns a CertLoader that reads the server key pair and CA bundle from the service's ServerSpec config file,
+// i.e., new certs are picked up when the config file is replaced. If the service has no config file, then the spec
+// certs are used. The revocation lists are read from the config dir - see LoadRevocationFiles().
+func (a *ServerSpec) CertLoader() CertLoader {
+	return func() (*CertSource, error) {
+		source := a.certSource
+		msg, err := app.Configs.Config(a.ServiceID())
+		if err != nil {
+			return nil, err
+		}
+		if msg != nil {
+			spec, err := config.ReadRootServerSpec(msg)
+			if err != nil {
+				return nil, err
+			}
+			if err := CheckServerSpec(spec); err != nil {
+				return nil, err
+			}
+			serverCert, err := spec.ServerCert()
+			if err != nil {
+				return nil, err
+			}
+			if source.Cert, err = serverCert.Cert(); err != nil {
+				return nil, err
+			}
+			if source.Key, err = serverCert.Key(); err != nil {
+				return nil, err
+			}
+			if source.CACert, err = spec.CaCert(); err != nil {
+				return nil, err
+			}
+		}
+		if err := LoadRevocationFiles(a.ServiceID(), &source); err != nil {
+			return nil, err
+		}
+		return &source, nil
+	}
+}
+
 func (a *ServerSpec) ListenerProvider() func() (net.Listener, error) {
 	return func() (net.Listener, error) {
-		return net.Listen("tcp", fmt.Sprintf(":%d", a.serverPort))
+		return a.network.Listen(uint16(a.serverPort))
 	}
 }
@@ -0,0 +1,519 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/command"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+	"zombiezen.com/go/capnproto2"
+)
+
+// DEFAULT_STREAM_WINDOW is the default number of messages that a stream peer may send before it needs to be granted more credits
+const DEFAULT_STREAM_WINDOW = 16
+
+// StreamHandler processes a message stream. The first message returned by Stream.Recv() is the message that opened the stream.
+// The handler may send any number of messages back on the stream.
+//
+// When the handler returns, the stream is closed, i.e., if the handler did not send a final message, then a final stream
+// control message is sent. If an error is returned, then an error response is sent as the final message - see NewErrorResponse().
+type StreamHandler func(ctx context.Context, stream *Stream) *app.Error
+
+// StreamRoute maps a message type to a stream handler
+type StreamRoute struct {
+	MessageType
+	Handler StreamHandler
+}
+
+type ctx_stream command.ContextKey
+
+// StreamFromContext returns the stream that the request message was received on, or nil if the request was not streamed.
+// It is used by pipeline commands to send and receive messages on the stream - see NewMessagePipelineConnHandler().
+func StreamFromContext(ctx context.Context) *Stream {
+	stream, ok := ctx.Value(ctx_stream{}).(*Stream)
+	if ok {
+		return stream
+	}
+	return nil
+}
+
+func withStream(ctx context.Context, stream *Stream) context.Context {
+	return context.WithValue(ctx, ctx_stream{}, stream)
+}
+
+// Stream is one side of a bi-directional message stream. All messages on the stream share the same correlation id, i.e.,
+// the stream id. Each message carries a stream header - see message.Message_Stream :
+//	- messages are sequenced per sender, starting at 1
+//	- the final flag marks the last message from the sender
+//	- flow control is credit based, i.e., each message that is sent consumes a credit, and credits are granted by the peer
+//	  as it consumes the messages it received. Credits are piggybacked on the next message or sent in a stream control
+//	  message, i.e., a MessageType_STREAM_CONTROL message that carries no data.
+//
+// The peer that opens the stream grants the other side its initial credits on the opening message. The opening message
+// does not consume a credit. The other side grants its initial credits via a stream control message.
+//
+// Receiving is not concurrency safe, i.e., Recv() should only be called by a single goroutine. Send() is concurrency safe.
+type Stream struct {
+	id          uint64
+	serviceID   app.ServiceID
+	compression message.Message_Compression
+	packed      bool
+	// if set, then the policy decides how the message data is compressed and packed
+	compressionPolicy *message.CompressionPolicy
+	window            uint32
+	// true if the peer opened the stream
+	peerOpened bool
+	write      func(msg *capnp.Message) error
+
+	// capacity is the window + 1 for the message that opened the stream, or for a final error response
+	received chan *message.Message
+
+	// serializes sends, i.e., messages are written in sequence order
+	sendMutex sync.Mutex
+
+	mutex sync.Mutex
+	// credits granted by the peer
+	sendCredits uint32
+	sendSeq     uint32
+	sendFinal   bool
+	// credits to grant to the peer on the next message that is sent
+	grant     uint32
+	recvSeq   uint32
+	recvFinal bool
+	// number of received messages that were consumed since credits were last granted
+	consumed uint32
+	err      error
+	// signalled when the peer grants credits
+	credited chan struct{}
+	// closed when the stream fails
+	done chan struct{}
+}
+
+func newStream(id uint64, serviceID app.ServiceID, window uint32, write func(*capnp.Message) error) *Stream {
+	if window == 0 {
+		window = DEFAULT_STREAM_WINDOW
+	}
+	return &Stream{
+		id:        id,
+		serviceID: serviceID,
+		window:    window,
+		write:     write,
+		received:  make(chan *message.Message, window+1),
+		credited:  make(chan struct{}, 1),
+		done:      make(chan struct{}),
+	}
+}
+
+// ID returns the stream id, which is the correlation id for all messages on the stream
+func (a *Stream) ID() uint64 {
+	return a.id
+}
+
+// Err returns the error that failed the stream, or nil
+func (a *Stream) Err() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.err
+}
+
+// Send sends a message on the stream. If the peer has not granted any credits, then Send blocks until credits are granted.
+// If final is true, then the stream is closed for sending.
+//
+// errors:
+//	- ErrSpec_StreamClosed
+//	- Context errors
+//	- capnp errors
+func (a *Stream) Send(ctx context.Context, messageType MessageType, data *capnp.Message, final bool) error {
+	return a.send(ctx, messageType, data, final, true)
+}
+
+// CloseSend closes the stream for sending, i.e., a final stream control message is sent. If the final message was already
+// sent, then this is a no-op.
+func (a *Stream) CloseSend(ctx context.Context) error {
+	a.mutex.Lock()
+	final := a.sendFinal
+	a.mutex.Unlock()
+	if final {
+		return nil
+	}
+	return a.send(ctx, MessageType_STREAM_CONTROL, nil, true, false)
+}
+
+// Recv returns the next message that was received on the stream. io.EOF is returned once the peer's final message has
+// been consumed. If the peer sends an error response, then the error response message is returned along with an *ErrorResponse.
+//
+// errors:
+//	- io.EOF
+//	- *ErrorResponse
+//	- ErrSpec_StreamProtocol
+//	- Context errors
+func (a *Stream) Recv(ctx context.Context) (*message.Message, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case msg, ok := <-a.received:
+		if !ok {
+			return nil, io.EOF
+		}
+		if err := a.consume(ctx, msg); err != nil {
+			return nil, err
+		}
+		if MessageType(msg.Type()) == MessageType_ERROR {
+			return msg, errorResponse(msg)
+		}
+		return msg, nil
+	case <-a.done:
+		return nil, a.Err()
+	}
+}
+
+// consume grants the peer credits, once half of the window has been consumed
+func (a *Stream) consume(ctx context.Context, msg *message.Message) error {
+	if a.peerOpened && a.isOpeningMessage(msg) {
+		// the opening message does not consume a credit
+		return nil
+	}
+	a.mutex.Lock()
+	if a.recvFinal {
+		a.mutex.Unlock()
+		return nil
+	}
+	a.consumed++
+	if a.consumed < (a.window+1)/2 {
+		a.mutex.Unlock()
+		return nil
+	}
+	a.grant += a.consumed
+	a.consumed = 0
+	a.mutex.Unlock()
+	if err := a.send(ctx, MessageType_STREAM_CONTROL, nil, false, false); err != nil && !app.IsError(err, ErrSpec_StreamClosed.ErrorID) {
+		return err
+	}
+	return nil
+}
+
+func (a *Stream) isOpeningMessage(msg *message.Message) bool {
+	header, err := msg.Stream()
+	return err == nil && header.Sequence() == 1
+}
+
+// send sends the message on the stream. Data messages consume a credit, if required. Stream control messages have no data,
+// and are assigned sequence number 0.
+func (a *Stream) send(ctx context.Context, messageType MessageType, data *capnp.Message, final, consumeCredit bool) error {
+	a.sendMutex.Lock()
+	defer a.sendMutex.Unlock()
+
+	control := messageType == MessageType_STREAM_CONTROL
+	for {
+		a.mutex.Lock()
+		if a.err != nil {
+			a.mutex.Unlock()
+			return StreamClosedError(a.serviceID, a.id)
+		}
+		if a.sendFinal {
+			a.mutex.Unlock()
+			if control && !final {
+				// credits no longer need to be granted
+				return nil
+			}
+			return StreamClosedError(a.serviceID, a.id)
+		}
+		if !consumeCredit || control || a.sendCredits > 0 {
+			break
+		}
+		a.mutex.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-a.done:
+		case <-a.credited:
+		}
+	}
+	var seq uint32
+	if !control {
+		if consumeCredit {
+			a.sendCredits--
+		}
+		a.sendSeq++
+		seq = a.sendSeq
+	}
+	grant := a.grant
+	a.grant = 0
+	if final {
+		a.sendFinal = true
+	}
+	a.mutex.Unlock()
+
+	msg, err := a.newMessage(ctx, messageType, data, seq, grant, final)
+	if err != nil {
+		return err
+	}
+	if err := a.write(msg); err != nil {
+		a.fail(err)
+		return err
+	}
+	return nil
+}
+
+func (a *Stream) newMessage(ctx context.Context, messageType MessageType, data *capnp.Message, seq, credits uint32, final bool) (*capnp.Message, error) {
+	msg, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		return nil, err
+	}
+	root, err := message.NewRootMessage(seg)
+	if err != nil {
+		return nil, err
+	}
+	if seq == 1 && !a.peerOpened {
+		// the opening message id is the stream id
+		root.SetId(a.id)
+	} else {
+		root.SetId(uid.NextUIDHash().UInt64())
+	}
+	root.SetType(messageType.UInt64())
+	root.SetCorrelationID(a.id)
+	root.SetTimestamp(time.Now().UnixNano())
+	if deadline, ok := ctx.Deadline(); ok {
+		root.Deadline().SetExpiresOn(deadline.UnixNano())
+	}
+	header, err := root.NewStream()
+	if err != nil {
+		return nil, err
+	}
+	header.SetSequence(seq)
+	header.SetCredits(credits)
+	header.SetFinal(final)
+	root.SetCompression(a.compression)
+	root.SetPacked(a.packed)
+	if data != nil {
+		if a.compressionPolicy != nil {
+			err = a.compressionPolicy.SetData(&root, data)
+		} else {
+			err = message.SetData(&root, data)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := trace.InjectMessage(ctx, &root); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// receive is called by the conn reader to deliver a message on the stream. Stream control messages grant credits, and
+// may close the stream for receiving. If the message violates the stream protocol, then an error is returned.
+func (a *Stream) receive(msg *message.Message) *app.Error {
+	header, err := msg.Stream()
+	if err != nil {
+		return StreamProtocolError(a.serviceID, a.id, err)
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.err != nil {
+		return nil
+	}
+	if credits := header.Credits(); credits > 0 {
+		a.sendCredits += credits
+		select {
+		case a.credited <- struct{}{}:
+		default:
+		}
+	}
+	seq := header.Sequence()
+	if seq == 0 {
+		if header.Final() && !a.recvFinal {
+			a.recvFinal = true
+			close(a.received)
+		}
+		return nil
+	}
+	if a.recvFinal {
+		return StreamProtocolError(a.serviceID, a.id, errors.New("message was received after the final message"))
+	}
+	if seq != a.recvSeq+1 {
+		return StreamProtocolError(a.serviceID, a.id, fmt.Errorf("message is out of sequence : %d != %d", seq, a.recvSeq+1))
+	}
+	select {
+	case a.received <- msg:
+	default:
+		return StreamProtocolError(a.serviceID, a.id, errors.New("flow control credits were exceeded"))
+	}
+	a.recvSeq = seq
+	if header.Final() || MessageType(msg.Type()) == MessageType_ERROR {
+		a.recvFinal = true
+		close(a.received)
+	}
+	return nil
+}
+
+// receivedFinal returns true if the peer's final message has been received
+func (a *Stream) receivedFinal() bool {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.recvFinal
+}
+
+// fail fails the stream, i.e., blocked senders and receivers are released
+func (a *Stream) fail(err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.err == nil {
+		a.err = err
+		close(a.done)
+	}
+}
+
+// open is called on the server side to grant the peer its initial credits, unless the opening message was final
+func (a *Stream) open(ctx context.Context) error {
+	if a.receivedFinal() {
+		return nil
+	}
+	a.mutex.Lock()
+	a.grant += a.window
+	a.mutex.Unlock()
+	return a.send(ctx, MessageType_STREAM_CONTROL, nil, false, false)
+}
+
+// finish is called on the server side once the stream has been processed. If the stream failed, then an error response
+// is sent as the final message. Otherwise, the stream is closed for sending.
+func (a *Stream) finish(ctx context.Context, err *app.Error) error {
+	defer a.fail(StreamClosedError(a.serviceID, a.id))
+	if err == nil {
+		return a.CloseSend(ctx)
+	}
+	data, seg, e := capnp.NewMessage(capnp.SingleSegment(nil))
+	if e != nil {
+		return e
+	}
+	errorMsg, e := message.NewRootError(seg)
+	if e != nil {
+		return e
+	}
+	errorMsg.SetErrorID(err.ErrorID.UInt64())
+	if e := errorMsg.SetMessage(err.Error()); e != nil {
+		return e
+	}
+	// the error response does not require a credit
+	return a.send(ctx, MessageType_ERROR, data, true, false)
+}
+
+// openStream returns a new stream for the message that opened the stream on the server side
+func openStream(serviceID app.ServiceID, request *message.Message, write func(*capnp.Message) error) (*Stream, *app.Error) {
+	header, err := request.Stream()
+	if err != nil {
+		return nil, StreamProtocolError(serviceID, request.Id(), err)
+	}
+	id := responseCorrelationID(*request)
+	if header.Sequence() != 1 {
+		return nil, StreamProtocolError(serviceID, id, errors.New("stream is unknown"))
+	}
+	stream := newStream(id, serviceID, DEFAULT_STREAM_WINDOW, write)
+	stream.peerOpened = true
+	if message.SupportedCompression(request.Compression()) {
+		stream.compression = request.Compression()
+		stream.packed = request.Packed()
+	}
+	if appErr := stream.receive(request); appErr != nil {
+		return nil, appErr
+	}
+	return stream, nil
+}
+
+// connStreams tracks the open streams on a server conn
+type connStreams struct {
+	service *app.Service
+	write   func(*capnp.Message) error
+
+	mutex   sync.Mutex
+	streams map[uint64]*Stream
+}
+
+func newConnStreams(service *app.Service, write func(*capnp.Message) error) *connStreams {
+	return &connStreams{
+		service: service,
+		write:   write,
+		streams: make(map[uint64]*Stream),
+	}
+}
+
+// dispatch delivers the stream message to the open stream that it belongs to. If the message opens a new stream, then
+// the new stream is returned. If the message violates the stream protocol, then the stream is failed, and an error is returned.
+// Stream control messages for unknown streams are ignored, i.e., the stream may have already been closed.
+func (a *connStreams) dispatch(request *message.Message) (*Stream, *app.Error) {
+	header, err := request.Stream()
+	if err != nil {
+		return nil, StreamProtocolError(a.service.ID(), request.Id(), err)
+	}
+	id := responseCorrelationID(*request)
+	a.mutex.Lock()
+	stream, ok := a.streams[id]
+	a.mutex.Unlock()
+	if ok {
+		if err := stream.receive(request); err != nil {
+			stream.fail(err)
+			a.remove(id)
+			return nil, err
+		}
+		return nil, nil
+	}
+	if header.Sequence() == 0 {
+		return nil, nil
+	}
+	stream, appErr := openStream(a.service.ID(), request, a.write)
+	if appErr != nil {
+		return nil, appErr
+	}
+	a.mutex.Lock()
+	a.streams[id] = stream
+	a.mutex.Unlock()
+	return stream, nil
+}
+
+func (a *connStreams) remove(id uint64) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	delete(a.streams, id)
+}
+
+// failAll fails all of the open streams, e.g., when the conn is closed
+func (a *connStreams) failAll() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	for id, stream := range a.streams {
+		stream.fail(StreamClosedError(a.service.ID(), id))
+		delete(a.streams, id)
+	}
+}
+
+// streamProtocolErrorResponse logs the stream protocol error, and returns the error response for the request
+func streamProtocolErrorResponse(service *app.Service, request message.Message, err *app.Error) *capnp.Message {
+	STREAM_PROTOCOL_ERROR.Log(service.Logger().Warn()).Uint64("stream", responseCorrelationID(request)).Err(err).Msg("stream protocol violation")
+	response, e := NewErrorResponse(request, err)
+	if e != nil {
+		MESSAGE_ENCODE_FAILED.Log(service.Logger().Error()).Err(e).Msg("failed to create error response message")
+		return nil
+	}
+	return response
+}
@@ -0,0 +1,228 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package net_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestStream(t *testing.T) {
+	app.Reset()
+	defer app.Reset()
+
+	const (
+		SERVICE_ID = app.ServiceID(0x996d296feb3335dd)
+
+		// streams back the number of messages that was requested via the opening message Ping count
+		SERVER_STREAM = opnet.MessageType(0xc17b27205cb996b5)
+		// echoes back each message on the stream
+		ECHO_STREAM = opnet.MessageType(0x9977e674efd64916)
+		FAILURE     = opnet.MessageType(0x8a56926df6286b9c)
+		ECHO        = opnet.MessageType(0x8fdd3fbd9aa7e318)
+
+		MESSAGE_COUNT = 3*opnet.DEFAULT_STREAM_WINDOW + 1
+	)
+	failureErrSpec := app.ErrSpec{ErrorID: app.ErrorID(0x98c00bc767b6c31e), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+
+	service := app.NewService(SERVICE_ID)
+	defer service.Kill(nil)
+
+	newData := func() *capnp.Message {
+		data, seg, _ := capnp.NewMessage(capnp.SingleSegment(nil))
+		message.NewRootPing(seg)
+		return data
+	}
+
+	serverStream := func(ctx context.Context, stream *opnet.Stream) *app.Error {
+		if _, err := stream.Recv(ctx); err != nil {
+			return app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		for i := 0; i < MESSAGE_COUNT; i++ {
+			if err := stream.Send(ctx, SERVER_STREAM, newData(), i == MESSAGE_COUNT-1); err != nil {
+				return app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+			}
+		}
+		return nil
+	}
+	echoStream := func(ctx context.Context, stream *opnet.Stream) *app.Error {
+		for {
+			msg, err := stream.Recv(ctx)
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+			}
+			data, err := message.Data(msg)
+			if err != nil {
+				return app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+			}
+			if err := stream.Send(ctx, ECHO_STREAM, data, false); err != nil {
+				return app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+			}
+		}
+	}
+	failure := func(ctx context.Context, stream *opnet.Stream) *app.Error {
+		return app.NewError(errors.New("BOOM"), "", failureErrSpec, SERVICE_ID, nil)
+	}
+	echo := func(ctx context.Context, request *message.Message) (*capnp.Message, *app.Error) {
+		response, err := opnet.NewResponse(*request, ECHO, nil)
+		if err != nil {
+			return nil, app.NewError(err, "", failureErrSpec, SERVICE_ID, nil)
+		}
+		return response, nil
+	}
+
+	if _, err := opnet.NewStreamingMessageRouter(service, []opnet.MessageRoute{{ECHO, echo}}, []opnet.StreamRoute{{ECHO, serverStream}}); err == nil {
+		t.Error("Message types should not be routed more than once")
+	}
+	if _, err := opnet.NewStreamingMessageRouter(service, nil, []opnet.StreamRoute{{opnet.MessageType_STREAM_CONTROL, serverStream}}); err == nil {
+		t.Error("Built-in message types should not be routable")
+	}
+
+	router, err := opnet.NewStreamingMessageRouter(service,
+		[]opnet.MessageRoute{{ECHO, echo}},
+		[]opnet.StreamRoute{{SERVER_STREAM, serverStream}, {ECHO_STREAM, echoStream}, {FAILURE, failure}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, err := opnet.NewClient(opnet.ClientSettings{
+		ServiceID: SERVICE_ID,
+		Dial: func() (net.Conn, error) {
+			serverConn, clientConn := net.Pipe()
+			go router.ConnHandler()(context.Background(), serverConn)
+			return clientConn, nil
+		},
+		Compression: message.Message_Compression_zstd,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+
+	// reads the stream until the final message is received, and returns the number of messages received
+	recvAll := func(t *testing.T, ctx context.Context, stream *opnet.Stream, messageType opnet.MessageType) int {
+		count := 0
+		for {
+			msg, err := stream.Recv(ctx)
+			if err == io.EOF {
+				return count
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if opnet.MessageType(msg.Type()) != messageType || msg.CorrelationID() != stream.ID() {
+				t.Fatalf("unexpected message : type = %x, correlationID = %x", msg.Type(), msg.CorrelationID())
+			}
+			header, err := msg.Stream()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count++; header.Sequence() != uint32(count) {
+				t.Errorf("message is out of sequence : %d != %d", header.Sequence(), count)
+			}
+		}
+	}
+
+	t.Run("server stream", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := client.Stream(ctx, SERVER_STREAM, newData(), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if count := recvAll(t, ctx, stream, SERVER_STREAM); count != MESSAGE_COUNT {
+			t.Errorf("Expected %d messages : %d", MESSAGE_COUNT, count)
+		}
+	})
+
+	t.Run("bidirectional stream", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := client.Stream(ctx, ECHO_STREAM, newData(), false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sendErr := make(chan error, 1)
+		go func() {
+			// the opening message counts as the first message
+			for i := 1; i < MESSAGE_COUNT; i++ {
+				if err := stream.Send(ctx, ECHO_STREAM, newData(), false); err != nil {
+					sendErr <- err
+					return
+				}
+			}
+			sendErr <- stream.CloseSend(ctx)
+		}()
+		if count := recvAll(t, ctx, stream, ECHO_STREAM); count != MESSAGE_COUNT {
+			t.Errorf("Expected %d messages : %d", MESSAGE_COUNT, count)
+		}
+		if err := <-sendErr; err != nil {
+			t.Error(err)
+		}
+		if err := stream.Send(ctx, ECHO_STREAM, newData(), false); err == nil {
+			t.Error("Sending on a stream that is closed for sending should fail")
+		}
+	})
+
+	t.Run("stream handler error", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := client.Stream(ctx, FAILURE, newData(), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stream.Recv(ctx); err == nil {
+			t.Error("Expected an error response")
+		} else if errorResponse, ok := err.(*opnet.ErrorResponse); !ok || errorResponse.ErrorID != failureErrSpec.ErrorID {
+			t.Errorf("Expected an *ErrorResponse : %v", err)
+		}
+		if _, err := stream.Recv(ctx); err != io.EOF {
+			t.Errorf("The error response should be the final message : %v", err)
+		}
+	})
+
+	t.Run("streaming is not supported", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		stream, err := client.Stream(ctx, ECHO, newData(), true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := stream.Recv(ctx); err == nil {
+			t.Error("Expected an error response")
+		} else if errorResponse, ok := err.(*opnet.ErrorResponse); !ok || errorResponse.ErrorID != opnet.ErrSpec_StreamProtocol.ErrorID {
+			t.Errorf("Expected an *ErrorResponse : %v", err)
+		}
+
+		// stream handlers require a stream
+		if _, err := client.Request(ctx, SERVER_STREAM, newData()); err == nil {
+			t.Error("Expected an error response")
+		} else if errorResponse, ok := err.(*opnet.ErrorResponse); !ok || errorResponse.ErrorID != opnet.ErrSpec_StreamProtocol.ErrorID {
+			t.Errorf("Expected an *ErrorResponse : %v", err)
+		}
+	})
+}
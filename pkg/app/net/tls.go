@@ -16,6 +16,8 @@ package net
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/oysterpack/oysterpack.go/pkg/app"
 )
@@ -24,3 +26,20 @@ import (
 func ServerCN(domain app.DomainID, app app.AppID, service app.ServiceID) string {
 	return fmt.Sprintf("%x.%x.%x", service, app, domain)
 }
+
+// ParseServerCN parses the ids that are encoded in a service CN - see ServerCN()
+func ParseServerCN(cn string) (app.DomainID, app.AppID, app.ServiceID, error) {
+	parts := strings.Split(cn, ".")
+	if len(parts) != 3 {
+		return 0, 0, 0, fmt.Errorf("CN is not a server CN : %q", cn)
+	}
+	ids := make([]uint64, len(parts))
+	for i, part := range parts {
+		id, err := strconv.ParseUint(part, 16, 64)
+		if err != nil || id == 0 {
+			return 0, 0, 0, fmt.Errorf("CN is not a server CN : %q", cn)
+		}
+		ids[i] = id
+	}
+	return app.DomainID(ids[2]), app.AppID(ids[1]), app.ServiceID(ids[0]), nil
+}
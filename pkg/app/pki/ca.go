@@ -0,0 +1,603 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/data/keyvalue"
+	"github.com/oysterpack/oysterpack.go/pkg/domain"
+)
+
+const (
+	ROOT_CA_VALIDITY         = 10 * 365 * 24 * time.Hour
+	INTERMEDIATE_CA_VALIDITY = 5 * 365 * 24 * time.Hour
+	CERT_VALIDITY            = 90 * 24 * time.Hour
+	// CRLs are regenerated on demand - see CA.CRL()
+	CRL_VALIDITY = 24 * time.Hour
+
+	// certs are backdated to allow for clock skew between the CA and the peers
+	CLOCK_SKEW = time.Minute
+)
+
+// database layout :
+//
+//	root/cert                  - org root CA cert
+//	root/key
+//	cas/{app}.{domain}/cert    - app intermediate CA cert, which is signed by the root CA
+//	cas/{app}.{domain}/key
+//	cas/{app}.{domain}/revoked - revoked cert serial -> revocation time
+//	certs/{serial}/cert        - issued certs
+//	certs/{serial}/ca          - name of the issuing intermediate CA
+const (
+	root_bucket    = "root"
+	cas_bucket     = "cas"
+	certs_bucket   = "certs"
+	revoked_bucket = "revoked"
+
+	cert_key = "cert"
+	key_key  = "key"
+	ca_key   = "ca"
+)
+
+// CA is the org's private certificate authority. The org root CA signs an intermediate CA per app, which issues the
+// app's server and client certs. The CAs, their keys, and the issued certs are stored in a keyvalue.Database.
+//
+// Issued certs are returned as a net.CertSource, i.e., they can be used directly as the source for a net.CertProvider :
+//	- Cert contains the leaf cert followed by the intermediate CA cert
+//	- CACert contains the root CA cert followed by the intermediate CA cert
+//	- CRL is the intermediate CA's CRL
+//	- DeniedSerials lists all revoked serials across all CAs, i.e., it covers certs that were issued by other apps' CAs
+//
+// NOTE: the CA keys are stored unencrypted - the database file must be protected.
+type CA struct {
+	service *app.Service
+	db      keyvalue.Database
+
+	orgID   domain.OrgId
+	orgName domain.OrgName
+
+	mutex sync.Mutex
+	root  *issuer
+	// intermediate CAs - lazily loaded
+	cas map[string]*issuer
+}
+
+type issuer struct {
+	name    string
+	cert    *x509.Certificate
+	certPEM []byte
+	key     *ecdsa.PrivateKey
+}
+
+func caName(domainID app.DomainID, appID app.AppID) string {
+	return fmt.Sprintf("%x.%x", appID, domainID)
+}
+
+// OpenCA opens the org CA that is stored in the database. If the root CA does not exist, then it is created.
+func OpenCA(service *app.Service, db keyvalue.Database, orgID domain.OrgId, orgName domain.OrgName) (*CA, error) {
+	if service == nil {
+		return nil, app.IllegalArgumentError("service is required")
+	}
+	if db == nil {
+		return nil, app.IllegalArgumentError("db is required")
+	}
+	if strings.TrimSpace(string(orgName)) == "" {
+		return nil, app.IllegalArgumentError("orgName must not be blank")
+	}
+	ca := &CA{
+		service: service,
+		db:      db,
+		orgID:   orgID,
+		orgName: orgName,
+		cas:     make(map[string]*issuer),
+	}
+
+	bucket, err := db.CreateBucketIfNotExists(root_bucket)
+	if err != nil {
+		return nil, CAFailureError(service.ID(), err)
+	}
+	if ca.root, err = loadIssuer(root_bucket, bucket); err != nil {
+		return nil, CAFailureError(service.ID(), err)
+	}
+	if ca.root == nil {
+		template := &x509.Certificate{
+			Subject: pkix.Name{
+				CommonName:         fmt.Sprintf("%s Root CA", orgName),
+				Organization:       []string{string(orgName)},
+				OrganizationalUnit: []string{string(orgID)},
+			},
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+			MaxPathLen:            1,
+			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		}
+		if ca.root, err = createIssuer(root_bucket, bucket, template, nil, ROOT_CA_VALIDITY); err != nil {
+			return nil, CAFailureError(service.ID(), err)
+		}
+		CA_CREATED.Log(service.Logger().Info()).Str("ca", root_bucket).Str("serial", opnet.SerialHex(ca.root.cert.SerialNumber)).Msg("root CA created")
+	}
+	return ca, nil
+}
+
+// RootCert returns the PEM encoded org root CA cert
+func (a *CA) RootCert() []byte {
+	return a.root.certPEM
+}
+
+// IssueServerCert issues a server cert for the service. The cert CN is net.ServerCN(), and the cert can also be used as
+// a client cert, i.e., for service to service calls.
+//
+// dnsNames are added to the cert as subject alternative names. IP addresses are added as IP SANs.
+// The app intermediate CA is created if it does not exist.
+func (a *CA) IssueServerCert(domainID app.DomainID, appID app.AppID, serviceID app.ServiceID, dnsNames ...string) (*opnet.CertSource, error) {
+	if domainID == 0 || appID == 0 || serviceID == 0 {
+		return nil, app.IllegalArgumentError("domainID, appID, and serviceID are required")
+	}
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:   opnet.ServerCN(domainID, appID, serviceID),
+			Organization: []string{string(a.orgName)},
+		},
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	for _, name := range dnsNames {
+		if ip := net.ParseIP(name); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, name)
+		}
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	ca, err := a.intermediate(caName(domainID, appID), true)
+	if err != nil {
+		return nil, err
+	}
+	return a.issue(ca, template)
+}
+
+// IssueClientCert issues a client cert for the subject, which is used to access the app. The cert CN is the subject id.
+//
+// errors:
+//	- ErrSpec_SubjectDisabled
+//	- ErrSpec_IllegalArgument if the subject does not belong to the org
+func (a *CA) IssueClientCert(domainID app.DomainID, appID app.AppID, subject domain.Subject) (*opnet.CertSource, error) {
+	if domainID == 0 || appID == 0 {
+		return nil, app.IllegalArgumentError("domainID and appID are required")
+	}
+	if subject == nil {
+		return nil, app.IllegalArgumentError("subject is required")
+	}
+	if subject.OrgId() != a.orgID {
+		return nil, app.IllegalArgumentError(fmt.Sprintf("subject does not belong to the org : %s", subject.Id()))
+	}
+	if !subject.Enabled() {
+		return nil, SubjectDisabledError(a.service.ID(), subject.Id())
+	}
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:   string(subject.Id()),
+			Organization: []string{string(a.orgName)},
+		},
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	ca, err := a.intermediate(caName(domainID, appID), true)
+	if err != nil {
+		return nil, err
+	}
+	return a.issue(ca, template)
+}
+
+// RenewCert issues a new cert, with a new key pair, for the same subject as the specified cert. The old cert is not
+// revoked, i.e., it remains valid until it expires.
+//
+// errors:
+//	- ErrSpec_CertNotFound
+//	- net.ErrSpec_CertRevoked - revoked certs cannot be renewed
+func (a *CA) RenewCert(serial string) (*opnet.CertSource, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	cert, ca, err := a.cert(serial)
+	if err != nil {
+		return nil, err
+	}
+	if a.revoked(ca, serial) {
+		return nil, opnet.CertRevokedError(a.service.ID(), serial)
+	}
+	template := &x509.Certificate{
+		Subject:     cert.Subject,
+		DNSNames:    cert.DNSNames,
+		IPAddresses: cert.IPAddresses,
+		KeyUsage:    cert.KeyUsage,
+		ExtKeyUsage: cert.ExtKeyUsage,
+	}
+	source, err := a.issue(ca, template)
+	if err != nil {
+		return nil, err
+	}
+	CERT_RENEWED.Log(a.service.Logger().Info()).Str("cn", cert.Subject.CommonName).Str("serial", serial).Msg("cert renewed")
+	return source, nil
+}
+
+// certCN returns the subject CN of the cert
+//
+// errors:
+//	- ErrSpec_CertNotFound
+func (a *CA) certCN(serial string) (string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	cert, _, err := a.cert(serial)
+	if err != nil {
+		return "", err
+	}
+	return cert.Subject.CommonName, nil
+}
+
+// RevokeCert revokes the cert. The revocation is published via the issuing CA's CRL and RevokedSerials().
+// Revoking a cert that is already revoked is a no-op.
+//
+// errors:
+//	- ErrSpec_CertNotFound
+func (a *CA) RevokeCert(serial string) error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	cert, ca, err := a.cert(serial)
+	if err != nil {
+		return err
+	}
+	bucket, err := a.db.Bucket(cas_bucket, ca.name).CreateBucketIfNotExists(revoked_bucket)
+	if err != nil {
+		return CAFailureError(a.service.ID(), err)
+	}
+	if bucket.Get(serial) != nil {
+		return nil
+	}
+	now, _ := time.Now().MarshalBinary() // ignoring err, because this will never err
+	if err := bucket.Put(serial, now); err != nil {
+		return CAFailureError(a.service.ID(), err)
+	}
+	CERT_REVOKED.Log(a.service.Logger().Info()).Str("cn", cert.Subject.CommonName).Str("serial", serial).Str("ca", ca.name).Msg("cert revoked")
+	return nil
+}
+
+// Cert returns the issued cert
+//
+// errors:
+//	- ErrSpec_CertNotFound
+func (a *CA) Cert(serial string) (*x509.Certificate, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	cert, _, err := a.cert(serial)
+	return cert, err
+}
+
+// Revoked returns true if the cert has been revoked
+//
+// errors:
+//	- ErrSpec_CertNotFound
+func (a *CA) Revoked(serial string) (bool, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	_, ca, err := a.cert(serial)
+	if err != nil {
+		return false, err
+	}
+	return a.revoked(ca, serial), nil
+}
+
+// CRL returns the PEM encoded CRL for the app intermediate CA. The CRL is valid for CRL_VALIDITY.
+//
+// errors:
+//	- ErrSpec_CANotFound
+func (a *CA) CRL(domainID app.DomainID, appID app.AppID) ([]byte, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	ca, err := a.intermediate(caName(domainID, appID), false)
+	if err != nil {
+		return nil, err
+	}
+	if ca == nil {
+		return nil, CANotFoundError(a.service.ID(), domainID, appID)
+	}
+	return a.crl(ca)
+}
+
+// RevokedSerials returns the serial numbers of all revoked certs across all CAs, sorted
+func (a *CA) RevokedSerials() []string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.revokedSerials()
+}
+
+func (a *CA) revokedSerials() []string {
+	serials := []string{}
+	cas := a.db.BucketView(cas_bucket)
+	if cas == nil {
+		return serials
+	}
+	for ca := range cas.BucketViews(nil) {
+		if revoked := ca.BucketView(revoked_bucket); revoked != nil {
+			for serial := range revoked.Keys("", nil) {
+				serials = append(serials, serial)
+			}
+		}
+	}
+	sort.Strings(serials)
+	return serials
+}
+
+func (a *CA) crl(ca *issuer) ([]byte, error) {
+	revoked := []pkix.RevokedCertificate{}
+	if bucket := a.revokedBucket(ca); bucket != nil {
+		for kv := range bucket.KeyValues("", nil) {
+			serial, ok := new(big.Int).SetString(kv.Key, 16)
+			if !ok {
+				return nil, CAFailureError(a.service.ID(), fmt.Errorf("Invalid revoked cert serial : %s", kv.Key))
+			}
+			var revokedOn time.Time
+			if err := revokedOn.UnmarshalBinary(kv.Value); err != nil {
+				return nil, CAFailureError(a.service.ID(), err)
+			}
+			revoked = append(revoked, pkix.RevokedCertificate{SerialNumber: serial, RevocationTime: revokedOn})
+		}
+	}
+	now := time.Now()
+	der, err := ca.cert.CreateCRL(rand.Reader, ca.key, revoked, now, now.Add(CRL_VALIDITY))
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: der}), nil
+}
+
+// issue signs the cert template using the CA, stores the cert, and returns it as a CertSource
+func (a *CA) issue(ca *issuer, template *x509.Certificate) (*opnet.CertSource, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	cert, certPEM, err := signCert(template, &key.PublicKey, ca, CERT_VALIDITY)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+
+	serial := opnet.SerialHex(cert.SerialNumber)
+	certs, err := a.db.CreateBucketIfNotExists(certs_bucket)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	bucket, err := certs.CreateBucket(serial)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	if err := bucket.Put(cert_key, certPEM); err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	if err := bucket.Put(ca_key, []byte(ca.name)); err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	CERT_ISSUED.Log(a.service.Logger().Info()).Str("cn", cert.Subject.CommonName).Str("serial", serial).Str("ca", ca.name).Time("not_after", cert.NotAfter).Msg("cert issued")
+
+	crl, err := a.crl(ca)
+	if err != nil {
+		return nil, err
+	}
+	return &opnet.CertSource{
+		Cert:          append(certPEM, ca.certPEM...),
+		Key:           keyPEM,
+		CACert:        append(append([]byte{}, a.root.certPEM...), ca.certPEM...),
+		CRL:           crl,
+		DeniedSerials: []byte(strings.Join(a.revokedSerials(), "\n")),
+	}, nil
+}
+
+// cert looks up the issued cert and its issuing CA
+func (a *CA) cert(serial string) (*x509.Certificate, *issuer, error) {
+	bucket := a.db.BucketView(certs_bucket, serial)
+	if bucket == nil {
+		return nil, nil, CertNotFoundError(a.service.ID(), serial)
+	}
+	cert, err := decodeCert(bucket.Get(cert_key))
+	if err != nil {
+		return nil, nil, CAFailureError(a.service.ID(), err)
+	}
+	ca, err := a.intermediate(string(bucket.Get(ca_key)), false)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ca == nil {
+		return nil, nil, CAFailureError(a.service.ID(), fmt.Errorf("Issuing CA not found for cert : %s", serial))
+	}
+	return cert, ca, nil
+}
+
+// intermediate returns the intermediate CA. If it does not exist and create is false, then nil is returned.
+// The CA mutex must be held.
+func (a *CA) intermediate(name string, create bool) (*issuer, error) {
+	if ca, ok := a.cas[name]; ok {
+		return ca, nil
+	}
+	if bucket := a.db.Bucket(cas_bucket, name); bucket != nil {
+		ca, err := loadIssuer(name, bucket)
+		if err != nil {
+			return nil, CAFailureError(a.service.ID(), err)
+		}
+		if ca != nil {
+			a.cas[name] = ca
+			return ca, nil
+		}
+	}
+	if !create {
+		return nil, nil
+	}
+
+	cas, err := a.db.CreateBucketIfNotExists(cas_bucket)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	bucket, err := cas.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	template := &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:   fmt.Sprintf("%s CA", name),
+			Organization: []string{string(a.orgName)},
+		},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		MaxPathLenZero:        true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	ca, err := createIssuer(name, bucket, template, a.root, INTERMEDIATE_CA_VALIDITY)
+	if err != nil {
+		return nil, CAFailureError(a.service.ID(), err)
+	}
+	CA_CREATED.Log(a.service.Logger().Info()).Str("ca", name).Str("serial", opnet.SerialHex(ca.cert.SerialNumber)).Msg("intermediate CA created")
+	a.cas[name] = ca
+	return ca, nil
+}
+
+// revokedBucket returns nil if no certs have been revoked by the CA
+func (a *CA) revokedBucket(ca *issuer) keyvalue.BucketView {
+	return a.db.BucketView(cas_bucket, ca.name, revoked_bucket)
+}
+
+func (a *CA) revoked(ca *issuer, serial string) bool {
+	bucket := a.revokedBucket(ca)
+	return bucket != nil && bucket.Get(serial) != nil
+}
+
+// loadIssuer loads the CA cert and key from the bucket. If the CA does not exist, then nil is returned.
+func loadIssuer(name string, bucket keyvalue.BucketView) (*issuer, error) {
+	certPEM := bucket.Get(cert_key)
+	if certPEM == nil {
+		return nil, nil
+	}
+	cert, err := decodeCert(certPEM)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(bucket.Get(key_key))
+	if block == nil {
+		return nil, fmt.Errorf("CA key not found : %s", name)
+	}
+	key, err := x509.ParseECPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	return &issuer{name: name, cert: cert, certPEM: certPEM, key: key}, nil
+}
+
+// createIssuer creates a new CA and stores it in the bucket. If parent is nil, then the CA is self-signed.
+func createIssuer(name string, bucket keyvalue.Bucket, template *x509.Certificate, parent *issuer, validity time.Duration) (*issuer, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	ca := &issuer{name: name, key: key}
+	if parent == nil {
+		parent = ca
+	}
+	if ca.cert, ca.certPEM, err = signCert(template, &key.PublicKey, parent, validity); err != nil {
+		return nil, err
+	}
+	keyPEM, err := encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	if err := bucket.Put(key_key, keyPEM); err != nil {
+		return nil, err
+	}
+	if err := bucket.Put(cert_key, ca.certPEM); err != nil {
+		return nil, err
+	}
+	return ca, nil
+}
+
+// signCert assigns a random serial number and the validity period, which is capped by the issuer's validity.
+// If the issuer cert is nil, then the cert is self-signed.
+func signCert(template *x509.Certificate, pub *ecdsa.PublicKey, issuer *issuer, validity time.Duration) (*x509.Certificate, []byte, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	template.SerialNumber = serial
+	template.NotBefore = now.Add(-CLOCK_SKEW)
+	template.NotAfter = now.Add(validity)
+	parent := template
+	if issuer.cert != nil {
+		parent = issuer.cert
+		if template.NotAfter.After(issuer.cert.NotAfter) {
+			template.NotAfter = issuer.cert.NotAfter
+		}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, pub, issuer.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), nil
+}
+
+func encodeKey(key *ecdsa.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+func decodeCert(certPEM []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return nil, errors.New("Failed to decode PEM cert")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// NeedsRenewal returns true if less than a third of the cert's validity period remains
+func NeedsRenewal(cert *x509.Certificate, now time.Time) bool {
+	return cert.NotAfter.Sub(now) < cert.NotAfter.Sub(cert.NotBefore)/3
+}
@@ -0,0 +1,310 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/pki"
+	"github.com/oysterpack/oysterpack.go/pkg/data/keyvalue"
+	"github.com/oysterpack/oysterpack.go/pkg/domain"
+	"zombiezen.com/go/capnproto2"
+)
+
+const (
+	ORG_ID   = domain.OrgId("oysterpack")
+	ORG_NAME = domain.OrgName("OysterPack")
+
+	DOMAIN_ID  = app.DomainID(0xed5cf026e8734361)
+	APP_ID     = app.AppID(0xd113a2e016e12f0f)
+	SERVICE_ID = app.ServiceID(0xe49214fa20b35ba8)
+
+	PKI_SERVICE_ID = app.ServiceID(0xb82a4c2b7b39be0f)
+)
+
+type subject struct {
+	id      domain.SubjectId
+	orgID   domain.OrgId
+	enabled bool
+}
+
+func (a subject) Id() domain.SubjectId  { return a.id }
+func (a subject) OrgId() domain.OrgId   { return a.orgID }
+func (a subject) Created() time.Time    { return time.Time{} }
+func (a subject) Enabled() bool         { return a.enabled }
+func (a subject) Cert() tls.Certificate { return tls.Certificate{} }
+
+func openCA(t *testing.T, dbFile string) (*pki.CA, keyvalue.Database) {
+	t.Helper()
+	db, err := keyvalue.CreateDatabase(dbFile, "pki", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := pki.OpenCA(app.NewService(PKI_SERVICE_ID), db, ORG_ID, ORG_NAME)
+	if err != nil {
+		db.Close()
+		t.Fatal(err)
+	}
+	return ca, db
+}
+
+func parseCerts(t *testing.T, data []byte) []*x509.Certificate {
+	t.Helper()
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); block != nil; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
+// verify checks that the cert chains to the root CA, and that the key pair matches
+func verify(t *testing.T, source *opnet.CertSource, usage x509.ExtKeyUsage) *x509.Certificate {
+	t.Helper()
+	if _, err := tls.X509KeyPair(source.Cert, source.Key); err != nil {
+		t.Fatal(err)
+	}
+	chain := parseCerts(t, source.Cert)
+	caCerts := parseCerts(t, source.CACert)
+	if len(chain) != 2 || len(caCerts) != 2 {
+		t.Fatalf("leaf + intermediate and root + intermediate were expected : %d, %d", len(chain), len(caCerts))
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(caCerts[0])
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(chain[1])
+	if _, err := chain[0].Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates, KeyUsages: []x509.ExtKeyUsage{usage}}); err != nil {
+		t.Fatal(err)
+	}
+	return chain[0]
+}
+
+func TestCA(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pki_test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbFile := filepath.Join(dir, "pki.db")
+
+	ca, db := openCA(t, dbFile)
+	rootCert := ca.RootCert()
+
+	serverCerts, err := ca.IssueServerCert(DOMAIN_ID, APP_ID, SERVICE_ID, "localhost", "127.0.0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverCert := verify(t, serverCerts, x509.ExtKeyUsageServerAuth)
+	verify(t, serverCerts, x509.ExtKeyUsageClientAuth)
+	identity := opnet.NewPeerIdentity(serverCert)
+	if identity.DomainID != DOMAIN_ID || identity.AppID != APP_ID || identity.ServiceID != SERVICE_ID {
+		t.Errorf("service identity does not match : %v", identity)
+	}
+	if len(serverCert.DNSNames) != 1 || len(serverCert.IPAddresses) != 1 {
+		t.Errorf("SANs do not match : %v : %v", serverCert.DNSNames, serverCert.IPAddresses)
+	}
+	if pki.NeedsRenewal(serverCert, time.Now()) {
+		t.Error("a new cert should not need renewal")
+	}
+	if !pki.NeedsRenewal(serverCert, serverCert.NotAfter.Add(-time.Hour)) {
+		t.Error("the cert should need renewal when it is about to expire")
+	}
+
+	t.Run("client cert", func(t *testing.T) {
+		clientCerts, err := ca.IssueClientCert(DOMAIN_ID, APP_ID, subject{"alice", ORG_ID, true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		clientCert := verify(t, clientCerts, x509.ExtKeyUsageClientAuth)
+		if clientCert.Subject.CommonName != "alice" {
+			t.Errorf("CN does not match : %v", clientCert.Subject.CommonName)
+		}
+
+		_, err = ca.IssueClientCert(DOMAIN_ID, APP_ID, subject{"bob", ORG_ID, false})
+		if appErr, ok := err.(*app.Error); !ok || appErr.ErrorID != pki.ErrSpec_SubjectDisabled.ErrorID {
+			t.Errorf("ErrSpec_SubjectDisabled was expected : %v", err)
+		}
+		if _, err = ca.IssueClientCert(DOMAIN_ID, APP_ID, subject{"carol", domain.OrgId("other"), true}); err == nil {
+			t.Error("subjects from other orgs should be rejected")
+		}
+	})
+
+	t.Run("renew", func(t *testing.T) {
+		renewedCerts, err := ca.RenewCert(opnet.SerialHex(serverCert.SerialNumber))
+		if err != nil {
+			t.Fatal(err)
+		}
+		renewedCert := verify(t, renewedCerts, x509.ExtKeyUsageServerAuth)
+		if renewedCert.SerialNumber.Cmp(serverCert.SerialNumber) == 0 {
+			t.Error("renewed cert should have a new serial number")
+		}
+		if renewedCert.Subject.CommonName != serverCert.Subject.CommonName || len(renewedCert.DNSNames) != 1 {
+			t.Errorf("renewed cert subject does not match : %v", renewedCert.Subject)
+		}
+		if _, err := ca.RenewCert("abcdef"); err == nil {
+			t.Error("unknown certs cannot be renewed")
+		}
+	})
+
+	t.Run("rpc authz", func(t *testing.T) {
+		server := pki.NewPKIServer(ca, nil)
+		serial := opnet.SerialHex(serverCert.SerialNumber)
+		isUnauthorized := func(err error) bool {
+			appErr, ok := err.(*app.Error)
+			return ok && appErr.ErrorID == opnet.ErrSpec_Unauthorized.ErrorID
+		}
+
+		renew := func(ctx context.Context) error {
+			_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			params, err := pki.NewPKI_renewCert_Params(seg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := params.SetSerial(serial); err != nil {
+				t.Fatal(err)
+			}
+			results, err := pki.NewPKI_renewCert_Results(seg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return server.RenewCert(pki.PKI_renewCert{Ctx: ctx, Params: params, Results: results})
+		}
+		if err := renew(opnet.WithPeerIdentity(context.Background(), identity)); err != nil {
+			t.Errorf("services should be able to renew their own cert : %v", err)
+		}
+		clientCerts, err := ca.IssueClientCert(DOMAIN_ID, APP_ID, subject{"alice", ORG_ID, true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		alice := opnet.NewPeerIdentity(verify(t, clientCerts, x509.ExtKeyUsageClientAuth))
+		if err := renew(opnet.WithPeerIdentity(context.Background(), alice)); !isUnauthorized(err) {
+			t.Errorf("other peers should not be able to renew the cert : %v", err)
+		}
+		if err := renew(context.Background()); !isUnauthorized(err) {
+			t.Errorf("anonymous peers should not be able to renew the cert : %v", err)
+		}
+
+		revoke := func(ctx context.Context) error {
+			_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			params, err := pki.NewPKI_revokeCert_Params(seg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := params.SetSerial(serial); err != nil {
+				t.Fatal(err)
+			}
+			return server.RevokeCert(pki.PKI_revokeCert{Ctx: ctx, Params: params})
+		}
+		// admin methods are denied unless an Authorizer allows them
+		if err := revoke(opnet.WithPeerIdentity(context.Background(), identity)); !isUnauthorized(err) {
+			t.Errorf("revokeCert should be denied without an Authorizer : %v", err)
+		}
+		denyAll := opnet.Authorizer(func(identity *opnet.PeerIdentity, op opnet.Operation) bool { return false })
+		if err := revoke(opnet.WithAuthorizer(opnet.WithPeerIdentity(context.Background(), identity), denyAll)); !isUnauthorized(err) {
+			t.Errorf("revokeCert should be denied by the Authorizer : %v", err)
+		}
+		if revoked, err := ca.Revoked(serial); err != nil || revoked {
+			t.Errorf("cert should not be revoked : %v", err)
+		}
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		serial := opnet.SerialHex(serverCert.SerialNumber)
+		if err := ca.RevokeCert(serial); err != nil {
+			t.Fatal(err)
+		}
+		// revoking again is a no-op
+		if err := ca.RevokeCert(serial); err != nil {
+			t.Fatal(err)
+		}
+		if revoked, err := ca.Revoked(serial); err != nil || !revoked {
+			t.Errorf("cert should be revoked : %v", err)
+		}
+		if serials := ca.RevokedSerials(); len(serials) != 1 || serials[0] != serial {
+			t.Errorf("revoked serials do not match : %v", serials)
+		}
+
+		crlPEM, err := ca.CRL(DOMAIN_ID, APP_ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		crl, err := x509.ParseCRL(crlPEM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if revoked := crl.TBSCertList.RevokedCertificates; len(revoked) != 1 || revoked[0].SerialNumber.Cmp(serverCert.SerialNumber) != 0 {
+			t.Errorf("CRL does not match : %v", revoked)
+		}
+		if _, err := ca.CRL(DOMAIN_ID, app.AppID(1)); err == nil {
+			t.Error("CA should not exist")
+		}
+
+		if _, err := ca.RenewCert(serial); err == nil {
+			t.Error("revoked certs cannot be renewed")
+		}
+
+		// newly issued certs carry the revocations
+		certs, err := ca.IssueServerCert(DOMAIN_ID, APP_ID, SERVICE_ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if strings.TrimSpace(string(certs.DeniedSerials)) != serial {
+			t.Errorf("denied serials do not match : %s", certs.DeniedSerials)
+		}
+		if len(certs.CRL) == 0 {
+			t.Error("CRL is missing")
+		}
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		db.Close()
+		ca, db = openCA(t, dbFile)
+		if string(ca.RootCert()) != string(rootCert) {
+			t.Error("root CA should have been loaded from the database")
+		}
+		if revoked, err := ca.Revoked(opnet.SerialHex(serverCert.SerialNumber)); err != nil || !revoked {
+			t.Errorf("cert should still be revoked : %v", err)
+		}
+		certs, err := ca.IssueServerCert(DOMAIN_ID, APP_ID, SERVICE_ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the existing intermediate CA is used
+		if chain := parseCerts(t, certs.Cert); chain[1].SerialNumber.Cmp(parseCerts(t, serverCerts.Cert)[1].SerialNumber) != 0 {
+			t.Error("intermediate CA should have been loaded from the database")
+		}
+	})
+	db.Close()
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"fmt"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/oysterpack/oysterpack.go/pkg/domain"
+)
+
+var (
+	ErrSpec_CAFailure       = app.ErrSpec{ErrorID: app.ErrorID(0xfb8170a12d9b08b0), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_HIGH}
+	ErrSpec_CANotFound      = app.ErrSpec{ErrorID: app.ErrorID(0xcbf2c0fbffec2a29), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_CertNotFound    = app.ErrSpec{ErrorID: app.ErrorID(0x8ab467ac200f2f29), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+	ErrSpec_SubjectDisabled = app.ErrSpec{ErrorID: app.ErrorID(0xa90003922b0c8e38), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_MEDIUM}
+	ErrSpec_SubjectNotFound = app.ErrSpec{ErrorID: app.ErrorID(0x9780fd5cf27c47f5), ErrorType: app.ErrorType_KNOWN_EDGE_CASE, ErrorSeverity: app.ErrorSeverity_LOW}
+)
+
+// CAFailureError is returned when the CA failed to create, sign, or store a cert
+func CAFailureError(serviceID app.ServiceID, err error) *app.Error {
+	return app.NewError(
+		err,
+		"CA failure",
+		ErrSpec_CAFailure,
+		serviceID,
+		nil,
+	)
+}
+
+// CANotFoundError is returned when the intermediate CA does not exist for the app
+func CANotFoundError(serviceID app.ServiceID, domainID app.DomainID, appID app.AppID) *app.Error {
+	return app.NewError(
+		fmt.Errorf("CA not found : %s", caName(domainID, appID)),
+		"",
+		ErrSpec_CANotFound,
+		serviceID,
+		nil,
+	)
+}
+
+// CertNotFoundError is returned when the cert serial number was not issued by the CA
+func CertNotFoundError(serviceID app.ServiceID, serial string) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Cert not found : %s", serial),
+		"",
+		ErrSpec_CertNotFound,
+		serviceID,
+		nil,
+	)
+}
+
+// SubjectDisabledError is returned when a client cert is requested for a subject that is disabled
+func SubjectDisabledError(serviceID app.ServiceID, subjectID domain.SubjectId) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Subject is disabled : %s", subjectID),
+		"",
+		ErrSpec_SubjectDisabled,
+		serviceID,
+		nil,
+	)
+}
+
+// SubjectNotFoundError is returned when a client cert is requested over RPC for an unknown subject
+func SubjectNotFoundError(serviceID app.ServiceID, subjectID domain.SubjectId) *app.Error {
+	return app.NewError(
+		fmt.Errorf("Subject not found : %s", subjectID),
+		"",
+		ErrSpec_SubjectNotFound,
+		serviceID,
+		nil,
+	)
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
+
+const (
+	CA_CREATED   = app.LogEventID(0xeeddcb537d4786f5)
+	CERT_ISSUED  = app.LogEventID(0xa8d8e84450c9d45a)
+	CERT_RENEWED = app.LogEventID(0x978bc5fc7e35a1f8)
+	CERT_REVOKED = app.LogEventID(0xc41e788f9642db10)
+)
+
+func init() {
+	caField := app.LogEventField{Name: "ca", Type: app.LogFieldType_STRING, Description: "CA name"}
+	cnField := app.LogEventField{Name: "cn", Type: app.LogFieldType_STRING, Description: "cert subject common name"}
+	serialField := app.LogEventField{Name: "serial", Type: app.LogFieldType_STRING, Description: "cert serial number in hex"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: CA_CREATED, Name: "CA_CREATED", Level: zerolog.InfoLevel, Description: "a CA cert was created",
+			Fields: []app.LogEventField{caField, serialField}},
+		app.LogEventSpec{ID: CERT_ISSUED, Name: "CERT_ISSUED", Level: zerolog.InfoLevel, Description: "a cert was issued",
+			Fields: []app.LogEventField{cnField, serialField, caField, {Name: "not_after", Type: app.LogFieldType_TIME, Description: "when the cert expires"}}},
+		app.LogEventSpec{ID: CERT_RENEWED, Name: "CERT_RENEWED", Level: zerolog.InfoLevel, Description: "a cert was renewed",
+			Fields: []app.LogEventField{cnField, serialField}},
+		app.LogEventSpec{ID: CERT_REVOKED, Name: "CERT_REVOKED", Level: zerolog.InfoLevel, Description: "a cert was revoked",
+			Fields: []app.LogEventField{cnField, serialField, caField}},
+	)
+}
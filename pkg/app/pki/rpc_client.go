@@ -0,0 +1,238 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"context"
+	"crypto/x509"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/domain"
+	"zombiezen.com/go/capnproto2"
+)
+
+// PKI function params - for RPC functions that take no params
+var (
+	_PKI_rootCert       = func(_ PKI_rootCert_Params) error { return nil }
+	_PKI_revokedSerials = func(_ PKI_revokedSerials_Params) error { return nil }
+)
+
+// PKIClient wraps the PKI capnp RPC client in order to provide a more user friendly interface
+type PKIClient struct {
+	PKI
+}
+
+// NewPKIClient wraps the PKI RPC service bootstrap client, e.g., conn.Bootstrap(ctx)
+func NewPKIClient(client capnp.Client) *PKIClient {
+	return &PKIClient{PKI{Client: trace.Client(client)}}
+}
+
+// RootCert returns the PEM encoded org root CA cert
+func (a *PKIClient) RootCert(ctx context.Context) ([]byte, error) {
+	results, err := a.PKI.RootCert(ctx, _PKI_rootCert).Struct()
+	if err != nil {
+		return nil, err
+	}
+	return results.Cert()
+}
+
+// OwnCert requests a server cert for the calling service, which is identified by its client cert
+func (a *PKIClient) OwnCert(ctx context.Context, dnsNames ...string) (*opnet.CertSource, error) {
+	results, err := a.PKI.OwnCert(ctx, func(params PKI_ownCert_Params) error {
+		list, err := params.NewDnsNames(int32(len(dnsNames)))
+		if err != nil {
+			return err
+		}
+		return setTextList(list, dnsNames)
+	}).Struct()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := results.Certs()
+	if err != nil {
+		return nil, err
+	}
+	return newCertSource(certs)
+}
+
+func (a *PKIClient) ServerCert(ctx context.Context, domainID app.DomainID, appID app.AppID, serviceID app.ServiceID, dnsNames ...string) (*opnet.CertSource, error) {
+	results, err := a.PKI.ServerCert(ctx, func(params PKI_serverCert_Params) error {
+		params.SetDomainId(domainID.UInt64())
+		params.SetAppId(appID.UInt64())
+		params.SetServiceId(serviceID.UInt64())
+		list, err := params.NewDnsNames(int32(len(dnsNames)))
+		if err != nil {
+			return err
+		}
+		return setTextList(list, dnsNames)
+	}).Struct()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := results.Certs()
+	if err != nil {
+		return nil, err
+	}
+	return newCertSource(certs)
+}
+
+func (a *PKIClient) ClientCert(ctx context.Context, domainID app.DomainID, appID app.AppID, subjectID domain.SubjectId) (*opnet.CertSource, error) {
+	results, err := a.PKI.ClientCert(ctx, func(params PKI_clientCert_Params) error {
+		params.SetDomainId(domainID.UInt64())
+		params.SetAppId(appID.UInt64())
+		return params.SetSubjectId(string(subjectID))
+	}).Struct()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := results.Certs()
+	if err != nil {
+		return nil, err
+	}
+	return newCertSource(certs)
+}
+
+func (a *PKIClient) RenewCert(ctx context.Context, serial string) (*opnet.CertSource, error) {
+	results, err := a.PKI.RenewCert(ctx, func(params PKI_renewCert_Params) error {
+		return params.SetSerial(serial)
+	}).Struct()
+	if err != nil {
+		return nil, err
+	}
+	certs, err := results.Certs()
+	if err != nil {
+		return nil, err
+	}
+	return newCertSource(certs)
+}
+
+func (a *PKIClient) RevokeCert(ctx context.Context, serial string) error {
+	_, err := a.PKI.RevokeCert(ctx, func(params PKI_revokeCert_Params) error {
+		return params.SetSerial(serial)
+	}).Struct()
+	return err
+}
+
+// CRL returns the PEM encoded CRL for the app intermediate CA
+func (a *PKIClient) CRL(ctx context.Context, domainID app.DomainID, appID app.AppID) ([]byte, error) {
+	results, err := a.PKI.Crl(ctx, func(params PKI_crl_Params) error {
+		params.SetDomainId(domainID.UInt64())
+		params.SetAppId(appID.UInt64())
+		return nil
+	}).Struct()
+	if err != nil {
+		return nil, err
+	}
+	return results.Crl()
+}
+
+func (a *PKIClient) RevokedSerials(ctx context.Context) ([]string, error) {
+	results, err := a.PKI.RevokedSerials(ctx, _PKI_revokedSerials).Struct()
+	if err != nil {
+		return nil, err
+	}
+	serials, err := results.Serials()
+	if err != nil {
+		return nil, err
+	}
+	return textListToStrings(serials)
+}
+
+// CertLoader returns a net.CertLoader, which is used to fetch the service's own cert at startup via a net.CertProvider.
+// The cert is renewed when it is close to expiring - see NeedsRenewal(). The CRL and revoked serials are refreshed on
+// each load, which means the CertProvider reload interval determines how quickly revocations are picked up.
+func (a *PKIClient) CertLoader(timeout time.Duration, dnsNames ...string) opnet.CertLoader {
+	var mutex sync.Mutex
+	var source *opnet.CertSource
+	var cert *x509.Certificate
+
+	return func() (*opnet.CertSource, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+
+		if cert == nil || NeedsRenewal(cert, time.Now()) {
+			newSource, err := a.OwnCert(ctx, dnsNames...)
+			if err != nil {
+				return nil, err
+			}
+			newCert, err := decodeCert(newSource.Cert)
+			if err != nil {
+				return nil, err
+			}
+			source, cert = newSource, newCert
+			return source, nil
+		}
+
+		domainID, appID, _, err := opnet.ParseServerCN(cert.Subject.CommonName)
+		if err != nil {
+			return nil, err
+		}
+		crl, err := a.CRL(ctx, domainID, appID)
+		if err != nil {
+			return nil, err
+		}
+		serials, err := a.RevokedSerials(ctx)
+		if err != nil {
+			return nil, err
+		}
+		refreshed := *source
+		refreshed.CRL, refreshed.DeniedSerials = crl, []byte(strings.Join(serials, "\n"))
+		source = &refreshed
+		return source, nil
+	}
+}
+
+// Close releases any resources associated with this client.
+// No further calls to the client should be made after calling Close.
+func (a *PKIClient) Close() error {
+	return a.PKI.Client.Close()
+}
+
+func newCertSource(certs CertSource) (*opnet.CertSource, error) {
+	source := &opnet.CertSource{}
+	var err error
+	if source.Cert, err = certs.Cert(); err != nil {
+		return nil, err
+	}
+	if source.Key, err = certs.Key(); err != nil {
+		return nil, err
+	}
+	if source.CACert, err = certs.CaCert(); err != nil {
+		return nil, err
+	}
+	if source.CRL, err = certs.Crl(); err != nil {
+		return nil, err
+	}
+	if source.DeniedSerials, err = certs.DeniedSerials(); err != nil {
+		return nil, err
+	}
+	return source, nil
+}
+
+func setTextList(list capnp.TextList, values []string) error {
+	for i, value := range values {
+		if err := list.Set(i, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pki
+
+import (
+	"context"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	opnet "github.com/oysterpack/oysterpack.go/pkg/app/net"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"github.com/oysterpack/oysterpack.go/pkg/domain"
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
+)
+
+// SubjectResolver looks up the subject that a client cert is requested for over RPC
+type SubjectResolver func(id domain.SubjectId) (domain.Subject, error)
+
+// NewPKIServer exposes the CA over capnp RPC. If subjects is nil, then client certs cannot be requested over RPC.
+//
+// Access to the PKI methods should be restricted via the RPC service Authorizer, e.g., only ownCert and rootCert should
+// be allowed for app services - see net.AuthzPolicy. The server enforces the following regardless:
+//	- serverCert, clientCert, and revokeCert are denied unless the RPC service has an Authorizer that allows them
+//	- renewCert only renews the caller's own cert, i.e., the caller CN must match the cert subject CN
+func NewPKIServer(ca *CA, subjects SubjectResolver) PKI_Server {
+	return pkiServer{ca, subjects}
+}
+
+// RPCMainInterface returns the PKI server as the RPC service main interface, i.e., it can be passed to the RPC service
+// as its RPCMainInterface
+func RPCMainInterface(ca *CA, subjects SubjectResolver) func() (capnp.Client, error) {
+	client := server.New(trace.ServerMethods(PKI_Methods(nil, NewPKIServer(ca, subjects))), nil)
+	return func() (capnp.Client, error) {
+		return client, nil
+	}
+}
+
+type pkiServer struct {
+	ca       *CA
+	subjects SubjectResolver
+}
+
+func (a pkiServer) RootCert(call PKI_rootCert) error {
+	return call.Results.SetCert(a.ca.RootCert())
+}
+
+// OwnCert issues a server cert for the calling service. The service identity is taken from the client cert, which must
+// follow the service naming convention - see net.ServerCN().
+func (a pkiServer) OwnCert(call PKI_ownCert) error {
+	identity := opnet.PeerIdentityFromContext(call.Ctx)
+	if identity == nil || !identity.IsService() {
+		return opnet.UnauthorizedError(a.ca.service.ID(), opnet.MethodOperation(PKI_TypeID, 1))
+	}
+	dnsNames, err := call.Params.DnsNames()
+	if err != nil {
+		return err
+	}
+	names, err := textListToStrings(dnsNames)
+	if err != nil {
+		return err
+	}
+	source, err := a.ca.IssueServerCert(identity.DomainID, identity.AppID, identity.ServiceID, names...)
+	if err != nil {
+		return err
+	}
+	certs, err := call.Results.NewCerts()
+	if err != nil {
+		return err
+	}
+	return certSourceToCapnp(source, certs)
+}
+
+func (a pkiServer) ServerCert(call PKI_serverCert) error {
+	if err := a.authorizeAdmin(call.Ctx, 2); err != nil {
+		return err
+	}
+	dnsNames, err := call.Params.DnsNames()
+	if err != nil {
+		return err
+	}
+	names, err := textListToStrings(dnsNames)
+	if err != nil {
+		return err
+	}
+	source, err := a.ca.IssueServerCert(app.DomainID(call.Params.DomainId()), app.AppID(call.Params.AppId()), app.ServiceID(call.Params.ServiceId()), names...)
+	if err != nil {
+		return err
+	}
+	certs, err := call.Results.NewCerts()
+	if err != nil {
+		return err
+	}
+	return certSourceToCapnp(source, certs)
+}
+
+func (a pkiServer) ClientCert(call PKI_clientCert) error {
+	if err := a.authorizeAdmin(call.Ctx, 3); err != nil {
+		return err
+	}
+	subjectID, err := call.Params.SubjectId()
+	if err != nil {
+		return err
+	}
+	if a.subjects == nil {
+		return SubjectNotFoundError(a.ca.service.ID(), domain.SubjectId(subjectID))
+	}
+	subject, err := a.subjects(domain.SubjectId(subjectID))
+	if err != nil {
+		return err
+	}
+	if subject == nil {
+		return SubjectNotFoundError(a.ca.service.ID(), domain.SubjectId(subjectID))
+	}
+	source, err := a.ca.IssueClientCert(app.DomainID(call.Params.DomainId()), app.AppID(call.Params.AppId()), subject)
+	if err != nil {
+		return err
+	}
+	certs, err := call.Results.NewCerts()
+	if err != nil {
+		return err
+	}
+	return certSourceToCapnp(source, certs)
+}
+
+// RenewCert renews the caller's own cert, i.e., the caller CN must match the cert subject CN
+func (a pkiServer) RenewCert(call PKI_renewCert) error {
+	identity := opnet.PeerIdentityFromContext(call.Ctx)
+	if identity == nil {
+		return opnet.UnauthorizedError(a.ca.service.ID(), opnet.MethodOperation(PKI_TypeID, 4))
+	}
+	serial, err := call.Params.Serial()
+	if err != nil {
+		return err
+	}
+	cn, err := a.ca.certCN(serial)
+	if err != nil {
+		return err
+	}
+	if identity.CN != cn {
+		return opnet.UnauthorizedError(a.ca.service.ID(), opnet.MethodOperation(PKI_TypeID, 4))
+	}
+	source, err := a.ca.RenewCert(serial)
+	if err != nil {
+		return err
+	}
+	certs, err := call.Results.NewCerts()
+	if err != nil {
+		return err
+	}
+	return certSourceToCapnp(source, certs)
+}
+
+func (a pkiServer) RevokeCert(call PKI_revokeCert) error {
+	if err := a.authorizeAdmin(call.Ctx, 5); err != nil {
+		return err
+	}
+	serial, err := call.Params.Serial()
+	if err != nil {
+		return err
+	}
+	return a.ca.RevokeCert(serial)
+}
+
+func (a pkiServer) Crl(call PKI_crl) error {
+	crl, err := a.ca.CRL(app.DomainID(call.Params.DomainId()), app.AppID(call.Params.AppId()))
+	if err != nil {
+		return err
+	}
+	return call.Results.SetCrl(crl)
+}
+
+func (a pkiServer) RevokedSerials(call PKI_revokedSerials) error {
+	serials := a.ca.RevokedSerials()
+	list, err := call.Results.NewSerials(int32(len(serials)))
+	if err != nil {
+		return err
+	}
+	for i, serial := range serials {
+		if err := list.Set(i, serial); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// authorizeAdmin denies the method unless the call Context has an Authorizer that allows it. The admin methods issue and
+// revoke certs for any subject, i.e., they are never allowed by default.
+func (a pkiServer) authorizeAdmin(ctx context.Context, methodID uint16) error {
+	op := opnet.MethodOperation(PKI_TypeID, methodID)
+	if opnet.AuthorizerFromContext(ctx) == nil {
+		return opnet.UnauthorizedError(a.ca.service.ID(), op)
+	}
+	if err := opnet.Authorize(ctx, a.ca.service.ID(), op); err != nil {
+		return err
+	}
+	return nil
+}
+
+func certSourceToCapnp(source *opnet.CertSource, certs CertSource) error {
+	if err := certs.SetCert(source.Cert); err != nil {
+		return err
+	}
+	if err := certs.SetKey(source.Key); err != nil {
+		return err
+	}
+	if err := certs.SetCaCert(source.CACert); err != nil {
+		return err
+	}
+	if err := certs.SetCrl(source.CRL); err != nil {
+		return err
+	}
+	return certs.SetDeniedSerials(source.DeniedSerials)
+}
+
+func textListToStrings(list capnp.TextList) ([]string, error) {
+	values := make([]string, list.Len())
+	for i := range values {
+		value, err := list.At(i)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
@@ -108,9 +108,9 @@ type CommandServer struct {
 
 // Start starts the server's main goroutine, i.e., the command event loop.
 //
-// 1. Log SERVICE_STARTING event
+// 1. Log and publish the SERVICE_STARTING event
 // 2. Init()
-// 3. Log the SERVICE_STARTED event
+// 3. Log and publish the SERVICE_STARTED event
 // 4. Run the command event loop until the service kill signal is received
 // 5. Destroy()
 //
@@ -153,6 +153,7 @@ func (a *CommandServer) starting() {
 		evt.Str("cmdsvr", a.Name)
 	}
 	evt.Msg("starting")
+	publishServiceEvent(SERVICE_STARTING, a.Service, nil)
 }
 
 func (a *CommandServer) started() {
@@ -161,4 +162,5 @@ func (a *CommandServer) started() {
 		evt.Str("cmdsvr", a.Name)
 	}
 	evt.Msg("started")
+	publishServiceEvent(SERVICE_STARTED, a.Service, nil)
 }
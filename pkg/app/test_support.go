@@ -43,6 +43,7 @@ func Reset() {
 	initHealthCheckService()
 
 	APP_RESET.Log(logger.Info()).Msg("reset")
+	publishAppEvent(APP_RESET)
 }
 
 func ResetWithConfigDir(configDir string) {
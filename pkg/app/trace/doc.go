@@ -0,0 +1,30 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package trace provides workflow tracing across command pipelines, net.Server connections, capnp RPC calls, and event messaging.
+//
+// The trace context (trace id, span id) is carried by the context.Context - see StartSpan() and FromContext().
+// It is propagated across process boundaries via :
+//	- message.Message - see InjectMessage() and ExtractMessage()
+//	- messaging.Message.Data - see InjectData() and ExtractData(). The messaging API is not context aware, i.e., the trace
+//	  context is only propagated by publishers that inject it, e.g., the eventbridge.Bridge NATS event messages.
+//	- capnp RPC - see ServerMethods() and Client()
+//
+// Finished spans are sent to the registered Exporter - see SetExporter(). If no exporter is registered, then spans are not
+// recorded, but the trace context is still propagated.
+//
+// Exporters :
+//	- InMemoryExporter - for testing purposes
+//	- OTLPExporter - exports spans in OTLP/JSON format to a file or to an OTLP/HTTP collector endpoint
+package trace
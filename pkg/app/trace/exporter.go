@@ -0,0 +1,83 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"sync"
+)
+
+// Exporter is where finished spans are sent.
+// ExportSpan is called on the goroutine that finished the span, thus it must be concurrency safe, and it should not block.
+type Exporter interface {
+	ExportSpan(span *Span)
+}
+
+var (
+	exporterMutex sync.RWMutex
+	exporter      Exporter
+)
+
+// SetExporter registers the exporter, replacing the currently registered exporter.
+// If nil, then spans are not exported.
+func SetExporter(e Exporter) {
+	exporterMutex.Lock()
+	defer exporterMutex.Unlock()
+	exporter = e
+}
+
+func registeredExporter() Exporter {
+	exporterMutex.RLock()
+	defer exporterMutex.RUnlock()
+	return exporter
+}
+
+// InMemoryExporter collects the spans in memory. It is meant to be used for testing purposes.
+type InMemoryExporter struct {
+	mutex sync.Mutex
+	spans []*Span
+}
+
+func (a *InMemoryExporter) ExportSpan(span *Span) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.spans = append(a.spans, span)
+}
+
+// Spans returns the exported spans in the order they were finished
+func (a *InMemoryExporter) Spans() []*Span {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	spans := make([]*Span, len(a.spans))
+	copy(spans, a.spans)
+	return spans
+}
+
+// Trace returns the exported spans for the specified trace
+func (a *InMemoryExporter) Trace(traceID TraceID) []*Span {
+	spans := []*Span{}
+	for _, span := range a.Spans() {
+		if span.TraceID == traceID {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+// Reset clears the exported spans
+func (a *InMemoryExporter) Reset() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.spans = nil
+}
@@ -0,0 +1,46 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"github.com/oysterpack/oysterpack.go/pkg/app"
+	"github.com/rs/zerolog"
+)
+
+const (
+	SPAN_EXPORT_FAILED = app.LogEventID(0xb6a2203851f23b3d)
+	SPANS_DROPPED      = app.LogEventID(0x8476d88cf2028c2d)
+)
+
+func init() {
+	destField := app.LogEventField{Name: "dest", Type: app.LogFieldType_STRING, Description: "OTLP destination"}
+
+	app.LogEvents.Register(
+		app.LogEventSpec{ID: SPAN_EXPORT_FAILED, Name: "SPAN_EXPORT_FAILED", Level: zerolog.ErrorLevel, Description: "a batch of spans failed to be written to the OTLP destination",
+			Fields: []app.LogEventField{destField, {Name: "spans", Type: app.LogFieldType_INT, Description: "number of spans in the batch"}, app.ErrLogEventField}},
+		app.LogEventSpec{ID: SPANS_DROPPED, Name: "SPANS_DROPPED", Level: zerolog.WarnLevel, Description: "spans were dropped because the exporter queue was full",
+			Fields: []app.LogEventField{destField, {Name: "dropped", Type: app.LogFieldType_INT, Description: "number of spans dropped"}}},
+	)
+}
+
+// spanExportFailed is logged when a batch of spans failed to be written to the OTLP destination
+func spanExportFailed(destination string, spans int, err error) {
+	SPAN_EXPORT_FAILED.Log(app.Logger().Error()).Str("dest", destination).Int("spans", spans).Err(err).Msg("span export failed")
+}
+
+// spansDropped is logged when spans are dropped because the exporter queue was full
+func spansDropped(destination string, dropped uint64) {
+	SPANS_DROPPED.Log(app.Logger().Warn()).Str("dest", destination).Uint64("dropped", dropped).Msg("spans dropped")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+)
+
+// InjectMessage adds the Context's SpanContext to the message. If the message correlation id is not set, then it is set
+// to the trace id. If the Context does not carry a SpanContext, then the message is not modified.
+func InjectMessage(ctx context.Context, msg *message.Message) error {
+	spanContext, ok := FromContext(ctx)
+	if !ok || !spanContext.IsValid() {
+		return nil
+	}
+	trace, err := msg.NewTrace()
+	if err != nil {
+		return err
+	}
+	trace.SetTraceID(spanContext.TraceID.UInt64())
+	trace.SetSpanID(spanContext.SpanID.UInt64())
+	trace.SetParentSpanID(spanContext.ParentSpanID.UInt64())
+	if msg.CorrelationID() == 0 {
+		msg.SetCorrelationID(spanContext.TraceID.UInt64())
+	}
+	return nil
+}
+
+// ExtractMessage returns a new Context with the message's SpanContext. If the message does not carry a trace, then the
+// Context is returned as is.
+func ExtractMessage(ctx context.Context, msg *message.Message) context.Context {
+	if !msg.HasTrace() {
+		return ctx
+	}
+	trace, err := msg.Trace()
+	if err != nil || trace.TraceID() == 0 {
+		return ctx
+	}
+	return WithSpanContext(ctx, SpanContext{
+		TraceID:      TraceID(trace.TraceID()),
+		SpanID:       SpanID(trace.SpanID()),
+		ParentSpanID: SpanID(trace.ParentSpanID()),
+	})
+}
+
+// trace envelope format : [magic][trace id][span id][parent span id][data]
+// all ids are encoded as big endian uint64
+var envelopeMagic = []byte{'o', 'p', 't', 1}
+
+const envelopeHeaderSize = 4 + 8*3
+
+// InjectData wraps the data in a trace envelope carrying the Context's SpanContext. It is used to propagate the trace
+// context via messaging.Message.Data, e.g., for NATS messages which do not support headers.
+// If the Context does not carry a SpanContext, then the data is returned as is.
+func InjectData(ctx context.Context, data []byte) []byte {
+	spanContext, ok := FromContext(ctx)
+	if !ok || !spanContext.IsValid() {
+		return data
+	}
+	envelope := make([]byte, envelopeHeaderSize+len(data))
+	copy(envelope, envelopeMagic)
+	binary.BigEndian.PutUint64(envelope[4:], spanContext.TraceID.UInt64())
+	binary.BigEndian.PutUint64(envelope[12:], spanContext.SpanID.UInt64())
+	binary.BigEndian.PutUint64(envelope[20:], spanContext.ParentSpanID.UInt64())
+	copy(envelope[envelopeHeaderSize:], data)
+	return envelope
+}
+
+// ExtractData unwraps the trace envelope, returning a new Context with the envelope's SpanContext, along with the
+// original data. If the data is not wrapped in a trace envelope, then the Context and data are returned as is.
+func ExtractData(ctx context.Context, data []byte) (context.Context, []byte) {
+	if len(data) < envelopeHeaderSize || !bytes.Equal(data[:4], envelopeMagic) {
+		return ctx, data
+	}
+	spanContext := SpanContext{
+		TraceID:      TraceID(binary.BigEndian.Uint64(data[4:])),
+		SpanID:       SpanID(binary.BigEndian.Uint64(data[12:])),
+		ParentSpanID: SpanID(binary.BigEndian.Uint64(data[20:])),
+	}
+	return WithSpanContext(ctx, spanContext), data[envelopeHeaderSize:]
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// max number of spans that are exported per request
+	DEFAULT_OTLP_BATCH_SIZE = 512
+	// max number of spans that are buffered waiting to be exported - once full, spans are dropped
+	DEFAULT_OTLP_QUEUE_SIZE = 2048
+	// how often buffered spans are exported
+	DEFAULT_OTLP_FLUSH_INTERVAL = 5 * time.Second
+
+	// OTLP status code for spans that failed
+	otlp_status_code_error = 2
+	// OTLP/HTTP trace endpoint path
+	otlp_http_traces_path = "/v1/traces"
+)
+
+// OTLPExporter exports spans in OTLP/JSON format, i.e., as ExportTraceServiceRequest messages. Spans are buffered and exported
+// in batches by a background goroutine. If the buffer is full, then spans are dropped.
+//
+// NOTE: TraceID is 64 bits, but OTLP trace ids are 128 bits. Thus, the trace id is exported zero-padded.
+type OTLPExporter struct {
+	// set as the "service.name" resource attribute
+	ServiceName string
+
+	destination string
+	write       func(payload []byte) error
+	close       func() error
+
+	batchSize     int
+	flushInterval time.Duration
+	spans         chan *Span
+	dropped       uint64
+
+	done      chan struct{}
+	closeOnce sync.Once
+	wait      sync.WaitGroup
+}
+
+// NewOTLPFileExporter returns an exporter that appends the span batches to the specified file, one JSON encoded
+// ExportTraceServiceRequest per line.
+func NewOTLPFileExporter(serviceName, path string) (*OTLPExporter, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	write := func(payload []byte) error {
+		_, err := f.Write(append(payload, '\n'))
+		return err
+	}
+	return newOTLPExporter(serviceName, path, write, f.Close), nil
+}
+
+// NewOTLPHTTPExporter returns an exporter that POSTs the span batches to an OTLP/HTTP collector, e.g., http://localhost:4318
+func NewOTLPHTTPExporter(serviceName, endpoint string) *OTLPExporter {
+	url := strings.TrimSuffix(endpoint, "/") + otlp_http_traces_path
+	client := &http.Client{Timeout: 10 * time.Second}
+	write := func(payload []byte) error {
+		response, err := client.Post(url, "application/json", bytes.NewReader(payload))
+		if err != nil {
+			return err
+		}
+		response.Body.Close()
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			return fmt.Errorf("OTLP collector returned status : %s", response.Status)
+		}
+		return nil
+	}
+	return newOTLPExporter(serviceName, url, write, nil)
+}
+
+func newOTLPExporter(serviceName, destination string, write func([]byte) error, close func() error) *OTLPExporter {
+	exporter := &OTLPExporter{
+		ServiceName:   serviceName,
+		destination:   destination,
+		write:         write,
+		close:         close,
+		batchSize:     DEFAULT_OTLP_BATCH_SIZE,
+		flushInterval: DEFAULT_OTLP_FLUSH_INTERVAL,
+		spans:         make(chan *Span, DEFAULT_OTLP_QUEUE_SIZE),
+		done:          make(chan struct{}),
+	}
+	exporter.wait.Add(1)
+	go exporter.run()
+	return exporter
+}
+
+// ExportSpan buffers the span for export. If the buffer is full, then the span is dropped.
+func (a *OTLPExporter) ExportSpan(span *Span) {
+	select {
+	case a.spans <- span:
+	default:
+		atomic.AddUint64(&a.dropped, 1)
+	}
+}
+
+// Close exports the buffered spans, and then releases the exporter's resources
+func (a *OTLPExporter) Close() error {
+	var err error
+	a.closeOnce.Do(func() {
+		close(a.done)
+		a.wait.Wait()
+		if a.close != nil {
+			err = a.close()
+		}
+	})
+	return err
+}
+
+func (a *OTLPExporter) run() {
+	defer a.wait.Done()
+	ticker := time.NewTicker(a.flushInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, a.batchSize)
+	for {
+		select {
+		case <-a.done:
+			// drain the buffer
+			for {
+				select {
+				case span := <-a.spans:
+					batch = append(batch, span)
+					if len(batch) == a.batchSize {
+						batch = a.flush(batch)
+					}
+				default:
+					a.flush(batch)
+					return
+				}
+			}
+		case span := <-a.spans:
+			batch = append(batch, span)
+			if len(batch) == a.batchSize {
+				batch = a.flush(batch)
+			}
+		case <-ticker.C:
+			batch = a.flush(batch)
+		}
+	}
+}
+
+// flush exports the batch, and returns the batch reset for reuse
+func (a *OTLPExporter) flush(batch []*Span) []*Span {
+	if dropped := atomic.SwapUint64(&a.dropped, 0); dropped > 0 {
+		spansDropped(a.destination, dropped)
+	}
+	if len(batch) == 0 {
+		return batch
+	}
+	payload, err := json.Marshal(a.exportTraceServiceRequest(batch))
+	if err == nil {
+		err = a.write(payload)
+	}
+	if err != nil {
+		spanExportFailed(a.destination, len(batch), err)
+	}
+	return batch[:0]
+}
+
+func (a *OTLPExporter) exportTraceServiceRequest(spans []*Span) otlpExportTraceServiceRequest {
+	otlpSpans := make([]otlpSpan, len(spans))
+	for i, span := range spans {
+		otlpSpans[i] = newOTLPSpan(span)
+	}
+	return otlpExportTraceServiceRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpKeyValue{newOTLPKeyValue("service.name", a.ServiceName)},
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "github.com/oysterpack/oysterpack.go/pkg/app/trace"},
+						Spans: otlpSpans,
+					},
+				},
+			},
+		},
+	}
+}
+
+// OTLP/JSON message types - see opentelemetry/proto/collector/trace/v1/trace_service.proto
+
+type otlpExportTraceServiceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              uint8          `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+func newOTLPKeyValue(key, value string) otlpKeyValue {
+	return otlpKeyValue{Key: key, Value: otlpAnyValue{StringValue: value}}
+}
+
+func newOTLPSpan(span *Span) otlpSpan {
+	otlpSpan := otlpSpan{
+		TraceID:           fmt.Sprintf("%032x", span.TraceID),
+		SpanID:            fmt.Sprintf("%016x", span.SpanID),
+		Name:              span.Name,
+		Kind:              span.Kind.UInt8(),
+		StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+	}
+	if span.ParentSpanID != 0 {
+		otlpSpan.ParentSpanID = fmt.Sprintf("%016x", span.ParentSpanID)
+	}
+	attributes := span.Attributes()
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		otlpSpan.Attributes = append(otlpSpan.Attributes, newOTLPKeyValue(key, attributes[key]))
+	}
+	if err := span.Err(); err != nil {
+		otlpSpan.Status = &otlpStatus{Code: otlp_status_code_error, Message: err.Error()}
+	}
+	return otlpSpan
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"fmt"
+
+	"zombiezen.com/go/capnproto2"
+	"zombiezen.com/go/capnproto2/server"
+)
+
+// ServerMethods wraps the capnp RPC server methods, producing a SERVER span per method call. The span is named
+// {InterfaceName}.{MethodName}.
+//
+// Example:
+//
+//	client := server.New(trace.ServerMethods(capnprpc.App_Methods(nil, apprpc.NewAppServer())), nil)
+//
+// NOTE: capnp RPC calls do not carry the trace context over the wire. Thus, server spans are linked to the client trace
+// only when the call is made within the same process.
+func ServerMethods(methods []server.Method) []server.Method {
+	traced := make([]server.Method, len(methods))
+	for i, method := range methods {
+		traced[i] = tracedServerMethod(method)
+	}
+	return traced
+}
+
+func tracedServerMethod(method server.Method) server.Method {
+	impl := method.Impl
+	name := rpcSpanName(method.Method)
+	method.Impl = func(ctx context.Context, options capnp.CallOptions, params, results capnp.Struct) error {
+		ctx, span := StartSpan(ctx, name, SpanKind_SERVER)
+		err := impl(ctx, options, params, results)
+		span.Finish(err)
+		return err
+	}
+	return method
+}
+
+// Client wraps the capnp RPC client, producing a CLIENT span per call. The span is finished when the call's answer resolves.
+func Client(client capnp.Client) capnp.Client {
+	return tracedClient{client}
+}
+
+type tracedClient struct {
+	capnp.Client
+}
+
+func (a tracedClient) Call(call *capnp.Call) capnp.Answer {
+	ctx := call.Ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, span := StartSpan(ctx, rpcSpanName(call.Method), SpanKind_CLIENT)
+	tracedCall := *call
+	tracedCall.Ctx = ctx
+	answer := a.Client.Call(&tracedCall)
+	go func() {
+		_, err := answer.Struct()
+		span.Finish(err)
+	}()
+	return answer
+}
+
+func rpcSpanName(method capnp.Method) string {
+	return fmt.Sprintf("%s.%s", method.InterfaceName, method.MethodName)
+}
@@ -0,0 +1,207 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/uid"
+)
+
+// TraceID identifies a workflow across processes
+type TraceID uint64
+
+func (a TraceID) UInt64() uint64 {
+	return uint64(a)
+}
+
+func (a TraceID) Hex() string {
+	return fmt.Sprintf("%x", a)
+}
+
+// SpanID identifies a unit of work within a trace
+type SpanID uint64
+
+func (a SpanID) UInt64() uint64 {
+	return uint64(a)
+}
+
+func (a SpanID) Hex() string {
+	return fmt.Sprintf("%x", a)
+}
+
+func nextSpanID() SpanID {
+	return SpanID(uid.NextUIDHash())
+}
+
+// NewTraceID returns a new random TraceID
+func NewTraceID() TraceID {
+	return TraceID(uid.NextUIDHash())
+}
+
+// SpanContext is the trace context that is propagated, i.e., it identifies the current span.
+type SpanContext struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+}
+
+// IsValid returns true if the trace id is set
+func (a SpanContext) IsValid() bool {
+	return a.TraceID != 0
+}
+
+// SpanContext
+type ctx_span_context struct{}
+
+// FromContext returns the Context's current SpanContext
+func FromContext(ctx context.Context) (spanContext SpanContext, ok bool) {
+	spanContext, ok = ctx.Value(ctx_span_context{}).(SpanContext)
+	return
+}
+
+// WithSpanContext returns a new Context with the SpanContext as the current span. It is used to continue a trace that
+// was started elsewhere, e.g., in another process or pipeline.
+func WithSpanContext(ctx context.Context, spanContext SpanContext) context.Context {
+	return context.WithValue(ctx, ctx_span_context{}, spanContext)
+}
+
+// SpanKind maps 1:1 to the OTLP span kind
+type SpanKind uint8
+
+func (a SpanKind) UInt8() uint8 {
+	return uint8(a)
+}
+
+func (a SpanKind) String() string {
+	switch a {
+	case SpanKind_INTERNAL:
+		return "internal"
+	case SpanKind_SERVER:
+		return "server"
+	case SpanKind_CLIENT:
+		return "client"
+	case SpanKind_PRODUCER:
+		return "producer"
+	case SpanKind_CONSUMER:
+		return "consumer"
+	default:
+		return "unknown"
+	}
+}
+
+// SpanKind enum values
+const (
+	// work done within the process, e.g., a pipeline stage
+	SpanKind_INTERNAL = SpanKind(iota + 1)
+	// handling a remote request, e.g., a net.Server conn or RPC method
+	SpanKind_SERVER
+	// a remote request, e.g., an RPC call
+	SpanKind_CLIENT
+	// a message that is published
+	SpanKind_PRODUCER
+	// a message that is received
+	SpanKind_CONSUMER
+)
+
+// Span represents a unit of work within a trace.
+type Span struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	Name         string
+	Kind         SpanKind
+	Start        time.Time
+	End          time.Time
+
+	mutex      sync.Mutex
+	attributes map[string]string
+	err        error
+	finished   bool
+}
+
+// SpanContext returns the span's context, which is what is propagated
+func (a *Span) SpanContext() SpanContext {
+	return SpanContext{a.TraceID, a.SpanID, a.ParentSpanID}
+}
+
+// SetAttribute adds an attribute to the span
+func (a *Span) SetAttribute(key, value string) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.attributes == nil {
+		a.attributes = make(map[string]string)
+	}
+	a.attributes[key] = value
+}
+
+// Attributes returns a copy of the span attributes
+func (a *Span) Attributes() map[string]string {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	attributes := make(map[string]string, len(a.attributes))
+	for k, v := range a.attributes {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+// Err returns the error that the span was finished with
+func (a *Span) Err() error {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	return a.err
+}
+
+// Finish records the span end time, and then exports the span. If the span failed, then err should be non-nil.
+// Only the first call is recorded.
+func (a *Span) Finish(err error) {
+	a.mutex.Lock()
+	if a.finished {
+		a.mutex.Unlock()
+		return
+	}
+	a.finished = true
+	a.End = time.Now()
+	a.err = err
+	a.mutex.Unlock()
+
+	if exporter := registeredExporter(); exporter != nil {
+		exporter.ExportSpan(a)
+	}
+}
+
+// StartSpan starts a new span, and returns a new Context with the span as the current span. The span must be finished
+// via Span.Finish().
+//
+// If the Context carries a SpanContext, then the span is a child span. Otherwise, a new trace is started.
+func StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	span := &Span{
+		SpanID: nextSpanID(),
+		Name:   name,
+		Kind:   kind,
+		Start:  time.Now(),
+	}
+	if parent, ok := FromContext(ctx); ok && parent.IsValid() {
+		span.TraceID = parent.TraceID
+		span.ParentSpanID = parent.SpanID
+	} else {
+		span.TraceID = NewTraceID()
+	}
+	return WithSpanContext(ctx, span.SpanContext()), span
+}
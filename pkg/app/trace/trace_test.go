@@ -0,0 +1,191 @@
+// Copyright (c) 2017 OysterPack, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package trace_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oysterpack/oysterpack.go/pkg/app/message"
+	"github.com/oysterpack/oysterpack.go/pkg/app/trace"
+	"zombiezen.com/go/capnproto2"
+)
+
+func TestStartSpan(t *testing.T) {
+	exporter := &trace.InMemoryExporter{}
+	trace.SetExporter(exporter)
+	defer trace.SetExporter(nil)
+
+	ctx, root := trace.StartSpan(context.Background(), "root", trace.SpanKind_SERVER)
+	_, child := trace.StartSpan(ctx, "child", trace.SpanKind_INTERNAL)
+	child.SetAttribute("a", "1")
+	child.Finish(errors.New("BOOM"))
+	root.Finish(nil)
+	root.Finish(errors.New("finishing twice is a no-op"))
+
+	spans := exporter.Trace(root.TraceID)
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans : %v", spans)
+	}
+	if spans[0] != child || spans[1] != root {
+		t.Errorf("Spans should be exported in the order they were finished : %v", spans)
+	}
+	if child.TraceID != root.TraceID || child.ParentSpanID != root.SpanID || root.ParentSpanID != 0 {
+		t.Errorf("child span is not linked to the root span : %v %v", root.SpanContext(), child.SpanContext())
+	}
+	if child.Err() == nil || root.Err() != nil {
+		t.Errorf("span errors were not recorded properly : %v %v", child.Err(), root.Err())
+	}
+	if child.Attributes()["a"] != "1" {
+		t.Errorf("attribute was not recorded : %v", child.Attributes())
+	}
+
+	spanContext, ok := trace.FromContext(ctx)
+	if !ok || spanContext != root.SpanContext() {
+		t.Errorf("Context span context does not match : %v", spanContext)
+	}
+
+	exporter.Reset()
+	if len(exporter.Spans()) != 0 {
+		t.Error("exporter was not reset")
+	}
+}
+
+func TestInjectMessage(t *testing.T) {
+	ctx, span := trace.StartSpan(context.Background(), "request", trace.SpanKind_CLIENT)
+
+	_, seg, err := capnp.NewMessage(capnp.SingleSegment(nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := message.NewRootMessage(seg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if extracted := trace.ExtractMessage(context.Background(), &msg); extracted != context.Background() {
+		t.Error("The context should not be modified if the message has no trace")
+	}
+
+	if err := trace.InjectMessage(ctx, &msg); err != nil {
+		t.Fatal(err)
+	}
+	if msg.CorrelationID() != span.TraceID.UInt64() {
+		t.Errorf("correlation id should be set to the trace id : %x != %x", msg.CorrelationID(), span.TraceID)
+	}
+
+	spanContext, ok := trace.FromContext(trace.ExtractMessage(context.Background(), &msg))
+	if !ok || spanContext != span.SpanContext() {
+		t.Errorf("extracted span context does not match : %v != %v", spanContext, span.SpanContext())
+	}
+}
+
+func TestInjectData(t *testing.T) {
+	data := []byte("data")
+	if envelope := trace.InjectData(context.Background(), data); string(envelope) != string(data) {
+		t.Error("data should not be wrapped if the context has no trace")
+	}
+
+	ctx, span := trace.StartSpan(context.Background(), "publish", trace.SpanKind_PRODUCER)
+	envelope := trace.InjectData(ctx, data)
+	ctx, unwrapped := trace.ExtractData(context.Background(), envelope)
+	if string(unwrapped) != string(data) {
+		t.Errorf("data does not match : %q", unwrapped)
+	}
+	spanContext, ok := trace.FromContext(ctx)
+	if !ok || spanContext != span.SpanContext() {
+		t.Errorf("extracted span context does not match : %v != %v", spanContext, span.SpanContext())
+	}
+
+	if _, unwrapped := trace.ExtractData(context.Background(), data); string(unwrapped) != string(data) {
+		t.Errorf("data without an envelope should be returned as is : %q", unwrapped)
+	}
+}
+
+func TestOTLPFileExporter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "trace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "spans.json")
+	exporter, err := trace.NewOTLPFileExporter("test", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	trace.SetExporter(exporter)
+	defer trace.SetExporter(nil)
+
+	ctx, root := trace.StartSpan(context.Background(), "root", trace.SpanKind_SERVER)
+	_, child := trace.StartSpan(ctx, "child", trace.SpanKind_INTERNAL)
+	child.Finish(errors.New("BOOM"))
+	root.Finish(nil)
+	if err := exporter.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	type span struct {
+		TraceID      string `json:"traceId"`
+		SpanID       string `json:"spanId"`
+		ParentSpanID string `json:"parentSpanId"`
+		Name         string `json:"name"`
+		Kind         int    `json:"kind"`
+		Status       *struct {
+			Code int `json:"code"`
+		} `json:"status"`
+	}
+	request := struct {
+		ResourceSpans []struct {
+			ScopeSpans []struct {
+				Spans []span `json:"spans"`
+			} `json:"scopeSpans"`
+		} `json:"resourceSpans"`
+	}{}
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		t.Fatal("no spans were exported")
+	}
+	if err := json.Unmarshal(scanner.Bytes(), &request); err != nil {
+		t.Fatal(err)
+	}
+	spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans : %v", spans)
+	}
+	if len(spans[0].TraceID) != 32 || len(spans[0].SpanID) != 16 {
+		t.Errorf("OTLP ids are not properly formatted : %v", spans[0])
+	}
+	if spans[0].ParentSpanID != spans[1].SpanID || spans[0].TraceID != spans[1].TraceID {
+		t.Errorf("child span is not linked to the root span : %v", spans)
+	}
+	if spans[0].Status == nil || spans[0].Status.Code != 2 || spans[1].Status != nil {
+		t.Errorf("span status is wrong : %v", spans)
+	}
+	if spans[1].Kind != int(trace.SpanKind_SERVER) {
+		t.Errorf("span kind is wrong : %v", spans[1])
+	}
+}